@@ -0,0 +1,225 @@
+// Package jtk implements a small UI toolkit on top of Wayland.
+//
+// All windows, event handlers and timers belong to an Application, and run
+// on the goroutine that calls Application.Run. Other goroutines must not use
+// them directly; instead, they can use Application.Invoke to run a function on
+// the application goroutine.
+package jtk
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/jchv/jtk/draw"
+	"github.com/jchv/jtk/internal/wayland"
+	"github.com/jchv/jtk/xcursor"
+)
+
+// Application manages a connection to the Wayland compositor, along with the
+// global objects shared by windows, and runs the event loop.
+type Application struct {
+	conn       *wayland.Display
+	compositor *wayland.WlCompositor
+	shm        *wayland.WlShm
+	wmBase     *wayland.XdgWmBase
+	poller     *poller
+	seat       *seat
+	outputs    []*Output
+	windows    []*Window
+
+	// scale is the scale set with the JTK_SCALE environment variable, or
+	// zero.
+	scale float64
+
+	// subcompositor is used for client-side decorations, if supported.
+	subcompositor *wayland.WlSubcompositor
+
+	// pointerTargets maps surfaces to what handles pointer events on them.
+	pointerTargets map[wayland.ObjectID]pointerTarget
+
+	font       *draw.Font
+	fontLoaded bool
+
+	cursorTheme *xcursor.Theme
+
+	// wpPresentation is nil if the compositor does not support it.
+	wpPresentation    *wayland.WpPresentation
+	presentationClock uint32
+
+	// err is the first error that occurred while handling an event, which
+	// ends Run.
+	err error
+
+	mu     sync.Mutex
+	queue  []func()
+	timers timerHeap
+	quit   bool
+}
+
+// NewApplication connects to a Wayland display. If display is empty, the
+// WAYLAND_DISPLAY environment variable is used.
+func NewApplication(display string) (*Application, error) {
+	conn, err := wayland.Connect(display)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to Wayland compositor: %w", err)
+	}
+	return newApplication(conn)
+}
+
+// newApplication returns an application using an established connection.
+func newApplication(conn *wayland.Display) (*Application, error) {
+	app := &Application{
+		conn:           conn,
+		pointerTargets: make(map[wayland.ObjectID]pointerTarget),
+	}
+	if scale, err := strconv.ParseFloat(os.Getenv("JTK_SCALE"), 64); err == nil && scale > 0 {
+		app.scale = scale
+	}
+
+	if err := app.init(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return app, nil
+}
+
+func (app *Application) init() error {
+	rawconn, err := app.conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var pollerErr error
+	if err := rawconn.Control(func(fd uintptr) {
+		app.poller, pollerErr = newPoller(int(fd))
+	}); err != nil {
+		return err
+	} else if pollerErr != nil {
+		return pollerErr
+	}
+
+	// Until Run is called, binding globals dispatches events on the calling
+	// goroutine.
+	globals := app.conn.Globals()
+	if app.compositor = globals.WlCompositor(); app.compositor == nil {
+		app.poller.close()
+		return errors.New("compositor does not support wl_compositor")
+	}
+	if app.shm = globals.WlShm(); app.shm == nil {
+		app.poller.close()
+		return errors.New("compositor does not support wl_shm")
+	}
+	if app.wmBase = globals.XdgWmBase(); app.wmBase == nil {
+		app.poller.close()
+		return errors.New("compositor does not support xdg_wm_base")
+	}
+
+	app.conn.RegisterHandler(app.wmBase.ID(), wayland.HandlerFunc(app.handleWmBase))
+
+	app.subcompositor = globals.WlSubcompositor()
+	if s := globals.WlSeat(); s != nil {
+		app.seat = newSeat(app, s)
+	}
+
+	// Outputs come and go; keep track of them through the registry.
+	app.syncOutputs()
+	app.conn.RegisterHandler(globals.Registry().ID(), wayland.HandlerFunc(app.handleRegistry))
+
+	if err := app.initPresentation(); err != nil {
+		app.poller.close()
+		return fmt.Errorf("binding wp_presentation: %w", err)
+	}
+
+	return nil
+}
+
+func (app *Application) handleWmBase(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.XdgWmBasePingEvent:
+		app.wmBase.Pong(app.conn, t.Serial)
+	}
+}
+
+// Run runs the event loop on the calling goroutine until Quit is called, or
+// until the connection or handling an event fails, in which case it returns
+// the error. It closes the connection to the compositor before returning.
+func (app *Application) Run() error {
+	defer app.close()
+
+	for {
+		app.runTimers()
+		app.runQueue()
+		if app.err != nil {
+			return app.err
+		}
+
+		app.mu.Lock()
+		quit := app.quit
+		app.mu.Unlock()
+		if quit {
+			return nil
+		}
+
+		readable, err := app.poller.wait(app.timeout())
+		if err != nil {
+			return err
+		}
+
+		if readable {
+			if err := app.conn.Dispatch(); err != nil {
+				return err
+			}
+			if app.err != nil {
+				return app.err
+			}
+		}
+	}
+}
+
+// fail records an error that occurred while handling an event, where there is
+// no caller to return it to. Run returns the first such error.
+func (app *Application) fail(err error) {
+	if app.err == nil {
+		app.err = err
+	}
+}
+
+func (app *Application) close() {
+	app.conn.Close()
+	app.poller.close()
+}
+
+// Invoke schedules fn to run on the application goroutine. It is safe to call
+// from any goroutine.
+func (app *Application) Invoke(fn func()) {
+	app.mu.Lock()
+	app.queue = append(app.queue, fn)
+	app.mu.Unlock()
+
+	app.poller.wake()
+}
+
+// runQueue runs functions scheduled with Invoke.
+func (app *Application) runQueue() {
+	app.mu.Lock()
+	queue := app.queue
+	app.queue = nil
+	app.mu.Unlock()
+
+	for _, fn := range queue {
+		fn()
+	}
+}
+
+// Quit causes Run to return once the current event has been handled. It is
+// safe to call from any goroutine.
+func (app *Application) Quit() {
+	app.mu.Lock()
+	app.quit = true
+	app.mu.Unlock()
+
+	app.poller.wake()
+}
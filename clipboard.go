@@ -0,0 +1,118 @@
+package jtk
+
+import (
+	"errors"
+	"io"
+	"sort"
+)
+
+// The clipboard is the selection of the seat. Compositors only let a client
+// set it in response to user input, such as a key press, and only tell a
+// client what it holds while one of the client's windows has keyboard focus.
+
+// ErrClipboardUnsupported is returned when using the clipboard with a
+// compositor that does not support wl_data_device_manager.
+var ErrClipboardUnsupported = errors.New("compositor does not support the clipboard")
+
+// ErrClipboardEmpty is returned when reading the clipboard when it holds no
+// data of the requested type.
+var ErrClipboardEmpty = errors.New("clipboard has no data of the requested type")
+
+// SetClipboard puts data on the clipboard, replacing what it held. Data is
+// given as functions that write it in a MIME type, which are called on the
+// application goroutine whenever a client pastes; text written for one of
+// MIMEText, "UTF8_STRING" or "text/plain" is offered under all of them. A nil
+// or empty map clears the clipboard, if it holds data from this application.
+func (app *Application) SetClipboard(types map[string]func(w io.Writer)) error {
+	s := app.seat
+	if s == nil || s.dataDevice == nil {
+		return ErrClipboardUnsupported
+	}
+	conn := app.conn
+
+	if len(types) == 0 {
+		if s.clipboard == nil {
+			return nil
+		}
+		s.destroyDataSource(s.clipboard)
+		s.clipboard = nil
+		return s.dataDevice.SetSelection(conn, nil, s.inputSerial)
+	}
+
+	src, err := s.newDataSource(types)
+	if err != nil {
+		return err
+	}
+	src.cancelled = func() {
+		if s.clipboard == src {
+			s.clipboard = nil
+		}
+	}
+	id := src.source.ID()
+	if err := s.dataDevice.SetSelection(conn, &id, s.inputSerial); err != nil {
+		s.destroyDataSource(src)
+		return err
+	}
+
+	// Setting a new selection cancels the old source, but there's no need
+	// to wait for that.
+	if s.clipboard != nil {
+		s.destroyDataSource(s.clipboard)
+	}
+	s.clipboard = src
+	return nil
+}
+
+// SetClipboardText puts text on the clipboard.
+func (app *Application) SetClipboardText(text string) error {
+	return app.SetClipboard(map[string]func(io.Writer){
+		MIMEText: func(w io.Writer) { io.WriteString(w, text) },
+	})
+}
+
+// ClipboardTypes returns the MIME types of the data on the clipboard.
+func (app *Application) ClipboardTypes() []string {
+	s := app.seat
+	switch {
+	case s == nil:
+		return nil
+	case s.clipboard != nil:
+		types := make([]string, 0, len(s.clipboard.types))
+		for t := range s.clipboard.types {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+		return types
+	case s.selection != nil:
+		return append([]string(nil), s.selection.types...)
+	}
+	return nil
+}
+
+// ReadClipboard returns a reader for the data on the clipboard, in a MIME
+// type. Text may be read as MIMEText whichever name the source offers it
+// under. The reader must be closed.
+//
+// Data from other applications arrives through a pipe as they write it, so it
+// should be read on another goroutine, to keep the event loop running. Data
+// from this application is available immediately.
+func (app *Application) ReadClipboard(mimeType string) (io.ReadCloser, error) {
+	s := app.seat
+	if s == nil || s.dataDevice == nil {
+		return nil, ErrClipboardUnsupported
+	}
+	if s.clipboard != nil {
+		if r, ok := s.clipboard.read(mimeType); ok {
+			return r, nil
+		}
+		return nil, ErrClipboardEmpty
+	}
+	if s.selection == nil {
+		return nil, ErrClipboardEmpty
+	}
+	offered, ok := s.selection.match(mimeType)
+	if !ok {
+		return nil, ErrClipboardEmpty
+	}
+	return s.selection.receive(app.conn, offered)
+}
@@ -0,0 +1,62 @@
+package jtk
+
+import "time"
+
+// fakeClock is a clock whose time only passes when advanced, for testing
+// code that schedules functions.
+type fakeClock struct {
+	now    time.Duration
+	timers []*fakeTimer
+}
+
+// fakeTimer is a function scheduled on a fakeClock.
+type fakeTimer struct {
+	when time.Duration
+	fn   func()
+	dead bool
+}
+
+func (t *fakeTimer) Stop() bool {
+	stopped := !t.dead
+	t.dead = true
+	return stopped
+}
+
+func (c *fakeClock) afterFunc(d time.Duration, fn func()) stopper {
+	t := &fakeTimer{when: c.now + d, fn: fn}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// advance advances the time by d, running the functions that become due in
+// order, each at the time it was scheduled for.
+func (c *fakeClock) advance(d time.Duration) {
+	end := c.now + d
+	for {
+		var next *fakeTimer
+		for _, t := range c.timers {
+			if !t.dead && t.when <= end && (next == nil || t.when < next.when) {
+				next = t
+			}
+		}
+		if next == nil {
+			break
+		}
+		next.dead = true
+		c.now = next.when
+		next.fn()
+	}
+	c.now = end
+}
+
+// pending returns the number of functions that are scheduled and haven't run
+// or been stopped.
+func (c *fakeClock) pending() int {
+	n := 0
+	for _, t := range c.timers {
+		if !t.dead {
+			n++
+		}
+	}
+	return n
+}
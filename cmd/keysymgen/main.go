@@ -0,0 +1,182 @@
+// Package main generates the keysym tables of package xkb from the X11 keysym
+// headers, such as keysymdef.h and XF86keysym.h, from xorgproto. A copy of the
+// headers is kept in third_party/xorgproto, so that the output doesn't depend
+// on what is installed.
+//
+// Each keysym defined as
+//
+//	#define XK_name 0x1234 /* U+5678 DESCRIPTION */
+//
+// becomes a constant named Key followed by the name, an entry in the table of
+// names, and, if it has a Unicode equivalent, an entry in the table of
+// characters. Equivalents in parentheses, which the headers use for legacy
+// keysyms, are used to convert keysyms to characters but not the reverse.
+// Vendor keysyms keep the prefix before XK_, such as XF86 or Sun.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var output = flag.String("o", "keysyms_gen.go", "output file")
+
+var defineRe = regexp.MustCompile(`^#define\s+([a-zA-Z0-9]*)XK_([a-zA-Z_0-9]+)\s+(0x[0-9a-fA-F]+|_EVDEVK\((0x[0-9a-fA-F]+)\))\s*(/\*\s*(\(?)U\+([0-9A-Fa-f]{4,6}))?`)
+
+// evdevBase is the base of keysyms defined with _EVDEVK, which correspond to
+// Linux input event codes.
+const evdevBase = 0x10081000
+
+type keysym struct {
+	name   string
+	value  uint32
+	r      rune
+	legacy bool
+}
+
+func parse(path string) ([]keysym, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var syms []keysym
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		m := defineRe.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		name := m[1] + m[2]
+
+		var value uint64
+		if m[4] != "" {
+			value, err = strconv.ParseUint(m[4][2:], 16, 32)
+			value += evdevBase
+		} else {
+			value, err = strconv.ParseUint(m[3][2:], 16, 32)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+
+		sym := keysym{name: name, value: uint32(value)}
+		if m[7] != "" {
+			r, err := strconv.ParseUint(m[7], 16, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+			sym.r = rune(r)
+			sym.legacy = m[6] == "("
+		}
+		syms = append(syms, sym)
+	}
+	return syms, scanner.Err()
+}
+
+func main() {
+	flag.Parse()
+
+	var syms []keysym
+	seen := map[string]bool{}
+	var sources []string
+	for _, path := range flag.Args() {
+		parsed, err := parse(path)
+		if err != nil {
+			log.Printf("Error: parsing %s: %v", path, err)
+			os.Exit(1)
+		}
+		for _, sym := range parsed {
+			if !seen[sym.name] {
+				seen[sym.name] = true
+				syms = append(syms, sym)
+			}
+		}
+		sources = append(sources, filepath.Base(path))
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// THIS FILE IS GENERATED BY KEYSYMGEN - DO NOT EDIT\n//\n// Generated from %s.\n\n", strings.Join(sources, ", "))
+	fmt.Fprintf(&b, "package xkb\n\n")
+
+	fmt.Fprintf(&b, "// Keysyms, named as in the X11 headers.\nconst (\n")
+	for _, sym := range syms {
+		fmt.Fprintf(&b, "\tKey%s Keysym = %#x\n", sym.name, sym.value)
+	}
+	fmt.Fprintf(&b, ")\n\n")
+
+	// Names, sorted by name for lookups by name.
+	byName := append([]keysym(nil), syms...)
+	sort.Slice(byName, func(i, j int) bool { return byName[i].name < byName[j].name })
+	fmt.Fprintf(&b, "// keysymNames contains every keysym name, sorted by name.\nvar keysymNames = [...]keysymName{\n")
+	for _, sym := range byName {
+		fmt.Fprintf(&b, "\t{%q, Key%s},\n", sym.name, sym.name)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	// Canonical names, the first defined for each keysym, sorted by keysym.
+	var canonical []keysym
+	named := map[uint32]bool{}
+	for _, sym := range syms {
+		if !named[sym.value] {
+			named[sym.value] = true
+			canonical = append(canonical, sym)
+		}
+	}
+	sort.SliceStable(canonical, func(i, j int) bool { return canonical[i].value < canonical[j].value })
+	fmt.Fprintf(&b, "// keysymValues contains the canonical name of each keysym, sorted by\n// keysym.\nvar keysymValues = [...]keysymName{\n")
+	for _, sym := range canonical {
+		fmt.Fprintf(&b, "\t{%q, Key%s},\n", sym.name, sym.name)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	// Characters of keysyms that are not converted arithmetically.
+	var runes []keysym
+	mapped := map[uint32]bool{}
+	for _, sym := range syms {
+		if sym.r == 0 || mapped[sym.value] || isLatin1(sym.value) || isUnicode(sym.value) {
+			continue
+		}
+		mapped[sym.value] = true
+		runes = append(runes, sym)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i].value < runes[j].value })
+	fmt.Fprintf(&b, "// keysymRunes maps keysyms to characters, sorted by keysym. Legacy\n// mappings are only used to convert keysyms to characters.\nvar keysymRunes = [...]keysymRune{\n")
+	for _, sym := range runes {
+		fmt.Fprintf(&b, "\t{%#x, %#x, %t},\n", sym.value, sym.r, sym.legacy)
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Printf("Error: formatting output: %v", err)
+		os.Exit(1)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Printf("Error: %v", err)
+		os.Exit(1)
+	}
+}
+
+// isLatin1 returns whether a keysym is a Latin-1 character, which has the
+// same value as its keysym.
+func isLatin1(ks uint32) bool {
+	return ks >= 0x20 && ks <= 0x7e || ks >= 0xa0 && ks <= 0xff
+}
+
+// isUnicode returns whether a keysym directly encodes a Unicode character.
+func isUnicode(ks uint32) bool {
+	return ks >= 0x01000100 && ks <= 0x0110ffff
+}
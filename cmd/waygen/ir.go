@@ -0,0 +1,180 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// dump writes the model as JSON to filename, or stdout if filename is empty.
+func dump(model *Model, filename string) error {
+	b, err := json.MarshalIndent(model, "", "\t")
+	if err != nil {
+		return fmt.Errorf("encoding protocols: %w", err)
+	}
+	b = append(b, '\n')
+
+	if filename == "" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+
+	if err := os.WriteFile(filename, b, 0644); err != nil {
+		return fmt.Errorf("creating output file %s: %w", filename, err)
+	}
+
+	return nil
+}
+
+// load reads a model previously written by dump.
+func load(filename string) (*Model, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %w", filename, err)
+	}
+
+	model := &Model{}
+	if err := json.Unmarshal(b, model); err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	return model, nil
+}
+
+// diff compares two protocol dumps and writes a line for each added or
+// removed protocol, interface and message, and for each interface version
+// change. It returns true if there were any changes.
+func diff(w io.Writer, oldfile, newfile string) (bool, error) {
+	oldmodel, err := load(oldfile)
+	if err != nil {
+		return false, err
+	}
+
+	newmodel, err := load(newfile)
+	if err != nil {
+		return false, err
+	}
+
+	lines := []string{}
+	report := func(format string, a ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, a...))
+	}
+
+	oldintfs, newintfs := map[string]*Interface{}, map[string]*Interface{}
+	for _, proto := range oldmodel.Protocols {
+		for _, intf := range proto.Interfaces {
+			oldintfs[intf.Name] = intf
+		}
+	}
+	for _, proto := range newmodel.Protocols {
+		for _, intf := range proto.Interfaces {
+			newintfs[intf.Name] = intf
+		}
+	}
+
+	diffnames(protocolnames(oldmodel), protocolnames(newmodel), func(name string, added bool) {
+		report("%s protocol %s", sign(added), name)
+	})
+
+	diffnames(interfacenames(oldintfs), interfacenames(newintfs), func(name string, added bool) {
+		if added {
+			report("+ interface %s (version %d)", name, newintfs[name].Version)
+		} else {
+			report("- interface %s (version %d)", name, oldintfs[name].Version)
+		}
+	})
+
+	for _, name := range interfacenames(newintfs) {
+		oldintf, ok := oldintfs[name]
+		if !ok {
+			continue
+		}
+		newintf := newintfs[name]
+
+		if oldintf.Version != newintf.Version {
+			report("~ interface %s: version %d -> %d", name, oldintf.Version, newintf.Version)
+		}
+
+		diffmessages("request", name, oldintf.Requests, newintf.Requests, report)
+		diffmessages("event", name, oldintf.Events, newintf.Events, report)
+	}
+
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return false, err
+		}
+	}
+
+	return len(lines) > 0, nil
+}
+
+func diffmessages(kind, intf string, oldmsgs, newmsgs []*Message, report func(string, ...interface{})) {
+	oldmap, newmap := map[string]*Message{}, map[string]*Message{}
+	oldnames, newnames := []string{}, []string{}
+	for _, msg := range oldmsgs {
+		oldmap[msg.Name] = msg
+		oldnames = append(oldnames, msg.Name)
+	}
+	for _, msg := range newmsgs {
+		newmap[msg.Name] = msg
+		newnames = append(newnames, msg.Name)
+	}
+
+	diffnames(oldnames, newnames, func(name string, added bool) {
+		if added {
+			report("+ %s %s.%s (since %d)", kind, intf, name, newmap[name].Since)
+		} else {
+			report("- %s %s.%s (since %d)", kind, intf, name, oldmap[name].Since)
+		}
+	})
+}
+
+// diffnames calls fn for each name removed from oldnames, then for each name
+// added in newnames.
+func diffnames(oldnames, newnames []string, fn func(name string, added bool)) {
+	oldset, newset := map[string]bool{}, map[string]bool{}
+	for _, name := range oldnames {
+		oldset[name] = true
+	}
+	for _, name := range newnames {
+		newset[name] = true
+	}
+
+	for _, name := range oldnames {
+		if !newset[name] {
+			fn(name, false)
+		}
+	}
+	for _, name := range newnames {
+		if !oldset[name] {
+			fn(name, true)
+		}
+	}
+}
+
+func protocolnames(model *Model) []string {
+	names := []string{}
+	for _, proto := range model.Protocols {
+		names = append(names, proto.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func interfacenames(intfs map[string]*Interface) []string {
+	names := []string{}
+	for name := range intfs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sign(added bool) string {
+	if added {
+		return "+"
+	}
+	return "-"
+}
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The following datatypes form an intermediate model of the parsed protocols.
+// This model is what templates are executed against; unlike the XML schema
+// types, it contains resolved Go names and types so that templates do not need
+// to reimplement the Wayland to Go mapping.
+
+// Model is the root of the intermediate model.
+type Model struct {
+	// Hash contains a SHA-256 hash over the contents of every input file, in
+	// protocol order.
+	Hash string `json:"-"`
+
+	// Protocols contains all protocols, sorted by name.
+	Protocols []*Protocol `json:"protocols"`
+}
+
+// Protocol is a single Wayland protocol, e.g. xdg_shell.
+type Protocol struct {
+	Name       string       `json:"name"`
+	Source     string       `json:"source"`
+	Copyright  string       `json:"copyright,omitempty"`
+	Interfaces []*Interface `json:"interfaces"`
+}
+
+// Interface is a Wayland interface.
+type Interface struct {
+	Protocol    string      `json:"-"`
+	Name        string      `json:"name"`
+	GoName      string      `json:"-"`
+	Version     int         `json:"version"`
+	Description Description `json:"description"`
+	Enums       []*Enum     `json:"enums"`
+	Requests    []*Message  `json:"requests"`
+	Events      []*Message  `json:"events"`
+}
+
+// DescriptorName returns the name of the interface descriptor variable.
+func (i *Interface) DescriptorName() string {
+	return namegen(i.Name, "descriptor")
+}
+
+// Enum is an enumeration or bitfield within an interface.
+type Enum struct {
+	Name        string      `json:"name"`
+	GoName      string      `json:"-"`
+	Bitfield    bool        `json:"bitfield"`
+	Description Description `json:"description"`
+	Entries     []*Entry    `json:"entries"`
+}
+
+// GoType returns the underlying Go type of the enum. Bitfields are uint; other
+// enums are just int.
+func (e *Enum) GoType() string {
+	if e.Bitfield {
+		return "uint"
+	}
+	return "int"
+}
+
+// Entry is a single enum entry.
+type Entry struct {
+	Name    string `json:"name"`
+	GoName  string `json:"-"`
+	Value   string `json:"-"`
+	Summary string `json:"summary,omitempty"`
+	Since   int    `json:"since"`
+
+	// NumericValue contains Value parsed as an integer.
+	NumericValue uint32 `json:"value"`
+}
+
+// Message is a request or event.
+type Message struct {
+	// Kind is either "request" or "event".
+	Kind        string      `json:"-"`
+	Name        string      `json:"name"`
+	Opcode      int         `json:"opcode"`
+	Since       int         `json:"since"`
+	Description Description `json:"description"`
+	Args        []*Arg      `json:"args"`
+
+	// GoName contains the name of the message struct.
+	GoName string `json:"-"`
+
+	// MethodName contains the name of the proxy method for requests.
+	MethodName string `json:"-"`
+}
+
+// HasProxies returns true if the message creates new proxies, i.e. it has any
+// typed new_id arguments.
+func (m *Message) HasProxies() bool {
+	for _, arg := range m.Args {
+		if arg.IsTypedNewID() {
+			return true
+		}
+	}
+	return false
+}
+
+// Arg is an argument of a request or event.
+type Arg struct {
+	Name      string `json:"name"`
+	GoName    string `json:"-"`
+	Type      string `json:"type"`
+	Interface string `json:"interface,omitempty"`
+	Enum      string `json:"enum,omitempty"`
+	AllowNull bool   `json:"allow_null"`
+	Summary   string `json:"summary,omitempty"`
+
+	// InterfaceGoName contains the Go proxy type for typed new_id arguments.
+	InterfaceGoName string `json:"-"`
+}
+
+// IsNewID returns true for new_id arguments.
+func (a *Arg) IsNewID() bool {
+	return a.Type == "new_id"
+}
+
+// IsUntypedNewID returns true for new_id arguments without an interface. These
+// carry an implied interface name and version on the wire.
+func (a *Arg) IsUntypedNewID() bool {
+	return a.Type == "new_id" && a.Interface == ""
+}
+
+// IsTypedNewID returns true for new_id arguments with an interface, which are
+// turned into proxies by request methods.
+func (a *Arg) IsTypedNewID() bool {
+	return a.Type == "new_id" && a.Interface != ""
+}
+
+// GoType returns the Go type used in message structs and method parameters.
+// Nullable arguments are represented as pointers, with nil corresponding to
+// null on the wire.
+func (a *Arg) GoType() string {
+	null := ""
+	if a.AllowNull {
+		null = "*"
+	}
+
+	switch a.Type {
+	case "int":
+		return "int32"
+	case "uint":
+		return "uint32"
+	case "fixed":
+		return "Fixed"
+	case "object":
+		return null + "ObjectID"
+	case "new_id":
+		return "ObjectID"
+	case "string":
+		return null + "string"
+	case "array":
+		return "[]byte"
+	case "fd":
+		return "FD"
+	}
+	return ""
+}
+
+// Codec returns the suffix of the EventScanner and RequestEmitter methods for
+// the argument, e.g. "Int" for EventScanner.Int and RequestEmitter.PutInt.
+func (a *Arg) Codec() string {
+	null := ""
+	if a.AllowNull {
+		null = "Nullable"
+	}
+
+	switch a.Type {
+	case "int":
+		return "Int"
+	case "uint":
+		return "Uint"
+	case "fixed":
+		return "Fixed"
+	case "object":
+		return null + "ObjectID"
+	case "new_id":
+		return "ObjectID"
+	case "string":
+		return null + "String"
+	case "array":
+		return "Array"
+	case "fd":
+		return "FD"
+	}
+	return ""
+}
+
+// Description is a summary and full text description.
+type Description struct {
+	Summary string `json:"summary,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// model returns the description with normalized whitespace.
+func (d description) model() Description {
+	lines := strings.Split(strings.TrimSpace(d.Text), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	return Description{
+		Summary: strings.TrimSpace(spacesRE.ReplaceAllString(d.Summary, " ")),
+		Text:    strings.Join(lines, "\n"),
+	}
+}
+
+// buildmodel builds the intermediate model from parsed protocols.
+func buildmodel(protos protocols) (*Model, error) {
+	m := &Model{}
+
+	h := sha256.New()
+	for _, proto := range protos {
+		h.Write(proto.Hash[:])
+	}
+	m.Hash = hex.EncodeToString(h.Sum(nil))
+
+	for _, proto := range protos {
+		p := &Protocol{
+			Name:      proto.Name,
+			Source:    proto.Source,
+			Copyright: strings.TrimSpace(proto.Copyright),
+		}
+
+		for _, intf := range proto.Interfaces {
+			i := &Interface{
+				Protocol:    proto.Name,
+				Name:        intf.Name,
+				GoName:      namegen(intf.Name),
+				Version:     intf.Version,
+				Description: intf.Description.model(),
+			}
+
+			for _, enum := range intf.Enums {
+				e := &Enum{
+					Name:        enum.Name,
+					GoName:      namegen(intf.Name, enum.Name),
+					Bitfield:    enum.Bitfield,
+					Description: enum.Description.model(),
+				}
+				for _, entry := range enum.Entries {
+					value, err := strconv.ParseUint(entry.Value, 0, 32)
+					if err != nil {
+						return nil, fmt.Errorf("protocol %s: enum %s.%s entry %s: %w", proto.Name, intf.Name, enum.Name, entry.Name, err)
+					}
+					e.Entries = append(e.Entries, &Entry{
+						Name:         entry.Name,
+						GoName:       namegen(intf.Name, enum.Name, entry.Name),
+						Value:        entry.Value,
+						Summary:      entry.Summary,
+						Since:        sincegen(entry.Since),
+						NumericValue: uint32(value),
+					})
+				}
+				i.Enums = append(i.Enums, e)
+			}
+
+			for opcode, request := range intf.Requests {
+				msg, err := buildmessage("request", intf.Name, opcode, request.Name, request.Since, request.Description, request.Args)
+				if err != nil {
+					return nil, fmt.Errorf("protocol %s: %w", proto.Name, err)
+				}
+				i.Requests = append(i.Requests, msg)
+			}
+
+			for opcode, event := range intf.Events {
+				msg, err := buildmessage("event", intf.Name, opcode, event.Name, event.Since, event.Description, event.Args)
+				if err != nil {
+					return nil, fmt.Errorf("protocol %s: %w", proto.Name, err)
+				}
+				i.Events = append(i.Events, msg)
+			}
+
+			p.Interfaces = append(p.Interfaces, i)
+		}
+
+		m.Protocols = append(m.Protocols, p)
+	}
+
+	return m, nil
+}
+
+func buildmessage(kind, intf string, opcode int, name string, since int, desc description, args []arg) (*Message, error) {
+	msg := &Message{
+		Kind:        kind,
+		Name:        name,
+		Opcode:      opcode,
+		Since:       sincegen(since),
+		Description: desc.model(),
+		GoName:      namegen(intf, name, kind),
+		MethodName:  namegen(name),
+	}
+
+	for _, arg := range args {
+		a := &Arg{
+			Name:      arg.Name,
+			GoName:    namegen(arg.Name),
+			Type:      arg.Type,
+			Interface: arg.Interface,
+			Enum:      arg.Enum,
+			AllowNull: arg.AllowNull,
+			Summary:   arg.Summary,
+		}
+
+		switch arg.Type {
+		case "int", "uint", "fixed", "string", "array", "fd", "object", "new_id":
+		default:
+			return nil, fmt.Errorf("%s %s.%s argument %s: unknown argument type %q", kind, intf, name, a.GoName, arg.Type)
+		}
+
+		if arg.AllowNull && arg.Type != "object" && arg.Type != "string" {
+			return nil, fmt.Errorf("%s %s.%s argument %s: type %q cannot be nullable", kind, intf, name, a.GoName, arg.Type)
+		}
+
+		if a.IsTypedNewID() {
+			a.InterfaceGoName = namegen(arg.Interface)
+		}
+
+		msg.Args = append(msg.Args, a)
+	}
+
+	return msg, nil
+}
+
+// sincegen normalizes a since attribute; elements without one have existed
+// since version 1.
+func sincegen(since int) int {
+	if since < 1 {
+		return 1
+	}
+	return since
+}
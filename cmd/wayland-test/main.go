@@ -1,49 +1,22 @@
 package main
 
 import (
-	"errors"
 	"flag"
-	"io/ioutil"
 	"log"
-	"os"
-	"syscall"
 	"time"
 
-	"github.com/jchv/jtk/internal/wayland"
+	"github.com/jchv/jtk"
 )
 
-func shmTempFile(size int64) (*os.File, error) {
-	dir := os.Getenv("XDG_RUNTIME_DIR")
-	if dir == "" {
-		return nil, errors.New("XDG_RUNTIME_DIR is not defined in env")
-	}
-
-	file, err := ioutil.TempFile(dir, "wl_shm")
-	if err != nil {
-		return nil, err
-	}
-
-	err = file.Truncate(size)
-	if err != nil {
-		return nil, err
-	}
-
-	err = os.Remove(file.Name())
-	if err != nil {
-		return nil, err
-	}
-
-	return file, nil
-}
-
-func drawimg(t int, data []byte) {
-	i := 0
-	for y := 0; y < 256; y++ {
-		for x := 0; x < 256; x++ {
-			data[i+0] = byte(x ^ y ^ t)
-			data[i+1] = byte(x/2 ^ y/2 ^ t)
-			data[i+2] = byte(x*2 ^ y*2 ^ t)
-			data[i+3] = 0xff
+func drawimg(t int, frame *jtk.Frame) {
+	img := frame.Image
+	for y := 0; y < img.Rect.Dy(); y++ {
+		i := y * img.Stride
+		for x := 0; x < img.Rect.Dx(); x++ {
+			img.Pix[i+0] = byte(x ^ y ^ t)
+			img.Pix[i+1] = byte(x/2 ^ y/2 ^ t)
+			img.Pix[i+2] = byte(x*2 ^ y*2 ^ t)
+			img.Pix[i+3] = 0xff
 			i += 4
 		}
 	}
@@ -53,57 +26,39 @@ func main() {
 	display := flag.String("display", "", "Wayland socket to connect to, e.g. wayland-0")
 	flag.Parse()
 
-	size := 256 * 256 * 4
-	file, err := shmTempFile(int64(size))
-	if err != nil {
-		log.Fatalf("Error creating shared memory tempfile: %v", err)
-	}
-	defer file.Close()
-	data, err := syscall.Mmap(int(file.Fd()), 0, int(size), syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	app, err := jtk.NewApplication(*display)
 	if err != nil {
-		log.Fatalf("Error mapping file into memory: %v", err)
+		log.Fatalf("Error creating application: %v", err)
 	}
-	drawimg(0, data)
 
-	conn, err := wayland.Connect(*display)
+	window, err := jtk.NewWindow(app, jtk.WindowOptions{
+		Title:  "Test!",
+		AppID:  "wayland-test",
+		Width:  256,
+		Height: 256,
+	})
 	if err != nil {
-		log.Fatalf("Error connecting to Wayland compositor: %v", err)
+		log.Fatalf("Error creating window: %v", err)
 	}
+	window.OnClose(app.Quit)
 
-	go func() {
-		if err := conn.EventLoop(); err != nil {
-			log.Printf("Error in event loop: %v", err)
-		}
-	}()
-
-	surface, _ := conn.Globals().WlCompositor().CreateSurface(conn)
-	pool, _ := conn.Globals().WlShm().CreatePool(conn, wayland.FD(file.Fd()), int32(size))
-	buf, _ := pool.CreateBuffer(conn, 0, 256, 256, 256*4, uint32(wayland.WlShmFormatArgb8888))
-	xdgsurface, _ := conn.Globals().XdgWmBase().GetXdgSurface(conn, surface.ID())
-	toplevel, _ := xdgsurface.GetToplevel(conn)
-	toplevel.SetTitle(conn, "Test!")
-	toplevel.SetAppID(conn, "wayland-test")
-	xdgsurface.SetWindowGeometry(conn, 0, 0, 256, 256)
-	surface.Commit(conn)
-	surface.Attach(conn, buf.ID(), 0, 0)
-
-	conn.RegisterHandler(xdgsurface.ID(), wayland.HandlerFunc(func(event wayland.Event) {
-		switch t := event.(type) {
-		case *wayland.XdgSurfaceConfigureEvent:
-			xdgsurface.AckConfigure(conn, t.Serial)
-			surface.Commit(conn)
+	i := 0
+	window.OnDraw(func(frame *jtk.Frame) {
+		drawimg(i, frame)
+	})
+
+	var animate func(t time.Time)
+	animate = func(t time.Time) {
+		if i++; i == 256 {
+			app.Quit()
+			return
 		}
-	}))
-
-	conn.Sync()
-
-	for i := 0; i < 256; i++ {
-		drawimg(i, data)
-		surface.Attach(conn, buf.ID(), 0, 0)
-		surface.DamageBuffer(conn, 0, 0, 256, 256)
-		surface.Commit(conn)
-		time.Sleep(time.Second / 30)
+		window.Invalidate()
+		window.RequestAnimationFrame(animate)
 	}
+	window.RequestAnimationFrame(animate)
 
-	defer conn.Close()
+	if err := app.Run(); err != nil {
+		log.Fatalf("Error in event loop: %v", err)
+	}
 }
@@ -0,0 +1,252 @@
+package jtk
+
+import (
+	"encoding/binary"
+	"image"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// fakeCompositor is a minimal compositor for testing what windows send. It
+// advertises the globals an Application requires and wp_viewporter,
+// configures toplevels on their first commit, and records every surface
+// commit.
+type fakeCompositor struct {
+	t    *testing.T
+	conn *net.UnixConn
+
+	mu        sync.Mutex
+	objects   map[uint32]string
+	toplevels map[uint32]uint32 // xdg_surface -> xdg_toplevel
+	surfaces  map[uint32]*fakeSurface
+	buffers   int
+	serial    uint32
+}
+
+// fakeSurface is the pending state of a surface.
+type fakeSurface struct {
+	xdgSurface uint32
+	configured bool
+	buffer     uint32
+	damage     []image.Rectangle
+	commits    []fakeCommit
+}
+
+// fakeCommit is a commit of a surface: the buffer attached, or zero if none
+// was, and the rectangles passed to damage_buffer.
+type fakeCommit struct {
+	buffer uint32
+	damage []image.Rectangle
+}
+
+var fakeGlobals = []struct {
+	name    string
+	version uint32
+}{
+	{"wl_compositor", 4},
+	{"wl_shm", 1},
+	{"xdg_wm_base", 2},
+	{"wp_viewporter", 1},
+}
+
+// newFakeApplication returns an application connected to a fake compositor.
+func newFakeApplication(t *testing.T) (*Application, *fakeCompositor) {
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM|syscall.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("creating socketpair: %v", err)
+	}
+	conns := [2]*net.UnixConn{}
+	for i, fd := range fds {
+		f := os.NewFile(uintptr(fd), "socketpair")
+		conn, err := net.FileConn(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("creating socketpair conn: %v", err)
+		}
+		conns[i] = conn.(*net.UnixConn)
+	}
+
+	c := &fakeCompositor{
+		t:         t,
+		conn:      conns[1],
+		objects:   map[uint32]string{1: "wl_display"},
+		toplevels: make(map[uint32]uint32),
+		surfaces:  make(map[uint32]*fakeSurface),
+	}
+	go c.serve()
+
+	app, err := newApplication(wayland.NewDisplay(conns[0]))
+	if err != nil {
+		t.Fatalf("creating application: %v", err)
+	}
+	t.Cleanup(func() {
+		app.close()
+		c.conn.Close()
+	})
+	return app, c
+}
+
+// roundtrip waits for the compositor to handle every request sent so far, and
+// dispatches the events it sent in response.
+func roundtrip(t *testing.T, app *Application) {
+	t.Helper()
+	if err := app.conn.Roundtrip(); err != nil {
+		t.Fatalf("roundtrip: %v", err)
+	}
+}
+
+func (c *fakeCompositor) serve() {
+	for {
+		header := make([]byte, 8)
+		oob := make([]byte, syscall.CmsgSpace(4*4))
+		n, oobn, _, _, err := c.conn.ReadMsgUnix(header, oob)
+		if err != nil || n != len(header) {
+			return
+		}
+
+		// File descriptors, passed for shm pools, aren't needed.
+		if msgs, err := syscall.ParseSocketControlMessage(oob[:oobn]); err == nil {
+			for _, msg := range msgs {
+				fds, _ := syscall.ParseUnixRights(&msg)
+				for _, fd := range fds {
+					syscall.Close(fd)
+				}
+			}
+		}
+
+		object := binary.LittleEndian.Uint32(header)
+		opcode := binary.LittleEndian.Uint32(header[4:]) & 0xffff
+		size := binary.LittleEndian.Uint32(header[4:]) >> 16
+		body := make([]byte, size-8)
+		if _, err := io.ReadFull(c.conn, body); err != nil {
+			return
+		}
+
+		c.mu.Lock()
+		c.handle(object, opcode, body)
+		c.mu.Unlock()
+	}
+}
+
+func (c *fakeCompositor) handle(object, opcode uint32, body []byte) {
+	arg := func(i int) uint32 { return binary.LittleEndian.Uint32(body[i*4:]) }
+
+	switch iface := c.objects[object]; {
+	case iface == "wl_display" && opcode == 0: // sync
+		c.send(arg(0), 0, uint32(0))
+		c.send(1, 1, arg(0))
+	case iface == "wl_display" && opcode == 1: // get_registry
+		c.objects[arg(0)] = "wl_registry"
+		for i, g := range fakeGlobals {
+			c.send(arg(0), 0, uint32(i+1), g.name, g.version)
+		}
+	case iface == "wl_registry" && opcode == 0: // bind
+		name := fakeGlobals[arg(0)-1].name
+		n := int(arg(1)+3) &^ 3
+		c.objects[binary.LittleEndian.Uint32(body[8+n+4:])] = name
+	case iface == "wl_compositor" && opcode == 0: // create_surface
+		c.objects[arg(0)] = "wl_surface"
+		c.surfaces[arg(0)] = &fakeSurface{}
+	case iface == "wl_compositor" && opcode == 1: // create_region
+		c.objects[arg(0)] = "wl_region"
+	case iface == "wl_shm" && opcode == 0: // create_pool
+		c.objects[arg(0)] = "wl_shm_pool"
+	case iface == "wl_shm_pool" && opcode == 0: // create_buffer
+		c.objects[arg(0)] = "wl_buffer"
+		c.buffers++
+	case iface == "xdg_wm_base" && opcode == 2: // get_xdg_surface
+		c.objects[arg(0)] = "xdg_surface"
+		c.surfaces[arg(1)].xdgSurface = arg(0)
+	case iface == "xdg_surface" && opcode == 1: // get_toplevel
+		c.objects[arg(0)] = "xdg_toplevel"
+		c.toplevels[object] = arg(0)
+	case iface == "wl_surface" && opcode == 1: // attach
+		c.surfaces[object].buffer = arg(0)
+	case iface == "wl_surface" && opcode == 9: // damage_buffer
+		r := image.Rect(int(int32(arg(0))), int(int32(arg(1))), int(int32(arg(0)+arg(2))), int(int32(arg(1)+arg(3))))
+		c.surfaces[object].damage = append(c.surfaces[object].damage, r)
+	case iface == "wl_surface" && opcode == 6: // commit
+		s := c.surfaces[object]
+		s.commits = append(s.commits, fakeCommit{buffer: s.buffer, damage: s.damage})
+		s.buffer, s.damage = 0, nil
+		if !s.configured && s.xdgSurface != 0 {
+			s.configured = true
+			c.configure(s.xdgSurface, 0, 0)
+		}
+	}
+}
+
+// send sends an event. Arguments may be uint32, int32, string or []byte for
+// arrays.
+func (c *fakeCompositor) send(object uint32, opcode uint16, args ...interface{}) {
+	var body []byte
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case uint32:
+			body = appendUint32(body, v)
+		case int32:
+			body = appendUint32(body, uint32(v))
+		case string:
+			body = appendUint32(body, uint32(len(v)+1))
+			body = append(body, v...)
+			body = append(body, make([]byte, 4-len(v)%4)...)
+		case []byte:
+			body = appendUint32(body, uint32(len(v)))
+			body = append(body, v...)
+			body = append(body, make([]byte, (4-len(v)%4)%4)...)
+		default:
+			c.t.Errorf("fake compositor: unsupported argument %T", arg)
+		}
+	}
+	header := appendUint32(nil, object)
+	header = appendUint32(header, uint32(opcode)|uint32(8+len(body))<<16)
+	c.conn.Write(append(header, body...))
+}
+
+// configure configures a toplevel with a size. Zero leaves the size up to the
+// client.
+func (c *fakeCompositor) configure(xdgSurface uint32, width, height int32) {
+	c.serial++
+	c.send(c.toplevels[xdgSurface], 0, width, height, []byte{})
+	c.send(xdgSurface, 0, c.serial)
+}
+
+// configureWindow configures the toplevel of a window with a size.
+func (c *fakeCompositor) configureWindow(w *Window, width, height int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.configure(c.surfaces[uint32(w.surface.ID())].xdgSurface, width, height)
+}
+
+// release releases a buffer.
+func (c *fakeCompositor) release(buffer uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.send(buffer, 0)
+}
+
+// commits returns the commits of a window's surface so far.
+func (c *fakeCompositor) commits(w *Window) []fakeCommit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]fakeCommit(nil), c.surfaces[uint32(w.surface.ID())].commits...)
+}
+
+// bufferCount returns the number of buffers created so far.
+func (c *fakeCompositor) bufferCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.buffers
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
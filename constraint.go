@@ -0,0 +1,306 @@
+package jtk
+
+import (
+	"errors"
+	"image"
+
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// The pointer can be locked in place or confined to a window with
+// zwp_pointer_constraints_v1. Constraints only take effect while the window
+// has focus and the pointer is within the constraint region, so they are
+// requested ahead of time and become active or inactive as the compositor
+// decides. Relative motion, from zwp_relative_pointer_v1, continues while the
+// pointer is locked, which is what mouse-look in 3D views needs.
+
+// ErrPointerConstraintsUnsupported is returned when constraining the pointer
+// with a compositor that does not support zwp_pointer_constraints_v1, or a
+// seat without a pointer.
+var ErrPointerConstraintsUnsupported = errors.New("compositor does not support pointer constraints")
+
+// PointerConstraint is the state of a pointer constraint.
+type PointerConstraint int
+
+// Pointer constraint states.
+const (
+	// PointerFree means the pointer moves freely: there is no constraint, or
+	// it is inactive.
+	PointerFree PointerConstraint = iota
+
+	// PointerLocked means the pointer is locked in place. Only relative
+	// motion is reported.
+	PointerLocked
+
+	// PointerConfined means the pointer can't leave the constraint region.
+	PointerConfined
+)
+
+func (c PointerConstraint) String() string {
+	switch c {
+	case PointerFree:
+		return "free"
+	case PointerLocked:
+		return "locked"
+	case PointerConfined:
+		return "confined"
+	}
+	return "unknown"
+}
+
+// ConstraintLifetime is what happens to a pointer constraint once it becomes
+// inactive.
+type ConstraintLifetime int
+
+// Constraint lifetimes, as defined by zwp_pointer_constraints_v1.lifetime.
+const (
+	// ConstraintOneshot constraints end the first time they become inactive,
+	// e.g. when the window loses focus.
+	ConstraintOneshot ConstraintLifetime = iota
+
+	// ConstraintPersistent constraints become active again whenever the
+	// window has focus and the pointer enters the region, until removed with
+	// ReleasePointer.
+	ConstraintPersistent
+)
+
+// RelativeMotionEvent is pointer motion that is not limited by the edges of
+// the screen or by pointer constraints.
+type RelativeMotionEvent struct {
+	// Time is the time of the motion, in microseconds, with an unspecified
+	// base.
+	Time uint64
+
+	// DX and DY are the motion after pointer acceleration, in logical
+	// pixels.
+	DX, DY float64
+
+	// UnacceleratedDX and UnacceleratedDY are the motion as reported by the
+	// device, before acceleration. Their unit depends on the device, and is
+	// usually that of the hardware.
+	UnacceleratedDX, UnacceleratedDY float64
+}
+
+// pointerConstraint is a lock or confinement of the pointer requested by a
+// window.
+type pointerConstraint struct {
+	locked   *wayland.ZwpLockedPointerV1
+	confined *wayland.ZwpConfinedPointerV1
+	lifetime ConstraintLifetime
+	active   bool
+}
+
+// pointerConstraints returns the pointer constraints global and the pointer to
+// constrain, or an error if either is missing.
+func (w *Window) pointerConstraints() (*wayland.ZwpPointerConstraintsV1, *wayland.WlPointer, error) {
+	if w.closed {
+		return nil, nil, ErrWindowClosed
+	}
+	constraints := w.app.conn.Globals().ZwpPointerConstraintsV1()
+	if constraints == nil || w.app.seat == nil || w.app.seat.pointer == nil {
+		return nil, nil, ErrPointerConstraintsUnsupported
+	}
+	return constraints, w.app.seat.pointer, nil
+}
+
+// LockPointer locks the pointer in place while it is over the window, within
+// region if it isn't empty. Region is in logical pixels relative to the
+// window's content. The lock replaces any other constraint of the window, and
+// takes effect once the compositor activates it; see OnPointerConstraint.
+func (w *Window) LockPointer(lifetime ConstraintLifetime, region []image.Rectangle) error {
+	constraints, pointer, err := w.pointerConstraints()
+	if err != nil {
+		return err
+	}
+	if err := w.ReleasePointer(); err != nil {
+		return err
+	}
+
+	conn := w.app.conn
+	return w.withRegion(region, func(regionID *wayland.ObjectID) error {
+		locked, err := constraints.LockPointer(conn, w.surface.ID(), pointer.ID(), regionID, constraintLifetime(lifetime))
+		if err != nil {
+			return err
+		}
+		w.constraint = &pointerConstraint{locked: locked, lifetime: lifetime}
+		conn.RegisterHandler(locked.ID(), wayland.HandlerFunc(w.handleConstraint))
+		return nil
+	})
+}
+
+// ConfinePointer keeps the pointer within the window while it is over it, or
+// within region if it isn't empty. Region is in logical pixels relative to
+// the window's content. The confinement replaces any other constraint of the
+// window, and takes effect once the compositor activates it; see
+// OnPointerConstraint.
+func (w *Window) ConfinePointer(lifetime ConstraintLifetime, region []image.Rectangle) error {
+	constraints, pointer, err := w.pointerConstraints()
+	if err != nil {
+		return err
+	}
+	if err := w.ReleasePointer(); err != nil {
+		return err
+	}
+
+	conn := w.app.conn
+	return w.withRegion(region, func(regionID *wayland.ObjectID) error {
+		confined, err := constraints.ConfinePointer(conn, w.surface.ID(), pointer.ID(), regionID, constraintLifetime(lifetime))
+		if err != nil {
+			return err
+		}
+		w.constraint = &pointerConstraint{confined: confined, lifetime: lifetime}
+		conn.RegisterHandler(confined.ID(), wayland.HandlerFunc(w.handleConstraint))
+		return nil
+	})
+}
+
+// ReleasePointer removes the window's pointer lock or confinement, if any.
+func (w *Window) ReleasePointer() error {
+	c := w.constraint
+	if c == nil {
+		return nil
+	}
+	w.constraint = nil
+
+	conn := w.app.conn
+	var err error
+	if c.locked != nil {
+		conn.UnregisterHandlers(c.locked.ID())
+		err = c.locked.Destroy(conn)
+	} else {
+		conn.UnregisterHandlers(c.confined.ID())
+		err = c.confined.Destroy(conn)
+	}
+	if c.active {
+		w.constraintChanged(PointerFree)
+	}
+	return err
+}
+
+// SetPointerConstraintRegion changes the region of the window's pointer lock
+// or confinement. An empty region is the whole window.
+func (w *Window) SetPointerConstraintRegion(region []image.Rectangle) error {
+	c := w.constraint
+	if c == nil || w.closed {
+		return nil
+	}
+	conn := w.app.conn
+	err := w.withRegion(region, func(regionID *wayland.ObjectID) error {
+		if c.locked != nil {
+			return c.locked.SetRegion(conn, regionID)
+		}
+		return c.confined.SetRegion(conn, regionID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// The region is double-buffered surface state.
+	return w.surface.Commit(conn)
+}
+
+// SetCursorPositionHint tells the compositor where the cursor should appear
+// when the pointer is unlocked, in logical pixels relative to the window's
+// content, e.g. to keep it over an object that was dragged while locked. It
+// does nothing unless the pointer is locked.
+func (w *Window) SetCursorPositionHint(x, y float64) error {
+	c := w.constraint
+	if c == nil || c.locked == nil || w.closed {
+		return nil
+	}
+	conn := w.app.conn
+	if err := c.locked.SetCursorPositionHint(conn, wayland.FixedFromFloat(x), wayland.FixedFromFloat(y)); err != nil {
+		return err
+	}
+
+	// The hint is double-buffered surface state.
+	return w.surface.Commit(conn)
+}
+
+// PointerConstraint returns the state of the window's pointer constraint.
+func (w *Window) PointerConstraint() PointerConstraint {
+	c := w.constraint
+	switch {
+	case c == nil || !c.active:
+		return PointerFree
+	case c.locked != nil:
+		return PointerLocked
+	}
+	return PointerConfined
+}
+
+// OnPointerConstraint sets a function to be called when the window's pointer
+// lock or confinement becomes active or inactive.
+func (w *Window) OnPointerConstraint(fn func(PointerConstraint)) {
+	w.onConstraint = fn
+}
+
+// OnRelativeMotion sets a function to be called for relative pointer motion
+// while the pointer is over the window. Relative motion is reported along
+// with pointer motion, and also while the pointer is locked. It requires
+// zwp_relative_pointer_manager_v1.
+func (w *Window) OnRelativeMotion(fn func(RelativeMotionEvent)) {
+	w.onRelativeMotion = fn
+}
+
+func (w *Window) relativeMotion(s *seat, e *RelativeMotionEvent) {
+	if w.onRelativeMotion != nil {
+		w.onRelativeMotion(*e)
+	}
+}
+
+func (w *Window) handleConstraint(event wayland.Event) {
+	c := w.constraint
+	if c == nil {
+		return
+	}
+	switch event.(type) {
+	case *wayland.ZwpLockedPointerV1LockedEvent:
+		c.active = true
+		w.constraintChanged(PointerLocked)
+	case *wayland.ZwpConfinedPointerV1ConfinedEvent:
+		c.active = true
+		w.constraintChanged(PointerConfined)
+	case *wayland.ZwpLockedPointerV1UnlockedEvent, *wayland.ZwpConfinedPointerV1UnconfinedEvent:
+		c.active = false
+		if c.lifetime == ConstraintOneshot {
+			// The constraint is defunct; it can't become active again.
+			w.ReleasePointer()
+		}
+		w.constraintChanged(PointerFree)
+	}
+}
+
+func (w *Window) constraintChanged(state PointerConstraint) {
+	if w.onConstraint != nil {
+		w.onConstraint(state)
+	}
+}
+
+// withRegion calls fn with a wl_region made of rects, or nil if rects is
+// empty, and destroys the region afterwards.
+func (w *Window) withRegion(rects []image.Rectangle, fn func(*wayland.ObjectID) error) error {
+	if len(rects) == 0 {
+		return fn(nil)
+	}
+	conn := w.app.conn
+	region, err := w.app.compositor.CreateRegion(conn)
+	if err != nil {
+		return err
+	}
+	defer region.Destroy(conn)
+	for _, r := range rects {
+		if err := region.Add(conn, int32(r.Min.X), int32(r.Min.Y), int32(r.Dx()), int32(r.Dy())); err != nil {
+			return err
+		}
+	}
+	id := region.ID()
+	return fn(&id)
+}
+
+func constraintLifetime(lifetime ConstraintLifetime) uint32 {
+	if lifetime == ConstraintPersistent {
+		return uint32(wayland.ZwpPointerConstraintsV1LifetimePersistent)
+	}
+	return uint32(wayland.ZwpPointerConstraintsV1LifetimeOneshot)
+}
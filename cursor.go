@@ -0,0 +1,332 @@
+package jtk
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/jchv/jtk/draw"
+	"github.com/jchv/jtk/internal/wayland"
+	"github.com/jchv/jtk/xcursor"
+)
+
+// Cursors are loaded from the Xcursor theme named by XCURSOR_THEME, at the
+// size in XCURSOR_SIZE, and shown on a surface of their own with
+// wl_pointer.set_cursor. They are drawn at the scale of the window under the
+// pointer, so that they are sharp and the same logical size everywhere.
+
+// Cursor is the name of a pointer cursor. Names follow CSS, and themes that
+// only have the traditional X cursor names are supported too.
+type Cursor string
+
+// Cursors that themes commonly provide.
+const (
+	CursorDefault    Cursor = "default"
+	CursorPointer    Cursor = "pointer"
+	CursorText       Cursor = "text"
+	CursorWait       Cursor = "wait"
+	CursorProgress   Cursor = "progress"
+	CursorCrosshair  Cursor = "crosshair"
+	CursorMove       Cursor = "move"
+	CursorGrab       Cursor = "grab"
+	CursorGrabbing   Cursor = "grabbing"
+	CursorNotAllowed Cursor = "not-allowed"
+	CursorHelp       Cursor = "help"
+
+	CursorNResize    Cursor = "n-resize"
+	CursorEResize    Cursor = "e-resize"
+	CursorSResize    Cursor = "s-resize"
+	CursorWResize    Cursor = "w-resize"
+	CursorNEResize   Cursor = "ne-resize"
+	CursorNWResize   Cursor = "nw-resize"
+	CursorSEResize   Cursor = "se-resize"
+	CursorSWResize   Cursor = "sw-resize"
+	CursorEWResize   Cursor = "ew-resize"
+	CursorNSResize   Cursor = "ns-resize"
+	CursorNESWResize Cursor = "nesw-resize"
+	CursorNWSEResize Cursor = "nwse-resize"
+	CursorColResize  Cursor = "col-resize"
+	CursorRowResize  Cursor = "row-resize"
+
+	// CursorNone hides the cursor.
+	CursorNone Cursor = "none"
+)
+
+// cursorFallbacks are the traditional X names of cursors, for themes that
+// don't have the CSS names.
+var cursorFallbacks = map[Cursor][]string{
+	CursorDefault:    {"left_ptr"},
+	CursorPointer:    {"hand2", "hand1", "pointing_hand"},
+	CursorText:       {"xterm", "ibeam"},
+	CursorWait:       {"watch"},
+	CursorProgress:   {"left_ptr_watch", "watch"},
+	CursorCrosshair:  {"cross", "tcross"},
+	CursorMove:       {"fleur", "all-scroll"},
+	CursorGrab:       {"openhand", "hand1"},
+	CursorGrabbing:   {"closedhand", "fleur"},
+	CursorNotAllowed: {"crossed_circle", "forbidden"},
+	CursorHelp:       {"question_arrow", "left_ptr_help"},
+	CursorNResize:    {"top_side"},
+	CursorEResize:    {"right_side"},
+	CursorSResize:    {"bottom_side"},
+	CursorWResize:    {"left_side"},
+	CursorNEResize:   {"top_right_corner"},
+	CursorNWResize:   {"top_left_corner"},
+	CursorSEResize:   {"bottom_right_corner"},
+	CursorSWResize:   {"bottom_left_corner"},
+	CursorEWResize:   {"sb_h_double_arrow", "h_double_arrow"},
+	CursorNSResize:   {"sb_v_double_arrow", "v_double_arrow"},
+	CursorNESWResize: {"fd_double_arrow", "size_bdiag"},
+	CursorNWSEResize: {"bd_double_arrow", "size_fdiag"},
+	CursorColResize:  {"sb_h_double_arrow"},
+	CursorRowResize:  {"sb_v_double_arrow"},
+}
+
+// SetCursor sets the cursor shown while the pointer is over the window's
+// content. The default is CursorDefault.
+func (w *Window) SetCursor(c Cursor) {
+	if c == "" {
+		c = CursorDefault
+	}
+	w.cursorName = c
+	if s := w.app.seat; s != nil && s.pointerFocus == pointerTarget(w) {
+		s.updateCursor()
+	}
+}
+
+// Cursor returns the cursor shown over the window's content.
+func (w *Window) Cursor() Cursor {
+	if w.cursorName == "" {
+		return CursorDefault
+	}
+	return w.cursorName
+}
+
+// cursorTarget is a pointer target that has a cursor.
+type cursorTarget interface {
+	// cursor returns the cursor to show over the target, and the scale to
+	// draw it at.
+	cursor() (Cursor, float64)
+}
+
+func (w *Window) cursor() (Cursor, float64) {
+	return w.Cursor(), w.scale
+}
+
+// CursorTheme returns the cursor theme, loading it on first use.
+func (app *Application) CursorTheme() *xcursor.Theme {
+	if app.cursorTheme == nil {
+		app.cursorTheme = xcursor.LoadTheme("", 0)
+	}
+	return app.cursorTheme
+}
+
+// SetCursorTheme replaces the cursor theme, e.g. to use one other than
+// XCURSOR_THEME. The new theme is used from the next cursor change.
+func (app *Application) SetCursorTheme(theme *xcursor.Theme) {
+	app.cursorTheme = theme
+}
+
+// cursorSurface shows the cursor of a seat's pointer.
+type cursorSurface struct {
+	surface *wayland.WlSurface
+	scaler  surfaceScaler
+	buffers *BufferPool
+
+	// name, scale and serial are the cursor being shown, the scale it is
+	// drawn at, and the serial of the wl_pointer.enter it was set for. The
+	// name is empty if no cursor was set since the pointer entered.
+	name   Cursor
+	scale  float64
+	serial uint32
+
+	// base is the theme's nominal cursor size, which frames are shown at in
+	// logical pixels.
+	base   int
+	frames []*xcursor.Image
+	frame  int
+	timer  stopper
+}
+
+// updateCursor shows the cursor of the target under the pointer, if it isn't
+// already shown.
+func (s *seat) updateCursor() {
+	target, ok := s.pointerFocus.(cursorTarget)
+	if s.pointer == nil || !ok {
+		return
+	}
+	name, scale := target.cursor()
+	c := &s.cursor
+	if c.name == name && c.scale == scale && c.serial == s.enterSerial {
+		return
+	}
+
+	c.stop()
+	c.name, c.scale, c.serial = name, scale, s.enterSerial
+	if name == CursorNone {
+		s.pointer.SetCursor(s.app.conn, c.serial, nil, 0, 0)
+		return
+	}
+
+	theme := s.app.CursorTheme()
+	c.base = theme.Size()
+	c.frames = loadCursor(theme, name, c.base*int(math.Ceil(scale)))
+	c.frame = 0
+	s.showCursorFrame()
+}
+
+// loadCursor loads a cursor from a theme, trying its traditional names, then
+// the default cursor. If the theme has neither, a plain arrow is drawn.
+func loadCursor(theme *xcursor.Theme, name Cursor, size int) []*xcursor.Image {
+	names := append([]string{string(name)}, cursorFallbacks[name]...)
+	if name != CursorDefault {
+		names = append(names, string(CursorDefault))
+		names = append(names, cursorFallbacks[CursorDefault]...)
+	}
+	for _, name := range names {
+		if frames, err := theme.Load(name, size); err == nil {
+			return frames
+		}
+	}
+	return []*xcursor.Image{arrowCursor(size)}
+}
+
+// showCursorFrame shows the current frame of the cursor, and schedules the
+// next one if the cursor is animated.
+func (s *seat) showCursorFrame() {
+	c := &s.cursor
+	conn := s.app.conn
+	if c.surface == nil {
+		surface, err := s.app.compositor.CreateSurface(conn)
+		if err != nil {
+			return
+		}
+		c.surface = surface
+		c.scaler = newSurfaceScaler(surface)
+		c.buffers = NewBufferPool(s.app)
+		if err := c.scaler.createViewport(conn); err != nil {
+			return
+		}
+	}
+
+	img := c.frames[c.frame]
+	hx, hy, err := c.draw(conn, img)
+	if err != nil {
+		return
+	}
+	id := c.surface.ID()
+	s.pointer.SetCursor(conn, c.serial, &id, hx, hy)
+
+	if len(c.frames) > 1 && img.Delay > 0 {
+		c.timer = s.app.afterFunc(img.Delay, func() {
+			c.timer = nil
+			c.frame = (c.frame + 1) % len(c.frames)
+			s.showCursorFrame()
+		})
+	}
+}
+
+// draw commits a frame to the cursor surface, and returns its hotspot in
+// logical pixels.
+func (c *cursorSurface) draw(conn *wayland.Display, img *xcursor.Image) (int32, int32, error) {
+	buffer, err := c.buffers.Acquire(img.Width, img.Height, FormatARGB8888)
+	if err != nil {
+		return 0, 0, err
+	}
+	dst := buffer.Image()
+	for y := 0; y < img.Height; y++ {
+		copy(dst.Pix[y*dst.Stride:], img.Pix[y*img.Width*4:(y+1)*img.Width*4])
+	}
+
+	// The frame is drawn for a nominal size, which is shown at the theme's
+	// size. Without a viewport, only integer buffer scales that divide the
+	// image size are allowed, so others are shown unscaled.
+	scale := float64(img.Size) / float64(c.base)
+	if c.scaler.viewport == nil {
+		bufferScale := int(scale)
+		if float64(bufferScale) != scale || bufferScale < 1 || c.surface.Version() < 3 ||
+			img.Width%bufferScale != 0 || img.Height%bufferScale != 0 {
+			bufferScale = 1
+		}
+		scale = float64(bufferScale)
+	}
+	size := image.Pt(scaleSize(img.Width, 1/scale), scaleSize(img.Height, 1/scale))
+	if size.X < 1 {
+		size.X = 1
+	}
+	if size.Y < 1 {
+		size.Y = 1
+	}
+	if err := c.scaler.apply(conn, scale, size); err != nil {
+		buffer.Discard()
+		return 0, 0, err
+	}
+
+	if err := buffer.attach(c.surface); err != nil {
+		buffer.Discard()
+		return 0, 0, err
+	}
+	if err := c.surface.DamageBuffer(conn, 0, 0, int32(img.Width), int32(img.Height)); err != nil {
+		return 0, 0, err
+	}
+	if err := c.surface.Commit(conn); err != nil {
+		return 0, 0, err
+	}
+	return int32(scaleSize(img.XHot, 1/scale)), int32(scaleSize(img.YHot, 1/scale)), nil
+}
+
+// stop stops animating the cursor.
+func (c *cursorSurface) stop() {
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+}
+
+// reset forgets the cursor that was shown, when the pointer leaves.
+func (c *cursorSurface) reset() {
+	c.stop()
+	c.name, c.frames = "", nil
+}
+
+// destroy destroys the cursor surface.
+func (c *cursorSurface) destroy(conn *wayland.Display) {
+	c.reset()
+	if c.surface == nil {
+		return
+	}
+	c.buffers.Destroy()
+	c.scaler.destroy(conn)
+	c.surface.Destroy(conn)
+	c.surface = nil
+}
+
+// arrowCursor draws a plain arrow, for when there is no cursor theme.
+func arrowCursor(size int) *xcursor.Image {
+	k := float64(size) / 24
+	w, h := int(math.Ceil(14*k)), int(math.Ceil(22*k))
+	img := draw.NewARGB(image.Rect(0, 0, w, h))
+	c := draw.NewCanvas(img)
+	c.Scale(k, k)
+
+	p := draw.Path{}
+	p.MoveTo(1, 1)
+	p.LineTo(1, 18)
+	p.LineTo(5, 14.5)
+	p.LineTo(8, 21)
+	p.LineTo(10.5, 20)
+	p.LineTo(7.5, 13.5)
+	p.LineTo(12.5, 13.5)
+	p.Close()
+	c.Fill(&p, draw.Solid{Color: color.Black}, draw.NonZero)
+	c.Stroke(&p, draw.Solid{Color: color.White}, draw.StrokeStyle{Width: 1})
+
+	return &xcursor.Image{
+		Size:   size,
+		Width:  w,
+		Height: h,
+		XHot:   int(k),
+		YHot:   int(k),
+		Pix:    img.Pix,
+	}
+}
@@ -0,0 +1,294 @@
+package jtk
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sort"
+	"syscall"
+
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// Data is transferred between clients with wl_data_device: the clipboard is
+// the seat's selection, and drag and drop moves data between surfaces; see
+// clipboard.go and dnd.go. Either way, the source offers MIME types, and the
+// receiving client asks for one and passes a pipe that the source writes the
+// data into.
+
+// Common MIME types.
+const (
+	MIMEText    = "text/plain;charset=utf-8"
+	MIMEPNG     = "image/png"
+	MIMEURIList = "text/uri-list"
+)
+
+// textTypes are MIME types of UTF-8 text, in order of preference. Sources
+// that offer one offer all of them, and receivers accept any of them, since
+// clients disagree on which to use.
+var textTypes = []string{MIMEText, "UTF8_STRING", "text/plain"}
+
+// dataOffer is data offered by another client, or by this one, through a
+// wl_data_offer.
+type dataOffer struct {
+	offer *wayland.WlDataOffer
+	types []string
+
+	// sourceActions and action are the drag actions the source allows, and
+	// the one the compositor picked, for drag and drop.
+	sourceActions DragAction
+	action        DragAction
+}
+
+// dataSource is data offered by this client through a wl_data_source.
+type dataSource struct {
+	source *wayland.WlDataSource
+	types  map[string]func(io.Writer)
+
+	// cancelled is called when the source is no longer used, after which it
+	// is destroyed.
+	cancelled func()
+
+	// action and finished are called, for drags, when the action a drop would
+	// perform changes, and when the drop is finished, after which the source
+	// is destroyed.
+	action   func(DragAction)
+	finished func()
+
+	destroyed bool
+}
+
+// initDataDevice creates the seat's data device, if wl_data_device_manager is
+// supported.
+func (s *seat) initDataDevice() {
+	manager := s.app.conn.Globals().WlDataDeviceManager()
+	if manager == nil {
+		return
+	}
+	device, err := manager.GetDataDevice(s.app.conn, s.seat.ID())
+	if err != nil {
+		return
+	}
+	s.dataDevice = device
+	s.offers = make(map[wayland.ObjectID]*dataOffer)
+	s.app.conn.RegisterHandler(device.ID(), wayland.HandlerFunc(s.handleDataDevice))
+}
+
+func (s *seat) handleDataDevice(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WlDataDeviceDataOfferEvent:
+		// The offer's types follow, then the selection or drag it is for.
+		o := &dataOffer{offer: t.IDProxy()}
+		s.offers[t.ID] = o
+		s.app.conn.RegisterHandler(t.ID, wayland.HandlerFunc(o.handle))
+	case *wayland.WlDataDeviceSelectionEvent:
+		if s.selection != nil {
+			s.destroyOffer(s.selection)
+			s.selection = nil
+		}
+		if t.ID != nil {
+			s.selection = s.offers[*t.ID]
+		}
+	default:
+		s.handleDrag(event)
+	}
+}
+
+func (o *dataOffer) handle(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WlDataOfferOfferEvent:
+		o.types = append(o.types, t.MimeType)
+	case *wayland.WlDataOfferSourceActionsEvent:
+		o.sourceActions = DragAction(t.SourceActions)
+	case *wayland.WlDataOfferActionEvent:
+		o.action = DragAction(t.DndAction)
+	}
+}
+
+// match returns the offered type to receive for a MIME type: the type
+// itself, or another name for UTF-8 text.
+func (o *dataOffer) match(mimeType string) (string, bool) {
+	for _, t := range o.types {
+		if t == mimeType {
+			return t, true
+		}
+	}
+	if !isText(mimeType) {
+		return "", false
+	}
+	for _, text := range textTypes {
+		for _, t := range o.types {
+			if t == text {
+				return t, true
+			}
+		}
+	}
+	return "", false
+}
+
+// receive asks the source for data of a MIME type, and returns the reading end
+// of the pipe it is written into. Reads wait for the source, which may be
+// slow, so they are best done away from the application goroutine.
+func (o *dataOffer) receive(conn *wayland.Display, mimeType string) (io.ReadCloser, error) {
+	var fds [2]int
+	if err := syscall.Pipe2(fds[:], syscall.O_CLOEXEC); err != nil {
+		return nil, err
+	}
+
+	// Only our end is non-blocking, so that reads go through the runtime's
+	// poller; the source may expect a blocking pipe.
+	if err := syscall.SetNonblock(fds[0], true); err != nil {
+		syscall.Close(fds[0])
+		syscall.Close(fds[1])
+		return nil, err
+	}
+
+	// The descriptor is sent along with the request, so our copy of the
+	// writing end can be closed right away. The source closes its copy when
+	// done, which ends the data.
+	err := o.offer.Receive(conn, mimeType, wayland.FD(fds[1]))
+	syscall.Close(fds[1])
+	if err != nil {
+		syscall.Close(fds[0])
+		return nil, err
+	}
+	return os.NewFile(uintptr(fds[0]), mimeType), nil
+}
+
+// destroyOffer destroys an offer that is no longer needed.
+func (s *seat) destroyOffer(o *dataOffer) {
+	conn := s.app.conn
+	delete(s.offers, o.offer.ID())
+	conn.UnregisterHandlers(o.offer.ID())
+	o.offer.Destroy(conn)
+	conn.UnregisterProxy(o.offer)
+}
+
+// newDataSource creates a source offering data of the given MIME types. Text
+// is also offered under the other names of UTF-8 text.
+func (s *seat) newDataSource(types map[string]func(io.Writer)) (*dataSource, error) {
+	conn := s.app.conn
+	source, err := s.app.conn.Globals().WlDataDeviceManager().CreateDataSource(conn)
+	if err != nil {
+		return nil, err
+	}
+	src := &dataSource{source: source, types: make(map[string]func(io.Writer), len(types))}
+	for t, fn := range types {
+		src.types[t] = fn
+	}
+	if text := src.text(); text != nil {
+		for _, t := range textTypes {
+			if src.types[t] == nil {
+				src.types[t] = text
+			}
+		}
+	}
+
+	offered := make([]string, 0, len(src.types))
+	for t := range src.types {
+		offered = append(offered, t)
+	}
+	sort.Strings(offered)
+	for _, t := range offered {
+		if err := source.Offer(conn, t); err != nil {
+			source.Destroy(conn)
+			return nil, err
+		}
+	}
+
+	conn.RegisterHandler(source.ID(), wayland.HandlerFunc(func(event wayland.Event) {
+		s.handleDataSource(src, event)
+	}))
+	return src, nil
+}
+
+// text returns the function that writes the source's text, if it has any.
+func (src *dataSource) text() func(io.Writer) {
+	for _, t := range textTypes {
+		if fn := src.types[t]; fn != nil {
+			return fn
+		}
+	}
+	return nil
+}
+
+// lookup returns the function that writes data of a MIME type, if the source
+// has it, accepting other names for UTF-8 text.
+func (src *dataSource) lookup(mimeType string) func(io.Writer) {
+	if fn := src.types[mimeType]; fn != nil {
+		return fn
+	}
+	if isText(mimeType) {
+		return src.text()
+	}
+	return nil
+}
+
+// read returns data of a MIME type straight from the source, for reading
+// data this client offers without a round trip through the compositor.
+func (src *dataSource) read(mimeType string) (io.ReadCloser, bool) {
+	fn := src.lookup(mimeType)
+	if fn == nil {
+		return nil, false
+	}
+	var buf bytes.Buffer
+	fn(&buf)
+	return io.NopCloser(&buf), true
+}
+
+func (s *seat) handleDataSource(src *dataSource, event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WlDataSourceSendEvent:
+		f := os.NewFile(uintptr(t.FD), t.MimeType)
+		fn := src.lookup(t.MimeType)
+		if fn == nil {
+			f.Close()
+			return
+		}
+
+		// The data is produced on the application goroutine, like any other
+		// callback, but written in the background, so that a slow reader
+		// doesn't stall the event loop.
+		var buf bytes.Buffer
+		fn(&buf)
+		go func() {
+			f.Write(buf.Bytes())
+			f.Close()
+		}()
+	case *wayland.WlDataSourceActionEvent:
+		if src.action != nil {
+			src.action(DragAction(t.DndAction))
+		}
+	case *wayland.WlDataSourceDndFinishedEvent:
+		s.destroyDataSource(src)
+		if src.finished != nil {
+			src.finished()
+		}
+	case *wayland.WlDataSourceCancelledEvent:
+		s.destroyDataSource(src)
+		if src.cancelled != nil {
+			src.cancelled()
+		}
+	}
+}
+
+// destroyDataSource destroys a source that is no longer used. It may be called
+// more than once.
+func (s *seat) destroyDataSource(src *dataSource) {
+	if src.destroyed {
+		return
+	}
+	src.destroyed = true
+	conn := s.app.conn
+	conn.UnregisterHandlers(src.source.ID())
+	src.source.Destroy(conn)
+}
+
+func isText(mimeType string) bool {
+	for _, t := range textTypes {
+		if t == mimeType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,784 @@
+package jtk
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/jchv/jtk/draw"
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// Decorations are negotiated with zxdg_toplevel_decoration_v1. When the
+// compositor doesn't support it, or picks client-side mode, the window draws
+// its own title bar, border and shadow on a subsurface placed below the
+// window's surface. The subsurface extends beyond the window geometry, which
+// covers the title bar and content but not the shadow, and the part just
+// outside the geometry is used for resizing.
+//
+// Window sizes exclude the title bar: the compositor configures the size of
+// the window geometry, and the title bar height is taken from it.
+
+// DecorationMode selects how a window is decorated with a title bar and
+// borders.
+type DecorationMode int
+
+const (
+	// DecorationsAuto uses decorations drawn by the compositor if it supports
+	// them, and draws decorations on the client side otherwise.
+	DecorationsAuto DecorationMode = iota
+
+	// DecorationsClientSide asks for decorations drawn on the client side,
+	// even if the compositor could draw them. The compositor may still insist
+	// on drawing them itself.
+	DecorationsClientSide
+
+	// DecorationsNone asks for no decorations at all. The application is
+	// responsible for letting the user move and resize the window.
+	DecorationsNone
+)
+
+// doubleClickInterval is the maximum time between two clicks on the title
+// bar, in milliseconds, for them to maximize the window.
+const doubleClickInterval = 400
+
+// DecorationTheme controls the appearance of client-side decorations. Sizes
+// are in logical pixels.
+type DecorationTheme struct {
+	TitleBarHeight int
+
+	// ShadowSize is the size of the shadow around a floating window.
+	ShadowSize int
+
+	// ResizeBorder is the width of the area just outside a floating window in
+	// which it can be resized. Corners can also be grabbed from inside the
+	// window, up to the corner radius.
+	ResizeBorder int
+
+	// CornerRadius is the radius of the top corners of a floating window.
+	CornerRadius float64
+
+	// ButtonSize is the diameter of the title bar buttons, and ButtonSpacing
+	// the space between them and around them.
+	ButtonSize    int
+	ButtonSpacing int
+
+	// Font is the font of the title. If nil, the application's default font is
+	// used.
+	Font     *draw.Font
+	FontSize float64
+
+	// Active and Inactive are the colors used when the window is activated and
+	// when it is not.
+	Active, Inactive DecorationColors
+}
+
+// DecorationColors are the colors of client-side decorations.
+type DecorationColors struct {
+	TitleBar color.Color
+	Title    color.Color
+	Border   color.Color
+
+	// Shadow is the color of the shadow where it is darkest, next to the
+	// window.
+	Shadow color.Color
+
+	// Button, ButtonHover and ButtonPressed are the background colors of the
+	// title bar buttons, and Icon the color of the symbols on them.
+	Button        color.Color
+	ButtonHover   color.Color
+	ButtonPressed color.Color
+	Icon          color.Color
+}
+
+// DefaultDecorationTheme returns the theme used for client-side decorations
+// unless another is set.
+func DefaultDecorationTheme() DecorationTheme {
+	return DecorationTheme{
+		TitleBarHeight: 36,
+		ShadowSize:     20,
+		ResizeBorder:   10,
+		CornerRadius:   8,
+		ButtonSize:     24,
+		ButtonSpacing:  8,
+		FontSize:       13,
+		Active: DecorationColors{
+			TitleBar:      color.RGBA{0xeb, 0xeb, 0xeb, 0xff},
+			Title:         color.RGBA{0x2e, 0x2e, 0x2e, 0xff},
+			Border:        color.RGBA{0, 0, 0, 0x40},
+			Shadow:        color.RGBA{0, 0, 0, 0x50},
+			Button:        color.RGBA{0, 0, 0, 0x10},
+			ButtonHover:   color.RGBA{0, 0, 0, 0x20},
+			ButtonPressed: color.RGBA{0, 0, 0, 0x38},
+			Icon:          color.RGBA{0x2e, 0x2e, 0x2e, 0xff},
+		},
+		Inactive: DecorationColors{
+			TitleBar:      color.RGBA{0xfa, 0xfa, 0xfa, 0xff},
+			Title:         color.RGBA{0x92, 0x92, 0x92, 0xff},
+			Border:        color.RGBA{0, 0, 0, 0x30},
+			Shadow:        color.RGBA{0, 0, 0, 0x28},
+			Button:        color.RGBA{0, 0, 0, 0x08},
+			ButtonHover:   color.RGBA{0, 0, 0, 0x20},
+			ButtonPressed: color.RGBA{0, 0, 0, 0x38},
+			Icon:          color.RGBA{0x92, 0x92, 0x92, 0xff},
+		},
+	}
+}
+
+// SetDecorationTheme sets the theme of the window's client-side decorations.
+func (w *Window) SetDecorationTheme(theme DecorationTheme) {
+	w.decorationTheme = theme
+	if w.decoration != nil && w.configured {
+		w.updateDecorations()
+		w.sendSizeLimits()
+	}
+}
+
+// ClientSideDecorated returns whether the window currently draws its own
+// decorations.
+func (w *Window) ClientSideDecorated() bool {
+	return w.decoration != nil
+}
+
+// initDecorations negotiates the decoration mode with the compositor, if it
+// supports xdg-decoration.
+func (w *Window) initDecorations() error {
+	conn := w.app.conn
+
+	// Without xdg-decoration, there are no server-side decorations.
+	w.clientSide = true
+
+	manager := conn.Globals().ZxdgDecorationManagerV1()
+	if manager == nil {
+		return nil
+	}
+	decoration, err := manager.GetToplevelDecoration(conn, w.toplevel.ID())
+	if err != nil {
+		return fmt.Errorf("creating toplevel decoration: %w", err)
+	}
+	w.toplevelDecoration = decoration
+	conn.RegisterHandler(decoration.ID(), wayland.HandlerFunc(w.handleToplevelDecoration))
+
+	// The compositor tells which mode it picked before the first configure.
+	w.clientSide = false
+	mode := wayland.ZxdgToplevelDecorationV1ModeServerSide
+	if w.opts.Decorations != DecorationsAuto {
+		mode = wayland.ZxdgToplevelDecorationV1ModeClientSide
+	}
+	return decoration.SetMode(conn, uint32(mode))
+}
+
+func (w *Window) handleToplevelDecoration(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.ZxdgToplevelDecorationV1ConfigureEvent:
+		// Takes effect with the next xdg_surface.configure.
+		w.clientSide = wayland.ZxdgToplevelDecorationV1Mode(t.Mode) == wayland.ZxdgToplevelDecorationV1ModeClientSide
+	}
+}
+
+// wantsDecoration returns whether the window should draw decorations.
+func (w *Window) wantsDecoration() bool {
+	return w.clientSide && w.opts.Decorations != DecorationsNone && w.app.subcompositor != nil
+}
+
+// titleBarHeight returns the height of the client-side title bar, if it is
+// shown in the given state.
+func (w *Window) titleBarHeight(state WindowState) int {
+	if !w.wantsDecoration() || state.Fullscreen {
+		return 0
+	}
+	return w.decorationTheme.TitleBarHeight
+}
+
+// updateDecorations creates or destroys the client-side decorations as
+// needed after a configure, and updates the window geometry.
+func (w *Window) updateDecorations() error {
+	conn := w.app.conn
+
+	if w.wantsDecoration() && w.decoration == nil {
+		d, err := newDecoration(w)
+		if err != nil {
+			return err
+		}
+		w.decoration = d
+		w.sendSizeLimits()
+	} else if !w.wantsDecoration() && w.decoration != nil {
+		w.decoration.destroy()
+		w.decoration = nil
+		w.sendSizeLimits()
+	}
+
+	titleBar := w.titleBarHeight(w.state)
+	if w.decoration != nil {
+		w.decoration.layout()
+	}
+	return w.xdgSurface.SetWindowGeometry(conn, 0, int32(-titleBar), int32(w.state.Width), int32(w.state.Height+titleBar))
+}
+
+// sendSizeLimits sends the minimum and maximum size of the window, which
+// include the title bar if there is one.
+func (w *Window) sendSizeLimits() error {
+	conn := w.app.conn
+	titleBar := w.titleBarHeight(w.state)
+	extend := func(size int) int32 {
+		if size <= 0 {
+			return 0
+		}
+		return int32(size + titleBar)
+	}
+
+	if err := w.toplevel.SetMinSize(conn, int32(w.opts.MinWidth), extend(w.opts.MinHeight)); err != nil {
+		return err
+	}
+	return w.toplevel.SetMaxSize(conn, int32(w.opts.MaxWidth), extend(w.opts.MaxHeight))
+}
+
+// decorationDirty returns whether the decorations need to be redrawn.
+func (w *Window) decorationDirty() bool {
+	return w.decoration != nil && w.decoration.dirty
+}
+
+// decorationPart is a part of the decorations the pointer can be over.
+type decorationPart int
+
+const (
+	partNone decorationPart = iota
+	partTitleBar
+	partEdge
+	partClose
+	partMaximize
+	partMinimize
+)
+
+// decoration draws client-side decorations for a window.
+type decoration struct {
+	w          *Window
+	surface    *wayland.WlSurface
+	subsurface *wayland.WlSubsurface
+	scaler     surfaceScaler
+	buffers    *BufferPool
+
+	// margin is the space between the edge of the surface and the window
+	// geometry, which is where the shadow is drawn.
+	margin int
+
+	// geometry is the window geometry, relative to the surface.
+	geometry image.Rectangle
+
+	// floating is true if the window is neither maximized, fullscreen nor
+	// tiled, so that it has a shadow and can be resized.
+	floating bool
+
+	visible bool
+	dirty   bool
+
+	hover, pressed decorationPart
+	hoverEdge      wayland.XdgToplevelResizeEdge
+	lastClick      uint32
+	clicked        bool
+}
+
+func newDecoration(w *Window) (*decoration, error) {
+	app := w.app
+	conn := app.conn
+
+	d := &decoration{w: w, buffers: NewBufferPool(app)}
+
+	var err error
+	if d.surface, err = app.compositor.CreateSurface(conn); err != nil {
+		return nil, fmt.Errorf("creating decoration surface: %w", err)
+	}
+	if d.subsurface, err = app.subcompositor.GetSubsurface(conn, d.surface.ID(), w.surface.ID()); err != nil {
+		return nil, fmt.Errorf("creating decoration subsurface: %w", err)
+	}
+	if err := d.subsurface.PlaceBelow(conn, w.surface.ID()); err != nil {
+		return nil, err
+	}
+
+	d.scaler = newSurfaceScaler(d.surface)
+	if err := d.scaler.createViewport(conn); err != nil {
+		return nil, err
+	}
+
+	app.pointerTargets[d.surface.ID()] = d
+	return d, nil
+}
+
+func (d *decoration) destroy() {
+	app := d.w.app
+	conn := app.conn
+
+	delete(app.pointerTargets, d.surface.ID())
+	if app.seat != nil {
+		app.seat.removeTarget(d)
+	}
+
+	d.buffers.Destroy()
+	d.scaler.destroy(conn)
+	d.subsurface.Destroy(conn)
+	d.surface.Destroy(conn)
+}
+
+// invalidate schedules the decorations to be redrawn.
+func (d *decoration) invalidate() {
+	d.dirty = true
+	if d.w.frameCallback == nil {
+		d.w.queueRedraw()
+	}
+}
+
+// layout updates the layout of the decorations after the window state or
+// theme changed, and positions the subsurface. Changes take effect with the
+// next commit of the window.
+func (d *decoration) layout() {
+	w := d.w
+	conn := w.app.conn
+	theme := &w.decorationTheme
+	state := w.state
+
+	d.visible = !state.Fullscreen
+	d.floating = !state.Maximized && !state.Fullscreen &&
+		!state.TiledLeft && !state.TiledRight && !state.TiledTop && !state.TiledBottom
+
+	d.margin = 0
+	if d.floating {
+		d.margin = theme.ShadowSize
+		if theme.ResizeBorder > d.margin {
+			d.margin = theme.ResizeBorder
+		}
+	}
+
+	titleBar := theme.TitleBarHeight
+	d.geometry = image.Rect(d.margin, d.margin, d.margin+state.Width, d.margin+titleBar+state.Height)
+	d.subsurface.SetPosition(conn, int32(-d.margin), int32(-d.margin-titleBar))
+
+	// Only take input where it is useful, so that clicks on the shadow go to
+	// whatever is below.
+	input := d.geometry
+	if d.floating {
+		input = input.Inset(-theme.ResizeBorder)
+	}
+	if region, err := w.app.compositor.CreateRegion(conn); err == nil {
+		region.Add(conn, int32(input.Min.X), int32(input.Min.Y), int32(input.Dx()), int32(input.Dy()))
+		id := region.ID()
+		d.surface.SetInputRegion(conn, &id)
+		region.Destroy(conn)
+	}
+
+	d.invalidate()
+}
+
+// size returns the logical size of the decoration surface.
+func (d *decoration) size() image.Point {
+	return d.geometry.Max.Add(image.Pt(d.margin, d.margin))
+}
+
+// update redraws the decorations if needed, and commits the subsurface. As
+// the subsurface is synchronized, the changes are applied with the next commit
+// of the window.
+func (d *decoration) update() error {
+	if !d.dirty {
+		return nil
+	}
+	d.dirty = false
+	conn := d.w.app.conn
+
+	if !d.visible {
+		if err := d.surface.Attach(conn, nil, 0, 0); err != nil {
+			return err
+		}
+		return d.surface.Commit(conn)
+	}
+
+	scale := d.w.scale
+	size := d.size()
+	buffer, err := d.buffers.Acquire(scaleSize(size.X, scale), scaleSize(size.Y, scale), FormatARGB8888)
+	if err != nil {
+		return err
+	}
+	d.draw(buffer.Image(), scale)
+
+	if err := buffer.attach(d.surface); err != nil {
+		return err
+	}
+	if err := d.scaler.apply(conn, scale, size); err != nil {
+		return err
+	}
+	b := buffer.Image().Rect
+	if err := d.surface.DamageBuffer(conn, 0, 0, int32(b.Dx()), int32(b.Dy())); err != nil {
+		return err
+	}
+	return d.surface.Commit(conn)
+}
+
+// buttons returns the title bar buttons, from right to left, with their
+// centers.
+func (d *decoration) buttons() ([]decorationPart, []draw.Point) {
+	theme := &d.w.decorationTheme
+	parts := []decorationPart{partClose, partMaximize, partMinimize}
+	centers := make([]draw.Point, len(parts))
+
+	r := float64(theme.ButtonSize) / 2
+	x := float64(d.geometry.Max.X-theme.ButtonSpacing) - r
+	y := float64(d.geometry.Min.Y) + float64(theme.TitleBarHeight)/2
+	for i := range parts {
+		centers[i] = draw.Point{X: x, Y: y}
+		x -= float64(theme.ButtonSize + theme.ButtonSpacing)
+	}
+	return parts, centers
+}
+
+// hit returns the part of the decorations at a point, and the edges to resize
+// from if it is partEdge.
+func (d *decoration) hit(x, y float64) (decorationPart, wayland.XdgToplevelResizeEdge) {
+	theme := &d.w.decorationTheme
+	g := d.geometry
+	fx0, fy0, fx1, fy1 := float64(g.Min.X), float64(g.Min.Y), float64(g.Max.X), float64(g.Max.Y)
+
+	if d.floating {
+		border := float64(theme.ResizeBorder)
+		if x < fx0-border || x >= fx1+border || y < fy0-border || y >= fy1+border {
+			return partNone, 0
+		}
+
+		// Outside the rounded top corners counts as outside the window, and
+		// near the corners, both edges are resized.
+		if x < fx0 || x >= fx1 || y < fy0 || y >= fy1 || d.inCorner(x, y) {
+			corner := border + theme.CornerRadius
+			top, bottom := y < fy0+corner, y >= fy1-corner
+			left, right := x < fx0+corner, x >= fx1-corner
+			edge := wayland.XdgToplevelResizeEdgeNone
+			if top {
+				edge |= wayland.XdgToplevelResizeEdgeTop
+			} else if bottom {
+				edge |= wayland.XdgToplevelResizeEdgeBottom
+			}
+			if left {
+				edge |= wayland.XdgToplevelResizeEdgeLeft
+			} else if right {
+				edge |= wayland.XdgToplevelResizeEdgeRight
+			}
+			return partEdge, edge
+		}
+	}
+
+	if x < fx0 || x >= fx1 || y < fy0 || y >= fy0+float64(theme.TitleBarHeight) {
+		return partNone, 0
+	}
+
+	parts, centers := d.buttons()
+	r := float64(theme.ButtonSize) / 2
+	for i, c := range centers {
+		if math.Hypot(x-c.X, y-c.Y) <= r {
+			return parts[i], 0
+		}
+	}
+	return partTitleBar, 0
+}
+
+// inCorner returns whether a point within the window geometry is outside the
+// rounded top corners, where the window can be resized from.
+func (d *decoration) inCorner(x, y float64) bool {
+	r := d.w.decorationTheme.CornerRadius
+	g := d.geometry
+	if y >= float64(g.Min.Y)+r {
+		return false
+	}
+	cy := float64(g.Min.Y) + r
+	cx := float64(g.Min.X) + r
+	if x >= float64(g.Max.X)-r {
+		cx = float64(g.Max.X) - r
+	} else if x >= cx {
+		return false
+	}
+	return math.Hypot(x-cx, y-cy) > r
+}
+
+func (d *decoration) pointer(s *seat, e *PointerEvent) {
+	switch e.Type {
+	case PointerEnter, PointerMotion:
+		d.pointerMotion(e.X, e.Y)
+	case PointerLeave:
+		if d.hover != partNone || d.pressed != partNone {
+			d.hover, d.pressed = partNone, partNone
+			d.invalidate()
+		}
+	case PointerButton:
+		d.pointerButton(s, e)
+	}
+}
+
+func (d *decoration) pointerMotion(x, y float64) {
+	part, edge := d.hit(x, y)
+	d.hoverEdge = edge
+	if part != d.hover {
+		if part >= partClose || d.hover >= partClose {
+			d.invalidate()
+		}
+		d.hover = part
+	}
+}
+
+func (d *decoration) pointerButton(s *seat, e *PointerEvent) {
+	w := d.w
+	conn := w.app.conn
+	part, edge := d.hit(e.X, e.Y)
+
+	if !e.Pressed {
+		if e.Button == ButtonLeft && d.pressed != partNone {
+			clicked := d.pressed
+			d.pressed = partNone
+			d.invalidate()
+			if clicked == part {
+				d.activate(part)
+			}
+		}
+		return
+	}
+
+	switch e.Button {
+	case ButtonLeft:
+		switch part {
+		case partEdge:
+			w.toplevel.Resize(conn, s.seat.ID(), e.Serial, uint32(edge))
+		case partTitleBar:
+			if d.clicked && e.Time-d.lastClick < doubleClickInterval {
+				d.clicked = false
+				d.activate(partMaximize)
+				return
+			}
+			d.clicked, d.lastClick = true, e.Time
+			w.toplevel.Move(conn, s.seat.ID(), e.Serial)
+		case partClose, partMaximize, partMinimize:
+			d.pressed = part
+			d.invalidate()
+		}
+	case ButtonRight:
+		if part == partTitleBar {
+			x := int32(e.X) - int32(d.geometry.Min.X)
+			y := int32(e.Y) - int32(d.geometry.Min.Y)
+			w.toplevel.ShowWindowMenu(conn, s.seat.ID(), e.Serial, x, y)
+		}
+	}
+}
+
+// cursor returns the cursor for the part of the decorations under the pointer:
+// a resize arrow on the edges, and the default cursor elsewhere.
+func (d *decoration) cursor() (Cursor, float64) {
+	c := CursorDefault
+	if d.hover == partEdge {
+		switch d.hoverEdge {
+		case wayland.XdgToplevelResizeEdgeTop:
+			c = CursorNResize
+		case wayland.XdgToplevelResizeEdgeBottom:
+			c = CursorSResize
+		case wayland.XdgToplevelResizeEdgeLeft:
+			c = CursorWResize
+		case wayland.XdgToplevelResizeEdgeRight:
+			c = CursorEResize
+		case wayland.XdgToplevelResizeEdgeTopLeft:
+			c = CursorNWResize
+		case wayland.XdgToplevelResizeEdgeTopRight:
+			c = CursorNEResize
+		case wayland.XdgToplevelResizeEdgeBottomLeft:
+			c = CursorSWResize
+		case wayland.XdgToplevelResizeEdgeBottomRight:
+			c = CursorSEResize
+		}
+	}
+	return c, d.w.scale
+}
+
+// activate performs the action of a title bar button.
+func (d *decoration) activate(part decorationPart) {
+	w := d.w
+	switch part {
+	case partClose:
+		w.requestClose()
+	case partMaximize:
+		if w.state.Maximized {
+			w.Unmaximize()
+		} else {
+			w.Maximize()
+		}
+	case partMinimize:
+		w.Minimize()
+	}
+}
+
+// draw draws the decorations into img at scale.
+func (d *decoration) draw(img *draw.ARGB, scale float64) {
+	w := d.w
+	theme := &w.decorationTheme
+	colors := &theme.Inactive
+	if w.state.Activated {
+		colors = &theme.Active
+	}
+
+	c := draw.NewCanvas(img)
+	c.Clear(color.Transparent)
+	c.Scale(scale, scale)
+
+	g := d.geometry
+	x, y := float64(g.Min.X), float64(g.Min.Y)
+	width, height := float64(g.Dx()), float64(g.Dy())
+	titleBar := float64(theme.TitleBarHeight)
+	radius := 0.0
+	if d.floating {
+		radius = math.Min(theme.CornerRadius, math.Min(width, titleBar)/2)
+	}
+
+	if d.floating && theme.ShadowSize > 0 && colors.Shadow != nil {
+		d.drawShadow(c, colors.Shadow, radius)
+	}
+
+	if colors.Border != nil {
+		p := draw.Path{}
+		topRoundedRect(&p, x-0.5, y-0.5, width+1, height+1, radius+0.5)
+		c.Stroke(&p, draw.Solid{Color: colors.Border}, draw.StrokeStyle{Width: 1})
+	}
+
+	p := draw.Path{}
+	topRoundedRect(&p, x, y, width, titleBar, radius)
+	c.Fill(&p, draw.Solid{Color: colors.TitleBar}, draw.NonZero)
+
+	// Buttons.
+	parts, centers := d.buttons()
+	r := float64(theme.ButtonSize) / 2
+	for i, part := range parts {
+		center := centers[i]
+		background := colors.Button
+		if d.hover == part && d.pressed == part {
+			background = colors.ButtonPressed
+		} else if d.hover == part && d.pressed == partNone {
+			background = colors.ButtonHover
+		}
+		if background != nil {
+			circle := draw.Path{}
+			circle.Circle(center.X, center.Y, r)
+			c.Fill(&circle, draw.Solid{Color: background}, draw.NonZero)
+		}
+		d.drawIcon(c, part, center, r/2.5, colors.Icon)
+	}
+
+	// Title, centered if possible, and kept clear of the buttons.
+	font := theme.Font
+	if font == nil {
+		font = w.app.DefaultFont()
+	}
+	if font == nil || w.opts.Title == "" || colors.Title == nil {
+		return
+	}
+	padding := float64(theme.ButtonSpacing) * 2
+	left := x + padding
+	right := centers[len(centers)-1].X - r - padding
+	if right <= left {
+		return
+	}
+	title := ellipsize(font, w.opts.Title, theme.FontSize, right-left)
+	advance := font.Measure(title, theme.FontSize)
+	tx := x + (width-advance)/2
+	if tx+advance > right {
+		tx = right - advance
+	}
+	if tx < left {
+		tx = left
+	}
+	m := font.Metrics(theme.FontSize)
+	ty := y + (titleBar+m.Ascent-m.Descent)/2
+	c.FillText(title, font, theme.FontSize, tx, ty, draw.Solid{Color: colors.Title})
+}
+
+// drawShadow draws a shadow around the window geometry, fading out with
+// distance. It is drawn as bands between successively larger rounded
+// rectangles.
+func (d *decoration) drawShadow(c *draw.Canvas, shadow color.Color, radius float64) {
+	g := d.geometry
+	size := d.w.decorationTheme.ShadowSize
+	x, y := float64(g.Min.X), float64(g.Min.Y)
+	width, height := float64(g.Dx()), float64(g.Dy())
+
+	// Keep the shadow away from below the window, where it would show through
+	// translucent content.
+	c.Save()
+	clip := draw.Path{}
+	clip.Rect(0, 0, float64(g.Max.X+d.margin), float64(g.Max.Y+d.margin))
+	topRoundedRect(&clip, x, y, width, height, radius)
+	c.ClipPath(&clip, draw.EvenOdd)
+
+	for i := 0; i < size; i++ {
+		t := (float64(i) + 0.5) / float64(size)
+		inner, outer := float64(i), float64(i+1)
+		band := draw.Path{}
+		band.RoundedRect(x-outer, y-outer, width+2*outer, height+2*outer, radius+outer)
+		band.RoundedRect(x-inner, y-inner, width+2*inner, height+2*inner, radius+inner)
+		c.Fill(&band, draw.Solid{Color: fade(shadow, (1-t)*(1-t))}, draw.EvenOdd)
+	}
+	c.Restore()
+}
+
+// drawIcon draws the symbol of a title bar button, extending e from center.
+func (d *decoration) drawIcon(c *draw.Canvas, part decorationPart, center draw.Point, e float64, col color.Color) {
+	if col == nil {
+		return
+	}
+	p := draw.Path{}
+	cx, cy := center.X, center.Y
+	switch part {
+	case partClose:
+		p.MoveTo(cx-e, cy-e)
+		p.LineTo(cx+e, cy+e)
+		p.MoveTo(cx+e, cy-e)
+		p.LineTo(cx-e, cy+e)
+	case partMaximize:
+		if d.w.state.Maximized {
+			k := e / 3
+			p.Rect(cx-e, cy-e+k, 2*e-k, 2*e-k)
+			p.MoveTo(cx-e+k, cy-e)
+			p.LineTo(cx+e, cy-e)
+			p.LineTo(cx+e, cy+e-k)
+		} else {
+			p.Rect(cx-e, cy-e, 2*e, 2*e)
+		}
+	case partMinimize:
+		p.MoveTo(cx-e, cy+e/2)
+		p.LineTo(cx+e, cy+e/2)
+	}
+	c.Stroke(&p, draw.Solid{Color: col}, draw.StrokeStyle{Width: 1.5})
+}
+
+// topRoundedRect adds a closed rectangle with rounded top corners to a path.
+func topRoundedRect(p *draw.Path, x, y, w, h, r float64) {
+	p.MoveTo(x, y+h)
+	p.Arc(x+r, y+r, r, math.Pi, 3*math.Pi/2)
+	p.Arc(x+w-r, y+r, r, 3*math.Pi/2, 2*math.Pi)
+	p.LineTo(x+w, y+h)
+	p.Close()
+}
+
+// fade multiplies the opacity of a color by k.
+func fade(c color.Color, k float64) color.Color {
+	r, g, b, a := c.RGBA()
+	return color.RGBA64{
+		R: uint16(float64(r) * k),
+		G: uint16(float64(g) * k),
+		B: uint16(float64(b) * k),
+		A: uint16(float64(a) * k),
+	}
+}
+
+// ellipsize shortens text with an ellipsis so that it fits in width.
+func ellipsize(font *draw.Font, text string, size, width float64) string {
+	if font.Measure(text, size) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for n := len(runes) - 1; n > 0; n-- {
+		s := string(runes[:n]) + "…"
+		if font.Measure(s, size) <= width {
+			return s
+		}
+	}
+	return ""
+}
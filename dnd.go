@@ -0,0 +1,506 @@
+package jtk
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"io"
+	"net/url"
+	"os"
+
+	"github.com/jchv/jtk/draw"
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// Drag and drop is driven by the compositor: a client starts a drag from a
+// pointer button press or a touch, and the compositor sends the data offer to
+// whichever surface is under the pointer, which accepts a MIME type and picks
+// an action. When the button is released, the target receives the data and
+// finishes the drop, and the source learns which action was performed.
+
+// ErrDragUnsupported is returned when starting a drag with a compositor that
+// does not support wl_data_device_manager.
+var ErrDragUnsupported = errors.New("compositor does not support drag and drop")
+
+// ErrDropType is returned when accepting or receiving dragged data in a MIME
+// type it isn't offered in.
+var ErrDropType = errors.New("drag offer has no data of the requested type")
+
+// ErrDropFinished is returned when receiving dragged data after the drop was
+// finished or the drag left the window.
+var ErrDropFinished = errors.New("drag offer is finished")
+
+// DragAction is a set of things a drop can do with the data.
+type DragAction uint32
+
+// Drag actions, as defined by wl_data_device_manager.dnd_action. Zero means
+// no action.
+const (
+	// DragCopy copies the data to the target.
+	DragCopy = DragAction(wayland.WlDataDeviceManagerDndActionCopy)
+
+	// DragMove moves the data to the target: the source deletes it once the
+	// drop is finished.
+	DragMove = DragAction(wayland.WlDataDeviceManagerDndActionMove)
+
+	// DragAsk lets the target ask the user what to do after the drop, e.g.
+	// with a menu, and pick copy or move with DragOffer.SetActions.
+	DragAsk = DragAction(wayland.WlDataDeviceManagerDndActionAsk)
+)
+
+// DragEventType is the type of a DragEvent.
+type DragEventType int
+
+// Drag event types.
+const (
+	// DragEnter is sent when a drag enters the window.
+	DragEnter DragEventType = iota
+
+	// DragMotion is sent when a drag moves within the window.
+	DragMotion
+
+	// DragLeave is sent when a drag leaves the window, or is cancelled. No
+	// more drag events are sent for the offer.
+	DragLeave
+
+	// DragDrop is sent when the data is dropped on the window. The window
+	// should receive the data in the MIME type it accepted, then call
+	// Finish; otherwise the drop is rejected.
+	DragDrop
+)
+
+func (t DragEventType) String() string {
+	switch t {
+	case DragEnter:
+		return "enter"
+	case DragMotion:
+		return "motion"
+	case DragLeave:
+		return "leave"
+	case DragDrop:
+		return "drop"
+	}
+	return "unknown"
+}
+
+// DragEvent is an event of a drag over a window.
+type DragEvent struct {
+	Type DragEventType
+
+	// Offer is the data being dragged.
+	Offer *DragOffer
+
+	// Time is the time of motion events, in milliseconds, with an
+	// unspecified base.
+	Time uint32
+
+	// X and Y are the position of the drag, in logical pixels relative to
+	// the top-left corner of the window's content. They are not set for
+	// leave events.
+	X, Y float64
+}
+
+// DragOffer is data dragged over a window, from this application or another.
+type DragOffer struct {
+	seat   *seat
+	offer  *dataOffer
+	serial uint32
+
+	accepted  string
+	dropped   bool
+	destroyed bool
+}
+
+// OnDrag sets a function to be called for drags over the window. To be a drop
+// target, the window accepts a MIME type and sets the actions it supports on
+// enter or motion events; see DragOffer.
+func (w *Window) OnDrag(fn func(DragEvent)) {
+	w.onDrag = fn
+}
+
+func (w *Window) drag(e *DragEvent) {
+	if w.onDrag != nil {
+		w.onDrag(*e)
+	}
+}
+
+// Types returns the MIME types the data is offered in.
+func (d *DragOffer) Types() []string {
+	return append([]string(nil), d.offer.types...)
+}
+
+// SourceActions returns the actions the source allows.
+func (d *DragOffer) SourceActions() DragAction {
+	return d.offer.sourceActions
+}
+
+// Action returns the action the drop would perform, as picked by the
+// compositor from the actions of the source and target and the modifiers the
+// user holds. It is zero if there's no action in common, in which case a
+// drop is cancelled.
+func (d *DragOffer) Action() DragAction {
+	return d.offer.action
+}
+
+// Accept tells the source which MIME type the window would receive if the
+// data is dropped at the current position, or that it wouldn't accept a drop
+// if mimeType is empty. Text may be accepted as MIMEText whichever name the
+// source offers it under.
+func (d *DragOffer) Accept(mimeType string) error {
+	if d.destroyed || d.dropped {
+		return nil
+	}
+	if mimeType != "" {
+		offered, ok := d.offer.match(mimeType)
+		if !ok {
+			return ErrDropType
+		}
+		mimeType = offered
+	}
+	if mimeType == d.accepted {
+		return nil
+	}
+	d.accepted = mimeType
+
+	var accepted *string
+	if mimeType != "" {
+		accepted = &mimeType
+	}
+	return d.offer.offer.Accept(d.seat.app.conn, d.serial, accepted)
+}
+
+// SetActions tells the compositor which actions the window supports, and
+// which it prefers. It is also used after a DragAsk drop, to pick the action
+// that was chosen. Without wl_data_device_manager version 3, actions aren't
+// supported, and every drop is a copy.
+func (d *DragOffer) SetActions(actions, preferred DragAction) error {
+	if d.destroyed || d.offer.offer.Version() < 3 {
+		return nil
+	}
+	return d.offer.offer.SetActions(d.seat.app.conn, uint32(actions), uint32(preferred))
+}
+
+// Receive returns a reader for the data in a MIME type. It is usually called
+// when the data is dropped, but may be called earlier, e.g. to preview the
+// data. The reader must be closed.
+//
+// Data from other applications arrives through a pipe as they write it, so it
+// should be read on another goroutine, to keep the event loop running; use
+// Application.Invoke to call Finish afterwards. Data dragged from this
+// application is available immediately.
+func (d *DragOffer) Receive(mimeType string) (io.ReadCloser, error) {
+	if d.destroyed {
+		return nil, ErrDropFinished
+	}
+	if drag := d.seat.drag; drag != nil {
+		// The data is our own: it is being dragged from one of our windows.
+		if r, ok := drag.source.read(mimeType); ok {
+			return r, nil
+		}
+		return nil, ErrDropType
+	}
+	offered, ok := d.offer.match(mimeType)
+	if !ok {
+		return nil, ErrDropType
+	}
+	return d.offer.receive(d.seat.app.conn, offered)
+}
+
+// Finish ends a drop once the data has been received, which tells the
+// source which action was performed. If the data wasn't dropped yet, or the
+// window didn't accept it, it rejects the offer instead.
+func (d *DragOffer) Finish() error {
+	if d.destroyed {
+		return nil
+	}
+	var err error
+	if d.dropped && d.accepted != "" && d.offer.action != 0 && d.offer.offer.Version() >= 3 {
+		err = d.offer.offer.Finish(d.seat.app.conn)
+	}
+	d.destroy()
+	return err
+}
+
+// destroy destroys the offer.
+func (d *DragOffer) destroy() {
+	if d.destroyed {
+		return
+	}
+	d.destroyed = true
+	d.seat.destroyOffer(d.offer)
+}
+
+func (s *seat) handleDrag(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WlDataDeviceEnterEvent:
+		s.endDragOffer()
+		if t.ID == nil {
+			// A drag within another client, without data.
+			return
+		}
+		o := s.offers[*t.ID]
+		if o == nil {
+			return
+		}
+		d := &DragOffer{seat: s, offer: o, serial: t.Serial}
+		s.dragOffer = d
+		for _, w := range s.app.windows {
+			if w.surface.ID() == t.Surface {
+				s.dragTarget = w
+				break
+			}
+		}
+		if s.dragTarget != nil {
+			s.dragTarget.drag(&DragEvent{Type: DragEnter, Offer: d, X: t.X.Float(), Y: t.Y.Float()})
+		}
+	case *wayland.WlDataDeviceMotionEvent:
+		if s.dragTarget != nil && s.dragOffer != nil {
+			s.dragTarget.drag(&DragEvent{Type: DragMotion, Offer: s.dragOffer, Time: t.Time, X: t.X.Float(), Y: t.Y.Float()})
+		}
+	case *wayland.WlDataDeviceLeaveEvent:
+		s.endDragOffer()
+	case *wayland.WlDataDeviceDropEvent:
+		d, target := s.dragOffer, s.dragTarget
+		s.dragOffer, s.dragTarget = nil, nil
+		if d == nil {
+			return
+		}
+		d.dropped = true
+		if target != nil {
+			target.drag(&DragEvent{Type: DragDrop, Offer: d})
+		}
+
+		// Without an accepted type and an action, the drop is cancelled, and
+		// there is nothing to finish.
+		if d.accepted == "" || d.offer.action == 0 && d.offer.offer.Version() >= 3 {
+			d.destroy()
+		}
+	}
+}
+
+// endDragOffer ends the current drag offer, after the drag left or before
+// another enters.
+func (s *seat) endDragOffer() {
+	d, target := s.dragOffer, s.dragTarget
+	s.dragOffer, s.dragTarget = nil, nil
+	if d == nil {
+		return
+	}
+	if target != nil {
+		target.drag(&DragEvent{Type: DragLeave, Offer: d})
+	}
+	d.destroy()
+}
+
+// DragOptions configures a drag started with Window.StartDrag.
+type DragOptions struct {
+	// Types are the data being dragged, as functions that write it in a MIME
+	// type; see Application.SetClipboard.
+	Types map[string]func(w io.Writer)
+
+	// Actions are the actions the data may be dropped with. Zero means
+	// DragCopy.
+	Actions DragAction
+
+	// IconSize is the logical size of an icon that follows the pointer during
+	// the drag. There is no icon if it is empty or DrawIcon is nil.
+	IconSize image.Point
+
+	// Hotspot is the point of the icon under the pointer, in logical pixels.
+	Hotspot image.Point
+
+	// DrawIcon draws the icon, with scale buffer pixels per logical pixel.
+	DrawIcon func(img *draw.ARGB, scale float64)
+
+	// OnAction is called when the action a drop would perform changes, e.g.
+	// as the drag moves between targets, for feedback.
+	OnAction func(DragAction)
+
+	// OnEnd is called when the drag ends, with the action performed by the
+	// drop, or zero if the drag was cancelled or the drop rejected. After a
+	// move, the source should delete the data.
+	OnEnd func(DragAction)
+}
+
+// dragSource is a drag started by this client.
+type dragSource struct {
+	source  *dataSource
+	opts    DragOptions
+	action  DragAction
+	icon    *wayland.WlSurface
+	scaler  surfaceScaler
+	buffers *BufferPool
+}
+
+// StartDrag starts dragging data from the window. Serial is that of the
+// pointer button press or touch that starts the drag, which the compositor
+// requires to still be held. Any drag already in progress is cancelled.
+func (w *Window) StartDrag(serial uint32, opts DragOptions) error {
+	s := w.app.seat
+	if w.closed {
+		return ErrWindowClosed
+	}
+	if s == nil || s.dataDevice == nil {
+		return ErrDragUnsupported
+	}
+	if s.drag != nil {
+		s.endDrag(0)
+	}
+
+	src, err := s.newDataSource(opts.Types)
+	if err != nil {
+		return err
+	}
+	conn := w.app.conn
+	d := &dragSource{source: src, opts: opts}
+	if src.source.Version() >= 3 {
+		actions := opts.Actions
+		if actions == 0 {
+			actions = DragCopy
+		}
+		if err := src.source.SetActions(conn, uint32(actions)); err != nil {
+			s.destroyDrag(d)
+			return err
+		}
+	}
+	src.action = func(action DragAction) {
+		d.action = action
+		if opts.OnAction != nil {
+			opts.OnAction(action)
+		}
+	}
+	src.finished = func() {
+		if s.drag == d {
+			s.endDrag(d.action)
+		}
+	}
+	src.cancelled = func() {
+		if s.drag == d {
+			s.endDrag(0)
+		}
+	}
+
+	if !opts.IconSize.Eq(image.Point{}) && opts.DrawIcon != nil {
+		if d.icon, err = w.app.compositor.CreateSurface(conn); err != nil {
+			s.destroyDrag(d)
+			return err
+		}
+		d.scaler = newSurfaceScaler(d.icon)
+		d.buffers = NewBufferPool(w.app)
+		if err := d.scaler.createViewport(conn); err != nil {
+			s.destroyDrag(d)
+			return err
+		}
+	}
+
+	var icon *wayland.ObjectID
+	if d.icon != nil {
+		id := d.icon.ID()
+		icon = &id
+	}
+	sourceID := src.source.ID()
+	if err := s.dataDevice.StartDrag(conn, &sourceID, w.surface.ID(), icon, serial); err != nil {
+		s.destroyDrag(d)
+		return err
+	}
+	s.drag = d
+	if d.icon != nil {
+		// The icon is drawn once it has its role, so that the hotspot offset
+		// applies.
+		return d.drawIcon(conn, w.scale)
+	}
+	return nil
+}
+
+// drawIcon draws the drag icon at a scale, with its hotspot at the pointer.
+func (d *dragSource) drawIcon(conn *wayland.Display, scale float64) error {
+	if d.scaler.viewport == nil {
+		// Only integer buffer scales are possible without a viewport.
+		bufferScale := int(scale)
+		if float64(bufferScale) != scale || d.icon.Version() < 3 {
+			bufferScale = 1
+		}
+		scale = float64(bufferScale)
+	}
+
+	size := d.opts.IconSize
+	width, height := scaleSize(size.X, scale), scaleSize(size.Y, scale)
+	buffer, err := d.buffers.Acquire(width, height, FormatARGB8888)
+	if err != nil {
+		return err
+	}
+	img := buffer.Image()
+	for i := range img.Pix {
+		img.Pix[i] = 0
+	}
+	d.opts.DrawIcon(img, scale)
+
+	if err := d.scaler.apply(conn, scale, size); err != nil {
+		buffer.Discard()
+		return err
+	}
+	if err := buffer.attachAt(d.icon, int32(-d.opts.Hotspot.X), int32(-d.opts.Hotspot.Y)); err != nil {
+		buffer.Discard()
+		return err
+	}
+	if err := d.icon.DamageBuffer(conn, 0, 0, int32(width), int32(height)); err != nil {
+		return err
+	}
+	return d.icon.Commit(conn)
+}
+
+// endDrag ends the drag in progress, with the action that was performed.
+func (s *seat) endDrag(action DragAction) {
+	d := s.drag
+	s.drag = nil
+	s.destroyDrag(d)
+	if d.opts.OnEnd != nil {
+		d.opts.OnEnd(action)
+	}
+}
+
+// destroyDrag destroys the data source and the icon of a drag.
+func (s *seat) destroyDrag(d *dragSource) {
+	conn := s.app.conn
+	s.destroyDataSource(d.source)
+	if d.icon != nil {
+		d.buffers.Destroy()
+		d.scaler.destroy(conn)
+		d.icon.Destroy(conn)
+	}
+}
+
+// ParseURIList parses a text/uri-list, as dropped by file managers: one URI
+// per line, ignoring comments, which start with #.
+func ParseURIList(data []byte) ([]*url.URL, error) {
+	var uris []*url.URL
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		u, err := url.Parse(string(line))
+		if err != nil {
+			return nil, err
+		}
+		uris = append(uris, u)
+	}
+	return uris, nil
+}
+
+// FilePaths returns the paths of the local files among URIs, such as those of
+// a text/uri-list.
+func FilePaths(uris []*url.URL) []string {
+	hostname, _ := os.Hostname()
+	var paths []string
+	for _, u := range uris {
+		if u.Scheme != "file" || u.Path == "" {
+			continue
+		}
+		if u.Host != "" && u.Host != "localhost" && u.Host != hostname {
+			continue
+		}
+		paths = append(paths, u.Path)
+	}
+	return paths
+}
@@ -0,0 +1,128 @@
+// Package draw implements drawing into in-memory images in the pixel formats
+// used by Wayland shared memory buffers.
+package draw
+
+import (
+	"image"
+	"image/color"
+)
+
+// ARGB is an in-memory image in the wl_shm ARGB8888 or XRGB8888 format: each
+// pixel is a little-endian 32-bit value with alpha (or padding) in the high
+// byte, so the bytes of each pixel are in B, G, R, A order. Like image.RGBA,
+// colors are alpha-premultiplied.
+type ARGB struct {
+	// Pix holds the image's pixels, in B, G, R, A order.
+	Pix []byte
+
+	// Stride is the Pix stride (in bytes) between vertically adjacent pixels.
+	Stride int
+
+	// Rect is the image's bounds.
+	Rect image.Rectangle
+
+	// Opaque is true for XRGB8888 images. The alpha byte of each pixel is
+	// ignored and treated as fully opaque.
+	Opaque bool
+}
+
+// NewARGB returns a new ARGB8888 image with the given bounds.
+func NewARGB(r image.Rectangle) *ARGB {
+	return &ARGB{
+		Pix:    make([]byte, 4*r.Dx()*r.Dy()),
+		Stride: 4 * r.Dx(),
+		Rect:   r,
+	}
+}
+
+// ColorModel returns color.RGBAModel.
+func (p *ARGB) ColorModel() color.Model {
+	return color.RGBAModel
+}
+
+// Bounds returns the bounds of the image.
+func (p *ARGB) Bounds() image.Rectangle {
+	return p.Rect
+}
+
+// At returns the color of the pixel at (x, y).
+func (p *ARGB) At(x, y int) color.Color {
+	return p.RGBAAt(x, y)
+}
+
+// RGBAAt returns the color of the pixel at (x, y).
+func (p *ARGB) RGBAAt(x, y int) color.RGBA {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return color.RGBA{}
+	}
+	i := p.PixOffset(x, y)
+	s := p.Pix[i : i+4 : i+4]
+	if p.Opaque {
+		return color.RGBA{s[2], s[1], s[0], 0xff}
+	}
+	return color.RGBA{s[2], s[1], s[0], s[3]}
+}
+
+// PixOffset returns the index of the first element of Pix that corresponds to
+// the pixel at (x, y).
+func (p *ARGB) PixOffset(x, y int) int {
+	return (y-p.Rect.Min.Y)*p.Stride + (x-p.Rect.Min.X)*4
+}
+
+// Set sets the color of the pixel at (x, y).
+func (p *ARGB) Set(x, y int, c color.Color) {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return
+	}
+	p.SetRGBA(x, y, color.RGBAModel.Convert(c).(color.RGBA))
+}
+
+// SetRGBA sets the color of the pixel at (x, y).
+func (p *ARGB) SetRGBA(x, y int, c color.RGBA) {
+	if !(image.Point{x, y}.In(p.Rect)) {
+		return
+	}
+	i := p.PixOffset(x, y)
+	s := p.Pix[i : i+4 : i+4]
+	s[0] = c.B
+	s[1] = c.G
+	s[2] = c.R
+	s[3] = c.A
+	if p.Opaque {
+		s[3] = 0xff
+	}
+}
+
+// SubImage returns an image representing the portion of the image p visible
+// through r. The returned value shares pixels with the original image.
+func (p *ARGB) SubImage(r image.Rectangle) image.Image {
+	r = r.Intersect(p.Rect)
+	if r.Empty() {
+		return &ARGB{Opaque: p.Opaque}
+	}
+	i := p.PixOffset(r.Min.X, r.Min.Y)
+	return &ARGB{
+		Pix:    p.Pix[i:],
+		Stride: p.Stride,
+		Rect:   r,
+		Opaque: p.Opaque,
+	}
+}
+
+// IsOpaque scans the entire image and reports whether it is fully opaque.
+func (p *ARGB) IsOpaque() bool {
+	if p.Rect.Empty() || p.Opaque {
+		return true
+	}
+	i0, i1 := 3, p.Rect.Dx()*4
+	for y := p.Rect.Min.Y; y < p.Rect.Max.Y; y++ {
+		for i := i0; i < i1; i += 4 {
+			if p.Pix[i] != 0xff {
+				return false
+			}
+		}
+		i0 += p.Stride
+		i1 += p.Stride
+	}
+	return true
+}
@@ -0,0 +1,293 @@
+package draw
+
+import (
+	"image"
+	"image/color"
+)
+
+// tolerance is the maximum distance in device pixels between curves and the
+// lines used to approximate them.
+const tolerance = 0.1
+
+// Canvas draws into an ARGB image. It maintains a current transform, clip and
+// compositing operator, which can be saved and restored.
+type Canvas struct {
+	dst   *ARGB
+	state canvasState
+	stack []canvasState
+	r     rasterizer
+}
+
+type canvasState struct {
+	transform Matrix
+	op        Op
+
+	// clip is the clip rectangle in device space, relative to the destination
+	// bounds.
+	clip image.Rectangle
+
+	// mask contains clip coverage for pixels within clip, or is nil if only
+	// the clip rectangle applies. Masks are never modified once created, so
+	// they can be shared between saved states.
+	mask []uint8
+}
+
+// NewCanvas returns a canvas that draws into dst. Device space coordinates are
+// the coordinates of dst.
+func NewCanvas(dst *ARGB) *Canvas {
+	return &Canvas{
+		dst: dst,
+		state: canvasState{
+			transform: Translation(float64(-dst.Rect.Min.X), float64(-dst.Rect.Min.Y)),
+			clip:      image.Rect(0, 0, dst.Rect.Dx(), dst.Rect.Dy()),
+		},
+	}
+}
+
+// Save saves the current transform, clip and operator.
+func (c *Canvas) Save() {
+	c.stack = append(c.stack, c.state)
+}
+
+// Restore restores the transform, clip and operator last saved with Save.
+func (c *Canvas) Restore() {
+	if len(c.stack) == 0 {
+		return
+	}
+	c.state = c.stack[len(c.stack)-1]
+	c.stack = c.stack[:len(c.stack)-1]
+}
+
+// Transform returns the current transform from user space to the coordinates
+// of the destination image.
+func (c *Canvas) Transform() Matrix {
+	return Translation(float64(c.dst.Rect.Min.X), float64(c.dst.Rect.Min.Y)).Multiply(c.state.transform)
+}
+
+// SetTransform sets the transform from user space to the coordinates of the
+// destination image.
+func (c *Canvas) SetTransform(m Matrix) {
+	c.state.transform = Translation(float64(-c.dst.Rect.Min.X), float64(-c.dst.Rect.Min.Y)).Multiply(m)
+}
+
+// Concat applies m to user space, before the current transform.
+func (c *Canvas) Concat(m Matrix) {
+	c.state.transform = c.state.transform.Multiply(m)
+}
+
+// Translate translates user space.
+func (c *Canvas) Translate(x, y float64) {
+	c.Concat(Translation(x, y))
+}
+
+// Scale scales user space.
+func (c *Canvas) Scale(sx, sy float64) {
+	c.Concat(Scaling(sx, sy))
+}
+
+// Rotate rotates user space clockwise by angle radians.
+func (c *Canvas) Rotate(angle float64) {
+	c.Concat(Rotation(angle))
+}
+
+// SetOp sets the compositing operator. The default is SrcOver.
+func (c *Canvas) SetOp(op Op) {
+	c.state.op = op
+}
+
+// ClipRect intersects the clip with a rectangle in the coordinates of the
+// destination image, ignoring the current transform.
+func (c *Canvas) ClipRect(r image.Rectangle) {
+	r = r.Sub(c.dst.Rect.Min).Intersect(c.state.clip)
+	if c.state.mask != nil {
+		mask := make([]uint8, r.Dx()*r.Dy())
+		old := c.state.clip
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			copy(mask[(y-r.Min.Y)*r.Dx():], c.state.mask[(y-old.Min.Y)*old.Dx()+r.Min.X-old.Min.X:][:r.Dx()])
+		}
+		c.state.mask = mask
+	}
+	c.state.clip = r
+}
+
+// ClipPath intersects the clip with the inside of a path, in user space.
+func (c *Canvas) ClipPath(p *Path, rule FillRule) {
+	clip := c.state.clip
+	mask := make([]uint8, clip.Dx()*clip.Dy())
+
+	c.rasterizeFill(p, rule, func(y, x0, x1 int, cover []float32) {
+		row := mask[(y-clip.Min.Y)*clip.Dx():]
+		for x := x0; x < x1; x++ {
+			row[x-clip.Min.X] = unit8(cover[x])
+		}
+	})
+
+	if c.state.mask != nil {
+		for i, v := range c.state.mask {
+			mask[i] = uint8((uint16(mask[i])*uint16(v) + 0x7f) / 0xff)
+		}
+	}
+
+	// Shrink the clip rectangle to the covered area.
+	bounds := image.Rectangle{}
+	for y := 0; y < clip.Dy(); y++ {
+		for x := 0; x < clip.Dx(); x++ {
+			if mask[y*clip.Dx()+x] != 0 {
+				bounds = bounds.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	c.state.mask = mask
+	c.ClipRect(bounds.Add(clip.Min).Add(c.dst.Rect.Min))
+}
+
+// Fill fills the inside of a path.
+func (c *Canvas) Fill(p *Path, paint Paint, rule FillRule) {
+	sh := paint.shader(c.state.transform)
+	c.rasterizeFill(p, rule, func(y, x0, x1 int, cover []float32) {
+		c.span(y, x0, x1, cover, sh)
+	})
+}
+
+// Stroke strokes the outline of a path.
+func (c *Canvas) Stroke(p *Path, paint Paint, style StrokeStyle) {
+	m := c.state.transform
+	scale := m.scale()
+	if scale == 0 {
+		return
+	}
+	tol := tolerance / scale
+
+	width := style.Width
+	if width <= 0 {
+		width = 1
+	}
+
+	lines := p.flatten(tol)
+	if len(style.Dashes) > 0 {
+		lines = dash(lines, style.Dashes, style.DashOffset)
+	}
+
+	c.r.reset(c.state.clip.Max.X, c.state.clip.Max.Y)
+	buf := []Point{}
+	s := stroker{
+		style: style,
+		hw:    width / 2,
+		tol:   tol,
+		emit: func(pts []Point) {
+			buf = buf[:0]
+			for _, pt := range pts {
+				buf = append(buf, m.Apply(pt))
+			}
+			c.r.addPolygon(buf)
+		},
+	}
+	for _, line := range lines {
+		s.stroke(line)
+	}
+
+	sh := paint.shader(m)
+	c.rasterize(NonZero, func(y, x0, x1 int, cover []float32) {
+		c.span(y, x0, x1, cover, sh)
+	})
+}
+
+// FillRect fills a rectangle in user space.
+func (c *Canvas) FillRect(x, y, w, h float64, paint Paint) {
+	p := Path{}
+	p.Rect(x, y, w, h)
+	c.Fill(&p, paint, NonZero)
+}
+
+// DrawImage draws an image with its origin at (x, y) in user space.
+func (c *Canvas) DrawImage(img image.Image, x, y float64, filter Filter) {
+	c.Save()
+	c.Translate(x, y)
+
+	b := img.Bounds()
+	p := Path{}
+	p.Rect(float64(b.Min.X), float64(b.Min.Y), float64(b.Dx()), float64(b.Dy()))
+	c.Fill(&p, Pattern{Image: img, Filter: filter}, NonZero)
+
+	c.Restore()
+}
+
+// FillText fills text in a font at size, with the baseline starting at (x, y)
+// in user space. It returns the advance width of the text.
+func (c *Canvas) FillText(text string, font *Font, size, x, y float64, paint Paint) float64 {
+	p := Path{}
+	advance := font.AppendText(&p, text, size, x, y)
+	c.Fill(&p, paint, NonZero)
+	return advance
+}
+
+// Clear sets every pixel within the clip to a color, regardless of the
+// current operator.
+func (c *Canvas) Clear(col color.Color) {
+	c.Save()
+	c.SetOp(Src)
+	c.state.transform = Identity()
+	clip := c.state.clip
+	c.FillRect(float64(clip.Min.X), float64(clip.Min.Y), float64(clip.Dx()), float64(clip.Dy()), Solid{col})
+	c.Restore()
+}
+
+// rasterizeFill rasterizes the inside of a path in device space, limited to
+// the clip rectangle. The mask is not applied.
+func (c *Canvas) rasterizeFill(p *Path, rule FillRule, fn func(y, x0, x1 int, cover []float32)) {
+	m := c.state.transform
+	scale := m.scale()
+	if scale == 0 {
+		return
+	}
+
+	c.r.reset(c.state.clip.Max.X, c.state.clip.Max.Y)
+	pts := []Point{}
+	for _, line := range p.flatten(tolerance / scale) {
+		pts = pts[:0]
+		for _, pt := range line.pts {
+			pts = append(pts, m.Apply(pt))
+		}
+		c.r.addPolygon(pts)
+	}
+	c.rasterize(rule, fn)
+}
+
+// rasterize rasterizes the polygons added to the rasterizer, limited to the
+// clip rectangle. The rasterizer only clips to the maximum of the rectangle.
+func (c *Canvas) rasterize(rule FillRule, fn func(y, x0, x1 int, cover []float32)) {
+	clip := c.state.clip
+	c.r.rasterize(rule, func(y, x0, x1 int, cover []float32) {
+		if y < clip.Min.Y {
+			return
+		}
+		if x0 < clip.Min.X {
+			x0 = clip.Min.X
+		}
+		if x0 < x1 {
+			fn(y, x0, x1, cover)
+		}
+	})
+}
+
+// span composites a row of coverage onto the destination.
+func (c *Canvas) span(y, x0, x1 int, cover []float32, sh shader) {
+	clip := c.state.clip
+	var mask []uint8
+	if c.state.mask != nil {
+		mask = c.state.mask[(y-clip.Min.Y)*clip.Dx():]
+	}
+
+	dst := c.dst
+	row := dst.Pix[y*dst.Stride:]
+	for x := x0; x < x1; x++ {
+		coverage := cover[x]
+		if mask != nil {
+			coverage *= float32(mask[x-clip.Min.X]) / 0xff
+		}
+		if coverage <= 0 {
+			continue
+		}
+		composite(c.state.op, row[x*4:x*4+4], sh.at(x, y), coverage, dst.Opaque)
+	}
+}
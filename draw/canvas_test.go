@@ -0,0 +1,51 @@
+package draw
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestStrokeClip(t *testing.T) {
+	red := Solid{color.RGBA{0xff, 0, 0, 0xff}}
+	diagonal := func() *Path {
+		p := &Path{}
+		p.MoveTo(0, 0)
+		p.LineTo(40, 40)
+		return p
+	}
+
+	tests := []struct {
+		name string
+		clip func(c *Canvas)
+	}{
+		{"rect", func(c *Canvas) {
+			c.ClipRect(image.Rect(10, 10, 30, 30))
+		}},
+		{"path", func(c *Canvas) {
+			p := &Path{}
+			p.Rect(10, 10, 20, 20)
+			c.ClipPath(p, NonZero)
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			img := NewARGB(image.Rect(0, 0, 40, 40))
+			c := NewCanvas(img)
+			test.clip(c)
+			c.Stroke(diagonal(), red, StrokeStyle{Width: 4})
+
+			for y := 0; y < 40; y++ {
+				for x := 0; x < 40; x++ {
+					inside := image.Pt(x, y).In(image.Rect(10, 10, 30, 30))
+					if a := img.RGBAAt(x, y).A; a != 0 && !inside {
+						t.Fatalf("pixel (%d, %d) outside the clip has alpha %d", x, y, a)
+					}
+				}
+			}
+			if a := img.RGBAAt(20, 20).A; a != 0xff {
+				t.Errorf("pixel (20, 20) on the stroke has alpha %d, want 255", a)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package draw
+
+// Op is a Porter-Duff compositing operator, combining a source with the
+// destination. Only pixels covered by the shape being drawn are affected.
+type Op int
+
+// Porter-Duff operators.
+const (
+	SrcOver Op = iota
+	Src
+	Dst
+	Clear
+	DstOver
+	SrcIn
+	DstIn
+	SrcOut
+	DstOut
+	SrcAtop
+	DstAtop
+	Xor
+)
+
+// factors returns the Porter-Duff factors by which the source and destination
+// are multiplied, given their alphas.
+func (op Op) factors(sa, da float32) (fa, fb float32) {
+	switch op {
+	case Src:
+		return 1, 0
+	case Dst:
+		return 0, 1
+	case Clear:
+		return 0, 0
+	case DstOver:
+		return 1 - da, 1
+	case SrcIn:
+		return da, 0
+	case DstIn:
+		return 0, sa
+	case SrcOut:
+		return 1 - da, 0
+	case DstOut:
+		return 0, 1 - sa
+	case SrcAtop:
+		return da, 1 - sa
+	case DstAtop:
+		return 1 - da, sa
+	case Xor:
+		return 1 - da, 1 - sa
+	}
+	return 1, 1 - sa
+}
+
+// composite composites src onto the pixel at dst, a slice of 4 bytes in B, G,
+// R, A order, with the given coverage. With partial coverage, the result is
+// interpolated between the destination and the fully covered result.
+func composite(op Op, dst []byte, src premul, coverage float32, opaque bool) {
+	d := premul{
+		r: float32(dst[2]) / 0xff,
+		g: float32(dst[1]) / 0xff,
+		b: float32(dst[0]) / 0xff,
+		a: float32(dst[3]) / 0xff,
+	}
+	if opaque {
+		d.a = 1
+	}
+
+	fa, fb := op.factors(src.a, d.a)
+	res := premul{
+		r: src.r*fa + d.r*fb,
+		g: src.g*fa + d.g*fb,
+		b: src.b*fa + d.b*fb,
+		a: src.a*fa + d.a*fb,
+	}
+	if coverage < 1 {
+		res = d.lerp(res, coverage)
+	}
+
+	dst[0] = unit8(res.b)
+	dst[1] = unit8(res.g)
+	dst[2] = unit8(res.r)
+	if opaque {
+		dst[3] = 0xff
+	} else {
+		dst[3] = unit8(res.a)
+	}
+}
+
+// unit8 converts a value in [0, 1] to a byte, rounding and clamping.
+func unit8(v float32) byte {
+	if v <= 0 {
+		return 0
+	} else if v >= 1 {
+		return 0xff
+	}
+	return byte(v*0xff + 0.5)
+}
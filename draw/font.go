@@ -0,0 +1,553 @@
+package draw
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupportedFont is returned when parsing a font in a format other than
+// TrueType, such as CFF-based OpenType.
+var ErrUnsupportedFont = errors.New("unsupported font format")
+
+// maxCompositeDepth limits the nesting of composite glyphs, which may
+// otherwise refer to each other in a loop.
+const maxCompositeDepth = 8
+
+// Font is a TrueType font. Glyphs are drawn from their outlines, without
+// hinting.
+type Font struct {
+	unitsPerEm  float64
+	longLoca    bool
+	numGlyphs   int
+	numHMetrics int
+
+	ascent, descent, lineGap float64
+
+	loca, glyf, hmtx, cmap []byte
+
+	// cmapFormat is the format of the cmap subtable in cmap.
+	cmapFormat uint16
+}
+
+// FontMetrics contains the vertical metrics of a font at a given size, in user
+// space units. Descent is positive below the baseline.
+type FontMetrics struct {
+	Ascent, Descent, LineGap float64
+}
+
+// Height returns the distance between the baselines of consecutive lines.
+func (m FontMetrics) Height() float64 {
+	return m.Ascent + m.Descent + m.LineGap
+}
+
+// ParseFont parses a TrueType font file. For font collections, the first font
+// is used. The data must not be modified afterwards.
+func ParseFont(data []byte) (*Font, error) {
+	if len(data) < 12 {
+		return nil, errors.New("font: file too short")
+	}
+
+	offset := 0
+	switch tag := string(data[:4]); tag {
+	case "ttcf":
+		if len(data) < 16 {
+			return nil, errors.New("font: collection header too short")
+		}
+		offset = int(binary.BigEndian.Uint32(data[12:]))
+	case "\x00\x01\x00\x00", "true":
+	default:
+		return nil, ErrUnsupportedFont
+	}
+
+	f := &Font{}
+	tables, err := readTables(data, offset)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range []string{"head", "maxp", "hhea", "hmtx", "loca", "glyf", "cmap"} {
+		if tables[name] == nil {
+			return nil, fmt.Errorf("font: missing %s table", name)
+		}
+	}
+
+	head := tables["head"]
+	if len(head) < 54 {
+		return nil, errors.New("font: head table too short")
+	}
+	f.unitsPerEm = float64(binary.BigEndian.Uint16(head[18:]))
+	if f.unitsPerEm == 0 {
+		return nil, errors.New("font: invalid units per em")
+	}
+	f.longLoca = binary.BigEndian.Uint16(head[50:]) != 0
+
+	maxp := tables["maxp"]
+	if len(maxp) < 6 {
+		return nil, errors.New("font: maxp table too short")
+	}
+	f.numGlyphs = int(binary.BigEndian.Uint16(maxp[4:]))
+
+	hhea := tables["hhea"]
+	if len(hhea) < 36 {
+		return nil, errors.New("font: hhea table too short")
+	}
+	f.ascent = float64(int16(binary.BigEndian.Uint16(hhea[4:])))
+	f.descent = -float64(int16(binary.BigEndian.Uint16(hhea[6:])))
+	f.lineGap = float64(int16(binary.BigEndian.Uint16(hhea[8:])))
+	f.numHMetrics = int(binary.BigEndian.Uint16(hhea[34:]))
+
+	f.hmtx, f.loca, f.glyf = tables["hmtx"], tables["loca"], tables["glyf"]
+	if f.numHMetrics == 0 || len(f.hmtx) < f.numHMetrics*4 {
+		return nil, errors.New("font: hmtx table too short")
+	}
+
+	if err := f.findCmap(tables["cmap"]); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// readTables reads the table directory of the font at offset.
+func readTables(data []byte, offset int) (map[string][]byte, error) {
+	if offset+12 > len(data) {
+		return nil, errors.New("font: offset table out of range")
+	}
+	n := int(binary.BigEndian.Uint16(data[offset+4:]))
+	if offset+12+n*16 > len(data) {
+		return nil, errors.New("font: table directory out of range")
+	}
+
+	tables := make(map[string][]byte, n)
+	for i := 0; i < n; i++ {
+		rec := data[offset+12+i*16:]
+		start := int(binary.BigEndian.Uint32(rec[8:]))
+		length := int(binary.BigEndian.Uint32(rec[12:]))
+		if start < 0 || length < 0 || start+length > len(data) {
+			return nil, fmt.Errorf("font: table %q out of range", rec[:4])
+		}
+		tables[string(rec[:4])] = data[start : start+length]
+	}
+	return tables, nil
+}
+
+// findCmap picks a Unicode cmap subtable.
+func (f *Font) findCmap(cmap []byte) error {
+	if len(cmap) < 4 {
+		return errors.New("font: cmap table too short")
+	}
+	n := int(binary.BigEndian.Uint16(cmap[2:]))
+	if len(cmap) < 4+n*8 {
+		return errors.New("font: cmap table too short")
+	}
+
+	best := -1
+	for i := 0; i < n; i++ {
+		rec := cmap[4+i*8:]
+		platform := binary.BigEndian.Uint16(rec)
+		encoding := binary.BigEndian.Uint16(rec[2:])
+		offset := int(binary.BigEndian.Uint32(rec[4:]))
+		if offset+2 > len(cmap) {
+			continue
+		}
+		format := binary.BigEndian.Uint16(cmap[offset:])
+
+		// Prefer full Unicode subtables over BMP-only ones.
+		rank := -1
+		switch {
+		case format == 12 && (platform == 0 || platform == 3 && encoding == 10):
+			rank = 1
+		case format == 4 && (platform == 0 || platform == 3 && encoding == 1):
+			rank = 0
+		}
+		if rank > best {
+			best = rank
+			f.cmap = cmap[offset:]
+			f.cmapFormat = format
+		}
+	}
+	if best < 0 {
+		return errors.New("font: no Unicode cmap subtable")
+	}
+	return nil
+}
+
+// glyphIndex returns the glyph for a rune, or 0, the missing glyph.
+func (f *Font) glyphIndex(r rune) int {
+	c := uint32(r)
+	t := f.cmap
+
+	switch f.cmapFormat {
+	case 4:
+		if c > 0xffff || len(t) < 14 {
+			return 0
+		}
+		segs := int(binary.BigEndian.Uint16(t[6:])) / 2
+		if len(t) < 16+segs*8 {
+			return 0
+		}
+		ends := t[14:]
+		starts := t[16+segs*2:]
+		deltas := t[16+segs*4:]
+		ranges := t[16+segs*6:]
+
+		// Binary search for the first segment ending at or after c.
+		lo, hi := 0, segs
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if uint32(binary.BigEndian.Uint16(ends[mid*2:])) < c {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		if lo == segs {
+			return 0
+		}
+		start := uint32(binary.BigEndian.Uint16(starts[lo*2:]))
+		if c < start {
+			return 0
+		}
+		delta := binary.BigEndian.Uint16(deltas[lo*2:])
+		rangeOffset := int(binary.BigEndian.Uint16(ranges[lo*2:]))
+		if rangeOffset == 0 {
+			return int(uint16(c) + delta)
+		}
+		i := 16 + segs*6 + lo*2 + rangeOffset + int(c-start)*2
+		if i+2 > len(t) {
+			return 0
+		}
+		g := binary.BigEndian.Uint16(t[i:])
+		if g == 0 {
+			return 0
+		}
+		return int(g + delta)
+
+	case 12:
+		if len(t) < 16 {
+			return 0
+		}
+		n := int(binary.BigEndian.Uint32(t[12:]))
+		if len(t) < 16+n*12 {
+			return 0
+		}
+		lo, hi := 0, n
+		for lo < hi {
+			mid := (lo + hi) / 2
+			group := t[16+mid*12:]
+			if binary.BigEndian.Uint32(group[4:]) < c {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		if lo == n {
+			return 0
+		}
+		group := t[16+lo*12:]
+		start := binary.BigEndian.Uint32(group)
+		if c < start {
+			return 0
+		}
+		return int(binary.BigEndian.Uint32(group[8:]) + c - start)
+	}
+
+	return 0
+}
+
+// advance returns the advance width of a glyph in font units.
+func (f *Font) advance(g int) float64 {
+	if g >= f.numHMetrics {
+		g = f.numHMetrics - 1
+	}
+	return float64(binary.BigEndian.Uint16(f.hmtx[g*4:]))
+}
+
+// glyphData returns the glyf table entry for a glyph, or nil if the glyph has
+// no outline.
+func (f *Font) glyphData(g int) []byte {
+	if g < 0 || g >= f.numGlyphs {
+		return nil
+	}
+	var start, end int
+	if f.longLoca {
+		if (g+2)*4 > len(f.loca) {
+			return nil
+		}
+		start = int(binary.BigEndian.Uint32(f.loca[g*4:]))
+		end = int(binary.BigEndian.Uint32(f.loca[g*4+4:]))
+	} else {
+		if (g+2)*2 > len(f.loca) {
+			return nil
+		}
+		start = int(binary.BigEndian.Uint16(f.loca[g*2:])) * 2
+		end = int(binary.BigEndian.Uint16(f.loca[g*2+2:])) * 2
+	}
+	if start >= end || end > len(f.glyf) {
+		return nil
+	}
+	return f.glyf[start:end]
+}
+
+// Metrics returns the vertical metrics of the font at size, which is the
+// height of an em in user space units.
+func (f *Font) Metrics(size float64) FontMetrics {
+	k := size / f.unitsPerEm
+	return FontMetrics{
+		Ascent:  f.ascent * k,
+		Descent: f.descent * k,
+		LineGap: f.lineGap * k,
+	}
+}
+
+// Measure returns the advance width of text at size.
+func (f *Font) Measure(text string, size float64) float64 {
+	w := 0.0
+	for _, r := range text {
+		w += f.advance(f.glyphIndex(r))
+	}
+	return w * size / f.unitsPerEm
+}
+
+// AppendText adds the outlines of text at size to a path, with the baseline
+// starting at (x, y). It returns the advance width of the text. Outlines
+// should be filled with the NonZero rule.
+func (f *Font) AppendText(p *Path, text string, size, x, y float64) float64 {
+	k := size / f.unitsPerEm
+	m := Matrix{A: k, D: -k, E: x, F: y}
+	start := x
+	for _, r := range text {
+		g := f.glyphIndex(r)
+		f.appendGlyph(p, g, m, 0)
+		m.E += f.advance(g) * k
+	}
+	return m.E - start
+}
+
+// appendGlyph adds the outline of a glyph, transformed by m, to a path.
+func (f *Font) appendGlyph(p *Path, g int, m Matrix, depth int) {
+	data := f.glyphData(g)
+	if len(data) < 10 {
+		return
+	}
+
+	contours := int(int16(binary.BigEndian.Uint16(data)))
+	if contours >= 0 {
+		f.appendSimple(p, data, contours, m)
+		return
+	}
+	if depth >= maxCompositeDepth {
+		return
+	}
+
+	// Composite glyph flags.
+	const (
+		argsAreWords   = 0x0001
+		argsAreXY      = 0x0002
+		haveScale      = 0x0008
+		moreComponents = 0x0020
+		haveXYScale    = 0x0040
+		haveTwoByTwo   = 0x0080
+	)
+
+	i := 10
+	for {
+		if i+4 > len(data) {
+			return
+		}
+		flags := binary.BigEndian.Uint16(data[i:])
+		component := int(binary.BigEndian.Uint16(data[i+2:]))
+		i += 4
+
+		var dx, dy float64
+		if flags&argsAreWords != 0 {
+			if i+4 > len(data) {
+				return
+			}
+			dx = float64(int16(binary.BigEndian.Uint16(data[i:])))
+			dy = float64(int16(binary.BigEndian.Uint16(data[i+2:])))
+			i += 4
+		} else {
+			if i+2 > len(data) {
+				return
+			}
+			dx = float64(int8(data[i]))
+			dy = float64(int8(data[i+1]))
+			i += 2
+		}
+		if flags&argsAreXY == 0 {
+			// Components positioned by matching points are not supported.
+			dx, dy = 0, 0
+		}
+
+		f2dot14 := func(j int) float64 {
+			return float64(int16(binary.BigEndian.Uint16(data[j:]))) / (1 << 14)
+		}
+		t := Matrix{A: 1, D: 1, E: dx, F: dy}
+		switch {
+		case flags&haveScale != 0 && i+2 <= len(data):
+			t.A = f2dot14(i)
+			t.D = t.A
+			i += 2
+		case flags&haveXYScale != 0 && i+4 <= len(data):
+			t.A, t.D = f2dot14(i), f2dot14(i+2)
+			i += 4
+		case flags&haveTwoByTwo != 0 && i+8 <= len(data):
+			t.A, t.B, t.C, t.D = f2dot14(i), f2dot14(i+2), f2dot14(i+4), f2dot14(i+6)
+			i += 8
+		}
+
+		f.appendGlyph(p, component, m.Multiply(t), depth+1)
+
+		if flags&moreComponents == 0 {
+			return
+		}
+	}
+}
+
+// appendSimple adds a simple glyph's outline to a path.
+func (f *Font) appendSimple(p *Path, data []byte, contours int, m Matrix) {
+	// Simple glyph flags.
+	const (
+		onCurve    = 0x01
+		xShort     = 0x02
+		yShort     = 0x04
+		repeat     = 0x08
+		xSameOrPos = 0x10
+		ySameOrPos = 0x20
+	)
+
+	i := 10
+	if i+contours*2+2 > len(data) {
+		return
+	}
+	ends := make([]int, contours)
+	for c := range ends {
+		ends[c] = int(binary.BigEndian.Uint16(data[i:]))
+		i += 2
+	}
+	if contours == 0 {
+		return
+	}
+	n := ends[contours-1] + 1
+	i += 2 + int(binary.BigEndian.Uint16(data[i:]))
+
+	flags := make([]byte, 0, n)
+	for len(flags) < n {
+		if i >= len(data) {
+			return
+		}
+		flag := data[i]
+		i++
+		flags = append(flags, flag)
+		if flag&repeat != 0 {
+			if i >= len(data) {
+				return
+			}
+			count := int(data[i])
+			i++
+			for ; count > 0 && len(flags) < n; count-- {
+				flags = append(flags, flag)
+			}
+		}
+	}
+
+	pts := make([]Point, n)
+	readCoords := func(short, sameOrPos byte, y bool) bool {
+		v := 0
+		for j, flag := range flags {
+			switch {
+			case flag&short != 0:
+				if i >= len(data) {
+					return false
+				}
+				d := int(data[i])
+				i++
+				if flag&sameOrPos == 0 {
+					d = -d
+				}
+				v += d
+			case flag&sameOrPos == 0:
+				if i+2 > len(data) {
+					return false
+				}
+				v += int(int16(binary.BigEndian.Uint16(data[i:])))
+				i += 2
+			}
+			if y {
+				pts[j].Y = float64(v)
+			} else {
+				pts[j].X = float64(v)
+			}
+		}
+		return true
+	}
+	if !readCoords(xShort, xSameOrPos, false) || !readCoords(yShort, ySameOrPos, true) {
+		return
+	}
+	for j := range pts {
+		pts[j] = m.Apply(pts[j])
+	}
+
+	start := 0
+	for _, end := range ends {
+		if end < start || end >= n {
+			return
+		}
+		appendContour(p, pts[start:end+1], flags[start:end+1])
+		start = end + 1
+	}
+}
+
+// appendContour adds a closed contour of quadratic B-spline points to a path.
+// Between two consecutive off-curve points, an on-curve point is implied
+// halfway.
+func appendContour(p *Path, pts []Point, flags []byte) {
+	n := len(pts)
+	if n == 0 {
+		return
+	}
+	on := func(j int) bool { return flags[j%n]&1 != 0 }
+	pt := func(j int) Point { return pts[j%n] }
+
+	// Start at an on-curve point, or between the first two points if there
+	// is none.
+	first := -1
+	for j := 0; j < n; j++ {
+		if on(j) {
+			first = j
+			break
+		}
+	}
+	var start Point
+	if first >= 0 {
+		start = pt(first)
+	} else {
+		first = 0
+		start = pt(0).lerp(pt(1), 0.5)
+	}
+	p.MoveTo(start.X, start.Y)
+
+	var ctrl *Point
+	for j := first + 1; j <= first+n; j++ {
+		q := pt(j)
+		if on(j) {
+			if ctrl != nil {
+				p.QuadTo(ctrl.X, ctrl.Y, q.X, q.Y)
+				ctrl = nil
+			} else {
+				p.LineTo(q.X, q.Y)
+			}
+			continue
+		}
+		if ctrl != nil {
+			mid := ctrl.lerp(q, 0.5)
+			p.QuadTo(ctrl.X, ctrl.Y, mid.X, mid.Y)
+		}
+		ctrl = &q
+	}
+	if ctrl != nil {
+		p.QuadTo(ctrl.X, ctrl.Y, start.X, start.Y)
+	}
+	p.Close()
+}
@@ -0,0 +1,206 @@
+package draw
+
+import (
+	"bytes"
+	"flag"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// update rewrites the golden images in testdata instead of comparing against
+// them: go test ./draw -update.
+var update = flag.Bool("update", false, "update golden images")
+
+// goldenTolerance is the largest difference allowed in any channel, which
+// absorbs floating point differences between architectures.
+const goldenTolerance = 2
+
+var (
+	black = Solid{color.Black}
+	red   = Solid{color.RGBA{0xff, 0, 0, 0xff}}
+	blue  = Solid{color.RGBA{0, 0, 0xff, 0xff}}
+)
+
+func star(cx, cy, r float64) *Path {
+	p := &Path{}
+	for i := 0; i < 5; i++ {
+		a := float64(i*2)*2*math.Pi/5 - math.Pi/2
+		x, y := cx+r*math.Cos(a), cy+r*math.Sin(a)
+		if i == 0 {
+			p.MoveTo(x, y)
+		} else {
+			p.LineTo(x, y)
+		}
+	}
+	p.Close()
+	return p
+}
+
+func zigzag(x, y float64) *Path {
+	p := &Path{}
+	p.MoveTo(x, y+16)
+	p.LineTo(x+10, y)
+	p.LineTo(x+20, y+16)
+	p.LineTo(x+30, y+4)
+	return p
+}
+
+func line(x0, y0, x1, y1 float64) *Path {
+	p := &Path{}
+	p.MoveTo(x0, y0)
+	p.LineTo(x1, y1)
+	return p
+}
+
+var goldenTests = []struct {
+	name string
+	draw func(c *Canvas)
+}{
+	{"fill_nonzero", func(c *Canvas) {
+		c.Fill(star(32, 34, 28), red, NonZero)
+	}},
+	{"fill_evenodd", func(c *Canvas) {
+		c.Fill(star(32, 34, 28), red, EvenOdd)
+	}},
+	{"fill_curves", func(c *Canvas) {
+		p := &Path{}
+		p.MoveTo(8, 56)
+		p.QuadTo(32, -8, 56, 56)
+		p.CubicTo(40, 40, 24, 72, 8, 56)
+		c.Fill(p, blue, NonZero)
+	}},
+	{"stroke_joins", func(c *Canvas) {
+		for i, join := range []LineJoin{MiterJoin, RoundJoin, BevelJoin} {
+			c.Stroke(zigzag(16, 4+float64(i)*20), black, StrokeStyle{Width: 6, Join: join})
+		}
+	}},
+	{"stroke_caps", func(c *Canvas) {
+		for i, cap := range []LineCap{ButtCap, RoundCap, SquareCap} {
+			y := 12 + float64(i)*20
+			c.Stroke(line(16, y, 48, y), black, StrokeStyle{Width: 10, Cap: cap})
+		}
+		c.Stroke(line(16, 0, 16, 64), red, StrokeStyle{Width: 1})
+		c.Stroke(line(48, 0, 48, 64), red, StrokeStyle{Width: 1})
+	}},
+	{"stroke_dashes", func(c *Canvas) {
+		p := &Path{}
+		p.Circle(32, 32, 24)
+		c.Stroke(p, black, StrokeStyle{Width: 4, Dashes: []float64{12, 6}, DashOffset: 3})
+		c.Stroke(line(8, 32, 56, 32), red, StrokeStyle{Width: 3, Cap: RoundCap, Dashes: []float64{1, 8}})
+	}},
+	{"stroke_transformed", func(c *Canvas) {
+		c.Translate(32, 32)
+		c.Rotate(math.Pi / 6)
+		c.Scale(2, 1)
+		p := &Path{}
+		p.Rect(-12, -12, 24, 24)
+		c.Stroke(p, blue, StrokeStyle{Width: 3, Join: RoundJoin})
+	}},
+	{"gradient_linear", func(c *Canvas) {
+		c.FillRect(0, 0, 64, 64, LinearGradient{
+			Start: Point{8, 8},
+			End:   Point{56, 56},
+			Stops: []Stop{
+				{0, color.RGBA{0xff, 0, 0, 0xff}},
+				{0.5, color.RGBA{0, 0xff, 0, 0xff}},
+				{1, color.RGBA{0, 0, 0xff, 0xff}},
+			},
+		})
+	}},
+	{"gradient_radial", func(c *Canvas) {
+		c.FillRect(0, 0, 64, 64, RadialGradient{
+			Center: Point{32, 32},
+			Radius: 28,
+			Stops: []Stop{
+				{0, color.White},
+				{1, color.RGBA{0, 0, 0x80, 0x80}},
+			},
+		})
+	}},
+	{"clip_rect", func(c *Canvas) {
+		c.ClipRect(image.Rect(12, 16, 52, 48))
+		c.Fill(star(32, 34, 28), red, NonZero)
+		c.Stroke(line(0, 0, 64, 64), blue, StrokeStyle{Width: 4})
+	}},
+	{"clip_path", func(c *Canvas) {
+		p := &Path{}
+		p.Circle(32, 32, 22)
+		c.ClipPath(p, NonZero)
+		c.FillRect(0, 0, 64, 64, LinearGradient{
+			Start: Point{0, 0},
+			End:   Point{64, 0},
+			Stops: []Stop{{0, color.RGBA{0xff, 0, 0, 0xff}}, {1, color.RGBA{0, 0, 0xff, 0xff}}},
+		})
+		c.Stroke(line(0, 0, 64, 64), black, StrokeStyle{Width: 4})
+		c.Stroke(line(0, 64, 64, 0), black, StrokeStyle{Width: 4, Dashes: []float64{8, 4}})
+	}},
+}
+
+func TestGolden(t *testing.T) {
+	for _, test := range goldenTests {
+		t.Run(test.name, func(t *testing.T) {
+			img := NewARGB(image.Rect(0, 0, 64, 64))
+			test.draw(NewCanvas(img))
+
+			path := filepath.Join("testdata", test.name+".png")
+			if *update {
+				var buf bytes.Buffer
+				if err := png.Encode(&buf, img); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			f, err := os.Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			want, err := png.Decode(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			compareGolden(t, img, want)
+		})
+	}
+}
+
+// compareGolden fails the test if img differs from want by more than
+// goldenTolerance in any channel of any pixel.
+func compareGolden(t *testing.T, img *ARGB, want image.Image) {
+	t.Helper()
+	if want.Bounds() != img.Bounds() {
+		t.Fatalf("bounds are %v, want %v", img.Bounds(), want.Bounds())
+	}
+	bad := 0
+	for y := img.Rect.Min.Y; y < img.Rect.Max.Y; y++ {
+		for x := img.Rect.Min.X; x < img.Rect.Max.X; x++ {
+			got := img.RGBAAt(x, y)
+			exp := color.RGBAModel.Convert(want.At(x, y)).(color.RGBA)
+			if differs(got.R, exp.R) || differs(got.G, exp.G) || differs(got.B, exp.B) || differs(got.A, exp.A) {
+				if bad < 5 {
+					t.Errorf("pixel (%d, %d) is %v, want %v", x, y, got, exp)
+				}
+				bad++
+			}
+		}
+	}
+	if bad > 5 {
+		t.Errorf("%d pixels differ", bad)
+	}
+}
+
+func differs(a, b uint8) bool {
+	if a > b {
+		return a-b > goldenTolerance
+	}
+	return b-a > goldenTolerance
+}
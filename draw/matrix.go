@@ -0,0 +1,71 @@
+package draw
+
+import "math"
+
+// Matrix is a 2D affine transform. A point (x, y) is transformed to
+// (A*x + C*y + E, B*x + D*y + F).
+type Matrix struct {
+	A, B, C, D, E, F float64
+}
+
+// Identity returns the identity transform.
+func Identity() Matrix {
+	return Matrix{A: 1, D: 1}
+}
+
+// Translation returns a transform that translates by (x, y).
+func Translation(x, y float64) Matrix {
+	return Matrix{A: 1, D: 1, E: x, F: y}
+}
+
+// Scaling returns a transform that scales by (sx, sy).
+func Scaling(sx, sy float64) Matrix {
+	return Matrix{A: sx, D: sy}
+}
+
+// Rotation returns a transform that rotates by angle radians. As the y axis
+// points down, positive angles rotate clockwise.
+func Rotation(angle float64) Matrix {
+	s, c := math.Sincos(angle)
+	return Matrix{A: c, B: s, C: -s, D: c}
+}
+
+// Multiply returns the transform that applies n, then m.
+func (m Matrix) Multiply(n Matrix) Matrix {
+	return Matrix{
+		A: m.A*n.A + m.C*n.B,
+		B: m.B*n.A + m.D*n.B,
+		C: m.A*n.C + m.C*n.D,
+		D: m.B*n.C + m.D*n.D,
+		E: m.A*n.E + m.C*n.F + m.E,
+		F: m.B*n.E + m.D*n.F + m.F,
+	}
+}
+
+// Apply transforms a point.
+func (m Matrix) Apply(p Point) Point {
+	return Point{m.A*p.X + m.C*p.Y + m.E, m.B*p.X + m.D*p.Y + m.F}
+}
+
+// Invert returns the inverse transform. It returns false if the transform is
+// not invertible.
+func (m Matrix) Invert() (Matrix, bool) {
+	det := m.A*m.D - m.B*m.C
+	if det == 0 || math.IsNaN(det) || math.IsInf(det, 0) {
+		return Matrix{}, false
+	}
+	return Matrix{
+		A: m.D / det,
+		B: -m.B / det,
+		C: -m.C / det,
+		D: m.A / det,
+		E: (m.C*m.F - m.D*m.E) / det,
+		F: (m.B*m.E - m.A*m.F) / det,
+	}, true
+}
+
+// scale returns the geometric mean of the scale factors of the transform, used
+// to convert device space tolerances into user space.
+func (m Matrix) scale() float64 {
+	return math.Sqrt(math.Abs(m.A*m.D - m.B*m.C))
+}
@@ -0,0 +1,253 @@
+package draw
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sort"
+)
+
+// premul is an alpha-premultiplied color with components in [0, 1].
+type premul struct {
+	r, g, b, a float32
+}
+
+func premulOf(c color.Color) premul {
+	r, g, b, a := c.RGBA()
+	return premul{float32(r) / 0xffff, float32(g) / 0xffff, float32(b) / 0xffff, float32(a) / 0xffff}
+}
+
+func (c premul) lerp(d premul, t float32) premul {
+	return premul{
+		c.r + (d.r-c.r)*t,
+		c.g + (d.g-c.g)*t,
+		c.b + (d.b-c.b)*t,
+		c.a + (d.a-c.a)*t,
+	}
+}
+
+// Paint determines the color of each pixel drawn. Paints are defined in user
+// space, and are transformed along with the path they are drawn with.
+type Paint interface {
+	// shader returns a shader for device space, given the transform from user
+	// space to device space.
+	shader(m Matrix) shader
+}
+
+// shader returns the color at the center of a device pixel.
+type shader interface {
+	at(x, y int) premul
+}
+
+// Solid is a paint of a single color.
+type Solid struct {
+	Color color.Color
+}
+
+type solidShader premul
+
+func (s solidShader) at(x, y int) premul {
+	return premul(s)
+}
+
+func (s Solid) shader(Matrix) shader {
+	return solidShader(premulOf(s.Color))
+}
+
+// Stop is a color stop of a gradient.
+type Stop struct {
+	// Offset is the position of the stop along the gradient, from 0 to 1.
+	Offset float64
+
+	Color color.Color
+}
+
+// gradientLUTSize is the number of entries in the color lookup table of a
+// gradient.
+const gradientLUTSize = 256
+
+// gradientLUT builds a color lookup table for stops. Colors are interpolated
+// in premultiplied space; beyond the first and last stops, colors are padded.
+func gradientLUT(stops []Stop) []premul {
+	lut := make([]premul, gradientLUTSize)
+	if len(stops) == 0 {
+		return lut
+	}
+
+	sorted := append([]Stop(nil), stops...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Offset < sorted[j].Offset })
+	colors := make([]premul, len(sorted))
+	for i, s := range sorted {
+		colors[i] = premulOf(s.Color)
+	}
+
+	j := 0
+	for i := range lut {
+		t := float64(i) / (gradientLUTSize - 1)
+		for j < len(sorted) && sorted[j].Offset < t {
+			j++
+		}
+		switch {
+		case j == 0:
+			lut[i] = colors[0]
+		case j == len(sorted):
+			lut[i] = colors[len(colors)-1]
+		default:
+			a, b := sorted[j-1], sorted[j]
+			f := 0.0
+			if b.Offset > a.Offset {
+				f = (t - a.Offset) / (b.Offset - a.Offset)
+			}
+			lut[i] = colors[j-1].lerp(colors[j], float32(f))
+		}
+	}
+
+	return lut
+}
+
+func lookup(lut []premul, t float64) premul {
+	if !(t > 0) {
+		return lut[0]
+	} else if t >= 1 {
+		return lut[len(lut)-1]
+	}
+	return lut[int(t*(gradientLUTSize-1)+0.5)]
+}
+
+// LinearGradient is a paint that varies along the line from Start to End.
+type LinearGradient struct {
+	Start, End Point
+	Stops      []Stop
+}
+
+type linearShader struct {
+	inv    Matrix
+	start  Point
+	dir    Point
+	lut    []premul
+	single bool
+}
+
+func (g LinearGradient) shader(m Matrix) shader {
+	inv, _ := m.Invert()
+	d := g.End.sub(g.Start)
+	l2 := d.dot(d)
+	s := &linearShader{inv: inv, start: g.Start, lut: gradientLUT(g.Stops)}
+	if l2 == 0 {
+		s.single = true
+	} else {
+		s.dir = d.mul(1 / l2)
+	}
+	return s
+}
+
+func (s *linearShader) at(x, y int) premul {
+	if s.single {
+		return s.lut[len(s.lut)-1]
+	}
+	p := s.inv.Apply(Point{float64(x) + 0.5, float64(y) + 0.5})
+	return lookup(s.lut, p.sub(s.start).dot(s.dir))
+}
+
+// RadialGradient is a paint that varies with distance from Center, reaching
+// the last stop at Radius.
+type RadialGradient struct {
+	Center Point
+	Radius float64
+	Stops  []Stop
+}
+
+type radialShader struct {
+	inv    Matrix
+	center Point
+	radius float64
+	lut    []premul
+}
+
+func (g RadialGradient) shader(m Matrix) shader {
+	inv, _ := m.Invert()
+	return &radialShader{inv: inv, center: g.Center, radius: g.Radius, lut: gradientLUT(g.Stops)}
+}
+
+func (s *radialShader) at(x, y int) premul {
+	if s.radius <= 0 {
+		return s.lut[len(s.lut)-1]
+	}
+	p := s.inv.Apply(Point{float64(x) + 0.5, float64(y) + 0.5})
+	return lookup(s.lut, p.sub(s.center).length()/s.radius)
+}
+
+// Filter selects how images are sampled when transformed.
+type Filter int
+
+const (
+	// Bilinear interpolates between the four nearest pixels.
+	Bilinear Filter = iota
+
+	// Nearest uses the nearest pixel.
+	Nearest
+)
+
+// Pattern is a paint that samples an image, with the image's origin at the
+// user space origin. Outside the image, the nearest edge pixel is used.
+type Pattern struct {
+	Image  image.Image
+	Filter Filter
+}
+
+type patternShader struct {
+	inv    Matrix
+	src    image.Image
+	bounds image.Rectangle
+	filter Filter
+}
+
+func (p Pattern) shader(m Matrix) shader {
+	inv, _ := m.Invert()
+	return &patternShader{inv: inv, src: p.Image, bounds: p.Image.Bounds(), filter: p.Filter}
+}
+
+func (s *patternShader) pixel(x, y int) premul {
+	b := s.bounds
+	if b.Empty() {
+		return premul{}
+	}
+	if x < b.Min.X {
+		x = b.Min.X
+	} else if x >= b.Max.X {
+		x = b.Max.X - 1
+	}
+	if y < b.Min.Y {
+		y = b.Min.Y
+	} else if y >= b.Max.Y {
+		y = b.Max.Y - 1
+	}
+
+	switch img := s.src.(type) {
+	case *ARGB:
+		c := img.RGBAAt(x, y)
+		return premul{float32(c.R) / 0xff, float32(c.G) / 0xff, float32(c.B) / 0xff, float32(c.A) / 0xff}
+	case *image.RGBA:
+		c := img.RGBAAt(x, y)
+		return premul{float32(c.R) / 0xff, float32(c.G) / 0xff, float32(c.B) / 0xff, float32(c.A) / 0xff}
+	}
+	return premulOf(s.src.At(x, y))
+}
+
+func (s *patternShader) at(x, y int) premul {
+	p := s.inv.Apply(Point{float64(x) + 0.5, float64(y) + 0.5})
+
+	if s.filter == Nearest {
+		return s.pixel(int(math.Floor(p.X)), int(math.Floor(p.Y)))
+	}
+
+	// Sample between pixel centers.
+	fx, fy := p.X-0.5, p.Y-0.5
+	x0, y0 := math.Floor(fx), math.Floor(fy)
+	tx, ty := float32(fx-x0), float32(fy-y0)
+	ix, iy := int(x0), int(y0)
+
+	top := s.pixel(ix, iy).lerp(s.pixel(ix+1, iy), tx)
+	bottom := s.pixel(ix, iy+1).lerp(s.pixel(ix+1, iy+1), tx)
+	return top.lerp(bottom, ty)
+}
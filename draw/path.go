@@ -0,0 +1,219 @@
+package draw
+
+import "math"
+
+// Point is a point in user or device space.
+type Point struct {
+	X, Y float64
+}
+
+func (p Point) add(q Point) Point     { return Point{p.X + q.X, p.Y + q.Y} }
+func (p Point) sub(q Point) Point     { return Point{p.X - q.X, p.Y - q.Y} }
+func (p Point) mul(k float64) Point   { return Point{p.X * k, p.Y * k} }
+func (p Point) dot(q Point) float64   { return p.X*q.X + p.Y*q.Y }
+func (p Point) cross(q Point) float64 { return p.X*q.Y - p.Y*q.X }
+func (p Point) length() float64       { return math.Hypot(p.X, p.Y) }
+func (p Point) lerp(q Point, t float64) Point {
+	return Point{p.X + (q.X-p.X)*t, p.Y + (q.Y-p.Y)*t}
+}
+
+type pathOp uint8
+
+const (
+	opMoveTo pathOp = iota
+	opLineTo
+	opQuadTo
+	opCubicTo
+	opClose
+)
+
+// Path is a sequence of subpaths, each made of lines and Bézier curves.
+// Coordinates are in user space, and transformed when the path is drawn.
+type Path struct {
+	ops []pathOp
+	pts []Point
+
+	start, cur Point
+	open       bool
+}
+
+// MoveTo starts a new subpath at (x, y).
+func (p *Path) MoveTo(x, y float64) {
+	p.ops = append(p.ops, opMoveTo)
+	p.pts = append(p.pts, Point{x, y})
+	p.start = Point{x, y}
+	p.cur = p.start
+	p.open = true
+}
+
+// ensure starts a subpath at the current point if there is none.
+func (p *Path) ensure() {
+	if !p.open {
+		p.MoveTo(p.cur.X, p.cur.Y)
+	}
+}
+
+// LineTo adds a line to (x, y).
+func (p *Path) LineTo(x, y float64) {
+	p.ensure()
+	p.ops = append(p.ops, opLineTo)
+	p.pts = append(p.pts, Point{x, y})
+	p.cur = Point{x, y}
+}
+
+// QuadTo adds a quadratic Bézier curve with control point (cx, cy) to (x, y).
+func (p *Path) QuadTo(cx, cy, x, y float64) {
+	p.ensure()
+	p.ops = append(p.ops, opQuadTo)
+	p.pts = append(p.pts, Point{cx, cy}, Point{x, y})
+	p.cur = Point{x, y}
+}
+
+// CubicTo adds a cubic Bézier curve with control points (c1x, c1y) and
+// (c2x, c2y) to (x, y).
+func (p *Path) CubicTo(c1x, c1y, c2x, c2y, x, y float64) {
+	p.ensure()
+	p.ops = append(p.ops, opCubicTo)
+	p.pts = append(p.pts, Point{c1x, c1y}, Point{c2x, c2y}, Point{x, y})
+	p.cur = Point{x, y}
+}
+
+// Close closes the current subpath with a line to its start.
+func (p *Path) Close() {
+	if !p.open {
+		return
+	}
+	p.ops = append(p.ops, opClose)
+	p.cur = p.start
+	p.open = false
+}
+
+// Arc adds a circular arc centered at (cx, cy) with radius r, from angle a0
+// to angle a1 in radians. Angles increase clockwise, as the y axis points
+// down; if a1 is less than a0, the arc is drawn counter-clockwise. If there
+// is a current subpath, a line is added to the start of the arc.
+func (p *Path) Arc(cx, cy, r, a0, a1 float64) {
+	start := Point{cx + r*math.Cos(a0), cy + r*math.Sin(a0)}
+	if p.open {
+		p.LineTo(start.X, start.Y)
+	} else {
+		p.MoveTo(start.X, start.Y)
+	}
+
+	sweep := a1 - a0
+	if sweep > 2*math.Pi {
+		sweep = 2 * math.Pi
+	} else if sweep < -2*math.Pi {
+		sweep = -2 * math.Pi
+	}
+
+	// Approximate the arc with cubics of at most a quarter turn each.
+	n := int(math.Ceil(math.Abs(sweep) / (math.Pi / 2)))
+	if n == 0 {
+		return
+	}
+	step := sweep / float64(n)
+	k := 4.0 / 3.0 * math.Tan(step/4)
+
+	a := a0
+	for i := 0; i < n; i++ {
+		s0, c0 := math.Sincos(a)
+		s1, c1 := math.Sincos(a + step)
+		p.CubicTo(
+			cx+r*(c0-k*s0), cy+r*(s0+k*c0),
+			cx+r*(c1+k*s1), cy+r*(s1-k*c1),
+			cx+r*c1, cy+r*s1,
+		)
+		a += step
+	}
+}
+
+// Rect adds a closed rectangle subpath.
+func (p *Path) Rect(x, y, w, h float64) {
+	p.MoveTo(x, y)
+	p.LineTo(x+w, y)
+	p.LineTo(x+w, y+h)
+	p.LineTo(x, y+h)
+	p.Close()
+}
+
+// RoundedRect adds a closed rectangle subpath with corners rounded to radius
+// r.
+func (p *Path) RoundedRect(x, y, w, h, r float64) {
+	r = math.Min(r, math.Min(w, h)/2)
+	if r <= 0 {
+		p.Rect(x, y, w, h)
+		return
+	}
+	p.open = false
+	p.Arc(x+w-r, y+r, r, -math.Pi/2, 0)
+	p.Arc(x+w-r, y+h-r, r, 0, math.Pi/2)
+	p.Arc(x+r, y+h-r, r, math.Pi/2, math.Pi)
+	p.Arc(x+r, y+r, r, math.Pi, 3*math.Pi/2)
+	p.Close()
+}
+
+// Circle adds a closed circle subpath.
+func (p *Path) Circle(cx, cy, r float64) {
+	p.open = false
+	p.Arc(cx, cy, r, 0, 2*math.Pi)
+	p.Close()
+}
+
+// polyline is a flattened subpath.
+type polyline struct {
+	pts    []Point
+	closed bool
+}
+
+// flatten converts the path into polylines, approximating curves with lines
+// to within tol.
+func (p *Path) flatten(tol float64) []polyline {
+	var lines []polyline
+	var cur *polyline
+
+	i := 0
+	for _, op := range p.ops {
+		switch op {
+		case opMoveTo:
+			lines = append(lines, polyline{pts: []Point{p.pts[i]}})
+			cur = &lines[len(lines)-1]
+			i++
+		case opLineTo:
+			cur.pts = append(cur.pts, p.pts[i])
+			i++
+		case opQuadTo:
+			p0 := cur.pts[len(cur.pts)-1]
+			p1, p2 := p.pts[i], p.pts[i+1]
+			dd := p0.sub(p1.mul(2)).add(p2).length()
+			n := int(math.Ceil(math.Sqrt(dd / (4 * tol))))
+			for j := 1; j <= n; j++ {
+				t := float64(j) / float64(n)
+				cur.pts = append(cur.pts, p0.lerp(p1, t).lerp(p1.lerp(p2, t), t))
+			}
+			if n < 1 {
+				cur.pts = append(cur.pts, p2)
+			}
+			i += 2
+		case opCubicTo:
+			p0 := cur.pts[len(cur.pts)-1]
+			p1, p2, p3 := p.pts[i], p.pts[i+1], p.pts[i+2]
+			dd := math.Max(p0.sub(p1.mul(2)).add(p2).length(), p1.sub(p2.mul(2)).add(p3).length())
+			n := int(math.Ceil(math.Sqrt(0.75 * dd / tol)))
+			for j := 1; j <= n; j++ {
+				t := float64(j) / float64(n)
+				a, b, c := p0.lerp(p1, t), p1.lerp(p2, t), p2.lerp(p3, t)
+				d, e := a.lerp(b, t), b.lerp(c, t)
+				cur.pts = append(cur.pts, d.lerp(e, t))
+			}
+			if n < 1 {
+				cur.pts = append(cur.pts, p3)
+			}
+			i += 3
+		case opClose:
+			cur.closed = true
+		}
+	}
+
+	return lines
+}
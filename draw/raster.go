@@ -0,0 +1,235 @@
+package draw
+
+import (
+	"math"
+	"sort"
+)
+
+// FillRule determines which regions of a self-intersecting path are inside.
+type FillRule int
+
+const (
+	// NonZero fills regions with a non-zero winding number.
+	NonZero FillRule = iota
+
+	// EvenOdd fills regions with an odd winding number.
+	EvenOdd
+)
+
+// subsamples is the number of sample rows per pixel row. Coverage along each
+// sample row is computed exactly, so this only limits anti-aliasing quality
+// of near-horizontal edges.
+const subsamples = 16
+
+type edge struct {
+	x0, y0, x1, y1 float64
+	dxdy           float64
+	winding        int
+}
+
+type crossing struct {
+	x       float64
+	winding int
+}
+
+// rasterizer computes anti-aliased coverage of polygons in device space.
+type rasterizer struct {
+	width, height int
+
+	edges  []edge
+	active []*edge
+	xs     []crossing
+
+	// cover and delta accumulate coverage for a single row; cover holds
+	// coverage of single pixels, while delta holds the change in coverage of
+	// all following pixels, for the interior of spans.
+	cover []float32
+	delta []float32
+}
+
+func (r *rasterizer) reset(width, height int) {
+	r.width, r.height = width, height
+	r.edges = r.edges[:0]
+	if cap(r.cover) < width+2 {
+		r.cover = make([]float32, width+2)
+		r.delta = make([]float32, width+2)
+	}
+	r.cover = r.cover[:width+2]
+	r.delta = r.delta[:width+2]
+}
+
+// addLine adds an edge. Polygons must be closed by the caller.
+func (r *rasterizer) addLine(p0, p1 Point) {
+	if p0.Y == p1.Y || math.IsNaN(p0.X+p0.Y+p1.X+p1.Y) {
+		return
+	}
+	winding := 1
+	if p0.Y > p1.Y {
+		p0, p1 = p1, p0
+		winding = -1
+	}
+	r.edges = append(r.edges, edge{
+		x0: p0.X, y0: p0.Y, x1: p1.X, y1: p1.Y,
+		dxdy:    (p1.X - p0.X) / (p1.Y - p0.Y),
+		winding: winding,
+	})
+}
+
+// addPolygon adds the edges of a closed polygon.
+func (r *rasterizer) addPolygon(pts []Point) {
+	for i := range pts {
+		j := i + 1
+		if j == len(pts) {
+			j = 0
+		}
+		r.addLine(pts[i], pts[j])
+	}
+}
+
+// rasterize calls fn for each row touched by the polygons, with the coverage
+// of pixels x0 to x1 (exclusive) in cover[x0:x1]. Coverage is in [0, 1].
+func (r *rasterizer) rasterize(rule FillRule, fn func(y, x0, x1 int, cover []float32)) {
+	if len(r.edges) == 0 {
+		return
+	}
+
+	sort.Slice(r.edges, func(i, j int) bool { return r.edges[i].y0 < r.edges[j].y0 })
+
+	ymin := int(math.Floor(r.edges[0].y0))
+	ymax := 0
+	for i := range r.edges {
+		if y := int(math.Ceil(r.edges[i].y1)); y > ymax {
+			ymax = y
+		}
+	}
+	if ymin < 0 {
+		ymin = 0
+	}
+	if ymax > r.height {
+		ymax = r.height
+	}
+
+	next := 0
+	r.active = r.active[:0]
+	const weight = 1.0 / subsamples
+
+	for y := ymin; y < ymax; y++ {
+		fy := float64(y)
+
+		// Update the active edge list for this row.
+		for next < len(r.edges) && r.edges[next].y0 < fy+1 {
+			r.active = append(r.active, &r.edges[next])
+			next++
+		}
+		n := 0
+		for _, e := range r.active {
+			if e.y1 > fy {
+				r.active[n] = e
+				n++
+			}
+		}
+		r.active = r.active[:n]
+		if n == 0 {
+			continue
+		}
+
+		minx, maxx := r.width, 0
+
+		for s := 0; s < subsamples; s++ {
+			sy := fy + (float64(s)+0.5)*weight
+
+			r.xs = r.xs[:0]
+			for _, e := range r.active {
+				if e.y0 <= sy && sy < e.y1 {
+					r.xs = append(r.xs, crossing{e.x0 + (sy-e.y0)*e.dxdy, e.winding})
+				}
+			}
+			if len(r.xs) < 2 {
+				continue
+			}
+			sortCrossings(r.xs)
+
+			winding := 0
+			for i := 0; i < len(r.xs)-1; i++ {
+				winding += r.xs[i].winding
+				inside := winding != 0
+				if rule == EvenOdd {
+					inside = winding%2 != 0
+				}
+				if !inside {
+					continue
+				}
+				x0, x1 := r.span(r.xs[i].x, r.xs[i+1].x, weight)
+				if x0 < minx {
+					minx = x0
+				}
+				if x1 > maxx {
+					maxx = x1
+				}
+			}
+		}
+
+		if minx >= maxx {
+			continue
+		}
+
+		// Resolve the accumulated spans into coverage.
+		acc := float32(0)
+		for x := minx; x < maxx; x++ {
+			acc += r.delta[x]
+			c := acc + r.cover[x]
+			if c > 1 {
+				c = 1
+			}
+			r.cover[x] = c
+			r.delta[x] = 0
+		}
+		r.delta[maxx] = 0
+
+		fn(y, minx, maxx, r.cover)
+
+		for x := minx; x < maxx; x++ {
+			r.cover[x] = 0
+		}
+	}
+}
+
+// span accumulates coverage for the span [xa, xb) on one sample row, and
+// returns the range of pixels it touches.
+func (r *rasterizer) span(xa, xb float64, weight float32) (int, int) {
+	w := float64(r.width)
+	if xa < 0 {
+		xa = 0
+	}
+	if xb > w {
+		xb = w
+	}
+	if xa >= xb {
+		return r.width, 0
+	}
+
+	ia, ib := int(xa), int(xb)
+	if ia == ib {
+		r.cover[ia] += float32(xb-xa) * weight
+		return ia, ia + 1
+	}
+
+	r.cover[ia] += float32(float64(ia+1)-xa) * weight
+	r.delta[ia+1] += weight
+	r.delta[ib] -= weight
+	if ib < r.width {
+		r.cover[ib] += float32(xb-float64(ib)) * weight
+		return ia, ib + 1
+	}
+	return ia, ib
+}
+
+// sortCrossings sorts crossings by x. There are usually only a few, so an
+// insertion sort is used.
+func sortCrossings(xs []crossing) {
+	for i := 1; i < len(xs); i++ {
+		for j := i; j > 0 && xs[j].x < xs[j-1].x; j-- {
+			xs[j], xs[j-1] = xs[j-1], xs[j]
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package draw
+
+import "math"
+
+// LineJoin is the shape used to join two segments of a stroke.
+type LineJoin int
+
+const (
+	// MiterJoin extends the outer edges of the segments until they meet,
+	// falling back to BevelJoin beyond the miter limit.
+	MiterJoin LineJoin = iota
+
+	// RoundJoin joins segments with a circular arc.
+	RoundJoin
+
+	// BevelJoin joins segments with a straight line.
+	BevelJoin
+)
+
+// LineCap is the shape used at the ends of open strokes.
+type LineCap int
+
+const (
+	// ButtCap ends the stroke exactly at the end point.
+	ButtCap LineCap = iota
+
+	// RoundCap ends the stroke with a semicircle.
+	RoundCap
+
+	// SquareCap extends the stroke by half its width.
+	SquareCap
+)
+
+// StrokeStyle describes how a path is stroked.
+type StrokeStyle struct {
+	// Width is the width of the stroke, in user space. Zero means 1.
+	Width float64
+
+	Join LineJoin
+	Cap  LineCap
+
+	// MiterLimit limits the ratio of miter length to stroke width for
+	// MiterJoin. Zero means 10.
+	MiterLimit float64
+
+	// Dashes contains alternating lengths of dashes and gaps. If empty, the
+	// stroke is solid.
+	Dashes []float64
+
+	// DashOffset is the distance into the dash pattern at which to start.
+	DashOffset float64
+}
+
+// stroker converts polylines into polygons covering their stroke. Every
+// polygon is emitted with the same orientation, so that the result can be
+// filled using the non-zero rule to get the union of the polygons.
+type stroker struct {
+	style StrokeStyle
+	hw    float64
+	tol   float64
+	emit  func([]Point)
+	buf   []Point
+}
+
+func (s *stroker) polygon(pts ...Point) {
+	// Ensure consistent orientation.
+	area := 0.0
+	for i := range pts {
+		j := (i + 1) % len(pts)
+		area += pts[i].cross(pts[j])
+	}
+	if area < 0 {
+		for i, j := 0, len(pts)-1; i < j; i, j = i+1, j-1 {
+			pts[i], pts[j] = pts[j], pts[i]
+		}
+	}
+	s.emit(pts)
+}
+
+func (s *stroker) circle(c Point) {
+	// Choose the number of segments so the error is within tolerance.
+	n := 8
+	if s.hw > s.tol {
+		n = int(math.Ceil(math.Pi / math.Acos(1-s.tol/s.hw)))
+		if n < 8 {
+			n = 8
+		}
+	}
+	s.buf = s.buf[:0]
+	for i := 0; i < n; i++ {
+		sin, cos := math.Sincos(2 * math.Pi * float64(i) / float64(n))
+		s.buf = append(s.buf, Point{c.X + s.hw*cos, c.Y + s.hw*sin})
+	}
+	s.polygon(s.buf...)
+}
+
+func normal(d Point) Point {
+	return Point{-d.Y, d.X}
+}
+
+func (s *stroker) stroke(line polyline) {
+	// Remove repeated points.
+	pts := make([]Point, 0, len(line.pts))
+	for _, p := range line.pts {
+		if len(pts) == 0 || p != pts[len(pts)-1] {
+			pts = append(pts, p)
+		}
+	}
+	if line.closed && len(pts) > 1 && pts[0] == pts[len(pts)-1] {
+		pts = pts[:len(pts)-1]
+	}
+
+	if len(pts) == 1 {
+		switch s.style.Cap {
+		case RoundCap:
+			s.circle(pts[0])
+		case SquareCap:
+			p, hw := pts[0], s.hw
+			s.polygon(Point{p.X - hw, p.Y - hw}, Point{p.X + hw, p.Y - hw}, Point{p.X + hw, p.Y + hw}, Point{p.X - hw, p.Y + hw})
+		}
+		return
+	}
+
+	nseg := len(pts) - 1
+	if line.closed {
+		nseg = len(pts)
+	}
+
+	dir := func(i int) Point {
+		a, b := pts[i%len(pts)], pts[(i+1)%len(pts)]
+		d := b.sub(a)
+		return d.mul(1 / d.length())
+	}
+
+	for i := 0; i < nseg; i++ {
+		a, b := pts[i], pts[(i+1)%len(pts)]
+		n := normal(dir(i)).mul(s.hw)
+		s.polygon(a.add(n), b.add(n), b.sub(n), a.sub(n))
+	}
+
+	// Joins at interior vertices, and at the start of closed polylines.
+	for i := 1; i < len(pts); i++ {
+		if i == len(pts)-1 && !line.closed {
+			break
+		}
+		s.join(pts[i], dir(i-1), dir(i))
+	}
+	if line.closed {
+		s.join(pts[0], dir(len(pts)-1), dir(0))
+	} else {
+		s.cap(pts[0], dir(0).mul(-1))
+		s.cap(pts[len(pts)-1], dir(len(pts)-2))
+	}
+}
+
+func (s *stroker) join(v, d0, d1 Point) {
+	cross := d0.cross(d1)
+	if math.Abs(cross) < 1e-9 && d0.dot(d1) > 0 {
+		return
+	}
+
+	if s.style.Join == RoundJoin {
+		s.circle(v)
+		return
+	}
+
+	// The outer side of the join is opposite the direction of the turn.
+	side := 1.0
+	if cross > 0 {
+		side = -1
+	}
+	n0 := normal(d0).mul(s.hw * side)
+	n1 := normal(d1).mul(s.hw * side)
+	a, b := v.add(n0), v.add(n1)
+
+	if s.style.Join == MiterJoin {
+		limit := s.style.MiterLimit
+		if limit == 0 {
+			limit = 10
+		}
+		mid := n0.add(n1)
+		if l := mid.length(); l > 0 {
+			// cos of half the angle between the segments' normals.
+			cosHalf := mid.dot(n0) / (l * s.hw)
+			if cosHalf > 0 && 1/cosHalf <= limit {
+				m := v.add(mid.mul(s.hw / (cosHalf * l)))
+				s.polygon(v, a, m, b)
+				return
+			}
+		}
+	}
+
+	s.polygon(v, a, b)
+}
+
+func (s *stroker) cap(p, d Point) {
+	switch s.style.Cap {
+	case RoundCap:
+		s.circle(p)
+	case SquareCap:
+		n := normal(d).mul(s.hw)
+		e := p.add(d.mul(s.hw))
+		s.polygon(p.add(n), e.add(n), e.sub(n), p.sub(n))
+	}
+}
+
+// dash splits polylines according to the dash pattern.
+func dash(lines []polyline, dashes []float64, offset float64) []polyline {
+	total := 0.0
+	for _, d := range dashes {
+		if d < 0 {
+			return lines
+		}
+		total += d
+	}
+	if total <= 0 {
+		return lines
+	}
+	// Patterns with an odd number of entries repeat twice, alternating.
+	if len(dashes)%2 == 1 {
+		dashes = append(append([]float64(nil), dashes...), dashes...)
+		total *= 2
+	}
+
+	var out []polyline
+	for _, line := range lines {
+		pts := line.pts
+		if line.closed && len(pts) > 0 {
+			pts = append(append([]Point(nil), pts...), pts[0])
+		}
+
+		// Find the starting position in the pattern.
+		i := 0
+		remaining := math.Mod(offset, total)
+		if remaining < 0 {
+			remaining += total
+		}
+		for remaining >= dashes[i] {
+			remaining -= dashes[i]
+			i = (i + 1) % len(dashes)
+		}
+		left := dashes[i] - remaining
+		on := i%2 == 0
+
+		var cur []Point
+		if on && len(pts) > 0 {
+			cur = []Point{pts[0]}
+		}
+
+		for j := 1; j < len(pts); j++ {
+			a, b := pts[j-1], pts[j]
+			seglen := b.sub(a).length()
+			pos := 0.0
+			for seglen-pos > left {
+				pos += left
+				p := a.lerp(b, pos/seglen)
+				if on {
+					cur = append(cur, p)
+					out = append(out, polyline{pts: cur})
+					cur = nil
+				} else {
+					cur = []Point{p}
+				}
+				on = !on
+				i = (i + 1) % len(dashes)
+				left = dashes[i]
+			}
+			left -= seglen - pos
+			if on {
+				cur = append(cur, b)
+			}
+		}
+		if on && len(cur) > 1 {
+			out = append(out, polyline{pts: cur})
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,164 @@
+package jtk
+
+import (
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jchv/jtk/draw"
+)
+
+// fontFiles are the file names of common sans-serif TrueType fonts, in order
+// of preference, searched for when no font is configured.
+var fontFiles = []string{
+	"DejaVuSans.ttf",
+	"NotoSans-Regular.ttf",
+	"LiberationSans-Regular.ttf",
+	"Roboto-Regular.ttf",
+	"OpenSans-Regular.ttf",
+	"FreeSans.ttf",
+	"Arial.ttf",
+	"arial.ttf",
+}
+
+// knownFontPaths are where distributions install some of fontFiles, relative
+// to a font directory. They are tried before searching the font directories,
+// which can take a while.
+var knownFontPaths = []string{
+	"truetype/dejavu/DejaVuSans.ttf",
+	"TTF/DejaVuSans.ttf",
+	"dejavu/DejaVuSans.ttf",
+	"dejavu-sans-fonts/DejaVuSans.ttf",
+	"truetype/noto/NotoSans-Regular.ttf",
+	"noto/NotoSans-Regular.ttf",
+	"google-noto/NotoSans-Regular.ttf",
+	"truetype/liberation/LiberationSans-Regular.ttf",
+	"liberation/LiberationSans-Regular.ttf",
+	"liberation-sans/LiberationSans-Regular.ttf",
+	"truetype/freefont/FreeSans.ttf",
+}
+
+// DefaultFont returns the font used for text when no other font is given: the
+// TrueType font file named by the JTK_FONT environment variable, or else a
+// common sans-serif font found in the system font directories. It returns nil
+// if there is none.
+//
+// The font is looked for in a few well-known places first. If it isn't there,
+// the font directories are searched in the background, and DefaultFont returns
+// nil until the search finds a font, at which point decorations are redrawn.
+func (app *Application) DefaultFont() *draw.Font {
+	if app.fontLoaded {
+		return app.font
+	}
+	app.fontLoaded = true
+
+	if app.font = findDefaultFont(); app.font == nil {
+		go func() {
+			font := searchDefaultFont()
+			if font == nil {
+				return
+			}
+			app.Invoke(func() {
+				app.font = font
+				for _, w := range app.windows {
+					if w.decoration != nil {
+						w.decoration.invalidate()
+					}
+				}
+			})
+		}()
+	}
+	return app.font
+}
+
+// findDefaultFont loads the font named by JTK_FONT, or one of fontFiles from
+// a well-known path.
+func findDefaultFont() *draw.Font {
+	if path := os.Getenv("JTK_FONT"); path != "" {
+		if font := loadFont(path); font != nil {
+			return font
+		}
+	}
+
+	for _, dir := range fontDirs() {
+		for _, path := range knownFontPaths {
+			if font := loadFont(filepath.Join(dir, path)); font != nil {
+				return font
+			}
+		}
+	}
+	return nil
+}
+
+// searchDefaultFont searches the font directories for fontFiles, and loads
+// the most preferred one found.
+func searchDefaultFont() *draw.Font {
+	wanted := make(map[string]int, len(fontFiles))
+	for i, name := range fontFiles {
+		wanted[name] = i
+	}
+	found := make([]string, len(fontFiles))
+	for _, dir := range fontDirs() {
+		filepath.Walk(dir, func(path string, info fs.FileInfo, err error) error {
+			if err != nil {
+				return nil
+			}
+			if i, ok := wanted[info.Name()]; ok && found[i] == "" && !info.IsDir() {
+				found[i] = path
+			}
+			return nil
+		})
+	}
+
+	for _, path := range found {
+		if path == "" {
+			continue
+		}
+		if font := loadFont(path); font != nil {
+			return font
+		}
+	}
+	return nil
+}
+
+func loadFont(path string) *draw.Font {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	font, err := draw.ParseFont(data)
+	if err != nil {
+		return nil
+	}
+	return font
+}
+
+// fontDirs returns the directories fonts are installed in, following the XDG
+// base directory specification.
+func fontDirs() []string {
+	dirs := []string{}
+
+	home, _ := os.UserHomeDir()
+	if dataHome := os.Getenv("XDG_DATA_HOME"); dataHome != "" {
+		dirs = append(dirs, filepath.Join(dataHome, "fonts"))
+	} else if home != "" {
+		dirs = append(dirs, filepath.Join(home, ".local", "share", "fonts"))
+	}
+	if home != "" {
+		dirs = append(dirs, filepath.Join(home, ".fonts"))
+	}
+
+	dataDirs := os.Getenv("XDG_DATA_DIRS")
+	if dataDirs == "" {
+		dataDirs = "/usr/local/share:/usr/share"
+	}
+	for _, dir := range strings.Split(dataDirs, ":") {
+		if dir != "" {
+			dirs = append(dirs, filepath.Join(dir, "fonts"))
+		}
+	}
+
+	return dirs
+}
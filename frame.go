@@ -0,0 +1,341 @@
+package jtk
+
+import (
+	"image"
+	"time"
+
+	"github.com/jchv/jtk/draw"
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// Rendering is driven by wl_surface.frame callbacks: every commit requests a
+// callback, and the next redraw waits for it. The compositor only sends the
+// callback when it is a good time to draw again, so a window that is hidden
+// does no work, and a visible window draws at most once per refresh.
+//
+// Only damaged parts of a window are redrawn. Invalidated rectangles are
+// collected into a damage region, and each frame reports exactly that region
+// to the compositor with wl_surface.damage_buffer. As the buffer pool rotates
+// between buffers, a buffer handed out for drawing may hold an older frame;
+// the parts damaged since then, but not damaged now, are copied forward from
+// the previous frame's buffer, so that only the current damage needs drawing.
+
+// maxFrameHistory is the number of past frames whose damage is remembered for
+// bringing older buffers up to date. Buffers older than this are repaired by
+// copying the whole previous frame.
+const maxFrameHistory = 8
+
+// frameDamage is the damage of a presented frame.
+type frameDamage struct {
+	seq    uint64
+	damage region
+}
+
+// Frame is a buffer that can be drawn to and presented to a window.
+type Frame struct {
+	// Image is the image to draw to. It must not be used after the frame has
+	// been presented or discarded.
+	Image *draw.ARGB
+
+	// Damage lists the rectangles of Image that must be drawn. Outside them,
+	// Image already holds the contents of the previous frame. Only the damage
+	// is reported to the compositor, so drawing outside it has no effect.
+	Damage []image.Rectangle
+
+	// Scale is the number of pixels of Image per logical pixel of the window.
+	// It may be fractional, in which case the size of Image is rounded.
+	Scale float64
+
+	window *Window
+	size   image.Point
+	buffer *Buffer
+}
+
+// NextFrame returns a frame for drawing the next frame of the window, sized
+// to the current window size at the window's scale. The frame is backed by a
+// buffer that is not in use by the compositor. Its damage is the region invalidated since the last
+// frame, or the whole window if nothing was invalidated. It returns
+// ErrNotConfigured if the window has not been configured yet.
+//
+// Most windows should use OnDraw instead, which calls NextFrame and Present
+// at the right time.
+func (w *Window) NextFrame() (*Frame, error) {
+	if w.closed {
+		return nil, ErrWindowClosed
+	}
+	if !w.configured {
+		return nil, ErrNotConfigured
+	}
+
+	bounds := w.bounds()
+	buffer, err := w.buffers.Acquire(bounds.Dx(), bounds.Dy(), FormatARGB8888)
+	if err != nil {
+		return nil, err
+	}
+
+	damage := w.damage.intersect(bounds)
+	w.damage = nil
+	if len(damage) == 0 {
+		damage = region{bounds}
+	}
+	damage = w.repair(buffer, damage)
+
+	return &Frame{
+		Image:  buffer.Image(),
+		Damage: damage,
+		Scale:  w.scale,
+		window: w,
+		size:   image.Pt(w.state.Width, w.state.Height),
+		buffer: buffer,
+	}, nil
+}
+
+// repair brings the contents of a buffer up to date with the last presented
+// frame, outside of damage, by copying from the last frame's buffer. It
+// returns the damage that must be drawn, which is the whole buffer if the
+// buffer cannot be repaired.
+func (w *Window) repair(b *Buffer, damage region) region {
+	if b == w.last {
+		return damage
+	}
+
+	bounds := b.image.Rect
+	last := w.last
+	if last == nil || last.image == nil || last.image.Rect != bounds {
+		return region{bounds}
+	}
+
+	// Find the damage since the buffer was last presented. If the history
+	// doesn't reach back that far, copy everything.
+	stale := region{bounds}
+	if b.seq != 0 && len(w.history) > 0 && w.history[0].seq <= b.seq+1 {
+		stale = nil
+		for _, h := range w.history {
+			if h.seq > b.seq {
+				stale.union(h.damage)
+			}
+		}
+	}
+
+	src, dst := last.image, b.image
+	for _, r := range stale {
+		n := r.Dx() * 4
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			i := src.PixOffset(r.Min.X, y)
+			copy(dst.Pix[i:i+n], src.Pix[i:i+n])
+		}
+	}
+
+	return damage
+}
+
+// bounds returns the rectangle covered by the window, in buffer coordinates.
+func (w *Window) bounds() image.Rectangle {
+	return image.Rect(0, 0, scaleSize(w.state.Width, w.scale), scaleSize(w.state.Height, w.scale))
+}
+
+// Present attaches the frame to the window and commits it.
+func (f *Frame) Present() error {
+	w := f.window
+	if w.closed {
+		return ErrWindowClosed
+	}
+
+	conn := w.app.conn
+	if err := f.buffer.attach(w.surface); err != nil {
+		return err
+	}
+	if err := w.applyScale(f); err != nil {
+		return err
+	}
+	for _, r := range f.Damage {
+		if err := w.surface.DamageBuffer(conn, int32(r.Min.X), int32(r.Min.Y), int32(r.Dx()), int32(r.Dy())); err != nil {
+			return err
+		}
+	}
+
+	if w.last != nil && w.last.image != nil && w.last.image.Rect != f.Image.Rect {
+		// Damage from frames of another size doesn't apply to this one.
+		w.history = w.history[:0]
+	}
+	w.frameSeq++
+	w.history = append(w.history, frameDamage{seq: w.frameSeq, damage: f.Damage})
+	if len(w.history) > maxFrameHistory {
+		w.history = append(w.history[:0], w.history[len(w.history)-maxFrameHistory:]...)
+	}
+	f.buffer.seq = w.frameSeq
+	w.last = f.buffer
+
+	return w.commit()
+}
+
+// Discard gives back the frame without presenting it. Its damage is kept for
+// the next frame.
+func (f *Frame) Discard() {
+	if !f.window.closed {
+		f.buffer.Discard()
+		f.window.damage.union(f.Damage)
+	}
+}
+
+// OnDraw sets the function used to draw the window. It is called with a new
+// frame whenever the window has been invalidated and the compositor is ready
+// for a new frame; the frame is presented once it returns.
+func (w *Window) OnDraw(fn func(*Frame)) {
+	w.onDraw = fn
+	w.Invalidate()
+}
+
+// Invalidate marks the whole window as needing to be redrawn.
+func (w *Window) Invalidate() {
+	w.InvalidateRect(w.bounds())
+}
+
+// InvalidateRect marks a rectangle of the window, in buffer coordinates, as
+// needing to be redrawn. The rectangles invalidated between two frames make up
+// the damage of the next frame.
+func (w *Window) InvalidateRect(r image.Rectangle) {
+	r = r.Intersect(w.bounds())
+	if r.Empty() {
+		return
+	}
+	w.damage.add(r)
+	w.dirty = true
+	if w.frameCallback == nil {
+		w.queueRedraw()
+	}
+}
+
+// RequestAnimationFrame arranges for fn to be called once, before the next
+// frame is drawn. It is called with the time at which the compositor
+// signalled the frame, which is suitable for advancing animations. To keep
+// animating, call RequestAnimationFrame again and invalidate the window from
+// fn.
+func (w *Window) RequestAnimationFrame(fn func(t time.Time)) {
+	w.animations = append(w.animations, fn)
+	if w.frameCallback == nil && w.configured && !w.dirty {
+		// Nothing is going to be drawn, so commit just to get a callback.
+		w.commit()
+	} else if w.frameCallback == nil {
+		w.queueRedraw()
+	}
+}
+
+// queueRedraw schedules a redraw on the application goroutine, unless one is
+// already scheduled. Redraws are deferred so that several invalidations in a
+// row result in a single frame.
+func (w *Window) queueRedraw() {
+	if w.redrawQueued {
+		return
+	}
+	w.redrawQueued = true
+	w.app.Invoke(func() {
+		w.redrawQueued = false
+		w.redraw()
+	})
+}
+
+// redraw draws and presents a frame, if the window is dirty.
+func (w *Window) redraw() {
+	if w.closed || !w.configured {
+		return
+	}
+	if !w.dirty {
+		// Only the decorations changed.
+		if w.decorationDirty() {
+			w.commit()
+		}
+		return
+	}
+	w.dirty = false
+
+	if w.onDraw == nil {
+		if len(w.animations) > 0 || w.decorationDirty() {
+			w.commit()
+		}
+		return
+	}
+
+	frame, err := w.NextFrame()
+	if err != nil {
+		return
+	}
+
+	w.onDraw(frame)
+	if frame.buffer.acquired {
+		frame.Present()
+	}
+}
+
+// commit updates the decorations, requests a frame callback, if there is none
+// pending, and presentation feedback, if enabled, and commits the surface.
+func (w *Window) commit() error {
+	conn := w.app.conn
+
+	if w.decoration != nil {
+		if err := w.decoration.update(); err != nil {
+			return err
+		}
+	}
+
+	if w.frameCallback == nil {
+		callback, err := w.surface.Frame(conn)
+		if err != nil {
+			return err
+		}
+		w.frameCallback = callback
+		conn.RegisterHandler(callback.ID(), wayland.HandlerFunc(w.handleFrameCallback))
+	}
+
+	if w.onPresentation != nil {
+		if err := w.requestPresentation(); err != nil {
+			return err
+		}
+	}
+
+	return w.surface.Commit(conn)
+}
+
+func (w *Window) handleFrameCallback(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WlCallbackDoneEvent:
+		w.frameCallback = nil
+		if w.closed {
+			return
+		}
+
+		now := w.frameClock.time(t.CallbackData)
+
+		animations := w.animations
+		w.animations = nil
+		for _, fn := range animations {
+			fn(now)
+		}
+
+		if w.dirty || w.decorationDirty() {
+			w.redraw()
+		} else if len(w.animations) > 0 {
+			w.commit()
+		}
+	}
+}
+
+// frameClock converts frame callback timestamps, which are in milliseconds
+// with an unspecified base, into times. The first timestamp is taken to be
+// now, and subsequent ones are offset from it, so that the intervals between
+// frames are preserved.
+type frameClock struct {
+	last   uint32
+	lastAt time.Time
+}
+
+func (c *frameClock) time(ms uint32) time.Time {
+	if c.lastAt.IsZero() {
+		c.lastAt = time.Now()
+	} else {
+		// Signed difference handles wrapping of the 32-bit timestamp.
+		c.lastAt = c.lastAt.Add(time.Duration(int32(ms-c.last)) * time.Millisecond)
+	}
+	c.last = ms
+	return c.lastAt
+}
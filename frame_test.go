@@ -0,0 +1,115 @@
+package jtk
+
+import (
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+
+	"github.com/jchv/jtk/draw"
+)
+
+func TestFrameDamage(t *testing.T) {
+	app, c := newFakeApplication(t)
+	w, err := NewWindow(app, WindowOptions{Width: 100, Height: 80, Decorations: DecorationsNone})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundtrip(t, app)
+
+	white := color.RGBA{0xff, 0xff, 0xff, 0xff}
+	red := color.RGBA{0xff, 0, 0, 0xff}
+	blue := color.RGBA{0, 0, 0xff, 0xff}
+	green := color.RGBA{0, 0xff, 0, 0xff}
+
+	// present invalidates r, unless it is empty, and presents a frame that
+	// fills its damage with col. It checks the damage of the frame, and that
+	// the pixels at each point of keep were brought up to date from earlier
+	// frames, and returns the commit the compositor received.
+	type pixel struct {
+		at  image.Point
+		col color.RGBA
+	}
+	present := func(r image.Rectangle, col color.RGBA, damage []image.Rectangle, keep ...pixel) fakeCommit {
+		t.Helper()
+		if !r.Empty() {
+			w.InvalidateRect(r)
+		}
+		f, err := w.NextFrame()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(f.Damage, damage) {
+			t.Errorf("frame damage is %v, want %v", f.Damage, damage)
+		}
+		for _, p := range keep {
+			if got := f.Image.RGBAAt(p.at.X, p.at.Y); got != p.col {
+				t.Errorf("pixel %v is %v before drawing, want %v", p.at, got, p.col)
+			}
+		}
+		canvas := draw.NewCanvas(f.Image)
+		for _, d := range f.Damage {
+			canvas.FillRect(float64(d.Min.X), float64(d.Min.Y), float64(d.Dx()), float64(d.Dy()), draw.Solid{Color: col})
+		}
+		if err := f.Present(); err != nil {
+			t.Fatal(err)
+		}
+		roundtrip(t, app)
+
+		commits := c.commits(w)
+		commit := commits[len(commits)-1]
+		if !reflect.DeepEqual(commit.damage, damage) {
+			t.Errorf("damage_buffer rectangles are %v, want %v", commit.damage, damage)
+		}
+		return commit
+	}
+
+	// The first frame is damaged in full.
+	full := image.Rect(0, 0, 100, 80)
+	a := present(image.Rectangle{}, white, []image.Rectangle{full}).buffer
+
+	// The first buffer is still held, so a second is allocated. Having never
+	// been presented, it is brought up to date by copying the whole first
+	// frame.
+	r2 := image.Rect(10, 10, 30, 30)
+	b := present(r2, red, []image.Rectangle{r2}, pixel{image.Pt(50, 50), white}).buffer
+	if a == b {
+		t.Fatalf("second frame reused buffer %d while it was held", a)
+	}
+	c.release(a)
+	roundtrip(t, app)
+
+	// The first buffer is reused. It is two frames old, so it only lacks the
+	// second frame's damage.
+	r3 := image.Rect(50, 40, 70, 60)
+	if got := present(r3, blue, []image.Rectangle{r3}, pixel{image.Pt(15, 15), red}, pixel{image.Pt(5, 5), white}).buffer; got != a {
+		t.Errorf("third frame used buffer %d, want %d", got, a)
+	}
+	c.release(b)
+	roundtrip(t, app)
+
+	// Likewise for the second buffer, which lacks the third frame's damage.
+	r4 := image.Rect(0, 0, 5, 5)
+	if got := present(r4, green, []image.Rectangle{r4}, pixel{image.Pt(55, 45), blue}, pixel{image.Pt(15, 15), red}).buffer; got != b {
+		t.Errorf("fourth frame used buffer %d, want %d", got, b)
+	}
+
+	// Both buffers are held, so a third is allocated and copied in full.
+	r5 := image.Rect(90, 70, 100, 80)
+	present(r5, red, []image.Rectangle{r5}, pixel{image.Pt(2, 2), green}, pixel{image.Pt(55, 45), blue}, pixel{image.Pt(15, 15), red})
+	if n := c.bufferCount(); n != 3 {
+		t.Errorf("%d buffers were created, want 3", n)
+	}
+
+	// Several invalidated rectangles are reported separately.
+	c.release(a)
+	c.release(b)
+	roundtrip(t, app)
+	w.InvalidateRect(image.Rect(0, 0, 10, 10))
+	present(image.Rect(80, 0, 100, 10), blue, []image.Rectangle{image.Rect(0, 0, 10, 10), image.Rect(80, 0, 100, 10)})
+
+	// After a resize, the new size is damaged in full.
+	c.configureWindow(w, 120, 90)
+	roundtrip(t, app)
+	present(image.Rectangle{}, white, []image.Rectangle{image.Rect(0, 0, 120, 90)})
+}
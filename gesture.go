@@ -0,0 +1,662 @@
+package jtk
+
+import (
+	"math"
+	"time"
+
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// Gestures come from two sources. Touchpads recognize swipes, pinches and
+// holds themselves, and the compositor forwards them to the window under the
+// pointer. On touchscreens, jtk recognizes taps, double taps, long presses,
+// pans, pinches and flings from the touch points on each window. Either way,
+// the underlying pointer or touch events are still delivered as usual.
+
+// GestureType is the type of a GestureEvent.
+type GestureType int
+
+// Gesture types. Swipe and hold gestures only come from touchpads, and
+// pinches from both touchpads and touchscreens; the rest only come from
+// touchscreens.
+const (
+	// GestureSwipe is several fingers moving together on a touchpad.
+	GestureSwipe GestureType = iota
+
+	// GesturePinch is fingers moving apart or together, and possibly
+	// rotating.
+	GesturePinch
+
+	// GestureHold is fingers resting on a touchpad, e.g. to stop kinetic
+	// scrolling.
+	GestureHold
+
+	// GestureTap is fingers touching briefly without moving.
+	GestureTap
+
+	// GestureDoubleTap is a second single-finger tap shortly after the
+	// first, in the same place. The tap is also delivered on its own.
+	GestureDoubleTap
+
+	// GestureLongPress is a finger held down without moving. It is
+	// delivered once the finger has been down for long enough, and is not
+	// followed by a tap when it is lifted.
+	GestureLongPress
+
+	// GesturePan is fingers dragging on a touchscreen.
+	GesturePan
+
+	// GestureFling is a pan that ended with the fingers still moving fast,
+	// e.g. to start kinetic scrolling. It follows the end of the pan.
+	GestureFling
+)
+
+func (t GestureType) String() string {
+	switch t {
+	case GestureSwipe:
+		return "swipe"
+	case GesturePinch:
+		return "pinch"
+	case GestureHold:
+		return "hold"
+	case GestureTap:
+		return "tap"
+	case GestureDoubleTap:
+		return "double-tap"
+	case GestureLongPress:
+		return "long-press"
+	case GesturePan:
+		return "pan"
+	case GestureFling:
+		return "fling"
+	}
+	return "unknown"
+}
+
+// GesturePhase is the progress of a gesture.
+type GesturePhase int
+
+// Gesture phases. Swipes, pinches, holds and pans begin, update any number of
+// times, and then end or are cancelled. Other gestures are instantaneous, and
+// only have an end.
+const (
+	GestureBegin GesturePhase = iota
+	GestureUpdate
+	GestureEnd
+
+	// GestureCancel means the gesture was interrupted, e.g. because the
+	// compositor took over the fingers, and should be undone.
+	GestureCancel
+)
+
+func (p GesturePhase) String() string {
+	switch p {
+	case GestureBegin:
+		return "begin"
+	case GestureUpdate:
+		return "update"
+	case GestureEnd:
+		return "end"
+	case GestureCancel:
+		return "cancel"
+	}
+	return "unknown"
+}
+
+// GestureEvent is a step in a gesture.
+type GestureEvent struct {
+	Type  GestureType
+	Phase GesturePhase
+
+	// Touchscreen is set for gestures recognized from touchscreens, as
+	// opposed to touchpads.
+	Touchscreen bool
+
+	// Serial identifies the start of the gesture, and Time is the time of
+	// the event, in milliseconds, with an unspecified base.
+	Serial uint32
+	Time   uint32
+
+	// Fingers is the number of fingers involved.
+	Fingers int
+
+	// X and Y are the position of the gesture, in logical pixels relative to
+	// the top-left corner of the window's content: the pointer for touchpad
+	// gestures, and the center of the fingers for touchscreen gestures.
+	X, Y float64
+
+	// DX and DY are the distance moved since the last event, for swipes,
+	// pinches and pans, in logical pixels.
+	DX, DY float64
+
+	// Scale is the distance between the fingers of a pinch, relative to the
+	// distance when it began, and Rotation is how far the fingers rotated
+	// since the last event, in degrees clockwise.
+	Scale    float64
+	Rotation float64
+
+	// VX and VY are the velocity at the end of pans and of flings, in
+	// logical pixels per second.
+	VX, VY float64
+}
+
+// OnGesture sets a function to be called for gestures on the window.
+func (w *Window) OnGesture(fn func(GestureEvent)) {
+	w.onGesture = fn
+	if fn != nil && w.recognizer == nil {
+		w.recognizer = &gestureRecognizer{clock: w.app, emit: w.gesture}
+	}
+}
+
+func (w *Window) gesture(s *seat, e *GestureEvent) {
+	if w.onGesture != nil {
+		w.onGesture(*e)
+	}
+}
+
+// gestureTarget receives touchpad gestures for a surface.
+type gestureTarget interface {
+	gesture(s *seat, e *GestureEvent)
+}
+
+// initGestures creates the touchpad gesture objects for the pointer, if the
+// compositor supports them.
+func (s *seat) initGestures() {
+	manager := s.app.conn.Globals().ZwpPointerGesturesV1()
+	if manager == nil {
+		return
+	}
+	conn := s.app.conn
+	handler := wayland.HandlerFunc(s.handleGesture)
+	if swipe, err := manager.GetSwipeGesture(conn, s.pointer.ID()); err == nil {
+		s.swipe = swipe
+		conn.RegisterHandler(swipe.ID(), handler)
+	}
+	if pinch, err := manager.GetPinchGesture(conn, s.pointer.ID()); err == nil {
+		s.pinch = pinch
+		conn.RegisterHandler(pinch.ID(), handler)
+	}
+	if manager.Version() >= 3 {
+		if hold, err := manager.GetHoldGesture(conn, s.pointer.ID()); err == nil {
+			s.hold = hold
+			conn.RegisterHandler(hold.ID(), handler)
+		}
+	}
+}
+
+// destroyGestures destroys the touchpad gesture objects, cancelling any
+// gesture in progress.
+func (s *seat) destroyGestures() {
+	if s.gestureTarget != nil {
+		s.gestureEvent.Phase = GestureCancel
+		s.deliverGesture()
+		s.gestureTarget = nil
+	}
+	conn := s.app.conn
+	if s.swipe != nil {
+		conn.UnregisterHandlers(s.swipe.ID())
+		s.swipe.Destroy(conn)
+		s.swipe = nil
+	}
+	if s.pinch != nil {
+		conn.UnregisterHandlers(s.pinch.ID())
+		s.pinch.Destroy(conn)
+		s.pinch = nil
+	}
+	if s.hold != nil {
+		conn.UnregisterHandlers(s.hold.ID())
+		s.hold.Destroy(conn)
+		s.hold = nil
+	}
+}
+
+func (s *seat) handleGesture(event wayland.Event) {
+	e := &s.gestureEvent
+	switch t := event.(type) {
+	case *wayland.ZwpPointerGestureSwipeV1BeginEvent:
+		s.beginGesture(GestureSwipe, t.Serial, t.Time, t.Surface, t.Fingers)
+	case *wayland.ZwpPointerGestureSwipeV1UpdateEvent:
+		e.Phase, e.Time = GestureUpdate, t.Time
+		e.DX, e.DY = t.Dx.Float(), t.Dy.Float()
+	case *wayland.ZwpPointerGestureSwipeV1EndEvent:
+		s.endGesture(t.Time, t.Cancelled)
+	case *wayland.ZwpPointerGesturePinchV1BeginEvent:
+		s.beginGesture(GesturePinch, t.Serial, t.Time, t.Surface, t.Fingers)
+	case *wayland.ZwpPointerGesturePinchV1UpdateEvent:
+		e.Phase, e.Time = GestureUpdate, t.Time
+		e.DX, e.DY = t.Dx.Float(), t.Dy.Float()
+		e.Scale, e.Rotation = t.Scale.Float(), t.Rotation.Float()
+	case *wayland.ZwpPointerGesturePinchV1EndEvent:
+		s.endGesture(t.Time, t.Cancelled)
+	case *wayland.ZwpPointerGestureHoldV1BeginEvent:
+		s.beginGesture(GestureHold, t.Serial, t.Time, t.Surface, t.Fingers)
+	case *wayland.ZwpPointerGestureHoldV1EndEvent:
+		s.endGesture(t.Time, t.Cancelled)
+	default:
+		return
+	}
+	if s.gestureTarget != nil {
+		s.deliverGesture()
+	}
+	if e.Phase == GestureEnd || e.Phase == GestureCancel {
+		s.gestureTarget = nil
+	}
+}
+
+func (s *seat) beginGesture(typ GestureType, serial, time uint32, surface wayland.ObjectID, fingers uint32) {
+	s.gestureTarget = s.app.pointerTargets[surface]
+	s.gestureEvent = GestureEvent{
+		Type:    typ,
+		Phase:   GestureBegin,
+		Serial:  serial,
+		Time:    time,
+		Fingers: int(fingers),
+	}
+	if typ == GesturePinch {
+		s.gestureEvent.Scale = 1
+	}
+}
+
+func (s *seat) endGesture(time uint32, cancelled int32) {
+	e := &s.gestureEvent
+	e.Phase, e.Time = GestureEnd, time
+	if cancelled != 0 {
+		e.Phase = GestureCancel
+	}
+	e.DX, e.DY, e.Rotation = 0, 0, 0
+}
+
+// deliverGesture delivers the current touchpad gesture event to its target.
+func (s *seat) deliverGesture() {
+	target, ok := s.gestureTarget.(gestureTarget)
+	if !ok {
+		return
+	}
+	e := s.gestureEvent
+	e.X, e.Y = s.pointerX, s.pointerY
+	target.gesture(s, &e)
+}
+
+// Thresholds for touchscreen gestures, in logical pixels and milliseconds.
+const (
+	// tapSlop is how far a finger may move and still tap.
+	tapSlop = 10
+
+	// doubleTapSlop and doubleTapInterval are how far apart and how long
+	// apart two taps may be to make a double tap.
+	doubleTapSlop     = 20
+	doubleTapInterval = 300
+
+	// longPressDelay is how long a finger must be held to long-press.
+	longPressDelay = 500 * time.Millisecond
+
+	// flingVelocity is the speed, in pixels per second, above which a pan
+	// ends in a fling, and velocityWindow the period it is measured over.
+	flingVelocity  = 300
+	velocityWindow = 100
+)
+
+// Recognizer states.
+const (
+	recognizeIdle = iota
+	recognizePan
+	recognizePinch
+
+	// recognizeDone means a gesture ended, but fingers remain, and nothing
+	// more is recognized until they are lifted.
+	recognizeDone
+)
+
+// gestureRecognizer recognizes gestures from the touch points on a window.
+type gestureRecognizer struct {
+	clock clock
+	emit  func(s *seat, e *GestureEvent)
+
+	// seat is the seat the current touch sequence comes from.
+	seat *seat
+
+	points      []*recognizerPoint
+	state       int
+	serial      uint32
+	fingers     int
+	moved       bool
+	longPressed bool
+	longPress   stopper
+
+	// x and y are the last center of the points, and samples the recent
+	// centers, for the velocity of pans.
+	x, y    float64
+	samples []gestureSample
+
+	// distance and angle are the base for the scale and rotation of pinches.
+	scale    float64
+	distance float64
+	angle    float64
+
+	lastTap      bool
+	lastTapTime  uint32
+	lastTapX     float64
+	lastTapY     float64
+	lastTapMoved bool
+}
+
+type recognizerPoint struct {
+	id             int32
+	startX, startY float64
+	x, y           float64
+}
+
+type gestureSample struct {
+	time uint32
+	x, y float64
+}
+
+// touch feeds a touch event to the recognizer.
+func (r *gestureRecognizer) touch(s *seat, e *TouchEvent) {
+	r.seat = s
+	if e.Cancelled() {
+		r.cancel(e)
+		return
+	}
+
+	changed := false
+	for _, p := range e.Points {
+		switch p.State {
+		case TouchDown:
+			if len(r.points) == 0 {
+				r.begin(e)
+			}
+			r.points = append(r.points, &recognizerPoint{id: p.ID, startX: p.X, startY: p.Y, x: p.X, y: p.Y})
+			changed = true
+		case TouchMotion, TouchUp:
+			if rp := r.point(p.ID); rp != nil {
+				rp.x, rp.y = p.X, p.Y
+				if math.Hypot(rp.x-rp.startX, rp.y-rp.startY) > tapSlop {
+					r.moved = true
+				}
+			}
+		}
+	}
+	if len(r.points) > r.fingers {
+		r.fingers = len(r.points)
+	}
+	if r.moved || r.fingers > 1 {
+		r.stopLongPress()
+	}
+
+	r.track(e, changed)
+
+	lifted := false
+	for _, p := range e.Points {
+		if p.State == TouchUp && r.remove(p.ID) {
+			lifted = true
+		}
+	}
+	if !lifted {
+		return
+	}
+	switch {
+	case len(r.points) == 0:
+		r.end(e)
+	case r.state == recognizePinch && len(r.points) < 2:
+		r.send(e.Time, GesturePinch, GestureEnd)
+		r.state = recognizeDone
+	default:
+		r.rebase()
+	}
+}
+
+// begin starts a touch sequence.
+func (r *gestureRecognizer) begin(e *TouchEvent) {
+	r.state = recognizeIdle
+	r.serial = e.Serial
+	r.fingers = 0
+	r.moved = false
+	r.longPressed = false
+	r.samples = r.samples[:0]
+	r.stopLongPress()
+	start := e.Time
+	r.longPress = r.clock.afterFunc(longPressDelay, func() {
+		r.longPress = nil
+		if len(r.points) == 1 && !r.moved {
+			r.longPressed = true
+			r.x, r.y = r.center()
+			r.send(start+uint32(longPressDelay/time.Millisecond), GestureLongPress, GestureEnd)
+		}
+	})
+}
+
+// track recognizes and updates pans and pinches.
+func (r *gestureRecognizer) track(e *TouchEvent, changed bool) {
+	if changed {
+		r.rebase()
+	}
+	if len(r.points) == 0 {
+		return
+	}
+
+	switch r.state {
+	case recognizeIdle:
+		if !r.moved {
+			return
+		}
+		if len(r.points) >= 2 {
+			r.beginPinch(e)
+		} else {
+			r.state = recognizePan
+			r.updatePan(e, GestureBegin)
+		}
+	case recognizePan:
+		if len(r.points) >= 2 {
+			r.send(e.Time, GesturePan, GestureEnd)
+			r.beginPinch(e)
+		} else {
+			r.updatePan(e, GestureUpdate)
+		}
+	case recognizePinch:
+		if len(r.points) >= 2 {
+			r.updatePinch(e, GestureUpdate)
+		}
+	}
+}
+
+func (r *gestureRecognizer) updatePan(e *TouchEvent, phase GesturePhase) {
+	x, y := r.center()
+	dx, dy := x-r.x, y-r.y
+	r.x, r.y = x, y
+	r.sample(e.Time)
+	if phase == GestureUpdate && dx == 0 && dy == 0 {
+		return
+	}
+	r.sendEvent(&GestureEvent{Type: GesturePan, Phase: phase, Time: e.Time, DX: dx, DY: dy})
+}
+
+func (r *gestureRecognizer) beginPinch(e *TouchEvent) {
+	r.state = recognizePinch
+	r.updatePinch(e, GestureBegin)
+}
+
+func (r *gestureRecognizer) updatePinch(e *TouchEvent, phase GesturePhase) {
+	x, y := r.center()
+	dx, dy := x-r.x, y-r.y
+	r.x, r.y = x, y
+
+	distance, angle := r.pinchGeometry()
+	scale := r.scale
+	if r.distance > 0 {
+		scale = distance / r.distance
+	}
+	rotation := math.Remainder(angle-r.angle, 360)
+	r.angle = angle
+	if phase == GestureUpdate && dx == 0 && dy == 0 && scale == r.scale && rotation == 0 {
+		return
+	}
+	r.scale = scale
+	r.sendEvent(&GestureEvent{
+		Type:     GesturePinch,
+		Phase:    phase,
+		Time:     e.Time,
+		DX:       dx,
+		DY:       dy,
+		Scale:    r.scale,
+		Rotation: rotation,
+	})
+}
+
+// end ends a touch sequence, once every finger is lifted.
+func (r *gestureRecognizer) end(e *TouchEvent) {
+	r.stopLongPress()
+	switch r.state {
+	case recognizePan:
+		vx, vy := r.velocity()
+		r.sendEvent(&GestureEvent{Type: GesturePan, Phase: GestureEnd, Time: e.Time, VX: vx, VY: vy})
+		if math.Hypot(vx, vy) > flingVelocity {
+			r.sendEvent(&GestureEvent{Type: GestureFling, Phase: GestureEnd, Time: e.Time, VX: vx, VY: vy})
+		}
+	case recognizePinch:
+		r.send(e.Time, GesturePinch, GestureEnd)
+	case recognizeIdle:
+		if r.moved || r.longPressed {
+			r.lastTap = false
+			break
+		}
+		r.send(e.Time, GestureTap, GestureEnd)
+		if r.fingers != 1 {
+			r.lastTap = false
+			break
+		}
+		if r.lastTap && e.Time-r.lastTapTime <= doubleTapInterval &&
+			math.Hypot(r.x-r.lastTapX, r.y-r.lastTapY) <= doubleTapSlop {
+			r.lastTap = false
+			r.send(e.Time, GestureDoubleTap, GestureEnd)
+			break
+		}
+		r.lastTap, r.lastTapTime, r.lastTapX, r.lastTapY = true, e.Time, r.x, r.y
+	}
+	r.state = recognizeIdle
+}
+
+// cancel abandons the touch sequence.
+func (r *gestureRecognizer) cancel(e *TouchEvent) {
+	r.stopLongPress()
+	switch r.state {
+	case recognizePan:
+		r.send(e.Time, GesturePan, GestureCancel)
+	case recognizePinch:
+		r.send(e.Time, GesturePinch, GestureCancel)
+	}
+	r.state = recognizeIdle
+	r.points = r.points[:0]
+	r.lastTap = false
+}
+
+func (r *gestureRecognizer) stopLongPress() {
+	if r.longPress != nil {
+		r.longPress.Stop()
+		r.longPress = nil
+	}
+}
+
+// send sends an event with no movement.
+func (r *gestureRecognizer) send(time uint32, typ GestureType, phase GesturePhase) {
+	scale := 0.0
+	if typ == GesturePinch {
+		scale = r.scale
+	}
+	r.sendEvent(&GestureEvent{Type: typ, Phase: phase, Time: time, Scale: scale})
+}
+
+func (r *gestureRecognizer) sendEvent(e *GestureEvent) {
+	e.Touchscreen = true
+	e.Serial = r.serial
+	e.Fingers = len(r.points)
+	if e.Type == GestureTap || e.Type == GestureDoubleTap || e.Fingers == 0 {
+		e.Fingers = r.fingers
+	}
+	e.X, e.Y = r.x, r.y
+	r.emit(r.seat, e)
+}
+
+// rebase restarts tracking from the current points when fingers are added or
+// removed, so that the center and the pinch don't jump.
+func (r *gestureRecognizer) rebase() {
+	if len(r.points) == 0 {
+		return
+	}
+	r.x, r.y = r.center()
+	r.samples = r.samples[:0]
+	if r.state != recognizePinch {
+		r.scale = 1
+	}
+	if len(r.points) >= 2 {
+		distance, angle := r.pinchGeometry()
+		r.distance, r.angle = distance/r.scale, angle
+	}
+}
+
+func (r *gestureRecognizer) point(id int32) *recognizerPoint {
+	for _, p := range r.points {
+		if p.id == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// remove forgets a point, returning false if it is unknown, e.g. because it
+// went down before the window started recognizing gestures.
+func (r *gestureRecognizer) remove(id int32) bool {
+	for i, p := range r.points {
+		if p.id == id {
+			if len(r.points) == 1 && r.state == recognizeIdle {
+				// Remember where the last finger was lifted, for taps.
+				r.x, r.y = p.x, p.y
+			}
+			r.points = append(r.points[:i], r.points[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// center returns the center of the points.
+func (r *gestureRecognizer) center() (x, y float64) {
+	for _, p := range r.points {
+		x += p.x
+		y += p.y
+	}
+	n := float64(len(r.points))
+	return x / n, y / n
+}
+
+// pinchGeometry returns the distance between the first two points, and the
+// angle of the line between them, in degrees clockwise.
+func (r *gestureRecognizer) pinchGeometry() (distance, angle float64) {
+	a, b := r.points[0], r.points[1]
+	return math.Hypot(b.x-a.x, b.y-a.y), math.Atan2(b.y-a.y, b.x-a.x) * 180 / math.Pi
+}
+
+// sample records the center of the points for velocity, keeping only recent
+// samples.
+func (r *gestureRecognizer) sample(time uint32) {
+	r.samples = append(r.samples, gestureSample{time: time, x: r.x, y: r.y})
+	i := 0
+	for time-r.samples[i].time > velocityWindow {
+		i++
+	}
+	r.samples = append(r.samples[:0], r.samples[i:]...)
+}
+
+// velocity returns the velocity over the recent samples, in pixels per
+// second.
+func (r *gestureRecognizer) velocity() (vx, vy float64) {
+	if len(r.samples) < 2 {
+		return 0, 0
+	}
+	first, last := r.samples[0], r.samples[len(r.samples)-1]
+	dt := float64(last.time-first.time) / 1000
+	if dt <= 0 {
+		return 0, 0
+	}
+	return (last.x - first.x) / dt, (last.y - first.y) / dt
+}
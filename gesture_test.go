@@ -0,0 +1,254 @@
+package jtk
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// touchSequence feeds touch frames to a gesture recognizer on a fake clock,
+// and records the gestures it recognizes.
+type touchSequence struct {
+	r      *gestureRecognizer
+	clock  *fakeClock
+	time   uint32
+	points map[int32]*TouchPoint
+	order  []int32
+	got    []string
+}
+
+func newTouchSequence(t *testing.T) *touchSequence {
+	q := &touchSequence{clock: &fakeClock{}, points: make(map[int32]*TouchPoint)}
+	q.r = &gestureRecognizer{clock: q.clock, emit: func(s *seat, e *GestureEvent) {
+		if !e.Touchscreen {
+			t.Errorf("%v %v is not marked as from a touchscreen", e.Type, e.Phase)
+		}
+		q.got = append(q.got, formatGesture(e))
+	}}
+	return q
+}
+
+// formatGesture summarizes a gesture event, with the fields that are
+// meaningful for its type, rounded to make them easy to compare.
+func formatGesture(e *GestureEvent) string {
+	s := fmt.Sprintf("%v %v fingers=%d t=%d at=%.0f,%.0f", e.Type, e.Phase, e.Fingers, e.Time, e.X, e.Y)
+	switch e.Type {
+	case GesturePan:
+		s += fmt.Sprintf(" d=%.0f,%.0f", e.DX, e.DY)
+		if e.Phase == GestureEnd {
+			s += fmt.Sprintf(" v=%.0f,%.0f", e.VX, e.VY)
+		}
+	case GesturePinch:
+		s += fmt.Sprintf(" d=%.0f,%.0f scale=%.2f rot=%.1f", e.DX, e.DY, e.Scale, e.Rotation)
+	case GestureFling:
+		s += fmt.Sprintf(" v=%.0f,%.0f", e.VX, e.VY)
+	}
+	return s
+}
+
+// frame advances the time by dt milliseconds, and then sends a touch frame
+// with the points that changed. Other points are sent as stationary.
+func (q *touchSequence) frame(dt uint32, changes ...TouchPoint) {
+	q.time += dt
+	q.clock.advance(time.Duration(dt) * time.Millisecond)
+	for _, p := range q.points {
+		p.State = TouchStationary
+	}
+	for _, p := range changes {
+		p := p
+		if _, ok := q.points[p.ID]; !ok {
+			q.order = append(q.order, p.ID)
+		}
+		q.points[p.ID] = &p
+	}
+
+	e := &TouchEvent{Time: q.time, Serial: q.time}
+	for _, id := range q.order {
+		e.Points = append(e.Points, *q.points[id])
+	}
+	q.r.touch(nil, e)
+
+	order := q.order[:0]
+	for _, id := range q.order {
+		if s := q.points[id].State; s == TouchUp || s == TouchCancelled {
+			delete(q.points, id)
+		} else {
+			order = append(order, id)
+		}
+	}
+	q.order = order
+}
+
+func down(id int32, x, y float64) TouchPoint {
+	return TouchPoint{ID: id, State: TouchDown, X: x, Y: y}
+}
+
+func move(id int32, x, y float64) TouchPoint {
+	return TouchPoint{ID: id, State: TouchMotion, X: x, Y: y}
+}
+
+func up(id int32, x, y float64) TouchPoint {
+	return TouchPoint{ID: id, State: TouchUp, X: x, Y: y}
+}
+
+func cancel(id int32) TouchPoint {
+	return TouchPoint{ID: id, State: TouchCancelled}
+}
+
+func TestGestureRecognizer(t *testing.T) {
+	tests := []struct {
+		name  string
+		touch func(q *touchSequence)
+		want  []string
+	}{
+		{
+			name: "tap and double tap",
+			touch: func(q *touchSequence) {
+				q.frame(1000, down(1, 10, 10))
+				q.frame(50, move(1, 12, 11))
+				q.frame(50, up(1, 12, 11))
+				q.frame(150, down(2, 14, 12))
+				q.frame(60, up(2, 14, 12))
+				// A third tap is not another double tap.
+				q.frame(100, down(3, 14, 12))
+				q.frame(60, up(3, 14, 12))
+			},
+			want: []string{
+				"tap end fingers=1 t=1100 at=12,11",
+				"tap end fingers=1 t=1310 at=14,12",
+				"double-tap end fingers=1 t=1310 at=14,12",
+				"tap end fingers=1 t=1470 at=14,12",
+			},
+		},
+		{
+			name: "two-finger tap",
+			touch: func(q *touchSequence) {
+				q.frame(10, down(1, 10, 10), down(2, 30, 10))
+				q.frame(50, up(1, 10, 10))
+				q.frame(10, up(2, 30, 10))
+			},
+			want: []string{
+				"tap end fingers=2 t=70 at=30,10",
+			},
+		},
+		{
+			name: "long press",
+			touch: func(q *touchSequence) {
+				q.frame(10, down(1, 10, 10))
+				q.frame(600, move(1, 11, 11))
+				q.frame(100, up(1, 11, 11))
+			},
+			want: []string{
+				"long-press end fingers=1 t=510 at=10,10",
+			},
+		},
+		{
+			name: "pan and fling",
+			touch: func(q *touchSequence) {
+				q.frame(10, down(1, 10, 10))
+				for i := 1; i <= 10; i++ {
+					q.frame(16, move(1, 10+float64(i)*10, 10))
+				}
+				q.frame(16, up(1, 110, 10))
+			},
+			want: []string{
+				"pan begin fingers=1 t=42 at=30,10 d=20,0",
+				"pan update fingers=1 t=58 at=40,10 d=10,0",
+				"pan update fingers=1 t=74 at=50,10 d=10,0",
+				"pan update fingers=1 t=90 at=60,10 d=10,0",
+				"pan update fingers=1 t=106 at=70,10 d=10,0",
+				"pan update fingers=1 t=122 at=80,10 d=10,0",
+				"pan update fingers=1 t=138 at=90,10 d=10,0",
+				"pan update fingers=1 t=154 at=100,10 d=10,0",
+				"pan update fingers=1 t=170 at=110,10 d=10,0",
+				"pan end fingers=1 t=186 at=110,10 d=0,0 v=521,0",
+				"fling end fingers=1 t=186 at=110,10 v=521,0",
+			},
+		},
+		{
+			name: "pan that stops before lifting",
+			touch: func(q *touchSequence) {
+				q.frame(10, down(1, 10, 10))
+				q.frame(16, move(1, 30, 10))
+				q.frame(300, up(1, 30, 10))
+			},
+			want: []string{
+				"pan begin fingers=1 t=26 at=30,10 d=20,0",
+				"pan end fingers=1 t=326 at=30,10 d=0,0 v=0,0",
+			},
+		},
+		{
+			name: "pinch",
+			touch: func(q *touchSequence) {
+				q.frame(10, down(1, 100, 100), down(2, 200, 100))
+				q.frame(16, move(1, 90, 100), move(2, 210, 100))
+				q.frame(16, move(2, 210, 110))
+				q.frame(16, down(3, 150, 150))
+				q.frame(16, move(1, 80, 100))
+				q.frame(16, up(1, 80, 100))
+				q.frame(16, up(2, 210, 110), up(3, 150, 150))
+			},
+			want: []string{
+				"pinch begin fingers=2 t=42 at=150,105 d=0,5 scale=1.20 rot=4.8",
+				"pinch update fingers=3 t=74 at=147,120 d=-3,0 scale=1.30 rot=-0.4",
+				"pinch end fingers=3 t=106 at=180,130 d=0,0 scale=1.30 rot=0.0",
+			},
+		},
+		{
+			name: "pan becomes a pinch",
+			touch: func(q *touchSequence) {
+				q.frame(10, down(1, 10, 10))
+				q.frame(16, move(1, 40, 10))
+				q.frame(16, down(2, 40, 110))
+				q.frame(16, move(2, 40, 130))
+				q.frame(16, up(1, 40, 10), up(2, 40, 130))
+			},
+			want: []string{
+				"pan begin fingers=1 t=26 at=40,10 d=30,0",
+				"pan end fingers=2 t=42 at=40,60 d=0,0 v=0,0",
+				"pinch begin fingers=2 t=42 at=40,60 d=0,0 scale=1.00 rot=0.0",
+				"pinch update fingers=2 t=58 at=40,70 d=0,10 scale=1.20 rot=0.0",
+				"pinch end fingers=2 t=74 at=40,70 d=0,0 scale=1.20 rot=0.0",
+			},
+		},
+		{
+			name: "cancelled pan",
+			touch: func(q *touchSequence) {
+				q.frame(10, down(1, 10, 10))
+				q.frame(16, move(1, 40, 10))
+				q.frame(24, cancel(1))
+			},
+			want: []string{
+				"pan begin fingers=1 t=26 at=40,10 d=30,0",
+				"pan cancel fingers=1 t=50 at=40,10 d=0,0",
+			},
+		},
+		{
+			name: "cancelled long press",
+			touch: func(q *touchSequence) {
+				q.frame(10, down(1, 10, 10))
+				q.frame(100, cancel(1))
+				q.frame(1000)
+			},
+			want: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q := newTouchSequence(t)
+			test.touch(q)
+			if !reflect.DeepEqual(q.got, test.want) {
+				t.Errorf("gestures are:\n%s\nwant:\n%s", formatLines(q.got), formatLines(test.want))
+			}
+		})
+	}
+}
+
+func formatLines(lines []string) string {
+	s := ""
+	for _, line := range lines {
+		s += "\t" + line + "\n"
+	}
+	return s
+}
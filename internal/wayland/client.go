@@ -7,6 +7,7 @@ import (
 	"os"
 	"sync"
 	"sync/atomic"
+	"syscall"
 	"time"
 )
 
@@ -27,6 +28,9 @@ type Display struct {
 	handlersMutex sync.RWMutex
 
 	id uint32
+
+	// looping is non-zero while EventLoop is running.
+	looping int32
 }
 
 // Connect connects to a Wayland display.
@@ -45,6 +49,12 @@ func Connect(display string) (*Display, error) {
 		return nil, err
 	}
 
+	return NewDisplay(socket), nil
+}
+
+// NewDisplay returns a Display that communicates with a compositor over an
+// established connection, e.g. one end of a socket pair.
+func NewDisplay(socket *net.UnixConn) *Display {
 	wldisplay := &WlDisplay{id: 1}
 
 	objects := make(map[ObjectID]Proxy)
@@ -62,13 +72,15 @@ func Connect(display string) (*Display, error) {
 	}
 
 	globals := &Globals{
-		globals: make(map[string]WlRegistryGlobalEvent),
-		conn:    conn,
+		globals:   make(map[string]WlRegistryGlobalEvent),
+		byName:    make(map[uint32]WlRegistryGlobalEvent),
+		wlOutputs: make(map[uint32]*WlOutput),
+		conn:      conn,
 	}
 
 	conn.globals = globals
 
-	return conn, nil
+	return conn
 }
 
 func (d *Display) SetErrorHandler(h ErrorHandler) {
@@ -113,6 +125,49 @@ func (d *Display) Sync() error {
 	return nil
 }
 
+type roundtripHandler struct {
+	done bool
+}
+
+func (s *roundtripHandler) Handle(event Event) {
+	switch event.(type) {
+	case *WlCallbackDoneEvent:
+		s.done = true
+	}
+}
+
+// Roundtrip synchronizes the connection, dispatching events on the calling
+// goroutine until the compositor has processed all prior requests. Unlike
+// Sync, it does not require an event loop to be running; it must not be used
+// while EventLoop is running on another goroutine.
+func (d *Display) Roundtrip() error {
+	callback, err := d.display.Sync(d)
+	if err != nil {
+		return err
+	}
+
+	handler := &roundtripHandler{}
+	d.RegisterHandler(callback.id, handler)
+	defer d.UnregisterHandler(callback.id, handler)
+
+	for !handler.done {
+		if err := d.Dispatch(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// wait synchronizes the connection using Sync if EventLoop is running, or
+// Roundtrip otherwise.
+func (d *Display) wait() error {
+	if atomic.LoadInt32(&d.looping) != 0 {
+		return d.Sync()
+	}
+	return d.Roundtrip()
+}
+
 // Globals gets the globals manager.
 func (d *Display) Globals() *Globals {
 	return d.globals
@@ -188,6 +243,12 @@ func (d *Display) Close() error {
 	return d.socket.Close()
 }
 
+// SyscallConn returns the raw connection to the compositor, e.g. for polling
+// the socket alongside other file descriptors.
+func (d *Display) SyscallConn() (syscall.RawConn, error) {
+	return d.socket.SyscallConn()
+}
+
 // PollEvent reads the socket for a new event.
 func (d *Display) PollEvent() (ObjectID, Event, error) {
 	scanner, err := ReadEvent(d.socket)
@@ -213,6 +274,12 @@ func (d *Display) PollEvent() (ObjectID, Event, error) {
 		return 0, nil, fmt.Errorf("scanning event %s for %d (interface %s): %w", event.MessageName(), scanner.header.ObjectID, object.Descriptor().Name, err)
 	}
 
+	if c, ok := event.(proxyCreator); ok {
+		for _, proxy := range c.newProxies(object.Version()) {
+			d.RegisterProxy(proxy)
+		}
+	}
+
 	return ObjectID(scanner.header.ObjectID), event, nil
 }
 
@@ -245,8 +312,24 @@ func (d *Display) DispatchEvent(object ObjectID, event Event) {
 	}
 }
 
+// Dispatch reads a single event from the socket and dispatches it. It blocks
+// until an event is available.
+func (d *Display) Dispatch() error {
+	object, event, err := d.PollEvent()
+	if err != nil {
+		return err
+	}
+
+	d.DispatchEvent(object, event)
+
+	return nil
+}
+
 // EventLoop runs the Wayland event loop.
 func (d *Display) EventLoop() error {
+	atomic.StoreInt32(&d.looping, 1)
+	defer atomic.StoreInt32(&d.looping, 0)
+
 	for {
 		object, event, err := d.PollEvent()
 		if err != nil {
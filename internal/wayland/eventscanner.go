@@ -2,7 +2,6 @@ package wayland
 
 import (
 	"bufio"
-	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -15,6 +14,7 @@ var (
 	ErrShortRead            = errors.New("short read")
 	ErrOutOfBandBufferShort = errors.New("oob buffer short")
 	ErrNoOutOfBand          = errors.New("no oob control message")
+	ErrNullString           = errors.New("null string in non-nullable argument")
 )
 
 type EventHeader struct {
@@ -86,6 +86,17 @@ func (s *EventScanner) ObjectID() (ObjectID, error) {
 	return *(*ObjectID)(unsafe.Pointer(&buf[0])), nil
 }
 
+// NullableObjectID scans an object ID, returning nil if the object is null.
+func (s *EventScanner) NullableObjectID() (*ObjectID, error) {
+	v, err := s.ObjectID()
+	if err != nil {
+		return nil, err
+	} else if v == 0 {
+		return nil, nil
+	}
+	return &v, nil
+}
+
 func (s *EventScanner) Fixed() (Fixed, error) {
 	buf := [4]byte{}
 	if _, err := s.reader.Read(buf[:]); err != nil {
@@ -95,21 +106,40 @@ func (s *EventScanner) Fixed() (Fixed, error) {
 }
 
 func (s *EventScanner) String() (string, error) {
-	len, err := s.Uint()
+	v, err := s.NullableString()
 	if err != nil {
 		return "", err
+	} else if v == nil {
+		return "", ErrNullString
+	}
+	return *v, nil
+}
+
+// NullableString scans a string, returning nil if the string is null.
+func (s *EventScanner) NullableString() (*string, error) {
+	len, err := s.Uint()
+	if err != nil {
+		return nil, err
 	}
 
-	if len&0x3 != 0 {
-		len += 4 - len&0x3
+	// A length of zero denotes a null string; an empty string still has a
+	// NUL terminator.
+	if len == 0 {
+		return nil, nil
 	}
 
-	buf := make([]byte, len)
-	if _, err := s.reader.Read(buf[:]); err != nil {
-		return "", err
+	size := len
+	if size&0x3 != 0 {
+		size += 4 - size&0x3
+	}
+
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(s.reader, buf); err != nil {
+		return nil, err
 	}
 
-	return string(bytes.TrimRight(buf[:len], "\x00")), nil
+	v := string(buf[:len-1])
+	return &v, nil
 }
 
 func (s *EventScanner) Array() ([]byte, error) {
@@ -118,12 +148,17 @@ func (s *EventScanner) Array() ([]byte, error) {
 		return nil, err
 	}
 
-	buf := make([]byte, len)
-	if _, err := s.reader.Read(buf[:]); err != nil {
+	size := len
+	if size&0x3 != 0 {
+		size += 4 - size&0x3
+	}
+
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(s.reader, buf); err != nil {
 		return nil, err
 	}
 
-	return buf, nil
+	return buf[:len], nil
 }
 
 func (s *EventScanner) FD() (FD, error) {
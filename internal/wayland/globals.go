@@ -14,31 +14,55 @@ type Globals struct {
 	zxdgDecorationManagerV1              *ZxdgDecorationManagerV1
 	zwpRelativePointerManagerV1          *ZwpRelativePointerManagerV1
 	zwpPointerConstraintsV1              *ZwpPointerConstraintsV1
+	zwpPointerGesturesV1                 *ZwpPointerGesturesV1
 	wpPresentation                       *WpPresentation
 	zwpTextInputManagerV3                *ZwpTextInputManagerV3
 	zwpPrimarySelectionDeviceManagerV1   *ZwpPrimarySelectionDeviceManagerV1
 	wpViewporter                         *WpViewporter
+	wpFractionalScaleManagerV1           *WpFractionalScaleManagerV1
 	zwpKeyboardShortcutsInhibitManagerV1 *ZwpKeyboardShortcutsInhibitManagerV1
 	wlSeat                               *WlSeat
 	wlOutput                             *WlOutput
+	wlOutputs                            map[uint32]*WlOutput
 
 	globals map[string]WlRegistryGlobalEvent
+	byName  map[uint32]WlRegistryGlobalEvent
 	conn    *Display
 }
 
 func (g *Globals) registerGlobal(event *WlRegistryGlobalEvent) {
 	g.globals[event.Interface] = *event
+	g.byName[event.Name] = *event
 }
 
 func (g *Globals) unregisterGlobal(event *WlRegistryGlobalRemoveEvent) {
+	delete(g.byName, event.Name)
+	delete(g.wlOutputs, event.Name)
 	for intf, global := range g.globals {
 		if global.Name == event.Name {
 			delete(g.globals, intf)
+			// Fall back to another global of the same interface, if any.
+			for _, other := range g.byName {
+				if other.Interface == intf {
+					g.globals[intf] = other
+					break
+				}
+			}
 			return
 		}
 	}
 }
 
+// bindVersion returns the version to bind a global at: the version advertised
+// by the compositor, but no higher than the version the bindings implement, so
+// that the compositor never sends events that cannot be decoded.
+func bindVersion(advertised, supported uint32) uint32 {
+	if advertised > supported {
+		return supported
+	}
+	return advertised
+}
+
 func (g *Globals) Registry() *WlRegistry {
 	if g.registry != nil {
 		return g.registry
@@ -48,7 +72,7 @@ func (g *Globals) Registry() *WlRegistry {
 		panic(err)
 	}
 	g.registry = registry
-	if err := g.conn.Sync(); err != nil {
+	if err := g.conn.wait(); err != nil {
 		panic(err)
 	}
 	return registry
@@ -60,12 +84,14 @@ func (g *Globals) WlShm() *WlShm {
 		if g.wlShm != nil {
 			return g.wlShm
 		}
-		id, err := registry.Bind(g.conn, global.Name, WlShmDescriptor.Name, global.Version)
+		version := bindVersion(global.Version, WlShmDescriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, WlShmDescriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &WlShm{id: id, version: global.Version}
+		proxy := &WlShm{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
+		g.wlShm = proxy
 		return proxy
 	}
 	return nil
@@ -77,11 +103,12 @@ func (g *Globals) ZwpLinuxDmabufV1() *ZwpLinuxDmabufV1 {
 		if g.zwpLinuxDmabufV1 != nil {
 			return g.zwpLinuxDmabufV1
 		}
-		id, err := registry.Bind(g.conn, global.Name, ZwpLinuxDmabufV1Descriptor.Name, global.Version)
+		version := bindVersion(global.Version, ZwpLinuxDmabufV1Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZwpLinuxDmabufV1Descriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &ZwpLinuxDmabufV1{id: id, version: global.Version}
+		proxy := &ZwpLinuxDmabufV1{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.zwpLinuxDmabufV1 = proxy
 		return proxy
@@ -95,11 +122,12 @@ func (g *Globals) WlCompositor() *WlCompositor {
 		if g.wlCompositor != nil {
 			return g.wlCompositor
 		}
-		id, err := registry.Bind(g.conn, global.Name, WlCompositorDescriptor.Name, global.Version)
+		version := bindVersion(global.Version, WlCompositorDescriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, WlCompositorDescriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &WlCompositor{id: id, version: global.Version}
+		proxy := &WlCompositor{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.wlCompositor = proxy
 		return proxy
@@ -113,11 +141,12 @@ func (g *Globals) WlSubcompositor() *WlSubcompositor {
 		if g.wlSubcompositor != nil {
 			return g.wlSubcompositor
 		}
-		id, err := registry.Bind(g.conn, global.Name, WlSubcompositorDescriptor.Name, global.Version)
+		version := bindVersion(global.Version, WlSubcompositorDescriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, WlSubcompositorDescriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &WlSubcompositor{id: id, version: global.Version}
+		proxy := &WlSubcompositor{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.wlSubcompositor = proxy
 		return proxy
@@ -131,11 +160,12 @@ func (g *Globals) WlDataDeviceManager() *WlDataDeviceManager {
 		if g.wlDataDeviceManager != nil {
 			return g.wlDataDeviceManager
 		}
-		id, err := registry.Bind(g.conn, global.Name, WlDataDeviceManagerDescriptor.Name, global.Version)
+		version := bindVersion(global.Version, WlDataDeviceManagerDescriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, WlDataDeviceManagerDescriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &WlDataDeviceManager{id: id, version: global.Version}
+		proxy := &WlDataDeviceManager{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.wlDataDeviceManager = proxy
 		return proxy
@@ -149,11 +179,12 @@ func (g *Globals) ZxdgOutputManagerV1() *ZxdgOutputManagerV1 {
 		if g.zxdgOutputManagerV1 != nil {
 			return g.zxdgOutputManagerV1
 		}
-		id, err := registry.Bind(g.conn, global.Name, ZxdgOutputManagerV1Descriptor.Name, global.Version)
+		version := bindVersion(global.Version, ZxdgOutputManagerV1Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZxdgOutputManagerV1Descriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &ZxdgOutputManagerV1{id: id, version: global.Version}
+		proxy := &ZxdgOutputManagerV1{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.zxdgOutputManagerV1 = proxy
 		return proxy
@@ -167,11 +198,12 @@ func (g *Globals) ZwpIdleInhibitManagerV1() *ZwpIdleInhibitManagerV1 {
 		if g.zwpIdleInhibitManagerV1 != nil {
 			return g.zwpIdleInhibitManagerV1
 		}
-		id, err := registry.Bind(g.conn, global.Name, ZwpIdleInhibitManagerV1Descriptor.Name, global.Version)
+		version := bindVersion(global.Version, ZwpIdleInhibitManagerV1Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZwpIdleInhibitManagerV1Descriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &ZwpIdleInhibitManagerV1{id: id, version: global.Version}
+		proxy := &ZwpIdleInhibitManagerV1{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.zwpIdleInhibitManagerV1 = proxy
 		return proxy
@@ -185,11 +217,12 @@ func (g *Globals) XdgWmBase() *XdgWmBase {
 		if g.xdgWmBase != nil {
 			return g.xdgWmBase
 		}
-		id, err := registry.Bind(g.conn, global.Name, XdgWmBaseDescriptor.Name, global.Version)
+		version := bindVersion(global.Version, XdgWmBaseDescriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, XdgWmBaseDescriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &XdgWmBase{id: id, version: global.Version}
+		proxy := &XdgWmBase{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.xdgWmBase = proxy
 		return proxy
@@ -203,11 +236,12 @@ func (g *Globals) ZwpTabletManagerV2() *ZwpTabletManagerV2 {
 		if g.zwpTabletManagerV2 != nil {
 			return g.zwpTabletManagerV2
 		}
-		id, err := registry.Bind(g.conn, global.Name, ZwpTabletManagerV2Descriptor.Name, global.Version)
+		version := bindVersion(global.Version, ZwpTabletManagerV2Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZwpTabletManagerV2Descriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &ZwpTabletManagerV2{id: id, version: global.Version}
+		proxy := &ZwpTabletManagerV2{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.zwpTabletManagerV2 = proxy
 		return proxy
@@ -221,11 +255,12 @@ func (g *Globals) ZxdgDecorationManagerV1() *ZxdgDecorationManagerV1 {
 		if g.zxdgDecorationManagerV1 != nil {
 			return g.zxdgDecorationManagerV1
 		}
-		id, err := registry.Bind(g.conn, global.Name, ZxdgDecorationManagerV1Descriptor.Name, global.Version)
+		version := bindVersion(global.Version, ZxdgDecorationManagerV1Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZxdgDecorationManagerV1Descriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &ZxdgDecorationManagerV1{id: id, version: global.Version}
+		proxy := &ZxdgDecorationManagerV1{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.zxdgDecorationManagerV1 = proxy
 		return proxy
@@ -239,11 +274,12 @@ func (g *Globals) ZwpRelativePointerManagerV1() *ZwpRelativePointerManagerV1 {
 		if g.zwpRelativePointerManagerV1 != nil {
 			return g.zwpRelativePointerManagerV1
 		}
-		id, err := registry.Bind(g.conn, global.Name, ZwpRelativePointerManagerV1Descriptor.Name, global.Version)
+		version := bindVersion(global.Version, ZwpRelativePointerManagerV1Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZwpRelativePointerManagerV1Descriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &ZwpRelativePointerManagerV1{id: id, version: global.Version}
+		proxy := &ZwpRelativePointerManagerV1{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.zwpRelativePointerManagerV1 = proxy
 		return proxy
@@ -257,11 +293,12 @@ func (g *Globals) ZwpPointerConstraintsV1() *ZwpPointerConstraintsV1 {
 		if g.zwpPointerConstraintsV1 != nil {
 			return g.zwpPointerConstraintsV1
 		}
-		id, err := registry.Bind(g.conn, global.Name, ZwpPointerConstraintsV1Descriptor.Name, global.Version)
+		version := bindVersion(global.Version, ZwpPointerConstraintsV1Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZwpPointerConstraintsV1Descriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &ZwpPointerConstraintsV1{id: id, version: global.Version}
+		proxy := &ZwpPointerConstraintsV1{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.zwpPointerConstraintsV1 = proxy
 		return proxy
@@ -269,17 +306,37 @@ func (g *Globals) ZwpPointerConstraintsV1() *ZwpPointerConstraintsV1 {
 	return nil
 }
 
+func (g *Globals) ZwpPointerGesturesV1() *ZwpPointerGesturesV1 {
+	registry := g.Registry()
+	if global, ok := g.globals[ZwpPointerGesturesV1Descriptor.Name]; ok {
+		if g.zwpPointerGesturesV1 != nil {
+			return g.zwpPointerGesturesV1
+		}
+		version := bindVersion(global.Version, ZwpPointerGesturesV1Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZwpPointerGesturesV1Descriptor.Name, version)
+		if err != nil {
+			panic(err)
+		}
+		proxy := &ZwpPointerGesturesV1{id: id, version: version}
+		g.conn.RegisterProxy(proxy)
+		g.zwpPointerGesturesV1 = proxy
+		return proxy
+	}
+	return nil
+}
+
 func (g *Globals) WpPresentation() *WpPresentation {
 	registry := g.Registry()
 	if global, ok := g.globals[WpPresentationDescriptor.Name]; ok {
 		if g.wpPresentation != nil {
 			return g.wpPresentation
 		}
-		id, err := registry.Bind(g.conn, global.Name, WpPresentationDescriptor.Name, global.Version)
+		version := bindVersion(global.Version, WpPresentationDescriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, WpPresentationDescriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &WpPresentation{id: id, version: global.Version}
+		proxy := &WpPresentation{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.wpPresentation = proxy
 		return proxy
@@ -293,11 +350,12 @@ func (g *Globals) ZwpTextInputManagerV3() *ZwpTextInputManagerV3 {
 		if g.zwpTextInputManagerV3 != nil {
 			return g.zwpTextInputManagerV3
 		}
-		id, err := registry.Bind(g.conn, global.Name, ZwpTextInputManagerV3Descriptor.Name, global.Version)
+		version := bindVersion(global.Version, ZwpTextInputManagerV3Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZwpTextInputManagerV3Descriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &ZwpTextInputManagerV3{id: id, version: global.Version}
+		proxy := &ZwpTextInputManagerV3{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.zwpTextInputManagerV3 = proxy
 		return proxy
@@ -311,11 +369,12 @@ func (g *Globals) ZwpPrimarySelectionDeviceManagerV1() *ZwpPrimarySelectionDevic
 		if g.zwpPrimarySelectionDeviceManagerV1 != nil {
 			return g.zwpPrimarySelectionDeviceManagerV1
 		}
-		id, err := registry.Bind(g.conn, global.Name, ZwpPrimarySelectionDeviceManagerV1Descriptor.Name, global.Version)
+		version := bindVersion(global.Version, ZwpPrimarySelectionDeviceManagerV1Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZwpPrimarySelectionDeviceManagerV1Descriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &ZwpPrimarySelectionDeviceManagerV1{id: id, version: global.Version}
+		proxy := &ZwpPrimarySelectionDeviceManagerV1{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.zwpPrimarySelectionDeviceManagerV1 = proxy
 		return proxy
@@ -329,11 +388,12 @@ func (g *Globals) WpViewporter() *WpViewporter {
 		if g.wpViewporter != nil {
 			return g.wpViewporter
 		}
-		id, err := registry.Bind(g.conn, global.Name, WpViewporterDescriptor.Name, global.Version)
+		version := bindVersion(global.Version, WpViewporterDescriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, WpViewporterDescriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &WpViewporter{id: id, version: global.Version}
+		proxy := &WpViewporter{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.wpViewporter = proxy
 		return proxy
@@ -341,17 +401,37 @@ func (g *Globals) WpViewporter() *WpViewporter {
 	return nil
 }
 
+func (g *Globals) WpFractionalScaleManagerV1() *WpFractionalScaleManagerV1 {
+	registry := g.Registry()
+	if global, ok := g.globals[WpFractionalScaleManagerV1Descriptor.Name]; ok {
+		if g.wpFractionalScaleManagerV1 != nil {
+			return g.wpFractionalScaleManagerV1
+		}
+		version := bindVersion(global.Version, WpFractionalScaleManagerV1Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, WpFractionalScaleManagerV1Descriptor.Name, version)
+		if err != nil {
+			panic(err)
+		}
+		proxy := &WpFractionalScaleManagerV1{id: id, version: version}
+		g.conn.RegisterProxy(proxy)
+		g.wpFractionalScaleManagerV1 = proxy
+		return proxy
+	}
+	return nil
+}
+
 func (g *Globals) ZwpKeyboardShortcutsInhibitManagerV1() *ZwpKeyboardShortcutsInhibitManagerV1 {
 	registry := g.Registry()
 	if global, ok := g.globals[ZwpKeyboardShortcutsInhibitManagerV1Descriptor.Name]; ok {
 		if g.zwpKeyboardShortcutsInhibitManagerV1 != nil {
 			return g.zwpKeyboardShortcutsInhibitManagerV1
 		}
-		id, err := registry.Bind(g.conn, global.Name, ZwpKeyboardShortcutsInhibitManagerV1Descriptor.Name, global.Version)
+		version := bindVersion(global.Version, ZwpKeyboardShortcutsInhibitManagerV1Descriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, ZwpKeyboardShortcutsInhibitManagerV1Descriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &ZwpKeyboardShortcutsInhibitManagerV1{id: id, version: global.Version}
+		proxy := &ZwpKeyboardShortcutsInhibitManagerV1{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.zwpKeyboardShortcutsInhibitManagerV1 = proxy
 		return proxy
@@ -365,11 +445,12 @@ func (g *Globals) WlSeat() *WlSeat {
 		if g.wlSeat != nil {
 			return g.wlSeat
 		}
-		id, err := registry.Bind(g.conn, global.Name, WlSeatDescriptor.Name, global.Version)
+		version := bindVersion(global.Version, WlSeatDescriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, WlSeatDescriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &WlSeat{id: id, version: global.Version}
+		proxy := &WlSeat{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.wlSeat = proxy
 		return proxy
@@ -383,14 +464,44 @@ func (g *Globals) WlOutput() *WlOutput {
 		if g.wlOutput != nil {
 			return g.wlOutput
 		}
-		id, err := registry.Bind(g.conn, global.Name, WlOutputDescriptor.Name, global.Version)
+		version := bindVersion(global.Version, WlOutputDescriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, WlOutputDescriptor.Name, version)
 		if err != nil {
 			panic(err)
 		}
-		proxy := &WlOutput{id: id, version: global.Version}
+		proxy := &WlOutput{id: id, version: version}
 		g.conn.RegisterProxy(proxy)
 		g.wlOutput = proxy
 		return proxy
 	}
 	return nil
 }
+
+// WlOutputs binds every advertised wl_output that is not bound yet, and
+// returns all bound outputs, keyed by global name. Outputs whose globals have
+// been removed are not included.
+func (g *Globals) WlOutputs() map[uint32]*WlOutput {
+	registry := g.Registry()
+	for name, global := range g.byName {
+		if global.Interface != WlOutputDescriptor.Name {
+			continue
+		}
+		if _, ok := g.wlOutputs[name]; ok {
+			continue
+		}
+		version := bindVersion(global.Version, WlOutputDescriptor.Version)
+		id, err := registry.Bind(g.conn, global.Name, WlOutputDescriptor.Name, version)
+		if err != nil {
+			panic(err)
+		}
+		proxy := &WlOutput{id: id, version: version}
+		g.conn.RegisterProxy(proxy)
+		g.wlOutputs[name] = proxy
+	}
+
+	outputs := make(map[uint32]*WlOutput, len(g.wlOutputs))
+	for name, proxy := range g.wlOutputs {
+		outputs[name] = proxy
+	}
+	return outputs
+}
@@ -11,6 +11,7 @@ import (
 
 var (
 	ErrMessageOverflow = errors.New("message too large")
+	ErrNullObject      = errors.New("null object in non-nullable argument")
 )
 
 type RequestHeader struct {
@@ -73,12 +74,23 @@ func (e *RequestEmitter) PutUint(v uint32) error {
 }
 
 func (e *RequestEmitter) PutObjectID(v ObjectID) error {
+	if v == 0 {
+		return ErrNullObject
+	}
 	buf := [4]byte{}
 	*(*ObjectID)(unsafe.Pointer(&buf[0])) = v
 	_, err := e.writer.Write(buf[:])
 	return err
 }
 
+// PutNullableObjectID emits an object ID, or null if v is nil.
+func (e *RequestEmitter) PutNullableObjectID(v *ObjectID) error {
+	if v == nil {
+		return e.PutUint(0)
+	}
+	return e.PutObjectID(*v)
+}
+
 func (e *RequestEmitter) PutFixed(v Fixed) error {
 	buf := [4]byte{}
 	*(*Fixed)(unsafe.Pointer(&buf[0])) = v
@@ -106,12 +118,28 @@ func (e *RequestEmitter) PutString(v string) error {
 	return nil
 }
 
+// PutNullableString emits a string, or null if v is nil. Null strings are
+// encoded with a length of zero, whereas the empty string has a length of one
+// to account for the NUL terminator.
+func (e *RequestEmitter) PutNullableString(v *string) error {
+	if v == nil {
+		return e.PutUint(0)
+	}
+	return e.PutString(*v)
+}
+
 func (e *RequestEmitter) PutArray(v []byte) error {
 	if err := e.PutUint(uint32(len(v))); err != nil {
 		return err
 	}
-	_, err := e.writer.Write(v)
-	return err
+	if _, err := e.writer.Write(v); err != nil {
+		return err
+	}
+	if len(v)&3 != 0 {
+		_, err := e.writer.Write(make([]byte, 4-len(v)&3))
+		return err
+	}
+	return nil
 }
 
 func (e *RequestEmitter) PutFD(v FD) error {
@@ -1,6 +1,8 @@
 package wayland
 
-//go:generate go run ../../cmd/waygen ../../third_party/wayland/protocol ../../third_party/wayland-protocols
+import "math"
+
+//go:generate go run ../../cmd/waygen -tests ../../third_party/wayland/protocol ../../third_party/wayland-protocols protocols
 
 // FD represents a UNIX file descriptor. This type is present inside Wayland
 // requests and events, but it is not sent over the main connection, and as
@@ -10,6 +12,16 @@ type FD uintptr
 // Fixed is Wayland's fixed-point decimal type.
 type Fixed uint32
 
+// Float returns the value of f as a float64.
+func (f Fixed) Float() float64 {
+	return float64(int32(f)) / 256
+}
+
+// FixedFromFloat returns the fixed-point value closest to v.
+func FixedFromFloat(v float64) Fixed {
+	return Fixed(int32(math.Round(v * 256)))
+}
+
 // ObjectID is an incrementing, per-connection object ID.
 type ObjectID uint32
 
@@ -27,6 +39,10 @@ type Proxy interface {
 	// ID returns the object ID of the proxied object.
 	ID() ObjectID
 
+	// Version returns the version of the interface the object was created
+	// with.
+	Version() uint32
+
 	// Descriptor returns the interface descriptor that corresponds to this
 	// proxy.
 	Descriptor() *InterfaceDescriptor
@@ -56,6 +72,13 @@ type Event interface {
 	Scan(s *EventScanner) error
 }
 
+// proxyCreator is implemented by events that create objects, such as
+// wl_data_device.data_offer. The objects must be registered before the next
+// event is read, since it may be for them.
+type proxyCreator interface {
+	newProxies(version uint32) []Proxy
+}
+
 // Request is an interface implemented by all Wayland requests.
 type Request interface {
 	Message
@@ -1,9 +1,41 @@
 // THIS FILE IS GENERATED BY WAYGEN - DO NOT EDIT
-// Generated with: waygen ../../third_party/wayland/protocol ../../third_party/wayland-protocols
+//
+// Generated from the following protocols:
+//	drm_lease_v1 (drm-lease-v1.xml)
+//	fractional_scale_v1 (fractional-scale-v1.xml)
+//	fullscreen_shell_unstable_v1 (fullscreen-shell-unstable-v1.xml)
+//	idle_inhibit_unstable_v1 (idle-inhibit-unstable-v1.xml)
+//	input_method_unstable_v1 (input-method-unstable-v1.xml)
+//	input_timestamps_unstable_v1 (input-timestamps-unstable-v1.xml)
+//	keyboard_shortcuts_inhibit_unstable_v1 (keyboard-shortcuts-inhibit-unstable-v1.xml)
+//	linux_dmabuf_unstable_v1 (linux-dmabuf-unstable-v1.xml)
+//	pointer_constraints_unstable_v1 (pointer-constraints-unstable-v1.xml)
+//	pointer_gestures_unstable_v1 (pointer-gestures-unstable-v1.xml)
+//	presentation_time (presentation-time.xml)
+//	relative_pointer_unstable_v1 (relative-pointer-unstable-v1.xml)
+//	tablet_unstable_v1 (tablet-unstable-v1.xml)
+//	tablet_unstable_v2 (tablet-unstable-v2.xml)
+//	text_input_unstable_v1 (text-input-unstable-v1.xml)
+//	text_input_unstable_v3 (text-input-unstable-v3.xml)
+//	viewporter (viewporter.xml)
+//	wayland (wayland.xml)
+//	wp_primary_selection_unstable_v1 (primary-selection-unstable-v1.xml)
+//	xdg_activation_v1 (xdg-activation-v1.xml)
+//	xdg_decoration_unstable_v1 (xdg-decoration-unstable-v1.xml)
+//	xdg_foreign_unstable_v1 (xdg-foreign-unstable-v1.xml)
+//	xdg_foreign_unstable_v2 (xdg-foreign-unstable-v2.xml)
+//	xdg_output_unstable_v1 (xdg-output-unstable-v1.xml)
+//	xdg_shell (xdg-shell.xml)
+//	xwayland_keyboard_grab_unstable_v1 (xwayland-keyboard-grab-unstable-v1.xml)
+//	zwp_linux_explicit_synchronization_unstable_v1 (linux-explicit-synchronization-unstable-v1.xml)
+//
+// Input hash: sha256:8120a2e7f4556d5633deceb1ce5fcfa1a7c1dd93ce2c4f3bb311ff17388fcd22
+
 package wayland
 
 ////////////////////////////////////////////////////////////////////////////////
 // Interface Descriptors
+
 var WpDrmLeaseDeviceV1Descriptor = InterfaceDescriptor{
 	Name:    "wp_drm_lease_device_v1",
 	Version: 1,
@@ -52,6 +84,25 @@ var WpDrmLeaseV1Descriptor = InterfaceDescriptor{
 		{Name: "destroy", Opcode: 0, Type: &WpDrmLeaseV1DestroyRequest{}},
 	},
 }
+var WpFractionalScaleManagerV1Descriptor = InterfaceDescriptor{
+	Name:    "wp_fractional_scale_manager_v1",
+	Version: 1,
+	Events:  []EventDescriptor{},
+	Requests: []RequestDescriptor{
+		{Name: "destroy", Opcode: 0, Type: &WpFractionalScaleManagerV1DestroyRequest{}},
+		{Name: "get_fractional_scale", Opcode: 1, Type: &WpFractionalScaleManagerV1GetFractionalScaleRequest{}},
+	},
+}
+var WpFractionalScaleV1Descriptor = InterfaceDescriptor{
+	Name:    "wp_fractional_scale_v1",
+	Version: 1,
+	Events: []EventDescriptor{
+		{Name: "preferred_scale", Opcode: 0, Type: &WpFractionalScaleV1PreferredScaleEvent{}},
+	},
+	Requests: []RequestDescriptor{
+		{Name: "destroy", Opcode: 0, Type: &WpFractionalScaleV1DestroyRequest{}},
+	},
+}
 var ZwpFullscreenShellV1Descriptor = InterfaceDescriptor{
 	Name:    "zwp_fullscreen_shell_v1",
 	Version: 1,
@@ -1219,6 +1270,7 @@ var ZwpLinuxBufferReleaseV1Descriptor = InterfaceDescriptor{
 
 ////////////////////////////////////////////////////////////////////////////////
 // Protocol Map
+
 var Protocols = map[string]ProtocolDescriptor{
 	"drm_lease_v1": {
 		Name: "drm_lease_v1",
@@ -1229,6 +1281,13 @@ var Protocols = map[string]ProtocolDescriptor{
 			&WpDrmLeaseV1Descriptor,
 		},
 	},
+	"fractional_scale_v1": {
+		Name: "fractional_scale_v1",
+		Interfaces: []*InterfaceDescriptor{
+			&WpFractionalScaleManagerV1Descriptor,
+			&WpFractionalScaleV1Descriptor,
+		},
+	},
 	"fullscreen_shell_unstable_v1": {
 		Name: "fullscreen_shell_unstable_v1",
 		Interfaces: []*InterfaceDescriptor{
@@ -1565,6 +1624,8 @@ var _ Event = &WpDrmLeaseDeviceV1DrmFDEvent{}
 // send additional connectors at any time.
 type WpDrmLeaseDeviceV1ConnectorEvent struct {
 	ID ObjectID
+
+	proxyID *WpDrmLeaseConnectorV1
 }
 
 // Opcode returns the event opcode for wp_drm_lease_device_v1.connector in drm_lease_v1
@@ -1589,6 +1650,24 @@ func (e *WpDrmLeaseDeviceV1ConnectorEvent) Scan(s *EventScanner) error {
 // Ensure WpDrmLeaseDeviceV1ConnectorEvent implements Event.
 var _ Event = &WpDrmLeaseDeviceV1ConnectorEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *WpDrmLeaseDeviceV1ConnectorEvent) newProxies(version uint32) []Proxy {
+	e.proxyID = &WpDrmLeaseConnectorV1{e.ID, version}
+	return []Proxy{
+		e.proxyID,
+	}
+}
+
+// Ensure WpDrmLeaseDeviceV1ConnectorEvent implements proxyCreator.
+var _ proxyCreator = &WpDrmLeaseDeviceV1ConnectorEvent{}
+
+// IDProxy returns the proxy for ID, registered when the event
+// was read.
+func (e *WpDrmLeaseDeviceV1ConnectorEvent) IDProxy() *WpDrmLeaseConnectorV1 {
+	return e.proxyID
+}
+
 // WpDrmLeaseDeviceV1DoneEvent signals when signals grouping of connectors
 //
 // The compositor will send this event to indicate that it has sent all
@@ -2319,6 +2398,261 @@ var _ Proxy = &WpDrmLeaseV1{}
 ////////////////////////////////////////////////////////////////////////////////
 // #endregion Protocol drm_lease_v1
 
+////////////////////////////////////////////////////////////////////////////////
+// #region Protocol fractional_scale_v1
+
+// ----------------------------------------------------------------------------
+// #region Interface fractional_scale_v1.wp_fractional_scale_manager_v1
+
+type WpFractionalScaleManagerV1Error int
+
+const (
+	// WpFractionalScaleManagerV1ErrorFractionalScaleExists corresponds to the surface already has a fractional_scale object associated
+	WpFractionalScaleManagerV1ErrorFractionalScaleExists WpFractionalScaleManagerV1Error = 0
+)
+
+// WpFractionalScaleManagerV1DestroyRequest requests to unbind the fractional surface scale interface
+//
+// Informs the server that the client will not be using this protocol
+// object anymore. This does not affect any other objects,
+// wp_fractional_scale_v1 objects included.
+type WpFractionalScaleManagerV1DestroyRequest struct {
+}
+
+// Opcode returns the request opcode for wp_fractional_scale_manager_v1.destroy in fractional_scale_v1
+func (WpFractionalScaleManagerV1DestroyRequest) Opcode() uint16 { return 0 }
+
+// MessageName returns the request name for wp_fractional_scale_manager_v1.destroy in fractional_scale_v1
+func (WpFractionalScaleManagerV1DestroyRequest) MessageName() string { return "destroy" }
+
+// Ensure WpFractionalScaleManagerV1DestroyRequest implements Message.
+var _ Message = WpFractionalScaleManagerV1DestroyRequest{}
+
+// Emit emits the message to the emitter.
+func (r *WpFractionalScaleManagerV1DestroyRequest) Emit(e *RequestEmitter) error {
+	return nil
+}
+
+// Ensure WpFractionalScaleManagerV1DestroyRequest implements Request.
+var _ Request = &WpFractionalScaleManagerV1DestroyRequest{}
+
+// WpFractionalScaleManagerV1GetFractionalScaleRequest requests to extend surface interface for scale information
+//
+// Create an add-on object for the the wl_surface to let the compositor
+// request fractional scales. If the given wl_surface already has a
+// wp_fractional_scale_v1 object associated, the fractional_scale_exists
+// protocol error is raised.
+type WpFractionalScaleManagerV1GetFractionalScaleRequest struct {
+	// ID contains the new surface scale info interface id
+	ID ObjectID
+
+	// Surface contains the surface
+	Surface ObjectID
+}
+
+// Opcode returns the request opcode for wp_fractional_scale_manager_v1.get_fractional_scale in fractional_scale_v1
+func (WpFractionalScaleManagerV1GetFractionalScaleRequest) Opcode() uint16 { return 1 }
+
+// MessageName returns the request name for wp_fractional_scale_manager_v1.get_fractional_scale in fractional_scale_v1
+func (WpFractionalScaleManagerV1GetFractionalScaleRequest) MessageName() string {
+	return "get_fractional_scale"
+}
+
+// Ensure WpFractionalScaleManagerV1GetFractionalScaleRequest implements Message.
+var _ Message = WpFractionalScaleManagerV1GetFractionalScaleRequest{}
+
+// Emit emits the message to the emitter.
+func (r *WpFractionalScaleManagerV1GetFractionalScaleRequest) Emit(e *RequestEmitter) error {
+	if err := e.PutObjectID(r.ID); err != nil {
+		return err
+	}
+	if err := e.PutObjectID(r.Surface); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Ensure WpFractionalScaleManagerV1GetFractionalScaleRequest implements Request.
+var _ Request = &WpFractionalScaleManagerV1GetFractionalScaleRequest{}
+
+// WpFractionalScaleManagerV1 fractional surface scale information
+//
+// A global interface for requesting surfaces to use fractional scales.
+type WpFractionalScaleManagerV1 struct {
+	id      ObjectID
+	version uint32
+}
+
+// ID returns the ID of the object.
+func (proxy *WpFractionalScaleManagerV1) ID() ObjectID {
+	return proxy.id
+}
+
+// ID returns the Version of the interface.
+func (proxy *WpFractionalScaleManagerV1) Version() uint32 {
+	return proxy.version
+}
+
+// Descriptor returns the interface descriptor for the interface of the object.
+func (WpFractionalScaleManagerV1) Descriptor() *InterfaceDescriptor {
+	return &WpFractionalScaleManagerV1Descriptor
+}
+
+// Dispatch returns an Event object for a given opcode.
+func (WpFractionalScaleManagerV1) Dispatch(opcode uint16) Event {
+	switch opcode {
+	default:
+		return nil
+	}
+}
+
+// Destroy requests to unbind the fractional surface scale interface
+//
+// Informs the server that the client will not be using this protocol
+// object anymore. This does not affect any other objects,
+// wp_fractional_scale_v1 objects included.
+func (proxy *WpFractionalScaleManagerV1) Destroy(connection Connection) (err error) {
+	request := WpFractionalScaleManagerV1DestroyRequest{}
+	err = connection.SendRequest(proxy.id, &request)
+	return
+}
+
+// GetFractionalScale requests to extend surface interface for scale information
+//
+// Create an add-on object for the the wl_surface to let the compositor
+// request fractional scales. If the given wl_surface already has a
+// wp_fractional_scale_v1 object associated, the fractional_scale_exists
+// protocol error is raised.
+func (proxy *WpFractionalScaleManagerV1) GetFractionalScale(connection Connection, aSurface ObjectID) (aID *WpFractionalScaleV1, err error) {
+	aID = &WpFractionalScaleV1{connection.NewID(), proxy.version}
+	request := WpFractionalScaleManagerV1GetFractionalScaleRequest{
+		ID:      aID.id,
+		Surface: aSurface,
+	}
+	err = connection.SendRequest(proxy.id, &request)
+	if err == nil {
+		connection.RegisterProxy(aID)
+	}
+	return
+}
+
+// Ensure WpFractionalScaleManagerV1 implements Proxy.
+var _ Proxy = &WpFractionalScaleManagerV1{}
+
+// #endregion Interface fractional_scale_v1.wp_fractional_scale_manager_v1
+
+// ----------------------------------------------------------------------------
+// #region Interface fractional_scale_v1.wp_fractional_scale_v1
+
+// WpFractionalScaleV1DestroyRequest requests to remove surface scale information for surface
+//
+// Destroy the fractional scale object. When this object is destroyed,
+// preferred_scale events will no longer be sent.
+type WpFractionalScaleV1DestroyRequest struct {
+}
+
+// Opcode returns the request opcode for wp_fractional_scale_v1.destroy in fractional_scale_v1
+func (WpFractionalScaleV1DestroyRequest) Opcode() uint16 { return 0 }
+
+// MessageName returns the request name for wp_fractional_scale_v1.destroy in fractional_scale_v1
+func (WpFractionalScaleV1DestroyRequest) MessageName() string { return "destroy" }
+
+// Ensure WpFractionalScaleV1DestroyRequest implements Message.
+var _ Message = WpFractionalScaleV1DestroyRequest{}
+
+// Emit emits the message to the emitter.
+func (r *WpFractionalScaleV1DestroyRequest) Emit(e *RequestEmitter) error {
+	return nil
+}
+
+// Ensure WpFractionalScaleV1DestroyRequest implements Request.
+var _ Request = &WpFractionalScaleV1DestroyRequest{}
+
+// WpFractionalScaleV1PreferredScaleEvent signals when notify of new preferred scale
+//
+// Notification of a new preferred scale for this surface that the
+// compositor suggests that the client should use.
+//
+// The sent scale is the numerator of a fraction with a denominator of 120.
+type WpFractionalScaleV1PreferredScaleEvent struct {
+	// Scale contains the new preferred scale
+	Scale uint32
+}
+
+// Opcode returns the event opcode for wp_fractional_scale_v1.preferred_scale in fractional_scale_v1
+func (WpFractionalScaleV1PreferredScaleEvent) Opcode() uint16 { return 0 }
+
+// MessageName returns the event name for wp_fractional_scale_v1.preferred_scale in fractional_scale_v1
+func (WpFractionalScaleV1PreferredScaleEvent) MessageName() string { return "preferred_scale" }
+
+// Ensure WpFractionalScaleV1PreferredScaleEvent implements Message.
+var _ Message = WpFractionalScaleV1PreferredScaleEvent{}
+
+// Scan scans the event from the socket.
+func (e *WpFractionalScaleV1PreferredScaleEvent) Scan(s *EventScanner) error {
+	if v, err := s.Uint(); err != nil {
+		return err
+	} else {
+		e.Scale = v
+	}
+	return nil
+}
+
+// Ensure WpFractionalScaleV1PreferredScaleEvent implements Event.
+var _ Event = &WpFractionalScaleV1PreferredScaleEvent{}
+
+// WpFractionalScaleV1 fractional scale interface to a wl_surface
+//
+// An additional interface to a wl_surface object which allows the compositor
+// to inform the client of the preferred scale.
+type WpFractionalScaleV1 struct {
+	id      ObjectID
+	version uint32
+}
+
+// ID returns the ID of the object.
+func (proxy *WpFractionalScaleV1) ID() ObjectID {
+	return proxy.id
+}
+
+// ID returns the Version of the interface.
+func (proxy *WpFractionalScaleV1) Version() uint32 {
+	return proxy.version
+}
+
+// Descriptor returns the interface descriptor for the interface of the object.
+func (WpFractionalScaleV1) Descriptor() *InterfaceDescriptor {
+	return &WpFractionalScaleV1Descriptor
+}
+
+// Dispatch returns an Event object for a given opcode.
+func (WpFractionalScaleV1) Dispatch(opcode uint16) Event {
+	switch opcode {
+	case 0:
+		return &WpFractionalScaleV1PreferredScaleEvent{}
+	default:
+		return nil
+	}
+}
+
+// Destroy requests to remove surface scale information for surface
+//
+// Destroy the fractional scale object. When this object is destroyed,
+// preferred_scale events will no longer be sent.
+func (proxy *WpFractionalScaleV1) Destroy(connection Connection) (err error) {
+	request := WpFractionalScaleV1DestroyRequest{}
+	err = connection.SendRequest(proxy.id, &request)
+	return
+}
+
+// Ensure WpFractionalScaleV1 implements Proxy.
+var _ Proxy = &WpFractionalScaleV1{}
+
+// #endregion Interface fractional_scale_v1.wp_fractional_scale_v1
+
+////////////////////////////////////////////////////////////////////////////////
+// #endregion Protocol fractional_scale_v1
+
 ////////////////////////////////////////////////////////////////////////////////
 // #region Protocol fullscreen_shell_unstable_v1
 
@@ -2446,11 +2780,11 @@ var _ Request = &ZwpFullscreenShellV1ReleaseRequest{}
 // If the surface already has another role, it raises a role protocol
 // error.
 type ZwpFullscreenShellV1PresentSurfaceRequest struct {
-	Surface ObjectID
+	Surface *ObjectID
 
 	Method uint32
 
-	Output ObjectID
+	Output *ObjectID
 }
 
 // Opcode returns the request opcode for zwp_fullscreen_shell_v1.present_surface in fullscreen_shell_unstable_v1
@@ -2464,13 +2798,13 @@ var _ Message = ZwpFullscreenShellV1PresentSurfaceRequest{}
 
 // Emit emits the message to the emitter.
 func (r *ZwpFullscreenShellV1PresentSurfaceRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Surface); err != nil {
+	if err := e.PutNullableObjectID(r.Surface); err != nil {
 		return err
 	}
 	if err := e.PutUint(r.Method); err != nil {
 		return err
 	}
-	if err := e.PutObjectID(r.Output); err != nil {
+	if err := e.PutNullableObjectID(r.Output); err != nil {
 		return err
 	}
 	return nil
@@ -2700,7 +3034,7 @@ func (proxy *ZwpFullscreenShellV1) Release(connection Connection) (err error) {
 // This request gives the surface the role of a fullscreen shell surface.
 // If the surface already has another role, it raises a role protocol
 // error.
-func (proxy *ZwpFullscreenShellV1) PresentSurface(connection Connection, aSurface ObjectID, aMethod uint32, aOutput ObjectID) (err error) {
+func (proxy *ZwpFullscreenShellV1) PresentSurface(connection Connection, aSurface *ObjectID, aMethod uint32, aOutput *ObjectID) (err error) {
 	request := ZwpFullscreenShellV1PresentSurfaceRequest{
 		Surface: aSurface,
 		Method:  aMethod,
@@ -4139,6 +4473,8 @@ var _ Proxy = &ZwpInputMethodContextV1{}
 // which allows communication with the text input.
 type ZwpInputMethodV1ActivateEvent struct {
 	ID ObjectID
+
+	proxyID *ZwpInputMethodContextV1
 }
 
 // Opcode returns the event opcode for zwp_input_method_v1.activate in input_method_unstable_v1
@@ -4163,6 +4499,24 @@ func (e *ZwpInputMethodV1ActivateEvent) Scan(s *EventScanner) error {
 // Ensure ZwpInputMethodV1ActivateEvent implements Event.
 var _ Event = &ZwpInputMethodV1ActivateEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpInputMethodV1ActivateEvent) newProxies(version uint32) []Proxy {
+	e.proxyID = &ZwpInputMethodContextV1{e.ID, version}
+	return []Proxy{
+		e.proxyID,
+	}
+}
+
+// Ensure ZwpInputMethodV1ActivateEvent implements proxyCreator.
+var _ proxyCreator = &ZwpInputMethodV1ActivateEvent{}
+
+// IDProxy returns the proxy for ID, registered when the event
+// was read.
+func (e *ZwpInputMethodV1ActivateEvent) IDProxy() *ZwpInputMethodContextV1 {
+	return e.proxyID
+}
+
 // ZwpInputMethodV1DeactivateEvent signals when deactivate event
 //
 // The text input corresponding to the context argument was deactivated.
@@ -5807,6 +6161,8 @@ var _ Request = &ZwpLinuxBufferParamsV1CreateImmedRequest{}
 type ZwpLinuxBufferParamsV1CreatedEvent struct {
 	// Buffer contains the newly created wl_buffer
 	Buffer ObjectID
+
+	proxyBuffer *WlBuffer
 }
 
 // Opcode returns the event opcode for zwp_linux_buffer_params_v1.created in linux_dmabuf_unstable_v1
@@ -5831,6 +6187,24 @@ func (e *ZwpLinuxBufferParamsV1CreatedEvent) Scan(s *EventScanner) error {
 // Ensure ZwpLinuxBufferParamsV1CreatedEvent implements Event.
 var _ Event = &ZwpLinuxBufferParamsV1CreatedEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpLinuxBufferParamsV1CreatedEvent) newProxies(version uint32) []Proxy {
+	e.proxyBuffer = &WlBuffer{e.Buffer, version}
+	return []Proxy{
+		e.proxyBuffer,
+	}
+}
+
+// Ensure ZwpLinuxBufferParamsV1CreatedEvent implements proxyCreator.
+var _ proxyCreator = &ZwpLinuxBufferParamsV1CreatedEvent{}
+
+// BufferProxy returns the proxy for Buffer, registered when the event
+// was read.
+func (e *ZwpLinuxBufferParamsV1CreatedEvent) BufferProxy() *WlBuffer {
+	return e.proxyBuffer
+}
+
 // ZwpLinuxBufferParamsV1FailedEvent signals when buffer creation failed
 //
 // This event indicates that the attempted buffer creation has
@@ -6171,7 +6545,7 @@ type ZwpPointerConstraintsV1LockPointerRequest struct {
 	Pointer ObjectID
 
 	// Region contains region of surface
-	Region ObjectID
+	Region *ObjectID
 
 	// Lifetime contains lock lifetime
 	Lifetime uint32
@@ -6197,7 +6571,7 @@ func (r *ZwpPointerConstraintsV1LockPointerRequest) Emit(e *RequestEmitter) erro
 	if err := e.PutObjectID(r.Pointer); err != nil {
 		return err
 	}
-	if err := e.PutObjectID(r.Region); err != nil {
+	if err := e.PutNullableObjectID(r.Region); err != nil {
 		return err
 	}
 	if err := e.PutUint(r.Lifetime); err != nil {
@@ -6238,7 +6612,7 @@ type ZwpPointerConstraintsV1ConfinePointerRequest struct {
 	Pointer ObjectID
 
 	// Region contains region of surface
-	Region ObjectID
+	Region *ObjectID
 
 	// Lifetime contains confinement lifetime
 	Lifetime uint32
@@ -6264,7 +6638,7 @@ func (r *ZwpPointerConstraintsV1ConfinePointerRequest) Emit(e *RequestEmitter) e
 	if err := e.PutObjectID(r.Pointer); err != nil {
 		return err
 	}
-	if err := e.PutObjectID(r.Region); err != nil {
+	if err := e.PutNullableObjectID(r.Region); err != nil {
 		return err
 	}
 	if err := e.PutUint(r.Lifetime); err != nil {
@@ -6365,7 +6739,7 @@ func (proxy *ZwpPointerConstraintsV1) Destroy(connection Connection) (err error)
 // relative motion events will still be emitted via wp_relative_pointer
 // objects of the same seat. wl_pointer.axis and wl_pointer.button events
 // are unaffected.
-func (proxy *ZwpPointerConstraintsV1) LockPointer(connection Connection, aSurface ObjectID, aPointer ObjectID, aRegion ObjectID, aLifetime uint32) (aID *ZwpLockedPointerV1, err error) {
+func (proxy *ZwpPointerConstraintsV1) LockPointer(connection Connection, aSurface ObjectID, aPointer ObjectID, aRegion *ObjectID, aLifetime uint32) (aID *ZwpLockedPointerV1, err error) {
 	aID = &ZwpLockedPointerV1{connection.NewID(), proxy.version}
 	request := ZwpPointerConstraintsV1LockPointerRequest{
 		ID:       aID.id,
@@ -6400,7 +6774,7 @@ func (proxy *ZwpPointerConstraintsV1) LockPointer(connection Connection, aSurfac
 // to interact with the confinement as well as receive updates about its
 // state. See the the description of wp_confined_pointer for further
 // information.
-func (proxy *ZwpPointerConstraintsV1) ConfinePointer(connection Connection, aSurface ObjectID, aPointer ObjectID, aRegion ObjectID, aLifetime uint32) (aID *ZwpConfinedPointerV1, err error) {
+func (proxy *ZwpPointerConstraintsV1) ConfinePointer(connection Connection, aSurface ObjectID, aPointer ObjectID, aRegion *ObjectID, aLifetime uint32) (aID *ZwpConfinedPointerV1, err error) {
 	aID = &ZwpConfinedPointerV1{connection.NewID(), proxy.version}
 	request := ZwpPointerConstraintsV1ConfinePointerRequest{
 		ID:       aID.id,
@@ -6505,7 +6879,7 @@ var _ Request = &ZwpLockedPointerV1SetCursorPositionHintRequest{}
 // For details about the lock region, see wp_locked_pointer.
 type ZwpLockedPointerV1SetRegionRequest struct {
 	// Region contains region of surface
-	Region ObjectID
+	Region *ObjectID
 }
 
 // Opcode returns the request opcode for zwp_locked_pointer_v1.set_region in pointer_constraints_unstable_v1
@@ -6519,7 +6893,7 @@ var _ Message = ZwpLockedPointerV1SetRegionRequest{}
 
 // Emit emits the message to the emitter.
 func (r *ZwpLockedPointerV1SetRegionRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Region); err != nil {
+	if err := e.PutNullableObjectID(r.Region); err != nil {
 		return err
 	}
 	return nil
@@ -6677,7 +7051,7 @@ func (proxy *ZwpLockedPointerV1) SetCursorPositionHint(connection Connection, aS
 // applied. See wl_surface.commit for details.
 //
 // For details about the lock region, see wp_locked_pointer.
-func (proxy *ZwpLockedPointerV1) SetRegion(connection Connection, aRegion ObjectID) (err error) {
+func (proxy *ZwpLockedPointerV1) SetRegion(connection Connection, aRegion *ObjectID) (err error) {
 	request := ZwpLockedPointerV1SetRegionRequest{
 		Region: aRegion,
 	}
@@ -6737,7 +7111,7 @@ var _ Request = &ZwpConfinedPointerV1DestroyRequest{}
 // For details about the confine region, see wp_confined_pointer.
 type ZwpConfinedPointerV1SetRegionRequest struct {
 	// Region contains region of surface
-	Region ObjectID
+	Region *ObjectID
 }
 
 // Opcode returns the request opcode for zwp_confined_pointer_v1.set_region in pointer_constraints_unstable_v1
@@ -6751,7 +7125,7 @@ var _ Message = ZwpConfinedPointerV1SetRegionRequest{}
 
 // Emit emits the message to the emitter.
 func (r *ZwpConfinedPointerV1SetRegionRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Region); err != nil {
+	if err := e.PutNullableObjectID(r.Region); err != nil {
 		return err
 	}
 	return nil
@@ -6890,7 +7264,7 @@ func (proxy *ZwpConfinedPointerV1) Destroy(connection Connection) (err error) {
 // pointer.
 //
 // For details about the confine region, see wp_confined_pointer.
-func (proxy *ZwpConfinedPointerV1) SetRegion(connection Connection, aRegion ObjectID) (err error) {
+func (proxy *ZwpConfinedPointerV1) SetRegion(connection Connection, aRegion *ObjectID) (err error) {
 	request := ZwpConfinedPointerV1SetRegionRequest{
 		Region: aRegion,
 	}
@@ -8004,8 +8378,6 @@ var _ Event = &WpPresentationClockIDEvent{}
 // the wl_surface.commit and provides feedback on the content
 // update, particularly the final realized presentation time.
 //
-//
-//
 // When the final realized presentation time is available, e.g.
 // after a framebuffer flip completes, the requested
 // presentation_feedback.presented events are sent. The final
@@ -8803,6 +9175,8 @@ var _ Request = &ZwpTabletSeatV1DestroyRequest{}
 type ZwpTabletSeatV1TabletAddedEvent struct {
 	// ID contains the newly added graphics tablet
 	ID ObjectID
+
+	proxyID *ZwpTabletV1
 }
 
 // Opcode returns the event opcode for zwp_tablet_seat_v1.tablet_added in tablet_unstable_v1
@@ -8827,6 +9201,24 @@ func (e *ZwpTabletSeatV1TabletAddedEvent) Scan(s *EventScanner) error {
 // Ensure ZwpTabletSeatV1TabletAddedEvent implements Event.
 var _ Event = &ZwpTabletSeatV1TabletAddedEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpTabletSeatV1TabletAddedEvent) newProxies(version uint32) []Proxy {
+	e.proxyID = &ZwpTabletV1{e.ID, version}
+	return []Proxy{
+		e.proxyID,
+	}
+}
+
+// Ensure ZwpTabletSeatV1TabletAddedEvent implements proxyCreator.
+var _ proxyCreator = &ZwpTabletSeatV1TabletAddedEvent{}
+
+// IDProxy returns the proxy for ID, registered when the event
+// was read.
+func (e *ZwpTabletSeatV1TabletAddedEvent) IDProxy() *ZwpTabletV1 {
+	return e.proxyID
+}
+
 // ZwpTabletSeatV1ToolAddedEvent signals when a new tool has been used with a tablet
 //
 // This event is sent whenever a tool that has not previously been used
@@ -8836,6 +9228,8 @@ var _ Event = &ZwpTabletSeatV1TabletAddedEvent{}
 type ZwpTabletSeatV1ToolAddedEvent struct {
 	// ID contains the newly added tablet tool
 	ID ObjectID
+
+	proxyID *ZwpTabletToolV1
 }
 
 // Opcode returns the event opcode for zwp_tablet_seat_v1.tool_added in tablet_unstable_v1
@@ -8860,6 +9254,24 @@ func (e *ZwpTabletSeatV1ToolAddedEvent) Scan(s *EventScanner) error {
 // Ensure ZwpTabletSeatV1ToolAddedEvent implements Event.
 var _ Event = &ZwpTabletSeatV1ToolAddedEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpTabletSeatV1ToolAddedEvent) newProxies(version uint32) []Proxy {
+	e.proxyID = &ZwpTabletToolV1{e.ID, version}
+	return []Proxy{
+		e.proxyID,
+	}
+}
+
+// Ensure ZwpTabletSeatV1ToolAddedEvent implements proxyCreator.
+var _ proxyCreator = &ZwpTabletSeatV1ToolAddedEvent{}
+
+// IDProxy returns the proxy for ID, registered when the event
+// was read.
+func (e *ZwpTabletSeatV1ToolAddedEvent) IDProxy() *ZwpTabletToolV1 {
+	return e.proxyID
+}
+
 // ZwpTabletSeatV1 controller object for graphic tablet devices of a seat
 //
 // An object that provides access to the graphics tablets available on this
@@ -9040,7 +9452,7 @@ type ZwpTabletToolV1SetCursorRequest struct {
 	// Serial contains serial of the enter event
 	Serial uint32
 
-	Surface ObjectID
+	Surface *ObjectID
 
 	// HotspotX contains surface-local x coordinate
 	HotspotX int32
@@ -9063,7 +9475,7 @@ func (r *ZwpTabletToolV1SetCursorRequest) Emit(e *RequestEmitter) error {
 	if err := e.PutUint(r.Serial); err != nil {
 		return err
 	}
-	if err := e.PutObjectID(r.Surface); err != nil {
+	if err := e.PutNullableObjectID(r.Surface); err != nil {
 		return err
 	}
 	if err := e.PutInt(r.HotspotX); err != nil {
@@ -9963,7 +10375,7 @@ func (ZwpTabletToolV1) Dispatch(opcode uint16) Event {
 // surface already has another role, it raises a protocol error.
 // The surface may be used on multiple tablets and across multiple
 // seats.
-func (proxy *ZwpTabletToolV1) SetCursor(connection Connection, aSerial uint32, aSurface ObjectID, aHotspotX int32, aHotspotY int32) (err error) {
+func (proxy *ZwpTabletToolV1) SetCursor(connection Connection, aSerial uint32, aSurface *ObjectID, aHotspotX int32, aHotspotY int32) (err error) {
 	request := ZwpTabletToolV1SetCursorRequest{
 		Serial:   aSerial,
 		Surface:  aSurface,
@@ -10410,6 +10822,8 @@ var _ Request = &ZwpTabletSeatV2DestroyRequest{}
 type ZwpTabletSeatV2TabletAddedEvent struct {
 	// ID contains the newly added graphics tablet
 	ID ObjectID
+
+	proxyID *ZwpTabletV2
 }
 
 // Opcode returns the event opcode for zwp_tablet_seat_v2.tablet_added in tablet_unstable_v2
@@ -10434,6 +10848,24 @@ func (e *ZwpTabletSeatV2TabletAddedEvent) Scan(s *EventScanner) error {
 // Ensure ZwpTabletSeatV2TabletAddedEvent implements Event.
 var _ Event = &ZwpTabletSeatV2TabletAddedEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpTabletSeatV2TabletAddedEvent) newProxies(version uint32) []Proxy {
+	e.proxyID = &ZwpTabletV2{e.ID, version}
+	return []Proxy{
+		e.proxyID,
+	}
+}
+
+// Ensure ZwpTabletSeatV2TabletAddedEvent implements proxyCreator.
+var _ proxyCreator = &ZwpTabletSeatV2TabletAddedEvent{}
+
+// IDProxy returns the proxy for ID, registered when the event
+// was read.
+func (e *ZwpTabletSeatV2TabletAddedEvent) IDProxy() *ZwpTabletV2 {
+	return e.proxyID
+}
+
 // ZwpTabletSeatV2ToolAddedEvent signals when a new tool has been used with a tablet
 //
 // This event is sent whenever a tool that has not previously been used
@@ -10443,6 +10875,8 @@ var _ Event = &ZwpTabletSeatV2TabletAddedEvent{}
 type ZwpTabletSeatV2ToolAddedEvent struct {
 	// ID contains the newly added tablet tool
 	ID ObjectID
+
+	proxyID *ZwpTabletToolV2
 }
 
 // Opcode returns the event opcode for zwp_tablet_seat_v2.tool_added in tablet_unstable_v2
@@ -10467,6 +10901,24 @@ func (e *ZwpTabletSeatV2ToolAddedEvent) Scan(s *EventScanner) error {
 // Ensure ZwpTabletSeatV2ToolAddedEvent implements Event.
 var _ Event = &ZwpTabletSeatV2ToolAddedEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpTabletSeatV2ToolAddedEvent) newProxies(version uint32) []Proxy {
+	e.proxyID = &ZwpTabletToolV2{e.ID, version}
+	return []Proxy{
+		e.proxyID,
+	}
+}
+
+// Ensure ZwpTabletSeatV2ToolAddedEvent implements proxyCreator.
+var _ proxyCreator = &ZwpTabletSeatV2ToolAddedEvent{}
+
+// IDProxy returns the proxy for ID, registered when the event
+// was read.
+func (e *ZwpTabletSeatV2ToolAddedEvent) IDProxy() *ZwpTabletToolV2 {
+	return e.proxyID
+}
+
 // ZwpTabletSeatV2PadAddedEvent signals when new pad notification
 //
 // This event is sent whenever a new pad is known to the system. Typically,
@@ -10482,6 +10934,8 @@ var _ Event = &ZwpTabletSeatV2ToolAddedEvent{}
 type ZwpTabletSeatV2PadAddedEvent struct {
 	// ID contains the newly added pad
 	ID ObjectID
+
+	proxyID *ZwpTabletPadV2
 }
 
 // Opcode returns the event opcode for zwp_tablet_seat_v2.pad_added in tablet_unstable_v2
@@ -10506,6 +10960,24 @@ func (e *ZwpTabletSeatV2PadAddedEvent) Scan(s *EventScanner) error {
 // Ensure ZwpTabletSeatV2PadAddedEvent implements Event.
 var _ Event = &ZwpTabletSeatV2PadAddedEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpTabletSeatV2PadAddedEvent) newProxies(version uint32) []Proxy {
+	e.proxyID = &ZwpTabletPadV2{e.ID, version}
+	return []Proxy{
+		e.proxyID,
+	}
+}
+
+// Ensure ZwpTabletSeatV2PadAddedEvent implements proxyCreator.
+var _ proxyCreator = &ZwpTabletSeatV2PadAddedEvent{}
+
+// IDProxy returns the proxy for ID, registered when the event
+// was read.
+func (e *ZwpTabletSeatV2PadAddedEvent) IDProxy() *ZwpTabletPadV2 {
+	return e.proxyID
+}
+
 // ZwpTabletSeatV2 controller object for graphic tablet devices of a seat
 //
 // An object that provides access to the graphics tablets available on this
@@ -10686,7 +11158,7 @@ type ZwpTabletToolV2SetCursorRequest struct {
 	// Serial contains serial of the enter event
 	Serial uint32
 
-	Surface ObjectID
+	Surface *ObjectID
 
 	// HotspotX contains surface-local x coordinate
 	HotspotX int32
@@ -10709,7 +11181,7 @@ func (r *ZwpTabletToolV2SetCursorRequest) Emit(e *RequestEmitter) error {
 	if err := e.PutUint(r.Serial); err != nil {
 		return err
 	}
-	if err := e.PutObjectID(r.Surface); err != nil {
+	if err := e.PutNullableObjectID(r.Surface); err != nil {
 		return err
 	}
 	if err := e.PutInt(r.HotspotX); err != nil {
@@ -11607,7 +12079,7 @@ func (ZwpTabletToolV2) Dispatch(opcode uint16) Event {
 // wp_tablet_tool. If the surface already has another role or has
 // previously been used as cursor surface for a different tool, a
 // protocol error is raised.
-func (proxy *ZwpTabletToolV2) SetCursor(connection Connection, aSerial uint32, aSurface ObjectID, aHotspotX int32, aHotspotY int32) (err error) {
+func (proxy *ZwpTabletToolV2) SetCursor(connection Connection, aSerial uint32, aSurface *ObjectID, aHotspotX int32, aHotspotY int32) (err error) {
 	request := ZwpTabletToolV2SetCursorRequest{
 		Serial:   aSerial,
 		Surface:  aSurface,
@@ -12617,6 +13089,8 @@ var _ Event = &ZwpTabletPadGroupV2ButtonsEvent{}
 // wp_tablet_pad_group.done event.
 type ZwpTabletPadGroupV2RingEvent struct {
 	Ring ObjectID
+
+	proxyRing *ZwpTabletPadRingV2
 }
 
 // Opcode returns the event opcode for zwp_tablet_pad_group_v2.ring in tablet_unstable_v2
@@ -12641,6 +13115,24 @@ func (e *ZwpTabletPadGroupV2RingEvent) Scan(s *EventScanner) error {
 // Ensure ZwpTabletPadGroupV2RingEvent implements Event.
 var _ Event = &ZwpTabletPadGroupV2RingEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpTabletPadGroupV2RingEvent) newProxies(version uint32) []Proxy {
+	e.proxyRing = &ZwpTabletPadRingV2{e.Ring, version}
+	return []Proxy{
+		e.proxyRing,
+	}
+}
+
+// Ensure ZwpTabletPadGroupV2RingEvent implements proxyCreator.
+var _ proxyCreator = &ZwpTabletPadGroupV2RingEvent{}
+
+// RingProxy returns the proxy for Ring, registered when the event
+// was read.
+func (e *ZwpTabletPadGroupV2RingEvent) RingProxy() *ZwpTabletPadRingV2 {
+	return e.proxyRing
+}
+
 // ZwpTabletPadGroupV2StripEvent signals when strip announced
 //
 // Sent on wp_tablet_pad initialization to announce available strips.
@@ -12650,6 +13142,8 @@ var _ Event = &ZwpTabletPadGroupV2RingEvent{}
 // wp_tablet_pad_group.done event.
 type ZwpTabletPadGroupV2StripEvent struct {
 	Strip ObjectID
+
+	proxyStrip *ZwpTabletPadStripV2
 }
 
 // Opcode returns the event opcode for zwp_tablet_pad_group_v2.strip in tablet_unstable_v2
@@ -12674,6 +13168,24 @@ func (e *ZwpTabletPadGroupV2StripEvent) Scan(s *EventScanner) error {
 // Ensure ZwpTabletPadGroupV2StripEvent implements Event.
 var _ Event = &ZwpTabletPadGroupV2StripEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpTabletPadGroupV2StripEvent) newProxies(version uint32) []Proxy {
+	e.proxyStrip = &ZwpTabletPadStripV2{e.Strip, version}
+	return []Proxy{
+		e.proxyStrip,
+	}
+}
+
+// Ensure ZwpTabletPadGroupV2StripEvent implements proxyCreator.
+var _ proxyCreator = &ZwpTabletPadGroupV2StripEvent{}
+
+// StripProxy returns the proxy for Strip, registered when the event
+// was read.
+func (e *ZwpTabletPadGroupV2StripEvent) StripProxy() *ZwpTabletPadStripV2 {
+	return e.proxyStrip
+}
+
 // ZwpTabletPadGroupV2ModesEvent signals when mode-switch ability announced
 //
 // Sent on wp_tablet_pad_group initialization to announce that the pad
@@ -13003,6 +13515,8 @@ var _ Request = &ZwpTabletPadV2DestroyRequest{}
 // wp_tablet_pad.done event. At least one group will be announced.
 type ZwpTabletPadV2GroupEvent struct {
 	PadGroup ObjectID
+
+	proxyPadGroup *ZwpTabletPadGroupV2
 }
 
 // Opcode returns the event opcode for zwp_tablet_pad_v2.group in tablet_unstable_v2
@@ -13027,6 +13541,24 @@ func (e *ZwpTabletPadV2GroupEvent) Scan(s *EventScanner) error {
 // Ensure ZwpTabletPadV2GroupEvent implements Event.
 var _ Event = &ZwpTabletPadV2GroupEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpTabletPadV2GroupEvent) newProxies(version uint32) []Proxy {
+	e.proxyPadGroup = &ZwpTabletPadGroupV2{e.PadGroup, version}
+	return []Proxy{
+		e.proxyPadGroup,
+	}
+}
+
+// Ensure ZwpTabletPadV2GroupEvent implements proxyCreator.
+var _ proxyCreator = &ZwpTabletPadV2GroupEvent{}
+
+// PadGroupProxy returns the proxy for PadGroup, registered when the event
+// was read.
+func (e *ZwpTabletPadV2GroupEvent) PadGroupProxy() *ZwpTabletPadGroupV2 {
+	return e.proxyPadGroup
+}
+
 // ZwpTabletPadV2PathEvent signals when path to the device
 //
 // A system-specific device path that indicates which device is behind
@@ -15284,7 +15816,7 @@ var _ Event = &ZwpTextInputV3LeaveEvent{}
 // The initial value of text is an empty string, and cursor_begin,
 // cursor_end and cursor_hidden are all 0.
 type ZwpTextInputV3PreeditStringEvent struct {
-	Text string
+	Text *string
 
 	CursorBegin int32
 
@@ -15302,7 +15834,7 @@ var _ Message = ZwpTextInputV3PreeditStringEvent{}
 
 // Scan scans the event from the socket.
 func (e *ZwpTextInputV3PreeditStringEvent) Scan(s *EventScanner) error {
-	if v, err := s.String(); err != nil {
+	if v, err := s.NullableString(); err != nil {
 		return err
 	} else {
 		e.Text = v
@@ -15334,7 +15866,7 @@ var _ Event = &ZwpTextInputV3PreeditStringEvent{}
 //
 // The initial value of text is an empty string.
 type ZwpTextInputV3CommitStringEvent struct {
-	Text string
+	Text *string
 }
 
 // Opcode returns the event opcode for zwp_text_input_v3.commit_string in text_input_unstable_v3
@@ -15348,7 +15880,7 @@ var _ Message = ZwpTextInputV3CommitStringEvent{}
 
 // Scan scans the event from the socket.
 func (e *ZwpTextInputV3CommitStringEvent) Scan(s *EventScanner) error {
-	if v, err := s.String(); err != nil {
+	if v, err := s.NullableString(); err != nil {
 		return err
 	} else {
 		e.Text = v
@@ -17862,7 +18394,7 @@ type WlDataOfferAcceptRequest struct {
 	Serial uint32
 
 	// MimeType contains mime type accepted by the client
-	MimeType string
+	MimeType *string
 }
 
 // Opcode returns the request opcode for wl_data_offer.accept in wayland
@@ -17879,7 +18411,7 @@ func (r *WlDataOfferAcceptRequest) Emit(e *RequestEmitter) error {
 	if err := e.PutUint(r.Serial); err != nil {
 		return err
 	}
-	if err := e.PutString(r.MimeType); err != nil {
+	if err := e.PutNullableString(r.MimeType); err != nil {
 		return err
 	}
 	return nil
@@ -18244,7 +18776,7 @@ func (WlDataOffer) Dispatch(opcode uint16) Event {
 // will be cancelled and the corresponding drag source will receive
 // wl_data_source.cancelled. Clients may still use this event in
 // conjunction with wl_data_source.action for feedback.
-func (proxy *WlDataOffer) Accept(connection Connection, aSerial uint32, aMimeType string) (err error) {
+func (proxy *WlDataOffer) Accept(connection Connection, aSerial uint32, aMimeType *string) (err error) {
 	request := WlDataOfferAcceptRequest{
 		Serial:   aSerial,
 		MimeType: aMimeType,
@@ -18471,7 +19003,7 @@ var _ Request = &WlDataSourceSetActionsRequest{}
 // Used for feedback during drag-and-drop.
 type WlDataSourceTargetEvent struct {
 	// MimeType contains mime type accepted by the target
-	MimeType string
+	MimeType *string
 }
 
 // Opcode returns the event opcode for wl_data_source.target in wayland
@@ -18485,7 +19017,7 @@ var _ Message = WlDataSourceTargetEvent{}
 
 // Scan scans the event from the socket.
 func (e *WlDataSourceTargetEvent) Scan(s *EventScanner) error {
-	if v, err := s.String(); err != nil {
+	if v, err := s.NullableString(); err != nil {
 		return err
 	} else {
 		e.MimeType = v
@@ -18829,13 +19361,13 @@ const (
 // undefined, and the wl_surface is unmapped.
 type WlDataDeviceStartDragRequest struct {
 	// Source contains data source for the eventual transfer
-	Source ObjectID
+	Source *ObjectID
 
 	// Origin contains surface where the drag originates
 	Origin ObjectID
 
 	// Icon contains drag-and-drop icon surface
-	Icon ObjectID
+	Icon *ObjectID
 
 	// Serial contains serial number of the implicit grab on the origin
 	Serial uint32
@@ -18852,13 +19384,13 @@ var _ Message = WlDataDeviceStartDragRequest{}
 
 // Emit emits the message to the emitter.
 func (r *WlDataDeviceStartDragRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Source); err != nil {
+	if err := e.PutNullableObjectID(r.Source); err != nil {
 		return err
 	}
 	if err := e.PutObjectID(r.Origin); err != nil {
 		return err
 	}
-	if err := e.PutObjectID(r.Icon); err != nil {
+	if err := e.PutNullableObjectID(r.Icon); err != nil {
 		return err
 	}
 	if err := e.PutUint(r.Serial); err != nil {
@@ -18878,7 +19410,7 @@ var _ Request = &WlDataDeviceStartDragRequest{}
 // To unset the selection, set the source to NULL.
 type WlDataDeviceSetSelectionRequest struct {
 	// Source contains data source for the selection
-	Source ObjectID
+	Source *ObjectID
 
 	// Serial contains serial number of the event that triggered this request
 	Serial uint32
@@ -18895,7 +19427,7 @@ var _ Message = WlDataDeviceSetSelectionRequest{}
 
 // Emit emits the message to the emitter.
 func (r *WlDataDeviceSetSelectionRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Source); err != nil {
+	if err := e.PutNullableObjectID(r.Source); err != nil {
 		return err
 	}
 	if err := e.PutUint(r.Serial); err != nil {
@@ -18942,6 +19474,8 @@ var _ Request = &WlDataDeviceReleaseRequest{}
 type WlDataDeviceDataOfferEvent struct {
 	// ID contains the new data_offer object
 	ID ObjectID
+
+	proxyID *WlDataOffer
 }
 
 // Opcode returns the event opcode for wl_data_device.data_offer in wayland
@@ -18966,6 +19500,24 @@ func (e *WlDataDeviceDataOfferEvent) Scan(s *EventScanner) error {
 // Ensure WlDataDeviceDataOfferEvent implements Event.
 var _ Event = &WlDataDeviceDataOfferEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *WlDataDeviceDataOfferEvent) newProxies(version uint32) []Proxy {
+	e.proxyID = &WlDataOffer{e.ID, version}
+	return []Proxy{
+		e.proxyID,
+	}
+}
+
+// Ensure WlDataDeviceDataOfferEvent implements proxyCreator.
+var _ proxyCreator = &WlDataDeviceDataOfferEvent{}
+
+// IDProxy returns the proxy for ID, registered when the event
+// was read.
+func (e *WlDataDeviceDataOfferEvent) IDProxy() *WlDataOffer {
+	return e.proxyID
+}
+
 // WlDataDeviceEnterEvent signals when initiate drag-and-drop session
 //
 // This event is sent when an active drag-and-drop pointer enters
@@ -18986,7 +19538,7 @@ type WlDataDeviceEnterEvent struct {
 	Y Fixed
 
 	// ID contains source data_offer object
-	ID ObjectID
+	ID *ObjectID
 }
 
 // Opcode returns the event opcode for wl_data_device.enter in wayland
@@ -19020,7 +19572,7 @@ func (e *WlDataDeviceEnterEvent) Scan(s *EventScanner) error {
 	} else {
 		e.Y = v
 	}
-	if v, err := s.ObjectID(); err != nil {
+	if v, err := s.NullableObjectID(); err != nil {
 		return err
 	} else {
 		e.ID = v
@@ -19155,7 +19707,7 @@ var _ Event = &WlDataDeviceDropEvent{}
 // this event.
 type WlDataDeviceSelectionEvent struct {
 	// ID contains selection data_offer object
-	ID ObjectID
+	ID *ObjectID
 }
 
 // Opcode returns the event opcode for wl_data_device.selection in wayland
@@ -19169,7 +19721,7 @@ var _ Message = WlDataDeviceSelectionEvent{}
 
 // Scan scans the event from the socket.
 func (e *WlDataDeviceSelectionEvent) Scan(s *EventScanner) error {
-	if v, err := s.ObjectID(); err != nil {
+	if v, err := s.NullableObjectID(); err != nil {
 		return err
 	} else {
 		e.ID = v
@@ -19257,7 +19809,7 @@ func (WlDataDevice) Dispatch(opcode uint16) Event {
 // wl_surface is no longer used as the icon surface. When the use
 // as an icon ends, the current and pending input regions become
 // undefined, and the wl_surface is unmapped.
-func (proxy *WlDataDevice) StartDrag(connection Connection, aSource ObjectID, aOrigin ObjectID, aIcon ObjectID, aSerial uint32) (err error) {
+func (proxy *WlDataDevice) StartDrag(connection Connection, aSource *ObjectID, aOrigin ObjectID, aIcon *ObjectID, aSerial uint32) (err error) {
 	request := WlDataDeviceStartDragRequest{
 		Source: aSource,
 		Origin: aOrigin,
@@ -19274,7 +19826,7 @@ func (proxy *WlDataDevice) StartDrag(connection Connection, aSource ObjectID, aO
 // to the data from the source on behalf of the client.
 //
 // To unset the selection, set the source to NULL.
-func (proxy *WlDataDevice) SetSelection(connection Connection, aSource ObjectID, aSerial uint32) (err error) {
+func (proxy *WlDataDevice) SetSelection(connection Connection, aSource *ObjectID, aSerial uint32) (err error) {
 	request := WlDataDeviceSetSelectionRequest{
 		Source: aSource,
 		Serial: aSerial,
@@ -19893,7 +20445,7 @@ type WlShellSurfaceSetFullscreenRequest struct {
 	Framerate uint32
 
 	// Output contains output on which the surface is to be fullscreen
-	Output ObjectID
+	Output *ObjectID
 }
 
 // Opcode returns the request opcode for wl_shell_surface.set_fullscreen in wayland
@@ -19913,7 +20465,7 @@ func (r *WlShellSurfaceSetFullscreenRequest) Emit(e *RequestEmitter) error {
 	if err := e.PutUint(r.Framerate); err != nil {
 		return err
 	}
-	if err := e.PutObjectID(r.Output); err != nil {
+	if err := e.PutNullableObjectID(r.Output); err != nil {
 		return err
 	}
 	return nil
@@ -20020,7 +20572,7 @@ var _ Request = &WlShellSurfaceSetPopupRequest{}
 // The details depend on the compositor implementation.
 type WlShellSurfaceSetMaximizedRequest struct {
 	// Output contains output on which the surface is to be maximized
-	Output ObjectID
+	Output *ObjectID
 }
 
 // Opcode returns the request opcode for wl_shell_surface.set_maximized in wayland
@@ -20034,7 +20586,7 @@ var _ Message = WlShellSurfaceSetMaximizedRequest{}
 
 // Emit emits the message to the emitter.
 func (r *WlShellSurfaceSetMaximizedRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Output); err != nil {
+	if err := e.PutNullableObjectID(r.Output); err != nil {
 		return err
 	}
 	return nil
@@ -20386,7 +20938,7 @@ func (proxy *WlShellSurface) SetTransient(connection Connection, aParent ObjectI
 // The compositor must reply to this request with a configure event
 // with the dimensions for the output on which the surface will
 // be made fullscreen.
-func (proxy *WlShellSurface) SetFullscreen(connection Connection, aMethod uint32, aFramerate uint32, aOutput ObjectID) (err error) {
+func (proxy *WlShellSurface) SetFullscreen(connection Connection, aMethod uint32, aFramerate uint32, aOutput *ObjectID) (err error) {
 	request := WlShellSurfaceSetFullscreenRequest{
 		Method:    aMethod,
 		Framerate: aFramerate,
@@ -20450,7 +21002,7 @@ func (proxy *WlShellSurface) SetPopup(connection Connection, aSeat ObjectID, aSe
 // fullscreen shell surface.
 //
 // The details depend on the compositor implementation.
-func (proxy *WlShellSurface) SetMaximized(connection Connection, aOutput ObjectID) (err error) {
+func (proxy *WlShellSurface) SetMaximized(connection Connection, aOutput *ObjectID) (err error) {
 	request := WlShellSurfaceSetMaximizedRequest{
 		Output: aOutput,
 	}
@@ -20591,7 +21143,7 @@ var _ Request = &WlSurfaceDestroyRequest{}
 // following wl_surface.commit will remove the surface content.
 type WlSurfaceAttachRequest struct {
 	// Buffer contains buffer of surface contents
-	Buffer ObjectID
+	Buffer *ObjectID
 
 	// X contains surface-local x coordinate
 	X int32
@@ -20611,7 +21163,7 @@ var _ Message = WlSurfaceAttachRequest{}
 
 // Emit emits the message to the emitter.
 func (r *WlSurfaceAttachRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Buffer); err != nil {
+	if err := e.PutNullableObjectID(r.Buffer); err != nil {
 		return err
 	}
 	if err := e.PutInt(r.X); err != nil {
@@ -20779,7 +21331,7 @@ var _ Request = &WlSurfaceFrameRequest{}
 // region to be set to empty.
 type WlSurfaceSetOpaqueRegionRequest struct {
 	// Region contains opaque region of the surface
-	Region ObjectID
+	Region *ObjectID
 }
 
 // Opcode returns the request opcode for wl_surface.set_opaque_region in wayland
@@ -20793,7 +21345,7 @@ var _ Message = WlSurfaceSetOpaqueRegionRequest{}
 
 // Emit emits the message to the emitter.
 func (r *WlSurfaceSetOpaqueRegionRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Region); err != nil {
+	if err := e.PutNullableObjectID(r.Region); err != nil {
 		return err
 	}
 	return nil
@@ -20828,7 +21380,7 @@ var _ Request = &WlSurfaceSetOpaqueRegionRequest{}
 // to infinite.
 type WlSurfaceSetInputRegionRequest struct {
 	// Region contains input region of the surface
-	Region ObjectID
+	Region *ObjectID
 }
 
 // Opcode returns the request opcode for wl_surface.set_input_region in wayland
@@ -20842,7 +21394,7 @@ var _ Message = WlSurfaceSetInputRegionRequest{}
 
 // Emit emits the message to the emitter.
 func (r *WlSurfaceSetInputRegionRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Region); err != nil {
+	if err := e.PutNullableObjectID(r.Region); err != nil {
 		return err
 	}
 	return nil
@@ -21280,7 +21832,7 @@ func (proxy *WlSurface) Destroy(connection Connection) (err error) {
 //
 // If wl_surface.attach is sent with a NULL wl_buffer, the
 // following wl_surface.commit will remove the surface content.
-func (proxy *WlSurface) Attach(connection Connection, aBuffer ObjectID, aX int32, aY int32) (err error) {
+func (proxy *WlSurface) Attach(connection Connection, aBuffer *ObjectID, aX int32, aY int32) (err error) {
 	request := WlSurfaceAttachRequest{
 		Buffer: aBuffer,
 		X:      aX,
@@ -21396,7 +21948,7 @@ func (proxy *WlSurface) Frame(connection Connection) (aCallback *WlCallback, err
 // opaque region has copy semantics, and the wl_region object can be
 // destroyed immediately. A NULL wl_region causes the pending opaque
 // region to be set to empty.
-func (proxy *WlSurface) SetOpaqueRegion(connection Connection, aRegion ObjectID) (err error) {
+func (proxy *WlSurface) SetOpaqueRegion(connection Connection, aRegion *ObjectID) (err error) {
 	request := WlSurfaceSetOpaqueRegionRequest{
 		Region: aRegion,
 	}
@@ -21428,7 +21980,7 @@ func (proxy *WlSurface) SetOpaqueRegion(connection Connection, aRegion ObjectID)
 // has copy semantics, and the wl_region object can be destroyed
 // immediately. A NULL wl_region causes the input region to be set
 // to infinite.
-func (proxy *WlSurface) SetInputRegion(connection Connection, aRegion ObjectID) (err error) {
+func (proxy *WlSurface) SetInputRegion(connection Connection, aRegion *ObjectID) (err error) {
 	request := WlSurfaceSetInputRegionRequest{
 		Region: aRegion,
 	}
@@ -22072,7 +22624,7 @@ type WlPointerSetCursorRequest struct {
 	Serial uint32
 
 	// Surface contains pointer surface
-	Surface ObjectID
+	Surface *ObjectID
 
 	// HotspotX contains surface-local x coordinate
 	HotspotX int32
@@ -22095,7 +22647,7 @@ func (r *WlPointerSetCursorRequest) Emit(e *RequestEmitter) error {
 	if err := e.PutUint(r.Serial); err != nil {
 		return err
 	}
-	if err := e.PutObjectID(r.Surface); err != nil {
+	if err := e.PutNullableObjectID(r.Surface); err != nil {
 		return err
 	}
 	if err := e.PutInt(r.HotspotX); err != nil {
@@ -22731,7 +23283,7 @@ func (WlPointer) Dispatch(opcode uint16) Event {
 // The serial parameter must match the latest wl_pointer.enter
 // serial number sent to the client. Otherwise the request will be
 // ignored.
-func (proxy *WlPointer) SetCursor(connection Connection, aSerial uint32, aSurface ObjectID, aHotspotX int32, aHotspotY int32) (err error) {
+func (proxy *WlPointer) SetCursor(connection Connection, aSerial uint32, aSurface *ObjectID, aHotspotX int32, aHotspotY int32) (err error) {
 	request := WlPointerSetCursorRequest{
 		Serial:   aSerial,
 		Surface:  aSurface,
@@ -25063,7 +25615,7 @@ var _ Proxy = &ZwpPrimarySelectionDeviceManagerV1{}
 //
 // To unset the selection, set the source to NULL.
 type ZwpPrimarySelectionDeviceV1SetSelectionRequest struct {
-	Source ObjectID
+	Source *ObjectID
 
 	// Serial contains serial of the event that triggered this request
 	Serial uint32
@@ -25080,7 +25632,7 @@ var _ Message = ZwpPrimarySelectionDeviceV1SetSelectionRequest{}
 
 // Emit emits the message to the emitter.
 func (r *ZwpPrimarySelectionDeviceV1SetSelectionRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Source); err != nil {
+	if err := e.PutNullableObjectID(r.Source); err != nil {
 		return err
 	}
 	if err := e.PutUint(r.Serial); err != nil {
@@ -25124,6 +25676,8 @@ var _ Request = &ZwpPrimarySelectionDeviceV1DestroyRequest{}
 // types.
 type ZwpPrimarySelectionDeviceV1DataOfferEvent struct {
 	Offer ObjectID
+
+	proxyOffer *ZwpPrimarySelectionOfferV1
 }
 
 // Opcode returns the event opcode for zwp_primary_selection_device_v1.data_offer in wp_primary_selection_unstable_v1
@@ -25148,6 +25702,24 @@ func (e *ZwpPrimarySelectionDeviceV1DataOfferEvent) Scan(s *EventScanner) error
 // Ensure ZwpPrimarySelectionDeviceV1DataOfferEvent implements Event.
 var _ Event = &ZwpPrimarySelectionDeviceV1DataOfferEvent{}
 
+// newProxies creates proxies for the objects created by the event, with the
+// version of the object that sent it.
+func (e *ZwpPrimarySelectionDeviceV1DataOfferEvent) newProxies(version uint32) []Proxy {
+	e.proxyOffer = &ZwpPrimarySelectionOfferV1{e.Offer, version}
+	return []Proxy{
+		e.proxyOffer,
+	}
+}
+
+// Ensure ZwpPrimarySelectionDeviceV1DataOfferEvent implements proxyCreator.
+var _ proxyCreator = &ZwpPrimarySelectionDeviceV1DataOfferEvent{}
+
+// OfferProxy returns the proxy for Offer, registered when the event
+// was read.
+func (e *ZwpPrimarySelectionDeviceV1DataOfferEvent) OfferProxy() *ZwpPrimarySelectionOfferV1 {
+	return e.proxyOffer
+}
+
 // ZwpPrimarySelectionDeviceV1SelectionEvent signals when advertise a new primary selection
 //
 // The wp_primary_selection_device.selection event is sent to notify the
@@ -25160,7 +25732,7 @@ var _ Event = &ZwpPrimarySelectionDeviceV1DataOfferEvent{}
 // or until the client loses keyboard focus. The client must destroy the
 // previous selection data_offer, if any, upon receiving this event.
 type ZwpPrimarySelectionDeviceV1SelectionEvent struct {
-	ID ObjectID
+	ID *ObjectID
 }
 
 // Opcode returns the event opcode for zwp_primary_selection_device_v1.selection in wp_primary_selection_unstable_v1
@@ -25174,7 +25746,7 @@ var _ Message = ZwpPrimarySelectionDeviceV1SelectionEvent{}
 
 // Scan scans the event from the socket.
 func (e *ZwpPrimarySelectionDeviceV1SelectionEvent) Scan(s *EventScanner) error {
-	if v, err := s.ObjectID(); err != nil {
+	if v, err := s.NullableObjectID(); err != nil {
 		return err
 	} else {
 		e.ID = v
@@ -25223,7 +25795,7 @@ func (ZwpPrimarySelectionDeviceV1) Dispatch(opcode uint16) Event {
 // selection will receive a wp_primary_selection_source.cancelled event.
 //
 // To unset the selection, set the source to NULL.
-func (proxy *ZwpPrimarySelectionDeviceV1) SetSelection(connection Connection, aSource ObjectID, aSerial uint32) (err error) {
+func (proxy *ZwpPrimarySelectionDeviceV1) SetSelection(connection Connection, aSource *ObjectID, aSerial uint32) (err error) {
 	request := ZwpPrimarySelectionDeviceV1SetSelectionRequest{
 		Source: aSource,
 		Serial: aSerial,
@@ -29140,7 +29712,7 @@ var _ Request = &XdgSurfaceGetToplevelRequest{}
 type XdgSurfaceGetPopupRequest struct {
 	ID ObjectID
 
-	Parent ObjectID
+	Parent *ObjectID
 
 	Positioner ObjectID
 }
@@ -29159,7 +29731,7 @@ func (r *XdgSurfaceGetPopupRequest) Emit(e *RequestEmitter) error {
 	if err := e.PutObjectID(r.ID); err != nil {
 		return err
 	}
-	if err := e.PutObjectID(r.Parent); err != nil {
+	if err := e.PutNullableObjectID(r.Parent); err != nil {
 		return err
 	}
 	if err := e.PutObjectID(r.Positioner); err != nil {
@@ -29447,7 +30019,7 @@ func (proxy *XdgSurface) GetToplevel(connection Connection) (aID *XdgToplevel, e
 //
 // See the documentation of xdg_popup for more details about what an
 // xdg_popup is and how it is used.
-func (proxy *XdgSurface) GetPopup(connection Connection, aParent ObjectID, aPositioner ObjectID) (aID *XdgPopup, err error) {
+func (proxy *XdgSurface) GetPopup(connection Connection, aParent *ObjectID, aPositioner ObjectID) (aID *XdgPopup, err error) {
 	aID = &XdgPopup{connection.NewID(), proxy.version}
 	request := XdgSurfaceGetPopupRequest{
 		ID:         aID.id,
@@ -29642,7 +30214,7 @@ var _ Request = &XdgToplevelDestroyRequest{}
 // parent then the children are managed as though they have no
 // parent surface.
 type XdgToplevelSetParentRequest struct {
-	Parent ObjectID
+	Parent *ObjectID
 }
 
 // Opcode returns the request opcode for xdg_toplevel.set_parent in xdg_shell
@@ -29656,7 +30228,7 @@ var _ Message = XdgToplevelSetParentRequest{}
 
 // Emit emits the message to the emitter.
 func (r *XdgToplevelSetParentRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Parent); err != nil {
+	if err := e.PutNullableObjectID(r.Parent); err != nil {
 		return err
 	}
 	return nil
@@ -30161,7 +30733,7 @@ var _ Request = &XdgToplevelUnsetMaximizedRequest{}
 // up of subsurfaces, popups or similarly coupled surfaces) are not
 // visible below the fullscreened surface.
 type XdgToplevelSetFullscreenRequest struct {
-	Output ObjectID
+	Output *ObjectID
 }
 
 // Opcode returns the request opcode for xdg_toplevel.set_fullscreen in xdg_shell
@@ -30175,7 +30747,7 @@ var _ Message = XdgToplevelSetFullscreenRequest{}
 
 // Emit emits the message to the emitter.
 func (r *XdgToplevelSetFullscreenRequest) Emit(e *RequestEmitter) error {
-	if err := e.PutObjectID(r.Output); err != nil {
+	if err := e.PutNullableObjectID(r.Output); err != nil {
 		return err
 	}
 	return nil
@@ -30423,7 +30995,7 @@ func (proxy *XdgToplevel) Destroy(connection Connection) (err error) {
 // parent of this surface. If no parent exists for the now-unmapped
 // parent then the children are managed as though they have no
 // parent surface.
-func (proxy *XdgToplevel) SetParent(connection Connection, aParent ObjectID) (err error) {
+func (proxy *XdgToplevel) SetParent(connection Connection, aParent *ObjectID) (err error) {
 	request := XdgToplevelSetParentRequest{
 		Parent: aParent,
 	}
@@ -30745,7 +31317,7 @@ func (proxy *XdgToplevel) UnsetMaximized(connection Connection) (err error) {
 // sure that other screen content not part of the same surface tree (made
 // up of subsurfaces, popups or similarly coupled surfaces) are not
 // visible below the fullscreened surface.
-func (proxy *XdgToplevel) SetFullscreen(connection Connection, aOutput ObjectID) (err error) {
+func (proxy *XdgToplevel) SetFullscreen(connection Connection, aOutput *ObjectID) (err error) {
 	request := XdgToplevelSetFullscreenRequest{
 		Output: aOutput,
 	}
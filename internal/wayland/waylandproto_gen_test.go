@@ -0,0 +1,13118 @@
+// THIS FILE IS GENERATED BY WAYGEN - DO NOT EDIT
+//
+// Generated from the following protocols:
+//	drm_lease_v1 (drm-lease-v1.xml)
+//	fractional_scale_v1 (fractional-scale-v1.xml)
+//	fullscreen_shell_unstable_v1 (fullscreen-shell-unstable-v1.xml)
+//	idle_inhibit_unstable_v1 (idle-inhibit-unstable-v1.xml)
+//	input_method_unstable_v1 (input-method-unstable-v1.xml)
+//	input_timestamps_unstable_v1 (input-timestamps-unstable-v1.xml)
+//	keyboard_shortcuts_inhibit_unstable_v1 (keyboard-shortcuts-inhibit-unstable-v1.xml)
+//	linux_dmabuf_unstable_v1 (linux-dmabuf-unstable-v1.xml)
+//	pointer_constraints_unstable_v1 (pointer-constraints-unstable-v1.xml)
+//	pointer_gestures_unstable_v1 (pointer-gestures-unstable-v1.xml)
+//	presentation_time (presentation-time.xml)
+//	relative_pointer_unstable_v1 (relative-pointer-unstable-v1.xml)
+//	tablet_unstable_v1 (tablet-unstable-v1.xml)
+//	tablet_unstable_v2 (tablet-unstable-v2.xml)
+//	text_input_unstable_v1 (text-input-unstable-v1.xml)
+//	text_input_unstable_v3 (text-input-unstable-v3.xml)
+//	viewporter (viewporter.xml)
+//	wayland (wayland.xml)
+//	wp_primary_selection_unstable_v1 (primary-selection-unstable-v1.xml)
+//	xdg_activation_v1 (xdg-activation-v1.xml)
+//	xdg_decoration_unstable_v1 (xdg-decoration-unstable-v1.xml)
+//	xdg_foreign_unstable_v1 (xdg-foreign-unstable-v1.xml)
+//	xdg_foreign_unstable_v2 (xdg-foreign-unstable-v2.xml)
+//	xdg_output_unstable_v1 (xdg-output-unstable-v1.xml)
+//	xdg_shell (xdg-shell.xml)
+//	xwayland_keyboard_grab_unstable_v1 (xwayland-keyboard-grab-unstable-v1.xml)
+//	zwp_linux_explicit_synchronization_unstable_v1 (linux-explicit-synchronization-unstable-v1.xml)
+//
+// Input hash: sha256:8120a2e7f4556d5633deceb1ce5fcfa1a7c1dd93ce2c4f3bb311ff17388fcd22
+
+package wayland
+
+import (
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"reflect"
+	"syscall"
+	"testing"
+)
+
+// roundTripEvent adapts an event into a request, so that it can be written
+// to a socket. Events and requests share the same wire encoding.
+type roundTripEvent struct {
+	Event
+	emit func(e *RequestEmitter) error
+}
+
+func (r roundTripEvent) Emit(e *RequestEmitter) error {
+	return r.emit(e)
+}
+
+// roundTripSocketpair returns both ends of a connected UNIX socket pair.
+func roundTripSocketpair(t *testing.T) (*net.UnixConn, *net.UnixConn) {
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM|syscall.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("creating socketpair: %v", err)
+	}
+
+	conns := [2]*net.UnixConn{}
+	for i, fd := range fds {
+		f := os.NewFile(uintptr(fd), "socketpair")
+		conn, err := net.FileConn(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("creating socketpair conn: %v", err)
+		}
+		conns[i] = conn.(*net.UnixConn)
+		t.Cleanup(func() { conn.Close() })
+	}
+
+	return conns[0], conns[1]
+}
+
+// roundTrip writes a message to one end of a socket pair and returns a
+// scanner for the message read back from the other end.
+func roundTrip(t *testing.T, request Request) *EventScanner {
+	a, b := roundTripSocketpair(t)
+
+	if err := WriteRequest(a, 1, request); err != nil {
+		t.Fatalf("writing %s: %v", request.MessageName(), err)
+	}
+
+	s, err := ReadEvent(b)
+	if err != nil {
+		t.Fatalf("reading %s: %v", request.MessageName(), err)
+	}
+
+	if s.header.ObjectID != 1 {
+		t.Errorf("object id: want 1, got %d", s.header.ObjectID)
+	}
+	if s.header.Opcode != request.Opcode() {
+		t.Errorf("opcode: want %d, got %d", request.Opcode(), s.header.Opcode)
+	}
+	if s.header.Size%4 != 0 {
+		t.Errorf("size %d is not a multiple of 4", s.header.Size)
+	}
+
+	return s
+}
+
+// roundTripDone checks that the scanner has consumed the entire message.
+func roundTripDone(t *testing.T, s *EventScanner) {
+	if n, err := s.reader.Read(make([]byte, 1)); n != 0 || err != io.EOF {
+		t.Errorf("message not fully consumed (n=%d, err=%v)", n, err)
+	}
+}
+
+func roundTripObjectID(r *rand.Rand) ObjectID {
+	return ObjectID(r.Uint32()>>1 + 1)
+}
+
+func roundTripNullableObjectID(r *rand.Rand) *ObjectID {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+	v := roundTripObjectID(r)
+	return &v
+}
+
+func roundTripString(r *rand.Rand) string {
+	b := make([]rune, r.Intn(32))
+	for i := range b {
+		b[i] = rune(' ' + r.Intn(0x3000))
+	}
+	return string(b)
+}
+
+func roundTripNullableString(r *rand.Rand) *string {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+	v := roundTripString(r)
+	return &v
+}
+
+func roundTripArray(r *rand.Rand) []byte {
+	b := make([]byte, r.Intn(32))
+	r.Read(b)
+	return b
+}
+
+// roundTripFD returns the read end of a new pipe, which is closed when the
+// test finishes.
+func roundTripFD(t *testing.T) FD {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("creating pipe: %v", err)
+	}
+	t.Cleanup(func() {
+		r.Close()
+		w.Close()
+	})
+	return FD(r.Fd())
+}
+
+// roundTripCheckFD checks that a received file descriptor refers to the same
+// file as the sent one, then closes the received file descriptor and replaces
+// it with the sent one so that the messages can be compared.
+func roundTripCheckFD(t *testing.T, want FD, got *FD) {
+	var wantStat, gotStat syscall.Stat_t
+	if err := syscall.Fstat(int(want), &wantStat); err != nil {
+		t.Fatalf("stat sent fd: %v", err)
+	}
+	if err := syscall.Fstat(int(*got), &gotStat); err != nil {
+		t.Fatalf("stat received fd: %v", err)
+	}
+	if wantStat.Dev != gotStat.Dev || wantStat.Ino != gotStat.Ino {
+		t.Errorf("received fd does not refer to the sent file")
+	}
+	syscall.Close(int(*got))
+	*got = want
+}
+
+func TestWpDrmLeaseDeviceV1CreateLeaseRequestRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2011409476))
+	_ = r
+
+	want := WpDrmLeaseDeviceV1CreateLeaseRequestRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpDrmLeaseDeviceV1CreateLeaseRequestRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseDeviceV1ReleaseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3134885350))
+	_ = r
+
+	want := WpDrmLeaseDeviceV1ReleaseRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpDrmLeaseDeviceV1ReleaseRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseDeviceV1DrmFDEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3278719129))
+	_ = r
+
+	want := WpDrmLeaseDeviceV1DrmFDEvent{
+		FD: roundTripFD(t),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutFD(want.FD); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WpDrmLeaseDeviceV1DrmFDEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.FD, &got.FD)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseDeviceV1ConnectorEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1923629677))
+	_ = r
+
+	want := WpDrmLeaseDeviceV1ConnectorEvent{
+		ID: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WpDrmLeaseDeviceV1ConnectorEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseDeviceV1DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1886607636))
+	_ = r
+
+	want := WpDrmLeaseDeviceV1DoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WpDrmLeaseDeviceV1DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseDeviceV1ReleasedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(527369293))
+	_ = r
+
+	want := WpDrmLeaseDeviceV1ReleasedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WpDrmLeaseDeviceV1ReleasedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseConnectorV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2478460292))
+	_ = r
+
+	want := WpDrmLeaseConnectorV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpDrmLeaseConnectorV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseConnectorV1NameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1812789008))
+	_ = r
+
+	want := WpDrmLeaseConnectorV1NameEvent{
+		Name: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Name); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WpDrmLeaseConnectorV1NameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseConnectorV1DescriptionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3392994325))
+	_ = r
+
+	want := WpDrmLeaseConnectorV1DescriptionEvent{
+		Description: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Description); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WpDrmLeaseConnectorV1DescriptionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseConnectorV1ConnectorIDEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3374709601))
+	_ = r
+
+	want := WpDrmLeaseConnectorV1ConnectorIDEvent{
+		ConnectorID: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.ConnectorID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WpDrmLeaseConnectorV1ConnectorIDEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseConnectorV1DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(528247981))
+	_ = r
+
+	want := WpDrmLeaseConnectorV1DoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WpDrmLeaseConnectorV1DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseConnectorV1WithdrawnEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1158130831))
+	_ = r
+
+	want := WpDrmLeaseConnectorV1WithdrawnEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WpDrmLeaseConnectorV1WithdrawnEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseRequestV1RequestConnectorRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(470282250))
+	_ = r
+
+	want := WpDrmLeaseRequestV1RequestConnectorRequest{
+		Connector: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpDrmLeaseRequestV1RequestConnectorRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Connector: %v", err)
+	} else {
+		got.Connector = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseRequestV1SubmitRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4217363922))
+	_ = r
+
+	want := WpDrmLeaseRequestV1SubmitRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpDrmLeaseRequestV1SubmitRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(873035845))
+	_ = r
+
+	want := WpDrmLeaseV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpDrmLeaseV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseV1LeaseFDEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(197066030))
+	_ = r
+
+	want := WpDrmLeaseV1LeaseFDEvent{
+		LeasedFD: roundTripFD(t),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutFD(want.LeasedFD); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WpDrmLeaseV1LeaseFDEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.LeasedFD, &got.LeasedFD)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpDrmLeaseV1FinishedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4053214364))
+	_ = r
+
+	want := WpDrmLeaseV1FinishedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WpDrmLeaseV1FinishedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpFractionalScaleManagerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(445561014))
+	_ = r
+
+	want := WpFractionalScaleManagerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpFractionalScaleManagerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpFractionalScaleManagerV1GetFractionalScaleRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2991415535))
+	_ = r
+
+	want := WpFractionalScaleManagerV1GetFractionalScaleRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpFractionalScaleManagerV1GetFractionalScaleRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpFractionalScaleV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(955449683))
+	_ = r
+
+	want := WpFractionalScaleV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpFractionalScaleV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpFractionalScaleV1PreferredScaleEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1413057263))
+	_ = r
+
+	want := WpFractionalScaleV1PreferredScaleEvent{
+		Scale: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Scale); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WpFractionalScaleV1PreferredScaleEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpFullscreenShellV1ReleaseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1595108932))
+	_ = r
+
+	want := ZwpFullscreenShellV1ReleaseRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpFullscreenShellV1ReleaseRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpFullscreenShellV1PresentSurfaceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1979661525))
+	_ = r
+
+	want := ZwpFullscreenShellV1PresentSurfaceRequest{
+		Surface: roundTripNullableObjectID(r),
+		Method:  r.Uint32(),
+		Output:  roundTripNullableObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpFullscreenShellV1PresentSurfaceRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Method: %v", err)
+	} else {
+		got.Method = v
+	}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Output: %v", err)
+	} else {
+		got.Output = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpFullscreenShellV1PresentSurfaceForModeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2363560405))
+	_ = r
+
+	want := ZwpFullscreenShellV1PresentSurfaceForModeRequest{
+		Surface:   roundTripObjectID(r),
+		Output:    roundTripObjectID(r),
+		Framerate: int32(r.Uint32()),
+		Feedback:  roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpFullscreenShellV1PresentSurfaceForModeRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Output: %v", err)
+	} else {
+		got.Output = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Framerate: %v", err)
+	} else {
+		got.Framerate = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Feedback: %v", err)
+	} else {
+		got.Feedback = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpFullscreenShellV1CapabilityEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2525012152))
+	_ = r
+
+	want := ZwpFullscreenShellV1CapabilityEvent{
+		Capability: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Capability); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpFullscreenShellV1CapabilityEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpFullscreenShellModeFeedbackV1ModeSuccessfulEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(86072893))
+	_ = r
+
+	want := ZwpFullscreenShellModeFeedbackV1ModeSuccessfulEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpFullscreenShellModeFeedbackV1ModeSuccessfulEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpFullscreenShellModeFeedbackV1ModeFailedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2529866056))
+	_ = r
+
+	want := ZwpFullscreenShellModeFeedbackV1ModeFailedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpFullscreenShellModeFeedbackV1ModeFailedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpFullscreenShellModeFeedbackV1PresentCancelledEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(848534020))
+	_ = r
+
+	want := ZwpFullscreenShellModeFeedbackV1PresentCancelledEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpFullscreenShellModeFeedbackV1PresentCancelledEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpIdleInhibitManagerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3212672712))
+	_ = r
+
+	want := ZwpIdleInhibitManagerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpIdleInhibitManagerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpIdleInhibitManagerV1CreateInhibitorRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(423462480))
+	_ = r
+
+	want := ZwpIdleInhibitManagerV1CreateInhibitorRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpIdleInhibitManagerV1CreateInhibitorRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpIdleInhibitorV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3229321048))
+	_ = r
+
+	want := ZwpIdleInhibitorV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpIdleInhibitorV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1009588528))
+	_ = r
+
+	want := ZwpInputMethodContextV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1CommitStringRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3146036984))
+	_ = r
+
+	want := ZwpInputMethodContextV1CommitStringRequest{
+		Serial: r.Uint32(),
+		Text:   roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1CommitStringRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Text: %v", err)
+	} else {
+		got.Text = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1PreeditStringRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3524656442))
+	_ = r
+
+	want := ZwpInputMethodContextV1PreeditStringRequest{
+		Serial: r.Uint32(),
+		Text:   roundTripString(r),
+		Commit: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1PreeditStringRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Text: %v", err)
+	} else {
+		got.Text = v
+	}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Commit: %v", err)
+	} else {
+		got.Commit = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1PreeditStylingRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3692372015))
+	_ = r
+
+	want := ZwpInputMethodContextV1PreeditStylingRequest{
+		Index:  r.Uint32(),
+		Length: r.Uint32(),
+		Style:  r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1PreeditStylingRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Index: %v", err)
+	} else {
+		got.Index = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Length: %v", err)
+	} else {
+		got.Length = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Style: %v", err)
+	} else {
+		got.Style = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1PreeditCursorRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2702356275))
+	_ = r
+
+	want := ZwpInputMethodContextV1PreeditCursorRequest{
+		Index: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1PreeditCursorRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Index: %v", err)
+	} else {
+		got.Index = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1DeleteSurroundingTextRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1593876630))
+	_ = r
+
+	want := ZwpInputMethodContextV1DeleteSurroundingTextRequest{
+		Index:  int32(r.Uint32()),
+		Length: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1DeleteSurroundingTextRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Index: %v", err)
+	} else {
+		got.Index = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Length: %v", err)
+	} else {
+		got.Length = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1CursorPositionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2521060159))
+	_ = r
+
+	want := ZwpInputMethodContextV1CursorPositionRequest{
+		Index:  int32(r.Uint32()),
+		Anchor: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1CursorPositionRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Index: %v", err)
+	} else {
+		got.Index = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Anchor: %v", err)
+	} else {
+		got.Anchor = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1ModifiersMapRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1935135536))
+	_ = r
+
+	want := ZwpInputMethodContextV1ModifiersMapRequest{
+		Map: roundTripArray(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1ModifiersMapRequest{}
+	if v, err := s.Array(); err != nil {
+		t.Fatalf("scanning Map: %v", err)
+	} else {
+		got.Map = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1KeysymRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(491302426))
+	_ = r
+
+	want := ZwpInputMethodContextV1KeysymRequest{
+		Serial:    r.Uint32(),
+		Time:      r.Uint32(),
+		Sym:       r.Uint32(),
+		State:     r.Uint32(),
+		Modifiers: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1KeysymRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Time: %v", err)
+	} else {
+		got.Time = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Sym: %v", err)
+	} else {
+		got.Sym = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning State: %v", err)
+	} else {
+		got.State = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Modifiers: %v", err)
+	} else {
+		got.Modifiers = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1GrabKeyboardRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3866298293))
+	_ = r
+
+	want := ZwpInputMethodContextV1GrabKeyboardRequest{
+		Keyboard: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1GrabKeyboardRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Keyboard: %v", err)
+	} else {
+		got.Keyboard = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1KeyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1217306987))
+	_ = r
+
+	want := ZwpInputMethodContextV1KeyRequest{
+		Serial: r.Uint32(),
+		Time:   r.Uint32(),
+		Key:    r.Uint32(),
+		State:  r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1KeyRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Time: %v", err)
+	} else {
+		got.Time = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Key: %v", err)
+	} else {
+		got.Key = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning State: %v", err)
+	} else {
+		got.State = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1ModifiersRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(81552272))
+	_ = r
+
+	want := ZwpInputMethodContextV1ModifiersRequest{
+		Serial:        r.Uint32(),
+		ModsDepressed: r.Uint32(),
+		ModsLatched:   r.Uint32(),
+		ModsLocked:    r.Uint32(),
+		Group:         r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1ModifiersRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning ModsDepressed: %v", err)
+	} else {
+		got.ModsDepressed = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning ModsLatched: %v", err)
+	} else {
+		got.ModsLatched = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning ModsLocked: %v", err)
+	} else {
+		got.ModsLocked = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Group: %v", err)
+	} else {
+		got.Group = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1LanguageRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3257598486))
+	_ = r
+
+	want := ZwpInputMethodContextV1LanguageRequest{
+		Serial:   r.Uint32(),
+		Language: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1LanguageRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Language: %v", err)
+	} else {
+		got.Language = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1TextDirectionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1558641822))
+	_ = r
+
+	want := ZwpInputMethodContextV1TextDirectionRequest{
+		Serial:    r.Uint32(),
+		Direction: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputMethodContextV1TextDirectionRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Direction: %v", err)
+	} else {
+		got.Direction = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1SurroundingTextEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3525864728))
+	_ = r
+
+	want := ZwpInputMethodContextV1SurroundingTextEvent{
+		Text:   roundTripString(r),
+		Cursor: r.Uint32(),
+		Anchor: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Text); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Cursor); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Anchor); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpInputMethodContextV1SurroundingTextEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1ResetEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(530257658))
+	_ = r
+
+	want := ZwpInputMethodContextV1ResetEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpInputMethodContextV1ResetEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1ContentTypeEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3441597608))
+	_ = r
+
+	want := ZwpInputMethodContextV1ContentTypeEvent{
+		Hint:    r.Uint32(),
+		Purpose: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Hint); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Purpose); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpInputMethodContextV1ContentTypeEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1InvokeActionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3476900623))
+	_ = r
+
+	want := ZwpInputMethodContextV1InvokeActionEvent{
+		Button: r.Uint32(),
+		Index:  r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Button); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Index); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpInputMethodContextV1InvokeActionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1CommitStateEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3207325177))
+	_ = r
+
+	want := ZwpInputMethodContextV1CommitStateEvent{
+		Serial: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpInputMethodContextV1CommitStateEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodContextV1PreferredLanguageEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2047004730))
+	_ = r
+
+	want := ZwpInputMethodContextV1PreferredLanguageEvent{
+		Language: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Language); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpInputMethodContextV1PreferredLanguageEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodV1ActivateEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3393024937))
+	_ = r
+
+	want := ZwpInputMethodV1ActivateEvent{
+		ID: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpInputMethodV1ActivateEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputMethodV1DeactivateEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1627083792))
+	_ = r
+
+	want := ZwpInputMethodV1DeactivateEvent{
+		Context: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Context); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpInputMethodV1DeactivateEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputPanelV1GetInputPanelSurfaceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3110441069))
+	_ = r
+
+	want := ZwpInputPanelV1GetInputPanelSurfaceRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputPanelV1GetInputPanelSurfaceRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputPanelSurfaceV1SetToplevelRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3219454326))
+	_ = r
+
+	want := ZwpInputPanelSurfaceV1SetToplevelRequest{
+		Output:   roundTripObjectID(r),
+		Position: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputPanelSurfaceV1SetToplevelRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Output: %v", err)
+	} else {
+		got.Output = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Position: %v", err)
+	} else {
+		got.Position = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputPanelSurfaceV1SetOverlayPanelRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(473694381))
+	_ = r
+
+	want := ZwpInputPanelSurfaceV1SetOverlayPanelRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputPanelSurfaceV1SetOverlayPanelRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputTimestampsManagerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2970271844))
+	_ = r
+
+	want := ZwpInputTimestampsManagerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputTimestampsManagerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputTimestampsManagerV1GetKeyboardTimestampsRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3620396426))
+	_ = r
+
+	want := ZwpInputTimestampsManagerV1GetKeyboardTimestampsRequest{
+		ID:       roundTripObjectID(r),
+		Keyboard: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputTimestampsManagerV1GetKeyboardTimestampsRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Keyboard: %v", err)
+	} else {
+		got.Keyboard = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputTimestampsManagerV1GetPointerTimestampsRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4124088004))
+	_ = r
+
+	want := ZwpInputTimestampsManagerV1GetPointerTimestampsRequest{
+		ID:      roundTripObjectID(r),
+		Pointer: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputTimestampsManagerV1GetPointerTimestampsRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Pointer: %v", err)
+	} else {
+		got.Pointer = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputTimestampsManagerV1GetTouchTimestampsRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4219400206))
+	_ = r
+
+	want := ZwpInputTimestampsManagerV1GetTouchTimestampsRequest{
+		ID:    roundTripObjectID(r),
+		Touch: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputTimestampsManagerV1GetTouchTimestampsRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Touch: %v", err)
+	} else {
+		got.Touch = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputTimestampsV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(235943409))
+	_ = r
+
+	want := ZwpInputTimestampsV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpInputTimestampsV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpInputTimestampsV1TimestampEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2414474562))
+	_ = r
+
+	want := ZwpInputTimestampsV1TimestampEvent{
+		TvSecHi: r.Uint32(),
+		TvSecLo: r.Uint32(),
+		TvNsec:  r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.TvSecHi); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.TvSecLo); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.TvNsec); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpInputTimestampsV1TimestampEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpKeyboardShortcutsInhibitManagerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2591427354))
+	_ = r
+
+	want := ZwpKeyboardShortcutsInhibitManagerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpKeyboardShortcutsInhibitManagerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpKeyboardShortcutsInhibitManagerV1InhibitShortcutsRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4210473424))
+	_ = r
+
+	want := ZwpKeyboardShortcutsInhibitManagerV1InhibitShortcutsRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+		Seat:    roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpKeyboardShortcutsInhibitManagerV1InhibitShortcutsRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpKeyboardShortcutsInhibitorV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3583945010))
+	_ = r
+
+	want := ZwpKeyboardShortcutsInhibitorV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpKeyboardShortcutsInhibitorV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpKeyboardShortcutsInhibitorV1ActiveEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1884738861))
+	_ = r
+
+	want := ZwpKeyboardShortcutsInhibitorV1ActiveEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpKeyboardShortcutsInhibitorV1ActiveEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpKeyboardShortcutsInhibitorV1InactiveEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3441495584))
+	_ = r
+
+	want := ZwpKeyboardShortcutsInhibitorV1InactiveEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpKeyboardShortcutsInhibitorV1InactiveEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxDmabufV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3081390809))
+	_ = r
+
+	want := ZwpLinuxDmabufV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxDmabufV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxDmabufV1CreateParamsRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2141873969))
+	_ = r
+
+	want := ZwpLinuxDmabufV1CreateParamsRequest{
+		ParamsID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxDmabufV1CreateParamsRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ParamsID: %v", err)
+	} else {
+		got.ParamsID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxDmabufV1FormatEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3044320927))
+	_ = r
+
+	want := ZwpLinuxDmabufV1FormatEvent{
+		Format: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Format); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpLinuxDmabufV1FormatEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxDmabufV1ModifierEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3812864329))
+	_ = r
+
+	want := ZwpLinuxDmabufV1ModifierEvent{
+		Format:     r.Uint32(),
+		ModifierHi: r.Uint32(),
+		ModifierLo: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Format); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.ModifierHi); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.ModifierLo); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpLinuxDmabufV1ModifierEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxBufferParamsV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(902212430))
+	_ = r
+
+	want := ZwpLinuxBufferParamsV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxBufferParamsV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxBufferParamsV1AddRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1531917097))
+	_ = r
+
+	want := ZwpLinuxBufferParamsV1AddRequest{
+		FD:         roundTripFD(t),
+		PlaneIdx:   r.Uint32(),
+		Offset:     r.Uint32(),
+		Stride:     r.Uint32(),
+		ModifierHi: r.Uint32(),
+		ModifierLo: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxBufferParamsV1AddRequest{}
+	if v, err := s.FD(); err != nil {
+		t.Fatalf("scanning FD: %v", err)
+	} else {
+		got.FD = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning PlaneIdx: %v", err)
+	} else {
+		got.PlaneIdx = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Offset: %v", err)
+	} else {
+		got.Offset = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Stride: %v", err)
+	} else {
+		got.Stride = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning ModifierHi: %v", err)
+	} else {
+		got.ModifierHi = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning ModifierLo: %v", err)
+	} else {
+		got.ModifierLo = v
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.FD, &got.FD)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxBufferParamsV1CreateRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2534732998))
+	_ = r
+
+	want := ZwpLinuxBufferParamsV1CreateRequest{
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+		Format: r.Uint32(),
+		Flags:  r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxBufferParamsV1CreateRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Format: %v", err)
+	} else {
+		got.Format = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Flags: %v", err)
+	} else {
+		got.Flags = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxBufferParamsV1CreateImmedRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2844600936))
+	_ = r
+
+	want := ZwpLinuxBufferParamsV1CreateImmedRequest{
+		BufferID: roundTripObjectID(r),
+		Width:    int32(r.Uint32()),
+		Height:   int32(r.Uint32()),
+		Format:   r.Uint32(),
+		Flags:    r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxBufferParamsV1CreateImmedRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning BufferID: %v", err)
+	} else {
+		got.BufferID = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Format: %v", err)
+	} else {
+		got.Format = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Flags: %v", err)
+	} else {
+		got.Flags = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxBufferParamsV1CreatedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(602541613))
+	_ = r
+
+	want := ZwpLinuxBufferParamsV1CreatedEvent{
+		Buffer: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Buffer); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpLinuxBufferParamsV1CreatedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxBufferParamsV1FailedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2968321928))
+	_ = r
+
+	want := ZwpLinuxBufferParamsV1FailedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpLinuxBufferParamsV1FailedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerConstraintsV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3830100435))
+	_ = r
+
+	want := ZwpPointerConstraintsV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPointerConstraintsV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerConstraintsV1LockPointerRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2317186505))
+	_ = r
+
+	want := ZwpPointerConstraintsV1LockPointerRequest{
+		ID:       roundTripObjectID(r),
+		Surface:  roundTripObjectID(r),
+		Pointer:  roundTripObjectID(r),
+		Region:   roundTripNullableObjectID(r),
+		Lifetime: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPointerConstraintsV1LockPointerRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Pointer: %v", err)
+	} else {
+		got.Pointer = v
+	}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Region: %v", err)
+	} else {
+		got.Region = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Lifetime: %v", err)
+	} else {
+		got.Lifetime = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerConstraintsV1ConfinePointerRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3211418710))
+	_ = r
+
+	want := ZwpPointerConstraintsV1ConfinePointerRequest{
+		ID:       roundTripObjectID(r),
+		Surface:  roundTripObjectID(r),
+		Pointer:  roundTripObjectID(r),
+		Region:   roundTripNullableObjectID(r),
+		Lifetime: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPointerConstraintsV1ConfinePointerRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Pointer: %v", err)
+	} else {
+		got.Pointer = v
+	}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Region: %v", err)
+	} else {
+		got.Region = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Lifetime: %v", err)
+	} else {
+		got.Lifetime = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLockedPointerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4020200863))
+	_ = r
+
+	want := ZwpLockedPointerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLockedPointerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLockedPointerV1SetCursorPositionHintRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2891428009))
+	_ = r
+
+	want := ZwpLockedPointerV1SetCursorPositionHintRequest{
+		SurfaceX: Fixed(r.Uint32()),
+		SurfaceY: Fixed(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLockedPointerV1SetCursorPositionHintRequest{}
+	if v, err := s.Fixed(); err != nil {
+		t.Fatalf("scanning SurfaceX: %v", err)
+	} else {
+		got.SurfaceX = v
+	}
+	if v, err := s.Fixed(); err != nil {
+		t.Fatalf("scanning SurfaceY: %v", err)
+	} else {
+		got.SurfaceY = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLockedPointerV1SetRegionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(561107269))
+	_ = r
+
+	want := ZwpLockedPointerV1SetRegionRequest{
+		Region: roundTripNullableObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLockedPointerV1SetRegionRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Region: %v", err)
+	} else {
+		got.Region = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLockedPointerV1LockedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4041167914))
+	_ = r
+
+	want := ZwpLockedPointerV1LockedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpLockedPointerV1LockedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLockedPointerV1UnlockedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3817333295))
+	_ = r
+
+	want := ZwpLockedPointerV1UnlockedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpLockedPointerV1UnlockedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpConfinedPointerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2666799433))
+	_ = r
+
+	want := ZwpConfinedPointerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpConfinedPointerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpConfinedPointerV1SetRegionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(979959591))
+	_ = r
+
+	want := ZwpConfinedPointerV1SetRegionRequest{
+		Region: roundTripNullableObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpConfinedPointerV1SetRegionRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Region: %v", err)
+	} else {
+		got.Region = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpConfinedPointerV1ConfinedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1916397926))
+	_ = r
+
+	want := ZwpConfinedPointerV1ConfinedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpConfinedPointerV1ConfinedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpConfinedPointerV1UnconfinedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1281854343))
+	_ = r
+
+	want := ZwpConfinedPointerV1UnconfinedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpConfinedPointerV1UnconfinedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGesturesV1GetSwipeGestureRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2570468408))
+	_ = r
+
+	want := ZwpPointerGesturesV1GetSwipeGestureRequest{
+		ID:      roundTripObjectID(r),
+		Pointer: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPointerGesturesV1GetSwipeGestureRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Pointer: %v", err)
+	} else {
+		got.Pointer = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGesturesV1GetPinchGestureRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3063798534))
+	_ = r
+
+	want := ZwpPointerGesturesV1GetPinchGestureRequest{
+		ID:      roundTripObjectID(r),
+		Pointer: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPointerGesturesV1GetPinchGestureRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Pointer: %v", err)
+	} else {
+		got.Pointer = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGesturesV1ReleaseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4269423564))
+	_ = r
+
+	want := ZwpPointerGesturesV1ReleaseRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPointerGesturesV1ReleaseRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGesturesV1GetHoldGestureRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(872865139))
+	_ = r
+
+	want := ZwpPointerGesturesV1GetHoldGestureRequest{
+		ID:      roundTripObjectID(r),
+		Pointer: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPointerGesturesV1GetHoldGestureRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Pointer: %v", err)
+	} else {
+		got.Pointer = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGestureSwipeV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3999989526))
+	_ = r
+
+	want := ZwpPointerGestureSwipeV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPointerGestureSwipeV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGestureSwipeV1BeginEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3990194942))
+	_ = r
+
+	want := ZwpPointerGestureSwipeV1BeginEvent{
+		Serial:  r.Uint32(),
+		Time:    r.Uint32(),
+		Surface: roundTripObjectID(r),
+		Fingers: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Fingers); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPointerGestureSwipeV1BeginEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGestureSwipeV1UpdateEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2383044304))
+	_ = r
+
+	want := ZwpPointerGestureSwipeV1UpdateEvent{
+		Time: r.Uint32(),
+		Dx:   Fixed(r.Uint32()),
+		Dy:   Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Dx); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Dy); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPointerGestureSwipeV1UpdateEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGestureSwipeV1EndEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(700472698))
+	_ = r
+
+	want := ZwpPointerGestureSwipeV1EndEvent{
+		Serial:    r.Uint32(),
+		Time:      r.Uint32(),
+		Cancelled: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Cancelled); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPointerGestureSwipeV1EndEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGesturePinchV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3523050784))
+	_ = r
+
+	want := ZwpPointerGesturePinchV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPointerGesturePinchV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGesturePinchV1BeginEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(805821880))
+	_ = r
+
+	want := ZwpPointerGesturePinchV1BeginEvent{
+		Serial:  r.Uint32(),
+		Time:    r.Uint32(),
+		Surface: roundTripObjectID(r),
+		Fingers: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Fingers); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPointerGesturePinchV1BeginEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGesturePinchV1UpdateEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1036428170))
+	_ = r
+
+	want := ZwpPointerGesturePinchV1UpdateEvent{
+		Time:     r.Uint32(),
+		Dx:       Fixed(r.Uint32()),
+		Dy:       Fixed(r.Uint32()),
+		Scale:    Fixed(r.Uint32()),
+		Rotation: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Dx); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Dy); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Scale); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Rotation); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPointerGesturePinchV1UpdateEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGesturePinchV1EndEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2592400468))
+	_ = r
+
+	want := ZwpPointerGesturePinchV1EndEvent{
+		Serial:    r.Uint32(),
+		Time:      r.Uint32(),
+		Cancelled: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Cancelled); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPointerGesturePinchV1EndEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGestureHoldV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4218809117))
+	_ = r
+
+	want := ZwpPointerGestureHoldV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPointerGestureHoldV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGestureHoldV1BeginEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3595232925))
+	_ = r
+
+	want := ZwpPointerGestureHoldV1BeginEvent{
+		Serial:  r.Uint32(),
+		Time:    r.Uint32(),
+		Surface: roundTripObjectID(r),
+		Fingers: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Fingers); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPointerGestureHoldV1BeginEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPointerGestureHoldV1EndEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(359766581))
+	_ = r
+
+	want := ZwpPointerGestureHoldV1EndEvent{
+		Serial:    r.Uint32(),
+		Time:      r.Uint32(),
+		Cancelled: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Cancelled); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPointerGestureHoldV1EndEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpPresentationDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4220297017))
+	_ = r
+
+	want := WpPresentationDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpPresentationDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpPresentationFeedbackRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3884964298))
+	_ = r
+
+	want := WpPresentationFeedbackRequest{
+		Surface:  roundTripObjectID(r),
+		Callback: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpPresentationFeedbackRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Callback: %v", err)
+	} else {
+		got.Callback = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpPresentationClockIDEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(530968591))
+	_ = r
+
+	want := WpPresentationClockIDEvent{
+		ClkID: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.ClkID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WpPresentationClockIDEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpPresentationFeedbackSyncOutputEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(923385809))
+	_ = r
+
+	want := WpPresentationFeedbackSyncOutputEvent{
+		Output: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Output); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WpPresentationFeedbackSyncOutputEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpPresentationFeedbackPresentedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3230454911))
+	_ = r
+
+	want := WpPresentationFeedbackPresentedEvent{
+		TvSecHi: r.Uint32(),
+		TvSecLo: r.Uint32(),
+		TvNsec:  r.Uint32(),
+		Refresh: r.Uint32(),
+		SeqHi:   r.Uint32(),
+		SeqLo:   r.Uint32(),
+		Flags:   r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.TvSecHi); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.TvSecLo); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.TvNsec); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Refresh); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.SeqHi); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.SeqLo); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Flags); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WpPresentationFeedbackPresentedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpPresentationFeedbackDiscardedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1400969736))
+	_ = r
+
+	want := WpPresentationFeedbackDiscardedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WpPresentationFeedbackDiscardedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpRelativePointerManagerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1303328016))
+	_ = r
+
+	want := ZwpRelativePointerManagerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpRelativePointerManagerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpRelativePointerManagerV1GetRelativePointerRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(96576011))
+	_ = r
+
+	want := ZwpRelativePointerManagerV1GetRelativePointerRequest{
+		ID:      roundTripObjectID(r),
+		Pointer: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpRelativePointerManagerV1GetRelativePointerRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Pointer: %v", err)
+	} else {
+		got.Pointer = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpRelativePointerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2299087381))
+	_ = r
+
+	want := ZwpRelativePointerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpRelativePointerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpRelativePointerV1RelativeMotionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1053764448))
+	_ = r
+
+	want := ZwpRelativePointerV1RelativeMotionEvent{
+		UtimeHi:   r.Uint32(),
+		UtimeLo:   r.Uint32(),
+		Dx:        Fixed(r.Uint32()),
+		Dy:        Fixed(r.Uint32()),
+		DxUnaccel: Fixed(r.Uint32()),
+		DyUnaccel: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.UtimeHi); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.UtimeLo); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Dx); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Dy); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.DxUnaccel); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.DyUnaccel); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpRelativePointerV1RelativeMotionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletManagerV1GetTabletSeatRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3081043802))
+	_ = r
+
+	want := ZwpTabletManagerV1GetTabletSeatRequest{
+		TabletSeat: roundTripObjectID(r),
+		Seat:       roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletManagerV1GetTabletSeatRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning TabletSeat: %v", err)
+	} else {
+		got.TabletSeat = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletManagerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4225469207))
+	_ = r
+
+	want := ZwpTabletManagerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletManagerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletSeatV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2451736173))
+	_ = r
+
+	want := ZwpTabletSeatV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletSeatV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletSeatV1TabletAddedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(633891372))
+	_ = r
+
+	want := ZwpTabletSeatV1TabletAddedEvent{
+		ID: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletSeatV1TabletAddedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletSeatV1ToolAddedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1942379418))
+	_ = r
+
+	want := ZwpTabletSeatV1ToolAddedEvent{
+		ID: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletSeatV1ToolAddedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1SetCursorRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1409030706))
+	_ = r
+
+	want := ZwpTabletToolV1SetCursorRequest{
+		Serial:   r.Uint32(),
+		Surface:  roundTripNullableObjectID(r),
+		HotspotX: int32(r.Uint32()),
+		HotspotY: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletToolV1SetCursorRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning HotspotX: %v", err)
+	} else {
+		got.HotspotX = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning HotspotY: %v", err)
+	} else {
+		got.HotspotY = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1858935742))
+	_ = r
+
+	want := ZwpTabletToolV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletToolV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1TypeEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1130549107))
+	_ = r
+
+	want := ZwpTabletToolV1TypeEvent{
+		ToolType: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.ToolType); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1TypeEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1HardwareSerialEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2274169947))
+	_ = r
+
+	want := ZwpTabletToolV1HardwareSerialEvent{
+		HardwareSerialHi: r.Uint32(),
+		HardwareSerialLo: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.HardwareSerialHi); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.HardwareSerialLo); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1HardwareSerialEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1HardwareIDWacomEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3628814035))
+	_ = r
+
+	want := ZwpTabletToolV1HardwareIDWacomEvent{
+		HardwareIDHi: r.Uint32(),
+		HardwareIDLo: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.HardwareIDHi); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.HardwareIDLo); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1HardwareIDWacomEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1CapabilityEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2975201009))
+	_ = r
+
+	want := ZwpTabletToolV1CapabilityEvent{
+		Capability: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Capability); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1CapabilityEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2018540567))
+	_ = r
+
+	want := ZwpTabletToolV1DoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletToolV1DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1RemovedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3671082429))
+	_ = r
+
+	want := ZwpTabletToolV1RemovedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletToolV1RemovedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1ProximityInEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4115677067))
+	_ = r
+
+	want := ZwpTabletToolV1ProximityInEvent{
+		Serial:  r.Uint32(),
+		Tablet:  roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Tablet); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1ProximityInEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1ProximityOutEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2864271950))
+	_ = r
+
+	want := ZwpTabletToolV1ProximityOutEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletToolV1ProximityOutEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1DownEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(639745979))
+	_ = r
+
+	want := ZwpTabletToolV1DownEvent{
+		Serial: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1DownEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1UpEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(296431532))
+	_ = r
+
+	want := ZwpTabletToolV1UpEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletToolV1UpEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1MotionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2214965209))
+	_ = r
+
+	want := ZwpTabletToolV1MotionEvent{
+		X: Fixed(r.Uint32()),
+		Y: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutFixed(want.X); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Y); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1MotionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1PressureEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2602331234))
+	_ = r
+
+	want := ZwpTabletToolV1PressureEvent{
+		Pressure: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Pressure); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1PressureEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1DistanceEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3702985594))
+	_ = r
+
+	want := ZwpTabletToolV1DistanceEvent{
+		Distance: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Distance); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1DistanceEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1TiltEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3291804886))
+	_ = r
+
+	want := ZwpTabletToolV1TiltEvent{
+		TiltX: int32(r.Uint32()),
+		TiltY: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.TiltX); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.TiltY); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1TiltEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1RotationEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(162035361))
+	_ = r
+
+	want := ZwpTabletToolV1RotationEvent{
+		Degrees: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Degrees); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1RotationEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1SliderEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4041260424))
+	_ = r
+
+	want := ZwpTabletToolV1SliderEvent{
+		Position: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Position); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1SliderEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1WheelEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1167844376))
+	_ = r
+
+	want := ZwpTabletToolV1WheelEvent{
+		Degrees: int32(r.Uint32()),
+		Clicks:  int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Degrees); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Clicks); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1WheelEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1ButtonEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3799780287))
+	_ = r
+
+	want := ZwpTabletToolV1ButtonEvent{
+		Serial: r.Uint32(),
+		Button: r.Uint32(),
+		State:  r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Button); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.State); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1ButtonEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV1FrameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2471581150))
+	_ = r
+
+	want := ZwpTabletToolV1FrameEvent{
+		Time: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV1FrameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(772981148))
+	_ = r
+
+	want := ZwpTabletV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV1NameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4205207720))
+	_ = r
+
+	want := ZwpTabletV1NameEvent{
+		Name: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Name); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletV1NameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV1IDEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1752731266))
+	_ = r
+
+	want := ZwpTabletV1IDEvent{
+		Vid: r.Uint32(),
+		Pid: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Vid); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Pid); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletV1IDEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV1PathEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1739871524))
+	_ = r
+
+	want := ZwpTabletV1PathEvent{
+		Path: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Path); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletV1PathEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV1DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1320197509))
+	_ = r
+
+	want := ZwpTabletV1DoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletV1DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV1RemovedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2260725843))
+	_ = r
+
+	want := ZwpTabletV1RemovedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletV1RemovedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletManagerV2GetTabletSeatRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1259427055))
+	_ = r
+
+	want := ZwpTabletManagerV2GetTabletSeatRequest{
+		TabletSeat: roundTripObjectID(r),
+		Seat:       roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletManagerV2GetTabletSeatRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning TabletSeat: %v", err)
+	} else {
+		got.TabletSeat = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletManagerV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2449191302))
+	_ = r
+
+	want := ZwpTabletManagerV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletManagerV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletSeatV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(666341432))
+	_ = r
+
+	want := ZwpTabletSeatV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletSeatV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletSeatV2TabletAddedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3387911745))
+	_ = r
+
+	want := ZwpTabletSeatV2TabletAddedEvent{
+		ID: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletSeatV2TabletAddedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletSeatV2ToolAddedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1357467235))
+	_ = r
+
+	want := ZwpTabletSeatV2ToolAddedEvent{
+		ID: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletSeatV2ToolAddedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletSeatV2PadAddedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1952937462))
+	_ = r
+
+	want := ZwpTabletSeatV2PadAddedEvent{
+		ID: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletSeatV2PadAddedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2SetCursorRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4090712135))
+	_ = r
+
+	want := ZwpTabletToolV2SetCursorRequest{
+		Serial:   r.Uint32(),
+		Surface:  roundTripNullableObjectID(r),
+		HotspotX: int32(r.Uint32()),
+		HotspotY: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletToolV2SetCursorRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning HotspotX: %v", err)
+	} else {
+		got.HotspotX = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning HotspotY: %v", err)
+	} else {
+		got.HotspotY = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1515689519))
+	_ = r
+
+	want := ZwpTabletToolV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletToolV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2TypeEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1829247160))
+	_ = r
+
+	want := ZwpTabletToolV2TypeEvent{
+		ToolType: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.ToolType); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2TypeEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2HardwareSerialEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2888282252))
+	_ = r
+
+	want := ZwpTabletToolV2HardwareSerialEvent{
+		HardwareSerialHi: r.Uint32(),
+		HardwareSerialLo: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.HardwareSerialHi); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.HardwareSerialLo); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2HardwareSerialEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2HardwareIDWacomEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1025448706))
+	_ = r
+
+	want := ZwpTabletToolV2HardwareIDWacomEvent{
+		HardwareIDHi: r.Uint32(),
+		HardwareIDLo: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.HardwareIDHi); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.HardwareIDLo); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2HardwareIDWacomEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2CapabilityEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3357854594))
+	_ = r
+
+	want := ZwpTabletToolV2CapabilityEvent{
+		Capability: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Capability); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2CapabilityEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1352326384))
+	_ = r
+
+	want := ZwpTabletToolV2DoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletToolV2DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2RemovedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2476829060))
+	_ = r
+
+	want := ZwpTabletToolV2RemovedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletToolV2RemovedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2ProximityInEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4170948462))
+	_ = r
+
+	want := ZwpTabletToolV2ProximityInEvent{
+		Serial:  r.Uint32(),
+		Tablet:  roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Tablet); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2ProximityInEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2ProximityOutEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(62337273))
+	_ = r
+
+	want := ZwpTabletToolV2ProximityOutEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletToolV2ProximityOutEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2DownEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1267424316))
+	_ = r
+
+	want := ZwpTabletToolV2DownEvent{
+		Serial: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2DownEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2UpEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3244964927))
+	_ = r
+
+	want := ZwpTabletToolV2UpEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletToolV2UpEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2MotionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2387581446))
+	_ = r
+
+	want := ZwpTabletToolV2MotionEvent{
+		X: Fixed(r.Uint32()),
+		Y: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutFixed(want.X); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Y); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2MotionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2PressureEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1360105921))
+	_ = r
+
+	want := ZwpTabletToolV2PressureEvent{
+		Pressure: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Pressure); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2PressureEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2DistanceEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4183151297))
+	_ = r
+
+	want := ZwpTabletToolV2DistanceEvent{
+		Distance: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Distance); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2DistanceEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2TiltEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(56112781))
+	_ = r
+
+	want := ZwpTabletToolV2TiltEvent{
+		TiltX: Fixed(r.Uint32()),
+		TiltY: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutFixed(want.TiltX); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.TiltY); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2TiltEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2RotationEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4245450858))
+	_ = r
+
+	want := ZwpTabletToolV2RotationEvent{
+		Degrees: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutFixed(want.Degrees); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2RotationEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2SliderEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3902670391))
+	_ = r
+
+	want := ZwpTabletToolV2SliderEvent{
+		Position: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Position); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2SliderEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2WheelEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1946849141))
+	_ = r
+
+	want := ZwpTabletToolV2WheelEvent{
+		Degrees: Fixed(r.Uint32()),
+		Clicks:  int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutFixed(want.Degrees); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Clicks); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2WheelEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2ButtonEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(860600068))
+	_ = r
+
+	want := ZwpTabletToolV2ButtonEvent{
+		Serial: r.Uint32(),
+		Button: r.Uint32(),
+		State:  r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Button); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.State); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2ButtonEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletToolV2FrameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2299205311))
+	_ = r
+
+	want := ZwpTabletToolV2FrameEvent{
+		Time: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletToolV2FrameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2099027537))
+	_ = r
+
+	want := ZwpTabletV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV2NameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1492477191))
+	_ = r
+
+	want := ZwpTabletV2NameEvent{
+		Name: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Name); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletV2NameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV2IDEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3363220929))
+	_ = r
+
+	want := ZwpTabletV2IDEvent{
+		Vid: r.Uint32(),
+		Pid: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Vid); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Pid); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletV2IDEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV2PathEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(368020863))
+	_ = r
+
+	want := ZwpTabletV2PathEvent{
+		Path: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Path); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletV2PathEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV2DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(806003778))
+	_ = r
+
+	want := ZwpTabletV2DoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletV2DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletV2RemovedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3095723238))
+	_ = r
+
+	want := ZwpTabletV2RemovedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletV2RemovedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadRingV2SetFeedbackRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(243075161))
+	_ = r
+
+	want := ZwpTabletPadRingV2SetFeedbackRequest{
+		Description: roundTripString(r),
+		Serial:      r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletPadRingV2SetFeedbackRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Description: %v", err)
+	} else {
+		got.Description = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadRingV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3493094418))
+	_ = r
+
+	want := ZwpTabletPadRingV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletPadRingV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadRingV2SourceEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(322923520))
+	_ = r
+
+	want := ZwpTabletPadRingV2SourceEvent{
+		Source: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Source); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadRingV2SourceEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadRingV2AngleEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1897342528))
+	_ = r
+
+	want := ZwpTabletPadRingV2AngleEvent{
+		Degrees: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutFixed(want.Degrees); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadRingV2AngleEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadRingV2StopEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3790166751))
+	_ = r
+
+	want := ZwpTabletPadRingV2StopEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletPadRingV2StopEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadRingV2FrameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3682671506))
+	_ = r
+
+	want := ZwpTabletPadRingV2FrameEvent{
+		Time: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadRingV2FrameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadStripV2SetFeedbackRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2352683411))
+	_ = r
+
+	want := ZwpTabletPadStripV2SetFeedbackRequest{
+		Description: roundTripString(r),
+		Serial:      r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletPadStripV2SetFeedbackRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Description: %v", err)
+	} else {
+		got.Description = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadStripV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2676046036))
+	_ = r
+
+	want := ZwpTabletPadStripV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletPadStripV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadStripV2SourceEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2006138994))
+	_ = r
+
+	want := ZwpTabletPadStripV2SourceEvent{
+		Source: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Source); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadStripV2SourceEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadStripV2PositionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2160602248))
+	_ = r
+
+	want := ZwpTabletPadStripV2PositionEvent{
+		Position: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Position); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadStripV2PositionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadStripV2StopEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2557894377))
+	_ = r
+
+	want := ZwpTabletPadStripV2StopEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletPadStripV2StopEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadStripV2FrameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4148254656))
+	_ = r
+
+	want := ZwpTabletPadStripV2FrameEvent{
+		Time: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadStripV2FrameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadGroupV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2269701801))
+	_ = r
+
+	want := ZwpTabletPadGroupV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletPadGroupV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadGroupV2ButtonsEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(197742669))
+	_ = r
+
+	want := ZwpTabletPadGroupV2ButtonsEvent{
+		Buttons: roundTripArray(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutArray(want.Buttons); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadGroupV2ButtonsEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadGroupV2RingEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(632055924))
+	_ = r
+
+	want := ZwpTabletPadGroupV2RingEvent{
+		Ring: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Ring); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadGroupV2RingEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadGroupV2StripEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(729606200))
+	_ = r
+
+	want := ZwpTabletPadGroupV2StripEvent{
+		Strip: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Strip); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadGroupV2StripEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadGroupV2ModesEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1232270326))
+	_ = r
+
+	want := ZwpTabletPadGroupV2ModesEvent{
+		Modes: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Modes); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadGroupV2ModesEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadGroupV2DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3328776346))
+	_ = r
+
+	want := ZwpTabletPadGroupV2DoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletPadGroupV2DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadGroupV2ModeSwitchEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4179967219))
+	_ = r
+
+	want := ZwpTabletPadGroupV2ModeSwitchEvent{
+		Time:   r.Uint32(),
+		Serial: r.Uint32(),
+		Mode:   r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Mode); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadGroupV2ModeSwitchEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadV2SetFeedbackRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2090691307))
+	_ = r
+
+	want := ZwpTabletPadV2SetFeedbackRequest{
+		Button:      r.Uint32(),
+		Description: roundTripString(r),
+		Serial:      r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletPadV2SetFeedbackRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Button: %v", err)
+	} else {
+		got.Button = v
+	}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Description: %v", err)
+	} else {
+		got.Description = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(837786140))
+	_ = r
+
+	want := ZwpTabletPadV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTabletPadV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadV2GroupEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3892246158))
+	_ = r
+
+	want := ZwpTabletPadV2GroupEvent{
+		PadGroup: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.PadGroup); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadV2GroupEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadV2PathEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4036956836))
+	_ = r
+
+	want := ZwpTabletPadV2PathEvent{
+		Path: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Path); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadV2PathEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadV2ButtonsEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3017189048))
+	_ = r
+
+	want := ZwpTabletPadV2ButtonsEvent{
+		Buttons: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Buttons); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadV2ButtonsEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadV2DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3617282821))
+	_ = r
+
+	want := ZwpTabletPadV2DoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletPadV2DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadV2ButtonEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3169833969))
+	_ = r
+
+	want := ZwpTabletPadV2ButtonEvent{
+		Time:   r.Uint32(),
+		Button: r.Uint32(),
+		State:  r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Button); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.State); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadV2ButtonEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadV2EnterEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1003002285))
+	_ = r
+
+	want := ZwpTabletPadV2EnterEvent{
+		Serial:  r.Uint32(),
+		Tablet:  roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Tablet); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadV2EnterEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadV2LeaveEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3784148714))
+	_ = r
+
+	want := ZwpTabletPadV2LeaveEvent{
+		Serial:  r.Uint32(),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTabletPadV2LeaveEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTabletPadV2RemovedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(117830867))
+	_ = r
+
+	want := ZwpTabletPadV2RemovedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTabletPadV2RemovedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1ActivateRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1188954152))
+	_ = r
+
+	want := ZwpTextInputV1ActivateRequest{
+		Seat:    roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1ActivateRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1DeactivateRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2162543405))
+	_ = r
+
+	want := ZwpTextInputV1DeactivateRequest{
+		Seat: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1DeactivateRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1ShowInputPanelRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(337732334))
+	_ = r
+
+	want := ZwpTextInputV1ShowInputPanelRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1ShowInputPanelRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1HideInputPanelRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1318391401))
+	_ = r
+
+	want := ZwpTextInputV1HideInputPanelRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1HideInputPanelRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1ResetRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(504671742))
+	_ = r
+
+	want := ZwpTextInputV1ResetRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1ResetRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1SetSurroundingTextRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2356592468))
+	_ = r
+
+	want := ZwpTextInputV1SetSurroundingTextRequest{
+		Text:   roundTripString(r),
+		Cursor: r.Uint32(),
+		Anchor: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1SetSurroundingTextRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Text: %v", err)
+	} else {
+		got.Text = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Cursor: %v", err)
+	} else {
+		got.Cursor = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Anchor: %v", err)
+	} else {
+		got.Anchor = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1SetContentTypeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1325875272))
+	_ = r
+
+	want := ZwpTextInputV1SetContentTypeRequest{
+		Hint:    r.Uint32(),
+		Purpose: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1SetContentTypeRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Hint: %v", err)
+	} else {
+		got.Hint = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Purpose: %v", err)
+	} else {
+		got.Purpose = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1SetCursorRectangleRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2552378302))
+	_ = r
+
+	want := ZwpTextInputV1SetCursorRectangleRequest{
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1SetCursorRectangleRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1SetPreferredLanguageRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1289191406))
+	_ = r
+
+	want := ZwpTextInputV1SetPreferredLanguageRequest{
+		Language: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1SetPreferredLanguageRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Language: %v", err)
+	} else {
+		got.Language = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1CommitStateRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(411944461))
+	_ = r
+
+	want := ZwpTextInputV1CommitStateRequest{
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1CommitStateRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1InvokeActionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1372612117))
+	_ = r
+
+	want := ZwpTextInputV1InvokeActionRequest{
+		Button: r.Uint32(),
+		Index:  r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV1InvokeActionRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Button: %v", err)
+	} else {
+		got.Button = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Index: %v", err)
+	} else {
+		got.Index = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1EnterEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(413345064))
+	_ = r
+
+	want := ZwpTextInputV1EnterEvent{
+		Surface: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1EnterEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1LeaveEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2697054255))
+	_ = r
+
+	want := ZwpTextInputV1LeaveEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpTextInputV1LeaveEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1ModifiersMapEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2845502298))
+	_ = r
+
+	want := ZwpTextInputV1ModifiersMapEvent{
+		Map: roundTripArray(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutArray(want.Map); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1ModifiersMapEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1InputPanelStateEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(752264535))
+	_ = r
+
+	want := ZwpTextInputV1InputPanelStateEvent{
+		State: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.State); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1InputPanelStateEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1PreeditStringEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1206742114))
+	_ = r
+
+	want := ZwpTextInputV1PreeditStringEvent{
+		Serial: r.Uint32(),
+		Text:   roundTripString(r),
+		Commit: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutString(want.Text); err != nil {
+			return err
+		}
+		if err := e.PutString(want.Commit); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1PreeditStringEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1PreeditStylingEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3516558293))
+	_ = r
+
+	want := ZwpTextInputV1PreeditStylingEvent{
+		Index:  r.Uint32(),
+		Length: r.Uint32(),
+		Style:  r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Index); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Length); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Style); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1PreeditStylingEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1PreeditCursorEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2524656683))
+	_ = r
+
+	want := ZwpTextInputV1PreeditCursorEvent{
+		Index: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Index); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1PreeditCursorEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1CommitStringEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1968649394))
+	_ = r
+
+	want := ZwpTextInputV1CommitStringEvent{
+		Serial: r.Uint32(),
+		Text:   roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutString(want.Text); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1CommitStringEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1CursorPositionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3396953589))
+	_ = r
+
+	want := ZwpTextInputV1CursorPositionEvent{
+		Index:  int32(r.Uint32()),
+		Anchor: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Index); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Anchor); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1CursorPositionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1DeleteSurroundingTextEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2750708614))
+	_ = r
+
+	want := ZwpTextInputV1DeleteSurroundingTextEvent{
+		Index:  int32(r.Uint32()),
+		Length: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Index); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Length); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1DeleteSurroundingTextEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1KeysymEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1318756404))
+	_ = r
+
+	want := ZwpTextInputV1KeysymEvent{
+		Serial:    r.Uint32(),
+		Time:      r.Uint32(),
+		Sym:       r.Uint32(),
+		State:     r.Uint32(),
+		Modifiers: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Sym); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.State); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Modifiers); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1KeysymEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1LanguageEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2556122028))
+	_ = r
+
+	want := ZwpTextInputV1LanguageEvent{
+		Serial:   r.Uint32(),
+		Language: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutString(want.Language); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1LanguageEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV1TextDirectionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(922838694))
+	_ = r
+
+	want := ZwpTextInputV1TextDirectionEvent{
+		Serial:    r.Uint32(),
+		Direction: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Direction); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV1TextDirectionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputManagerV1CreateTextInputRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2062981809))
+	_ = r
+
+	want := ZwpTextInputManagerV1CreateTextInputRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputManagerV1CreateTextInputRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3380818727))
+	_ = r
+
+	want := ZwpTextInputV3DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV3DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3EnableRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3797535280))
+	_ = r
+
+	want := ZwpTextInputV3EnableRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV3EnableRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3DisableRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1405240213))
+	_ = r
+
+	want := ZwpTextInputV3DisableRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV3DisableRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3SetSurroundingTextRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3511719146))
+	_ = r
+
+	want := ZwpTextInputV3SetSurroundingTextRequest{
+		Text:   roundTripString(r),
+		Cursor: int32(r.Uint32()),
+		Anchor: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV3SetSurroundingTextRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Text: %v", err)
+	} else {
+		got.Text = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Cursor: %v", err)
+	} else {
+		got.Cursor = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Anchor: %v", err)
+	} else {
+		got.Anchor = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3SetTextChangeCauseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3567981887))
+	_ = r
+
+	want := ZwpTextInputV3SetTextChangeCauseRequest{
+		Cause: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV3SetTextChangeCauseRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Cause: %v", err)
+	} else {
+		got.Cause = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3SetContentTypeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3871706890))
+	_ = r
+
+	want := ZwpTextInputV3SetContentTypeRequest{
+		Hint:    r.Uint32(),
+		Purpose: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV3SetContentTypeRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Hint: %v", err)
+	} else {
+		got.Hint = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Purpose: %v", err)
+	} else {
+		got.Purpose = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3SetCursorRectangleRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(659909884))
+	_ = r
+
+	want := ZwpTextInputV3SetCursorRectangleRequest{
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV3SetCursorRectangleRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3CommitRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(697129034))
+	_ = r
+
+	want := ZwpTextInputV3CommitRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputV3CommitRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3EnterEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2619940930))
+	_ = r
+
+	want := ZwpTextInputV3EnterEvent{
+		Surface: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV3EnterEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3LeaveEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3638791501))
+	_ = r
+
+	want := ZwpTextInputV3LeaveEvent{
+		Surface: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV3LeaveEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3PreeditStringEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1631751176))
+	_ = r
+
+	want := ZwpTextInputV3PreeditStringEvent{
+		Text:        roundTripNullableString(r),
+		CursorBegin: int32(r.Uint32()),
+		CursorEnd:   int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutNullableString(want.Text); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.CursorBegin); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.CursorEnd); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV3PreeditStringEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3CommitStringEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(658298728))
+	_ = r
+
+	want := ZwpTextInputV3CommitStringEvent{
+		Text: roundTripNullableString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutNullableString(want.Text); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV3CommitStringEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3DeleteSurroundingTextEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3150048620))
+	_ = r
+
+	want := ZwpTextInputV3DeleteSurroundingTextEvent{
+		BeforeLength: r.Uint32(),
+		AfterLength:  r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.BeforeLength); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.AfterLength); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV3DeleteSurroundingTextEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputV3DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3121774440))
+	_ = r
+
+	want := ZwpTextInputV3DoneEvent{
+		Serial: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpTextInputV3DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputManagerV3DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(378546990))
+	_ = r
+
+	want := ZwpTextInputManagerV3DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputManagerV3DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpTextInputManagerV3GetTextInputRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2828989769))
+	_ = r
+
+	want := ZwpTextInputManagerV3GetTextInputRequest{
+		ID:   roundTripObjectID(r),
+		Seat: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpTextInputManagerV3GetTextInputRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpViewporterDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4070738580))
+	_ = r
+
+	want := WpViewporterDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpViewporterDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpViewporterGetViewportRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(696750948))
+	_ = r
+
+	want := WpViewporterGetViewportRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpViewporterGetViewportRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpViewportDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(862877851))
+	_ = r
+
+	want := WpViewportDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpViewportDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpViewportSetSourceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2574583166))
+	_ = r
+
+	want := WpViewportSetSourceRequest{
+		X:      Fixed(r.Uint32()),
+		Y:      Fixed(r.Uint32()),
+		Width:  Fixed(r.Uint32()),
+		Height: Fixed(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpViewportSetSourceRequest{}
+	if v, err := s.Fixed(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Fixed(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Fixed(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Fixed(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWpViewportSetDestinationRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(86557477))
+	_ = r
+
+	want := WpViewportSetDestinationRequest{
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WpViewportSetDestinationRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDisplaySyncRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2893946560))
+	_ = r
+
+	want := WlDisplaySyncRequest{
+		Callback: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDisplaySyncRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Callback: %v", err)
+	} else {
+		got.Callback = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDisplayGetRegistryRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2615812528))
+	_ = r
+
+	want := WlDisplayGetRegistryRequest{
+		Registry: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDisplayGetRegistryRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Registry: %v", err)
+	} else {
+		got.Registry = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDisplayErrorEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(137892806))
+	_ = r
+
+	want := WlDisplayErrorEvent{
+		ObjectID: roundTripObjectID(r),
+		Code:     r.Uint32(),
+		Message:  roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.ObjectID); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Code); err != nil {
+			return err
+		}
+		if err := e.PutString(want.Message); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDisplayErrorEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDisplayDeleteIDEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2584472086))
+	_ = r
+
+	want := WlDisplayDeleteIDEvent{
+		ID: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDisplayDeleteIDEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlRegistryBindRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3596382491))
+	_ = r
+
+	want := WlRegistryBindRequest{
+		Name:               r.Uint32(),
+		IDInterfaceName:    roundTripString(r),
+		IDInterfaceVersion: r.Uint32(),
+		ID:                 roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlRegistryBindRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Name: %v", err)
+	} else {
+		got.Name = v
+	}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning IDInterfaceName: %v", err)
+	} else {
+		got.IDInterfaceName = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning IDInterfaceVersion: %v", err)
+	} else {
+		got.IDInterfaceVersion = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlRegistryGlobalEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3513921970))
+	_ = r
+
+	want := WlRegistryGlobalEvent{
+		Name:      r.Uint32(),
+		Interface: roundTripString(r),
+		Version:   r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Name); err != nil {
+			return err
+		}
+		if err := e.PutString(want.Interface); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Version); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlRegistryGlobalEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlRegistryGlobalRemoveEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2522504446))
+	_ = r
+
+	want := WlRegistryGlobalRemoveEvent{
+		Name: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Name); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlRegistryGlobalRemoveEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlCallbackDoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1534003767))
+	_ = r
+
+	want := WlCallbackDoneEvent{
+		CallbackData: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.CallbackData); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlCallbackDoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlCompositorCreateSurfaceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1231014847))
+	_ = r
+
+	want := WlCompositorCreateSurfaceRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlCompositorCreateSurfaceRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlCompositorCreateRegionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1231159536))
+	_ = r
+
+	want := WlCompositorCreateRegionRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlCompositorCreateRegionRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShmPoolCreateBufferRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(501452205))
+	_ = r
+
+	want := WlShmPoolCreateBufferRequest{
+		ID:     roundTripObjectID(r),
+		Offset: int32(r.Uint32()),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+		Stride: int32(r.Uint32()),
+		Format: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShmPoolCreateBufferRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Offset: %v", err)
+	} else {
+		got.Offset = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Stride: %v", err)
+	} else {
+		got.Stride = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Format: %v", err)
+	} else {
+		got.Format = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShmPoolDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4102949329))
+	_ = r
+
+	want := WlShmPoolDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShmPoolDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShmPoolResizeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3942500911))
+	_ = r
+
+	want := WlShmPoolResizeRequest{
+		Size: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShmPoolResizeRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Size: %v", err)
+	} else {
+		got.Size = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShmCreatePoolRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2198816871))
+	_ = r
+
+	want := WlShmCreatePoolRequest{
+		ID:   roundTripObjectID(r),
+		FD:   roundTripFD(t),
+		Size: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShmCreatePoolRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.FD(); err != nil {
+		t.Fatalf("scanning FD: %v", err)
+	} else {
+		got.FD = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Size: %v", err)
+	} else {
+		got.Size = v
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.FD, &got.FD)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShmFormatEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(520202497))
+	_ = r
+
+	want := WlShmFormatEvent{
+		Format: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Format); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlShmFormatEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlBufferDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2970738063))
+	_ = r
+
+	want := WlBufferDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlBufferDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlBufferReleaseEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(813994351))
+	_ = r
+
+	want := WlBufferReleaseEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlBufferReleaseEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataOfferAcceptRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1503444129))
+	_ = r
+
+	want := WlDataOfferAcceptRequest{
+		Serial:   r.Uint32(),
+		MimeType: roundTripNullableString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataOfferAcceptRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.NullableString(); err != nil {
+		t.Fatalf("scanning MimeType: %v", err)
+	} else {
+		got.MimeType = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataOfferReceiveRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2109771762))
+	_ = r
+
+	want := WlDataOfferReceiveRequest{
+		MimeType: roundTripString(r),
+		FD:       roundTripFD(t),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataOfferReceiveRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning MimeType: %v", err)
+	} else {
+		got.MimeType = v
+	}
+	if v, err := s.FD(); err != nil {
+		t.Fatalf("scanning FD: %v", err)
+	} else {
+		got.FD = v
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.FD, &got.FD)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataOfferDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(416942591))
+	_ = r
+
+	want := WlDataOfferDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataOfferDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataOfferFinishRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3427158088))
+	_ = r
+
+	want := WlDataOfferFinishRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataOfferFinishRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataOfferSetActionsRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1197265900))
+	_ = r
+
+	want := WlDataOfferSetActionsRequest{
+		DndActions:      r.Uint32(),
+		PreferredAction: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataOfferSetActionsRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning DndActions: %v", err)
+	} else {
+		got.DndActions = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning PreferredAction: %v", err)
+	} else {
+		got.PreferredAction = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataOfferOfferEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3282201258))
+	_ = r
+
+	want := WlDataOfferOfferEvent{
+		MimeType: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.MimeType); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDataOfferOfferEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataOfferSourceActionsEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2599080084))
+	_ = r
+
+	want := WlDataOfferSourceActionsEvent{
+		SourceActions: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.SourceActions); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDataOfferSourceActionsEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataOfferActionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2689356462))
+	_ = r
+
+	want := WlDataOfferActionEvent{
+		DndAction: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.DndAction); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDataOfferActionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataSourceOfferRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1256451752))
+	_ = r
+
+	want := WlDataSourceOfferRequest{
+		MimeType: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataSourceOfferRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning MimeType: %v", err)
+	} else {
+		got.MimeType = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataSourceDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2205408484))
+	_ = r
+
+	want := WlDataSourceDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataSourceDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataSourceSetActionsRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1630394273))
+	_ = r
+
+	want := WlDataSourceSetActionsRequest{
+		DndActions: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataSourceSetActionsRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning DndActions: %v", err)
+	} else {
+		got.DndActions = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataSourceTargetEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1015668050))
+	_ = r
+
+	want := WlDataSourceTargetEvent{
+		MimeType: roundTripNullableString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutNullableString(want.MimeType); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDataSourceTargetEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataSourceSendEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2594227343))
+	_ = r
+
+	want := WlDataSourceSendEvent{
+		MimeType: roundTripString(r),
+		FD:       roundTripFD(t),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.MimeType); err != nil {
+			return err
+		}
+		if err := e.PutFD(want.FD); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDataSourceSendEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.FD, &got.FD)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataSourceCancelledEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(794604774))
+	_ = r
+
+	want := WlDataSourceCancelledEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlDataSourceCancelledEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataSourceDndDropPerformedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2590935186))
+	_ = r
+
+	want := WlDataSourceDndDropPerformedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlDataSourceDndDropPerformedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataSourceDndFinishedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1825367683))
+	_ = r
+
+	want := WlDataSourceDndFinishedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlDataSourceDndFinishedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataSourceActionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3716688235))
+	_ = r
+
+	want := WlDataSourceActionEvent{
+		DndAction: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.DndAction); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDataSourceActionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceStartDragRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1284610579))
+	_ = r
+
+	want := WlDataDeviceStartDragRequest{
+		Source: roundTripNullableObjectID(r),
+		Origin: roundTripObjectID(r),
+		Icon:   roundTripNullableObjectID(r),
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataDeviceStartDragRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Source: %v", err)
+	} else {
+		got.Source = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Origin: %v", err)
+	} else {
+		got.Origin = v
+	}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Icon: %v", err)
+	} else {
+		got.Icon = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceSetSelectionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1358043907))
+	_ = r
+
+	want := WlDataDeviceSetSelectionRequest{
+		Source: roundTripNullableObjectID(r),
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataDeviceSetSelectionRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Source: %v", err)
+	} else {
+		got.Source = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceReleaseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3210468202))
+	_ = r
+
+	want := WlDataDeviceReleaseRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataDeviceReleaseRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceDataOfferEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3277473626))
+	_ = r
+
+	want := WlDataDeviceDataOfferEvent{
+		ID: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDataDeviceDataOfferEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceEnterEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3651904930))
+	_ = r
+
+	want := WlDataDeviceEnterEvent{
+		Serial:  r.Uint32(),
+		Surface: roundTripObjectID(r),
+		X:       Fixed(r.Uint32()),
+		Y:       Fixed(r.Uint32()),
+		ID:      roundTripNullableObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.X); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Y); err != nil {
+			return err
+		}
+		if err := e.PutNullableObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDataDeviceEnterEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceLeaveEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(432203693))
+	_ = r
+
+	want := WlDataDeviceLeaveEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlDataDeviceLeaveEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceMotionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1502110318))
+	_ = r
+
+	want := WlDataDeviceMotionEvent{
+		Time: r.Uint32(),
+		X:    Fixed(r.Uint32()),
+		Y:    Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.X); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Y); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDataDeviceMotionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceDropEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3617278775))
+	_ = r
+
+	want := WlDataDeviceDropEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlDataDeviceDropEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceSelectionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(873192586))
+	_ = r
+
+	want := WlDataDeviceSelectionEvent{
+		ID: roundTripNullableObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutNullableObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlDataDeviceSelectionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceManagerCreateDataSourceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2208135639))
+	_ = r
+
+	want := WlDataDeviceManagerCreateDataSourceRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataDeviceManagerCreateDataSourceRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlDataDeviceManagerGetDataDeviceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(909829224))
+	_ = r
+
+	want := WlDataDeviceManagerGetDataDeviceRequest{
+		ID:   roundTripObjectID(r),
+		Seat: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlDataDeviceManagerGetDataDeviceRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellGetShellSurfaceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(269635706))
+	_ = r
+
+	want := WlShellGetShellSurfaceRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellGetShellSurfaceRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfacePongRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(585161158))
+	_ = r
+
+	want := WlShellSurfacePongRequest{
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellSurfacePongRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfaceMoveRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2995078623))
+	_ = r
+
+	want := WlShellSurfaceMoveRequest{
+		Seat:   roundTripObjectID(r),
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellSurfaceMoveRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfaceResizeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3237207132))
+	_ = r
+
+	want := WlShellSurfaceResizeRequest{
+		Seat:   roundTripObjectID(r),
+		Serial: r.Uint32(),
+		Edges:  r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellSurfaceResizeRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Edges: %v", err)
+	} else {
+		got.Edges = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfaceSetToplevelRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3014454899))
+	_ = r
+
+	want := WlShellSurfaceSetToplevelRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellSurfaceSetToplevelRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfaceSetTransientRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2185124886))
+	_ = r
+
+	want := WlShellSurfaceSetTransientRequest{
+		Parent: roundTripObjectID(r),
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Flags:  r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellSurfaceSetTransientRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Parent: %v", err)
+	} else {
+		got.Parent = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Flags: %v", err)
+	} else {
+		got.Flags = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfaceSetFullscreenRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1857851709))
+	_ = r
+
+	want := WlShellSurfaceSetFullscreenRequest{
+		Method:    r.Uint32(),
+		Framerate: r.Uint32(),
+		Output:    roundTripNullableObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellSurfaceSetFullscreenRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Method: %v", err)
+	} else {
+		got.Method = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Framerate: %v", err)
+	} else {
+		got.Framerate = v
+	}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Output: %v", err)
+	} else {
+		got.Output = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfaceSetPopupRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(535089566))
+	_ = r
+
+	want := WlShellSurfaceSetPopupRequest{
+		Seat:   roundTripObjectID(r),
+		Serial: r.Uint32(),
+		Parent: roundTripObjectID(r),
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Flags:  r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellSurfaceSetPopupRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Parent: %v", err)
+	} else {
+		got.Parent = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Flags: %v", err)
+	} else {
+		got.Flags = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfaceSetMaximizedRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2625656618))
+	_ = r
+
+	want := WlShellSurfaceSetMaximizedRequest{
+		Output: roundTripNullableObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellSurfaceSetMaximizedRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Output: %v", err)
+	} else {
+		got.Output = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfaceSetTitleRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4184072994))
+	_ = r
+
+	want := WlShellSurfaceSetTitleRequest{
+		Title: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellSurfaceSetTitleRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Title: %v", err)
+	} else {
+		got.Title = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfaceSetClassRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2713728956))
+	_ = r
+
+	want := WlShellSurfaceSetClassRequest{
+		Class: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlShellSurfaceSetClassRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Class: %v", err)
+	} else {
+		got.Class = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfacePingEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2599732509))
+	_ = r
+
+	want := WlShellSurfacePingEvent{
+		Serial: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlShellSurfacePingEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfaceConfigureEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1974097823))
+	_ = r
+
+	want := WlShellSurfaceConfigureEvent{
+		Edges:  r.Uint32(),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Edges); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Width); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Height); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlShellSurfaceConfigureEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlShellSurfacePopupDoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3621163629))
+	_ = r
+
+	want := WlShellSurfacePopupDoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlShellSurfacePopupDoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(683338698))
+	_ = r
+
+	want := WlSurfaceDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSurfaceDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceAttachRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3613955797))
+	_ = r
+
+	want := WlSurfaceAttachRequest{
+		Buffer: roundTripNullableObjectID(r),
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSurfaceAttachRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Buffer: %v", err)
+	} else {
+		got.Buffer = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceDamageRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2405286521))
+	_ = r
+
+	want := WlSurfaceDamageRequest{
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSurfaceDamageRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceFrameRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2727232883))
+	_ = r
+
+	want := WlSurfaceFrameRequest{
+		Callback: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSurfaceFrameRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Callback: %v", err)
+	} else {
+		got.Callback = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceSetOpaqueRegionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3776252997))
+	_ = r
+
+	want := WlSurfaceSetOpaqueRegionRequest{
+		Region: roundTripNullableObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSurfaceSetOpaqueRegionRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Region: %v", err)
+	} else {
+		got.Region = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceSetInputRegionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(508060598))
+	_ = r
+
+	want := WlSurfaceSetInputRegionRequest{
+		Region: roundTripNullableObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSurfaceSetInputRegionRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Region: %v", err)
+	} else {
+		got.Region = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceCommitRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2050204461))
+	_ = r
+
+	want := WlSurfaceCommitRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSurfaceCommitRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceSetBufferTransformRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(824161368))
+	_ = r
+
+	want := WlSurfaceSetBufferTransformRequest{
+		Transform: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSurfaceSetBufferTransformRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Transform: %v", err)
+	} else {
+		got.Transform = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceSetBufferScaleRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2507029506))
+	_ = r
+
+	want := WlSurfaceSetBufferScaleRequest{
+		Scale: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSurfaceSetBufferScaleRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Scale: %v", err)
+	} else {
+		got.Scale = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceDamageBufferRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1845744431))
+	_ = r
+
+	want := WlSurfaceDamageBufferRequest{
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSurfaceDamageBufferRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceEnterEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3176685535))
+	_ = r
+
+	want := WlSurfaceEnterEvent{
+		Output: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Output); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlSurfaceEnterEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSurfaceLeaveEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1699240256))
+	_ = r
+
+	want := WlSurfaceLeaveEvent{
+		Output: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Output); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlSurfaceLeaveEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSeatGetPointerRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3337888159))
+	_ = r
+
+	want := WlSeatGetPointerRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSeatGetPointerRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSeatGetKeyboardRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(111167893))
+	_ = r
+
+	want := WlSeatGetKeyboardRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSeatGetKeyboardRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSeatGetTouchRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(638980113))
+	_ = r
+
+	want := WlSeatGetTouchRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSeatGetTouchRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSeatReleaseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1731567281))
+	_ = r
+
+	want := WlSeatReleaseRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSeatReleaseRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSeatCapabilitiesEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1963579771))
+	_ = r
+
+	want := WlSeatCapabilitiesEvent{
+		Capabilities: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Capabilities); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlSeatCapabilitiesEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSeatNameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(639213264))
+	_ = r
+
+	want := WlSeatNameEvent{
+		Name: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Name); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlSeatNameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerSetCursorRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3192949464))
+	_ = r
+
+	want := WlPointerSetCursorRequest{
+		Serial:   r.Uint32(),
+		Surface:  roundTripNullableObjectID(r),
+		HotspotX: int32(r.Uint32()),
+		HotspotY: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlPointerSetCursorRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning HotspotX: %v", err)
+	} else {
+		got.HotspotX = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning HotspotY: %v", err)
+	} else {
+		got.HotspotY = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerReleaseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(642109301))
+	_ = r
+
+	want := WlPointerReleaseRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlPointerReleaseRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerEnterEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(192033457))
+	_ = r
+
+	want := WlPointerEnterEvent{
+		Serial:   r.Uint32(),
+		Surface:  roundTripObjectID(r),
+		SurfaceX: Fixed(r.Uint32()),
+		SurfaceY: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.SurfaceX); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.SurfaceY); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlPointerEnterEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerLeaveEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2851709118))
+	_ = r
+
+	want := WlPointerLeaveEvent{
+		Serial:  r.Uint32(),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlPointerLeaveEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerMotionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1857158535))
+	_ = r
+
+	want := WlPointerMotionEvent{
+		Time:     r.Uint32(),
+		SurfaceX: Fixed(r.Uint32()),
+		SurfaceY: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.SurfaceX); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.SurfaceY); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlPointerMotionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerButtonEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1198077253))
+	_ = r
+
+	want := WlPointerButtonEvent{
+		Serial: r.Uint32(),
+		Time:   r.Uint32(),
+		Button: r.Uint32(),
+		State:  r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Button); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.State); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlPointerButtonEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerAxisEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3832976638))
+	_ = r
+
+	want := WlPointerAxisEvent{
+		Time:  r.Uint32(),
+		Axis:  r.Uint32(),
+		Value: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Axis); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Value); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlPointerAxisEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerFrameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(28256468))
+	_ = r
+
+	want := WlPointerFrameEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlPointerFrameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerAxisSourceEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4169842621))
+	_ = r
+
+	want := WlPointerAxisSourceEvent{
+		AxisSource: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.AxisSource); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlPointerAxisSourceEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerAxisStopEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2538330134))
+	_ = r
+
+	want := WlPointerAxisStopEvent{
+		Time: r.Uint32(),
+		Axis: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Axis); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlPointerAxisStopEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlPointerAxisDiscreteEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2212792387))
+	_ = r
+
+	want := WlPointerAxisDiscreteEvent{
+		Axis:     r.Uint32(),
+		Discrete: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Axis); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Discrete); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlPointerAxisDiscreteEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlKeyboardReleaseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(338700665))
+	_ = r
+
+	want := WlKeyboardReleaseRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlKeyboardReleaseRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlKeyboardKeymapEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3119350148))
+	_ = r
+
+	want := WlKeyboardKeymapEvent{
+		Format: r.Uint32(),
+		FD:     roundTripFD(t),
+		Size:   r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Format); err != nil {
+			return err
+		}
+		if err := e.PutFD(want.FD); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Size); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlKeyboardKeymapEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.FD, &got.FD)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlKeyboardEnterEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(142793069))
+	_ = r
+
+	want := WlKeyboardEnterEvent{
+		Serial:  r.Uint32(),
+		Surface: roundTripObjectID(r),
+		Keys:    roundTripArray(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		if err := e.PutArray(want.Keys); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlKeyboardEnterEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlKeyboardLeaveEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1290315178))
+	_ = r
+
+	want := WlKeyboardLeaveEvent{
+		Serial:  r.Uint32(),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlKeyboardLeaveEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlKeyboardKeyEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(97629694))
+	_ = r
+
+	want := WlKeyboardKeyEvent{
+		Serial: r.Uint32(),
+		Time:   r.Uint32(),
+		Key:    r.Uint32(),
+		State:  r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Key); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.State); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlKeyboardKeyEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlKeyboardModifiersEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2466387961))
+	_ = r
+
+	want := WlKeyboardModifiersEvent{
+		Serial:        r.Uint32(),
+		ModsDepressed: r.Uint32(),
+		ModsLatched:   r.Uint32(),
+		ModsLocked:    r.Uint32(),
+		Group:         r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.ModsDepressed); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.ModsLatched); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.ModsLocked); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Group); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlKeyboardModifiersEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlKeyboardRepeatInfoEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(803289144))
+	_ = r
+
+	want := WlKeyboardRepeatInfoEvent{
+		Rate:  int32(r.Uint32()),
+		Delay: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Rate); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Delay); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlKeyboardRepeatInfoEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlTouchReleaseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1479774687))
+	_ = r
+
+	want := WlTouchReleaseRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlTouchReleaseRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlTouchDownEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2868864443))
+	_ = r
+
+	want := WlTouchDownEvent{
+		Serial:  r.Uint32(),
+		Time:    r.Uint32(),
+		Surface: roundTripObjectID(r),
+		ID:      int32(r.Uint32()),
+		X:       Fixed(r.Uint32()),
+		Y:       Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutObjectID(want.Surface); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.ID); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.X); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Y); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlTouchDownEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlTouchUpEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1676338604))
+	_ = r
+
+	want := WlTouchUpEvent{
+		Serial: r.Uint32(),
+		Time:   r.Uint32(),
+		ID:     int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlTouchUpEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlTouchMotionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4027237849))
+	_ = r
+
+	want := WlTouchMotionEvent{
+		Time: r.Uint32(),
+		ID:   int32(r.Uint32()),
+		X:    Fixed(r.Uint32()),
+		Y:    Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Time); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.ID); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.X); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Y); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlTouchMotionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlTouchFrameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3158157278))
+	_ = r
+
+	want := WlTouchFrameEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlTouchFrameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlTouchCancelEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(288043693))
+	_ = r
+
+	want := WlTouchCancelEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlTouchCancelEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlTouchShapeEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(175343838))
+	_ = r
+
+	want := WlTouchShapeEvent{
+		ID:    int32(r.Uint32()),
+		Major: Fixed(r.Uint32()),
+		Minor: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.ID); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Major); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Minor); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlTouchShapeEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlTouchOrientationEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(786303335))
+	_ = r
+
+	want := WlTouchOrientationEvent{
+		ID:          int32(r.Uint32()),
+		Orientation: Fixed(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.ID); err != nil {
+			return err
+		}
+		if err := e.PutFixed(want.Orientation); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlTouchOrientationEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlOutputReleaseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2848438565))
+	_ = r
+
+	want := WlOutputReleaseRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlOutputReleaseRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlOutputGeometryEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1398946989))
+	_ = r
+
+	want := WlOutputGeometryEvent{
+		X:              int32(r.Uint32()),
+		Y:              int32(r.Uint32()),
+		PhysicalWidth:  int32(r.Uint32()),
+		PhysicalHeight: int32(r.Uint32()),
+		Subpixel:       int32(r.Uint32()),
+		Make:           roundTripString(r),
+		Model:          roundTripString(r),
+		Transform:      int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.X); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Y); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.PhysicalWidth); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.PhysicalHeight); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Subpixel); err != nil {
+			return err
+		}
+		if err := e.PutString(want.Make); err != nil {
+			return err
+		}
+		if err := e.PutString(want.Model); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Transform); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlOutputGeometryEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlOutputModeEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3590669608))
+	_ = r
+
+	want := WlOutputModeEvent{
+		Flags:   r.Uint32(),
+		Width:   int32(r.Uint32()),
+		Height:  int32(r.Uint32()),
+		Refresh: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Flags); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Width); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Height); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Refresh); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlOutputModeEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlOutputDoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2732426945))
+	_ = r
+
+	want := WlOutputDoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := WlOutputDoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlOutputScaleEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3744578853))
+	_ = r
+
+	want := WlOutputScaleEvent{
+		Factor: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Factor); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := WlOutputScaleEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlRegionDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1339545069))
+	_ = r
+
+	want := WlRegionDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlRegionDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlRegionAddRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(118244662))
+	_ = r
+
+	want := WlRegionAddRequest{
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlRegionAddRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlRegionSubtractRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2606487411))
+	_ = r
+
+	want := WlRegionSubtractRequest{
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlRegionSubtractRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSubcompositorDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2893088122))
+	_ = r
+
+	want := WlSubcompositorDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSubcompositorDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSubcompositorGetSubsurfaceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2176676291))
+	_ = r
+
+	want := WlSubcompositorGetSubsurfaceRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+		Parent:  roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSubcompositorGetSubsurfaceRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Parent: %v", err)
+	} else {
+		got.Parent = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSubsurfaceDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2163975448))
+	_ = r
+
+	want := WlSubsurfaceDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSubsurfaceDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSubsurfaceSetPositionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(473334175))
+	_ = r
+
+	want := WlSubsurfaceSetPositionRequest{
+		X: int32(r.Uint32()),
+		Y: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSubsurfaceSetPositionRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSubsurfacePlaceAboveRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2170733736))
+	_ = r
+
+	want := WlSubsurfacePlaceAboveRequest{
+		Sibling: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSubsurfacePlaceAboveRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Sibling: %v", err)
+	} else {
+		got.Sibling = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSubsurfacePlaceBelowRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1812938788))
+	_ = r
+
+	want := WlSubsurfacePlaceBelowRequest{
+		Sibling: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSubsurfacePlaceBelowRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Sibling: %v", err)
+	} else {
+		got.Sibling = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSubsurfaceSetSyncRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(17547743))
+	_ = r
+
+	want := WlSubsurfaceSetSyncRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSubsurfaceSetSyncRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestWlSubsurfaceSetDesyncRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3429075686))
+	_ = r
+
+	want := WlSubsurfaceSetDesyncRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := WlSubsurfaceSetDesyncRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionDeviceManagerV1CreateSourceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3260451188))
+	_ = r
+
+	want := ZwpPrimarySelectionDeviceManagerV1CreateSourceRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPrimarySelectionDeviceManagerV1CreateSourceRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionDeviceManagerV1GetDeviceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3198418209))
+	_ = r
+
+	want := ZwpPrimarySelectionDeviceManagerV1GetDeviceRequest{
+		ID:   roundTripObjectID(r),
+		Seat: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPrimarySelectionDeviceManagerV1GetDeviceRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionDeviceManagerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1440290245))
+	_ = r
+
+	want := ZwpPrimarySelectionDeviceManagerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPrimarySelectionDeviceManagerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionDeviceV1SetSelectionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(951164120))
+	_ = r
+
+	want := ZwpPrimarySelectionDeviceV1SetSelectionRequest{
+		Source: roundTripNullableObjectID(r),
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPrimarySelectionDeviceV1SetSelectionRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Source: %v", err)
+	} else {
+		got.Source = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionDeviceV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1112469010))
+	_ = r
+
+	want := ZwpPrimarySelectionDeviceV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPrimarySelectionDeviceV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionDeviceV1DataOfferEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2432672951))
+	_ = r
+
+	want := ZwpPrimarySelectionDeviceV1DataOfferEvent{
+		Offer: roundTripObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutObjectID(want.Offer); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPrimarySelectionDeviceV1DataOfferEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionDeviceV1SelectionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1374103599))
+	_ = r
+
+	want := ZwpPrimarySelectionDeviceV1SelectionEvent{
+		ID: roundTripNullableObjectID(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutNullableObjectID(want.ID); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPrimarySelectionDeviceV1SelectionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionOfferV1ReceiveRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1665560767))
+	_ = r
+
+	want := ZwpPrimarySelectionOfferV1ReceiveRequest{
+		MimeType: roundTripString(r),
+		FD:       roundTripFD(t),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPrimarySelectionOfferV1ReceiveRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning MimeType: %v", err)
+	} else {
+		got.MimeType = v
+	}
+	if v, err := s.FD(); err != nil {
+		t.Fatalf("scanning FD: %v", err)
+	} else {
+		got.FD = v
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.FD, &got.FD)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionOfferV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1963214946))
+	_ = r
+
+	want := ZwpPrimarySelectionOfferV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPrimarySelectionOfferV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionOfferV1OfferEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1933289095))
+	_ = r
+
+	want := ZwpPrimarySelectionOfferV1OfferEvent{
+		MimeType: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.MimeType); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPrimarySelectionOfferV1OfferEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionSourceV1OfferRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1586839481))
+	_ = r
+
+	want := ZwpPrimarySelectionSourceV1OfferRequest{
+		MimeType: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPrimarySelectionSourceV1OfferRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning MimeType: %v", err)
+	} else {
+		got.MimeType = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionSourceV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3703820689))
+	_ = r
+
+	want := ZwpPrimarySelectionSourceV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpPrimarySelectionSourceV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionSourceV1SendEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1669877420))
+	_ = r
+
+	want := ZwpPrimarySelectionSourceV1SendEvent{
+		MimeType: roundTripString(r),
+		FD:       roundTripFD(t),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.MimeType); err != nil {
+			return err
+		}
+		if err := e.PutFD(want.FD); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpPrimarySelectionSourceV1SendEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.FD, &got.FD)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpPrimarySelectionSourceV1CancelledEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(480929267))
+	_ = r
+
+	want := ZwpPrimarySelectionSourceV1CancelledEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpPrimarySelectionSourceV1CancelledEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgActivationV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(38895280))
+	_ = r
+
+	want := XdgActivationV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgActivationV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgActivationV1GetActivationTokenRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2354444435))
+	_ = r
+
+	want := XdgActivationV1GetActivationTokenRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgActivationV1GetActivationTokenRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgActivationV1ActivateRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1291278871))
+	_ = r
+
+	want := XdgActivationV1ActivateRequest{
+		Token:   roundTripString(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgActivationV1ActivateRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Token: %v", err)
+	} else {
+		got.Token = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgActivationTokenV1SetSerialRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2414773655))
+	_ = r
+
+	want := XdgActivationTokenV1SetSerialRequest{
+		Serial: r.Uint32(),
+		Seat:   roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgActivationTokenV1SetSerialRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgActivationTokenV1SetAppIDRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3781509945))
+	_ = r
+
+	want := XdgActivationTokenV1SetAppIDRequest{
+		AppID: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgActivationTokenV1SetAppIDRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning AppID: %v", err)
+	} else {
+		got.AppID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgActivationTokenV1SetSurfaceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2862091234))
+	_ = r
+
+	want := XdgActivationTokenV1SetSurfaceRequest{
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgActivationTokenV1SetSurfaceRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgActivationTokenV1CommitRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(517468548))
+	_ = r
+
+	want := XdgActivationTokenV1CommitRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgActivationTokenV1CommitRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgActivationTokenV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(549021041))
+	_ = r
+
+	want := XdgActivationTokenV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgActivationTokenV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgActivationTokenV1DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1646494434))
+	_ = r
+
+	want := XdgActivationTokenV1DoneEvent{
+		Token: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Token); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := XdgActivationTokenV1DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgDecorationManagerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1213977061))
+	_ = r
+
+	want := ZxdgDecorationManagerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgDecorationManagerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgDecorationManagerV1GetToplevelDecorationRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(219921152))
+	_ = r
+
+	want := ZxdgDecorationManagerV1GetToplevelDecorationRequest{
+		ID:       roundTripObjectID(r),
+		Toplevel: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgDecorationManagerV1GetToplevelDecorationRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Toplevel: %v", err)
+	} else {
+		got.Toplevel = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgToplevelDecorationV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1122206629))
+	_ = r
+
+	want := ZxdgToplevelDecorationV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgToplevelDecorationV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgToplevelDecorationV1SetModeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1337827278))
+	_ = r
+
+	want := ZxdgToplevelDecorationV1SetModeRequest{
+		Mode: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgToplevelDecorationV1SetModeRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Mode: %v", err)
+	} else {
+		got.Mode = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgToplevelDecorationV1UnsetModeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1970626577))
+	_ = r
+
+	want := ZxdgToplevelDecorationV1UnsetModeRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgToplevelDecorationV1UnsetModeRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgToplevelDecorationV1ConfigureEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3005174998))
+	_ = r
+
+	want := ZxdgToplevelDecorationV1ConfigureEvent{
+		Mode: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Mode); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZxdgToplevelDecorationV1ConfigureEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgExporterV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3324067113))
+	_ = r
+
+	want := ZxdgExporterV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgExporterV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgExporterV1ExportRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2702399157))
+	_ = r
+
+	want := ZxdgExporterV1ExportRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgExporterV1ExportRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgImporterV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3406696226))
+	_ = r
+
+	want := ZxdgImporterV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgImporterV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgImporterV1ImportRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2198495709))
+	_ = r
+
+	want := ZxdgImporterV1ImportRequest{
+		ID:     roundTripObjectID(r),
+		Handle: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgImporterV1ImportRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Handle: %v", err)
+	} else {
+		got.Handle = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgExportedV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4071804579))
+	_ = r
+
+	want := ZxdgExportedV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgExportedV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgExportedV1HandleEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2705927102))
+	_ = r
+
+	want := ZxdgExportedV1HandleEvent{
+		Handle: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Handle); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZxdgExportedV1HandleEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgImportedV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2639589836))
+	_ = r
+
+	want := ZxdgImportedV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgImportedV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgImportedV1SetParentOfRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2795382949))
+	_ = r
+
+	want := ZxdgImportedV1SetParentOfRequest{
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgImportedV1SetParentOfRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgImportedV1DestroyedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3813281966))
+	_ = r
+
+	want := ZxdgImportedV1DestroyedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZxdgImportedV1DestroyedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgExporterV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4051851732))
+	_ = r
+
+	want := ZxdgExporterV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgExporterV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgExporterV2ExportToplevelRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3986608963))
+	_ = r
+
+	want := ZxdgExporterV2ExportToplevelRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgExporterV2ExportToplevelRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgImporterV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(75502803))
+	_ = r
+
+	want := ZxdgImporterV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgImporterV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgImporterV2ImportToplevelRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3273587819))
+	_ = r
+
+	want := ZxdgImporterV2ImportToplevelRequest{
+		ID:     roundTripObjectID(r),
+		Handle: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgImporterV2ImportToplevelRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Handle: %v", err)
+	} else {
+		got.Handle = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgExportedV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(423068338))
+	_ = r
+
+	want := ZxdgExportedV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgExportedV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgExportedV2HandleEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1788150689))
+	_ = r
+
+	want := ZxdgExportedV2HandleEvent{
+		Handle: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Handle); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZxdgExportedV2HandleEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgImportedV2DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2549919937))
+	_ = r
+
+	want := ZxdgImportedV2DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgImportedV2DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgImportedV2SetParentOfRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2488000648))
+	_ = r
+
+	want := ZxdgImportedV2SetParentOfRequest{
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgImportedV2SetParentOfRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgImportedV2DestroyedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3653669851))
+	_ = r
+
+	want := ZxdgImportedV2DestroyedEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZxdgImportedV2DestroyedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgOutputManagerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4239021560))
+	_ = r
+
+	want := ZxdgOutputManagerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgOutputManagerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgOutputManagerV1GetXdgOutputRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2655517144))
+	_ = r
+
+	want := ZxdgOutputManagerV1GetXdgOutputRequest{
+		ID:     roundTripObjectID(r),
+		Output: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgOutputManagerV1GetXdgOutputRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Output: %v", err)
+	} else {
+		got.Output = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgOutputV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3408142029))
+	_ = r
+
+	want := ZxdgOutputV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZxdgOutputV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgOutputV1LogicalPositionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1243102886))
+	_ = r
+
+	want := ZxdgOutputV1LogicalPositionEvent{
+		X: int32(r.Uint32()),
+		Y: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.X); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Y); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZxdgOutputV1LogicalPositionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgOutputV1LogicalSizeEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1315437716))
+	_ = r
+
+	want := ZxdgOutputV1LogicalSizeEvent{
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Width); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Height); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZxdgOutputV1LogicalSizeEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgOutputV1DoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(365063422))
+	_ = r
+
+	want := ZxdgOutputV1DoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZxdgOutputV1DoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgOutputV1NameEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1222361595))
+	_ = r
+
+	want := ZxdgOutputV1NameEvent{
+		Name: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Name); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZxdgOutputV1NameEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZxdgOutputV1DescriptionEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4217821172))
+	_ = r
+
+	want := ZxdgOutputV1DescriptionEvent{
+		Description: roundTripString(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutString(want.Description); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZxdgOutputV1DescriptionEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgWmBaseDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1659867060))
+	_ = r
+
+	want := XdgWmBaseDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgWmBaseDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgWmBaseCreatePositionerRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3093805592))
+	_ = r
+
+	want := XdgWmBaseCreatePositionerRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgWmBaseCreatePositionerRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgWmBaseGetXdgSurfaceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1512521782))
+	_ = r
+
+	want := XdgWmBaseGetXdgSurfaceRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgWmBaseGetXdgSurfaceRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgWmBasePongRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3427573726))
+	_ = r
+
+	want := XdgWmBasePongRequest{
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgWmBasePongRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgWmBasePingEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2375714181))
+	_ = r
+
+	want := XdgWmBasePingEvent{
+		Serial: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := XdgWmBasePingEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPositionerDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(183282105))
+	_ = r
+
+	want := XdgPositionerDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPositionerDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPositionerSetSizeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2761572304))
+	_ = r
+
+	want := XdgPositionerSetSizeRequest{
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPositionerSetSizeRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPositionerSetAnchorRectRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3674053046))
+	_ = r
+
+	want := XdgPositionerSetAnchorRectRequest{
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPositionerSetAnchorRectRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPositionerSetAnchorRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(307861280))
+	_ = r
+
+	want := XdgPositionerSetAnchorRequest{
+		Anchor: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPositionerSetAnchorRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Anchor: %v", err)
+	} else {
+		got.Anchor = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPositionerSetGravityRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1106989837))
+	_ = r
+
+	want := XdgPositionerSetGravityRequest{
+		Gravity: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPositionerSetGravityRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Gravity: %v", err)
+	} else {
+		got.Gravity = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPositionerSetConstraintAdjustmentRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3624597779))
+	_ = r
+
+	want := XdgPositionerSetConstraintAdjustmentRequest{
+		ConstraintAdjustment: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPositionerSetConstraintAdjustmentRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning ConstraintAdjustment: %v", err)
+	} else {
+		got.ConstraintAdjustment = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPositionerSetOffsetRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3012133342))
+	_ = r
+
+	want := XdgPositionerSetOffsetRequest{
+		X: int32(r.Uint32()),
+		Y: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPositionerSetOffsetRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPositionerSetReactiveRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1055973322))
+	_ = r
+
+	want := XdgPositionerSetReactiveRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPositionerSetReactiveRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPositionerSetParentSizeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(190758880))
+	_ = r
+
+	want := XdgPositionerSetParentSizeRequest{
+		ParentWidth:  int32(r.Uint32()),
+		ParentHeight: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPositionerSetParentSizeRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning ParentWidth: %v", err)
+	} else {
+		got.ParentWidth = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning ParentHeight: %v", err)
+	} else {
+		got.ParentHeight = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPositionerSetParentConfigureRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(486832499))
+	_ = r
+
+	want := XdgPositionerSetParentConfigureRequest{
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPositionerSetParentConfigureRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgSurfaceDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4269765670))
+	_ = r
+
+	want := XdgSurfaceDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgSurfaceDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgSurfaceGetToplevelRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(841320161))
+	_ = r
+
+	want := XdgSurfaceGetToplevelRequest{
+		ID: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgSurfaceGetToplevelRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgSurfaceGetPopupRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3534456012))
+	_ = r
+
+	want := XdgSurfaceGetPopupRequest{
+		ID:         roundTripObjectID(r),
+		Parent:     roundTripNullableObjectID(r),
+		Positioner: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgSurfaceGetPopupRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Parent: %v", err)
+	} else {
+		got.Parent = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Positioner: %v", err)
+	} else {
+		got.Positioner = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgSurfaceSetWindowGeometryRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3615434648))
+	_ = r
+
+	want := XdgSurfaceSetWindowGeometryRequest{
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgSurfaceSetWindowGeometryRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgSurfaceAckConfigureRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2722966819))
+	_ = r
+
+	want := XdgSurfaceAckConfigureRequest{
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgSurfaceAckConfigureRequest{}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgSurfaceConfigureEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(521782405))
+	_ = r
+
+	want := XdgSurfaceConfigureEvent{
+		Serial: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Serial); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := XdgSurfaceConfigureEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2841171890))
+	_ = r
+
+	want := XdgToplevelDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelSetParentRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3437691252))
+	_ = r
+
+	want := XdgToplevelSetParentRequest{
+		Parent: roundTripNullableObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelSetParentRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Parent: %v", err)
+	} else {
+		got.Parent = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelSetTitleRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1997272716))
+	_ = r
+
+	want := XdgToplevelSetTitleRequest{
+		Title: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelSetTitleRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning Title: %v", err)
+	} else {
+		got.Title = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelSetAppIDRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4073358100))
+	_ = r
+
+	want := XdgToplevelSetAppIDRequest{
+		AppID: roundTripString(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelSetAppIDRequest{}
+	if v, err := s.String(); err != nil {
+		t.Fatalf("scanning AppID: %v", err)
+	} else {
+		got.AppID = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelShowWindowMenuRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(788002712))
+	_ = r
+
+	want := XdgToplevelShowWindowMenuRequest{
+		Seat:   roundTripObjectID(r),
+		Serial: r.Uint32(),
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelShowWindowMenuRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning X: %v", err)
+	} else {
+		got.X = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Y: %v", err)
+	} else {
+		got.Y = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelMoveRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2459843181))
+	_ = r
+
+	want := XdgToplevelMoveRequest{
+		Seat:   roundTripObjectID(r),
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelMoveRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelResizeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(408405398))
+	_ = r
+
+	want := XdgToplevelResizeRequest{
+		Seat:   roundTripObjectID(r),
+		Serial: r.Uint32(),
+		Edges:  r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelResizeRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Edges: %v", err)
+	} else {
+		got.Edges = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelSetMaxSizeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1452776007))
+	_ = r
+
+	want := XdgToplevelSetMaxSizeRequest{
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelSetMaxSizeRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelSetMinSizeRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2777714005))
+	_ = r
+
+	want := XdgToplevelSetMinSizeRequest{
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelSetMinSizeRequest{}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Width: %v", err)
+	} else {
+		got.Width = v
+	}
+	if v, err := s.Int(); err != nil {
+		t.Fatalf("scanning Height: %v", err)
+	} else {
+		got.Height = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelSetMaximizedRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3788486888))
+	_ = r
+
+	want := XdgToplevelSetMaximizedRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelSetMaximizedRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelUnsetMaximizedRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1215162405))
+	_ = r
+
+	want := XdgToplevelUnsetMaximizedRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelUnsetMaximizedRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelSetFullscreenRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1951301615))
+	_ = r
+
+	want := XdgToplevelSetFullscreenRequest{
+		Output: roundTripNullableObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelSetFullscreenRequest{}
+	if v, err := s.NullableObjectID(); err != nil {
+		t.Fatalf("scanning Output: %v", err)
+	} else {
+		got.Output = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelUnsetFullscreenRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(270410256))
+	_ = r
+
+	want := XdgToplevelUnsetFullscreenRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelUnsetFullscreenRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelSetMinimizedRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3965014466))
+	_ = r
+
+	want := XdgToplevelSetMinimizedRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgToplevelSetMinimizedRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelConfigureEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3937401785))
+	_ = r
+
+	want := XdgToplevelConfigureEvent{
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+		States: roundTripArray(r),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.Width); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Height); err != nil {
+			return err
+		}
+		if err := e.PutArray(want.States); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := XdgToplevelConfigureEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgToplevelCloseEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(153234129))
+	_ = r
+
+	want := XdgToplevelCloseEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := XdgToplevelCloseEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPopupDestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(77941059))
+	_ = r
+
+	want := XdgPopupDestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPopupDestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPopupGrabRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(677633971))
+	_ = r
+
+	want := XdgPopupGrabRequest{
+		Seat:   roundTripObjectID(r),
+		Serial: r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPopupGrabRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Serial: %v", err)
+	} else {
+		got.Serial = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPopupRepositionRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2132244111))
+	_ = r
+
+	want := XdgPopupRepositionRequest{
+		Positioner: roundTripObjectID(r),
+		Token:      r.Uint32(),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := XdgPopupRepositionRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Positioner: %v", err)
+	} else {
+		got.Positioner = v
+	}
+	if v, err := s.Uint(); err != nil {
+		t.Fatalf("scanning Token: %v", err)
+	} else {
+		got.Token = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPopupConfigureEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1672170032))
+	_ = r
+
+	want := XdgPopupConfigureEvent{
+		X:      int32(r.Uint32()),
+		Y:      int32(r.Uint32()),
+		Width:  int32(r.Uint32()),
+		Height: int32(r.Uint32()),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutInt(want.X); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Y); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Width); err != nil {
+			return err
+		}
+		if err := e.PutInt(want.Height); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := XdgPopupConfigureEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPopupPopupDoneEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1649538090))
+	_ = r
+
+	want := XdgPopupPopupDoneEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := XdgPopupPopupDoneEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestXdgPopupRepositionedEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3666792769))
+	_ = r
+
+	want := XdgPopupRepositionedEvent{
+		Token: r.Uint32(),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutUint(want.Token); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := XdgPopupRepositionedEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpXwaylandKeyboardGrabManagerV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2493503776))
+	_ = r
+
+	want := ZwpXwaylandKeyboardGrabManagerV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpXwaylandKeyboardGrabManagerV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpXwaylandKeyboardGrabManagerV1GrabKeyboardRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1566973221))
+	_ = r
+
+	want := ZwpXwaylandKeyboardGrabManagerV1GrabKeyboardRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+		Seat:    roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpXwaylandKeyboardGrabManagerV1GrabKeyboardRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Seat: %v", err)
+	} else {
+		got.Seat = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpXwaylandKeyboardGrabV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(916504101))
+	_ = r
+
+	want := ZwpXwaylandKeyboardGrabV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpXwaylandKeyboardGrabV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxExplicitSynchronizationV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3804245390))
+	_ = r
+
+	want := ZwpLinuxExplicitSynchronizationV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxExplicitSynchronizationV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxExplicitSynchronizationV1GetSynchronizationRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1539655366))
+	_ = r
+
+	want := ZwpLinuxExplicitSynchronizationV1GetSynchronizationRequest{
+		ID:      roundTripObjectID(r),
+		Surface: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxExplicitSynchronizationV1GetSynchronizationRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning ID: %v", err)
+	} else {
+		got.ID = v
+	}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Surface: %v", err)
+	} else {
+		got.Surface = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxSurfaceSynchronizationV1DestroyRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(4099779179))
+	_ = r
+
+	want := ZwpLinuxSurfaceSynchronizationV1DestroyRequest{}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxSurfaceSynchronizationV1DestroyRequest{}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxSurfaceSynchronizationV1SetAcquireFenceRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(488382342))
+	_ = r
+
+	want := ZwpLinuxSurfaceSynchronizationV1SetAcquireFenceRequest{
+		FD: roundTripFD(t),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxSurfaceSynchronizationV1SetAcquireFenceRequest{}
+	if v, err := s.FD(); err != nil {
+		t.Fatalf("scanning FD: %v", err)
+	} else {
+		got.FD = v
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.FD, &got.FD)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxSurfaceSynchronizationV1GetReleaseRequestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3455901098))
+	_ = r
+
+	want := ZwpLinuxSurfaceSynchronizationV1GetReleaseRequest{
+		Release: roundTripObjectID(r),
+	}
+
+	// Requests have no Scan method, so read the arguments directly.
+	s := roundTrip(t, &want)
+
+	got := ZwpLinuxSurfaceSynchronizationV1GetReleaseRequest{}
+	if v, err := s.ObjectID(); err != nil {
+		t.Fatalf("scanning Release: %v", err)
+	} else {
+		got.Release = v
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxBufferReleaseV1FencedReleaseEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(707467914))
+	_ = r
+
+	want := ZwpLinuxBufferReleaseV1FencedReleaseEvent{
+		Fence: roundTripFD(t),
+	}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		if err := e.PutFD(want.Fence); err != nil {
+			return err
+		}
+		return nil
+	}})
+
+	got := ZwpLinuxBufferReleaseV1FencedReleaseEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	roundTripCheckFD(t, want.Fence, &got.Fence)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestZwpLinuxBufferReleaseV1ImmediateReleaseEventRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3873787304))
+	_ = r
+
+	want := ZwpLinuxBufferReleaseV1ImmediateReleaseEvent{}
+
+	// Events have no Emit method, so write the arguments directly.
+	s := roundTrip(t, roundTripEvent{&want, func(e *RequestEmitter) error {
+		return nil
+	}})
+
+	got := ZwpLinuxBufferReleaseV1ImmediateReleaseEvent{}
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("scanning: %v", err)
+	}
+	roundTripDone(t, s)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("round trip mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
@@ -0,0 +1,83 @@
+package jtk
+
+import "github.com/jchv/jtk/xkb"
+
+// Keyboard events are delivered to the window with keyboard focus. Keys are
+// translated with the keymap sent by the compositor; see package xkb. Holding
+// a key down repeats it, at the rate set by the compositor, unless the keymap
+// says the key doesn't repeat, as with modifiers.
+
+// Modifiers is a set of modifier keys.
+type Modifiers uint32
+
+// Modifier keys.
+const (
+	ModShift Modifiers = 1 << iota
+	ModControl
+	ModAlt
+	ModSuper
+	ModCapsLock
+	ModNumLock
+)
+
+// KeyEvent is a key being pressed or released.
+type KeyEvent struct {
+	// Serial identifies the event, for requests that need to be tied to user
+	// input.
+	Serial uint32
+
+	// Time is the time of the event, in milliseconds, with an unspecified
+	// base.
+	Time uint32
+
+	// Key is the key that changed, identified by its Linux input event code,
+	// regardless of the keymap.
+	Key uint32
+
+	// Pressed is whether the key was pressed or released.
+	Pressed bool
+
+	// Repeat is set for presses generated by holding a key down, after the
+	// initial press. Repeats use the serial of the initial press.
+	Repeat bool
+
+	// Keysym is the symbol the key produces with the current modifiers and
+	// layout, or xkb.NoSymbol if it produces none.
+	Keysym xkb.Keysym
+
+	// Text is the text the key produces, or "" if it produces none. It is
+	// set for releases as well as presses.
+	Text string
+
+	// Modifiers are the modifiers active when the key changed, including any
+	// that were used to produce the keysym, such as Shift for "A".
+	Modifiers Modifiers
+}
+
+// OnKey sets a function to be called for key events on the window.
+func (w *Window) OnKey(fn func(KeyEvent)) {
+	w.onKey = fn
+}
+
+// OnFocus sets a function to be called when the window gains or loses
+// keyboard focus.
+func (w *Window) OnFocus(fn func(focused bool)) {
+	w.onFocus = fn
+}
+
+// Focused returns whether the window has keyboard focus.
+func (w *Window) Focused() bool {
+	return w.app.seat != nil && w.app.seat.keyboardFocus == w
+}
+
+func (w *Window) key(e *KeyEvent) {
+	if w.onKey != nil {
+		w.onKey(*e)
+	}
+}
+
+func (w *Window) focus(focused bool) {
+	if w.onFocus != nil {
+		w.onFocus(focused)
+	}
+}
@@ -0,0 +1,94 @@
+package jtk
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+// The syscall package predates memfd_create, so the syscall number and flags
+// are defined here.
+var sysMemfdCreate = map[string]uintptr{
+	"386":     356,
+	"amd64":   319,
+	"arm":     385,
+	"arm64":   279,
+	"ppc64":   360,
+	"ppc64le": 360,
+	"riscv64": 279,
+	"s390x":   350,
+}
+
+const (
+	mfdCloexec      = 0x1
+	mfdAllowSealing = 0x2
+
+	fAddSeals   = 1033
+	fSealSeal   = 0x1
+	fSealShrink = 0x2
+)
+
+// shmCreate creates an anonymous shared memory file of the given size. It uses
+// memfd_create where available, sealed so that it can not shrink underneath
+// the compositor, and falls back to an unlinked file in XDG_RUNTIME_DIR.
+func shmCreate(size int64) (*os.File, error) {
+	file, err := memfdCreate("jtk-shm")
+	if err != nil {
+		file, err = shmTempFile()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := file.Truncate(size); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	// Sealing is best-effort; it only protects the compositor from us.
+	syscall.Syscall(syscall.SYS_FCNTL, file.Fd(), fAddSeals, fSealShrink|fSealSeal)
+
+	return file, nil
+}
+
+func memfdCreate(name string) (*os.File, error) {
+	trap, ok := sysMemfdCreate[runtime.GOARCH]
+	if !ok {
+		return nil, errors.New("memfd_create is not supported on " + runtime.GOARCH)
+	}
+
+	p, err := syscall.BytePtrFromString(name)
+	if err != nil {
+		return nil, err
+	}
+
+	fd, _, errno := syscall.Syscall(trap, uintptr(unsafe.Pointer(p)), mfdCloexec|mfdAllowSealing, 0)
+	if errno != 0 {
+		return nil, errno
+	}
+
+	return os.NewFile(fd, name), nil
+}
+
+func shmTempFile() (*os.File, error) {
+	dir := os.Getenv("XDG_RUNTIME_DIR")
+	if dir == "" {
+		return nil, errors.New("XDG_RUNTIME_DIR is not defined in env")
+	}
+
+	file, err := ioutil.TempFile(dir, "wl_shm")
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Remove(file.Name())
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return file, nil
+}
@@ -0,0 +1,106 @@
+package jtk
+
+import (
+	"sort"
+
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// Output is a display output, e.g. a monitor.
+type Output struct {
+	app    *Application
+	name   uint32
+	output *wayland.WlOutput
+
+	// scale is the current scale; pendingScale is applied on the next done
+	// event, so that changes to several properties are seen at once.
+	scale        int
+	pendingScale int
+}
+
+// Scale returns the scale factor of the output: the number of physical pixels
+// per logical pixel, in each direction.
+func (o *Output) Scale() int {
+	return o.scale
+}
+
+// Outputs returns the outputs known to the application.
+func (app *Application) Outputs() []*Output {
+	return append([]*Output(nil), app.outputs...)
+}
+
+// syncOutputs brings the list of outputs up to date with the globals
+// advertised by the compositor, binding new outputs and dropping removed ones.
+func (app *Application) syncOutputs() {
+	bound := app.conn.Globals().WlOutputs()
+
+	outputs := app.outputs[:0]
+	for _, o := range app.outputs {
+		if _, ok := bound[o.name]; ok {
+			outputs = append(outputs, o)
+			delete(bound, o.name)
+			continue
+		}
+		app.conn.UnregisterHandlers(o.output.ID())
+		if o.output.Version() >= 3 {
+			o.output.Release(app.conn)
+		}
+		for _, w := range app.windows {
+			w.leaveOutput(o)
+		}
+	}
+
+	// Remaining outputs are new. Add them in the order they were advertised.
+	names := make([]uint32, 0, len(bound))
+	for name := range bound {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	for _, name := range names {
+		o := &Output{app: app, name: name, output: bound[name], scale: 1, pendingScale: 1}
+		app.conn.RegisterHandler(o.output.ID(), wayland.HandlerFunc(o.handle))
+		outputs = append(outputs, o)
+	}
+
+	app.outputs = outputs
+}
+
+func (app *Application) handleRegistry(event wayland.Event) {
+	switch event.(type) {
+	case *wayland.WlRegistryGlobalEvent, *wayland.WlRegistryGlobalRemoveEvent:
+		app.syncOutputs()
+	}
+}
+
+// output returns the output with the given object ID, or nil.
+func (app *Application) output(id wayland.ObjectID) *Output {
+	for _, o := range app.outputs {
+		if o.output.ID() == id {
+			return o
+		}
+	}
+	return nil
+}
+
+func (o *Output) handle(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WlOutputScaleEvent:
+		o.pendingScale = int(t.Factor)
+		if o.output.Version() < 2 {
+			// There is no done event before version 2.
+			o.done()
+		}
+	case *wayland.WlOutputDoneEvent:
+		o.done()
+	}
+}
+
+func (o *Output) done() {
+	if o.pendingScale < 1 || o.pendingScale == o.scale {
+		return
+	}
+	o.scale = o.pendingScale
+	for _, w := range o.app.windows {
+		w.updateScale()
+	}
+}
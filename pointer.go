@@ -0,0 +1,137 @@
+package jtk
+
+// Pointer events are delivered to the window under the pointer. The events
+// that the compositor groups together with wl_pointer.frame, such as leaving
+// one surface and entering another, or scrolling on both axes, are delivered
+// together, in order: leave, enter or motion, buttons, then scrolling.
+
+// PointerEventType is the type of a PointerEvent.
+type PointerEventType int
+
+// Pointer event types.
+const (
+	// PointerEnter is sent when the pointer enters the window.
+	PointerEnter PointerEventType = iota
+
+	// PointerLeave is sent when the pointer leaves the window. No more
+	// pointer events are sent to the window until it enters again.
+	PointerLeave
+
+	// PointerMotion is sent when the pointer moves within the window.
+	PointerMotion
+
+	// PointerButton is sent when a button is pressed or released.
+	PointerButton
+
+	// PointerAxis is sent when scrolling.
+	PointerAxis
+)
+
+func (t PointerEventType) String() string {
+	switch t {
+	case PointerEnter:
+		return "enter"
+	case PointerLeave:
+		return "leave"
+	case PointerMotion:
+		return "motion"
+	case PointerButton:
+		return "button"
+	case PointerAxis:
+		return "axis"
+	}
+	return "unknown"
+}
+
+// Button is a pointer button, identified by its Linux input event code.
+type Button uint32
+
+// Common pointer buttons, from linux/input-event-codes.h.
+const (
+	ButtonLeft    Button = 0x110
+	ButtonRight   Button = 0x111
+	ButtonMiddle  Button = 0x112
+	ButtonSide    Button = 0x113
+	ButtonExtra   Button = 0x114
+	ButtonForward Button = 0x115
+	ButtonBack    Button = 0x116
+)
+
+// AxisSource is the kind of device that generated scrolling.
+type AxisSource int
+
+// Axis sources, as defined by wl_pointer.axis_source.
+const (
+	// AxisSourceUnknown means the compositor didn't say.
+	AxisSourceUnknown AxisSource = iota
+
+	// AxisSourceWheel is a mouse wheel, which scrolls in discrete steps.
+	AxisSourceWheel
+
+	// AxisSourceFinger is a finger on a touchpad, which scrolls smoothly and
+	// is followed by a stop when the finger is lifted, so that kinetic
+	// scrolling can start.
+	AxisSourceFinger
+
+	// AxisSourceContinuous is a device that scrolls smoothly other than a
+	// finger, such as a trackpoint with a button held.
+	AxisSourceContinuous
+
+	// AxisSourceWheelTilt is a mouse wheel tilted sideways.
+	AxisSourceWheelTilt
+)
+
+// PointerEvent is an event from a pointing device such as a mouse or touchpad.
+type PointerEvent struct {
+	Type PointerEventType
+
+	// Serial identifies enter, leave and button events, for requests that
+	// need to be tied to user input.
+	Serial uint32
+
+	// Time is the time of motion, button and axis events, in milliseconds,
+	// with an unspecified base.
+	Time uint32
+
+	// X and Y are the position of the pointer, in logical pixels relative to
+	// the top-left corner of the window's content. They are set for every
+	// event type.
+	X, Y float64
+
+	// Button and Pressed are the button that changed and its new state, for
+	// button events.
+	Button  Button
+	Pressed bool
+
+	// Source is the source of axis events. It is usually known.
+	Source AxisSource
+
+	// DX and DY are the scroll distance of axis events, in logical pixels.
+	// Positive values scroll right and down.
+	DX, DY float64
+
+	// Value120X and Value120Y are the scroll distance of axis events from
+	// wheels, in fractions of a wheel detent: 120 is one detent. They are
+	// zero for other sources.
+	Value120X, Value120Y int32
+
+	// StopX and StopY are set on axis events when scrolling stopped on an
+	// axis, e.g. because the fingers were lifted from a touchpad.
+	StopX, StopY bool
+
+	// Emulated is set for events emulated from a touchscreen or a tablet
+	// tool, for windows that don't handle touch or tablet events.
+	Emulated bool
+}
+
+// OnPointer sets a function to be called for pointer events on the window.
+// Events on client-side decorations are not included.
+func (w *Window) OnPointer(fn func(PointerEvent)) {
+	w.onPointer = fn
+}
+
+func (w *Window) pointer(s *seat, e *PointerEvent) {
+	if w.onPointer != nil {
+		w.onPointer(*e)
+	}
+}
@@ -0,0 +1,83 @@
+package jtk
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+// poller waits for the Wayland socket to become readable, a timeout, or a
+// wakeup from another goroutine, using epoll and an eventfd.
+type poller struct {
+	epfd   int
+	wakefd int
+	connfd int
+	events [2]syscall.EpollEvent
+}
+
+func newPoller(connfd int) (*poller, error) {
+	epfd, err := syscall.EpollCreate1(syscall.EPOLL_CLOEXEC)
+	if err != nil {
+		return nil, fmt.Errorf("creating epoll: %w", err)
+	}
+
+	r, _, errno := syscall.Syscall(syscall.SYS_EVENTFD2, 0, syscall.O_CLOEXEC|syscall.O_NONBLOCK, 0)
+	if errno != 0 {
+		syscall.Close(epfd)
+		return nil, fmt.Errorf("creating eventfd: %w", errno)
+	}
+
+	p := &poller{
+		epfd:   epfd,
+		wakefd: int(r),
+		connfd: connfd,
+	}
+
+	for _, fd := range []int{p.wakefd, p.connfd} {
+		event := syscall.EpollEvent{Events: syscall.EPOLLIN, Fd: int32(fd)}
+		if err := syscall.EpollCtl(epfd, syscall.EPOLL_CTL_ADD, fd, &event); err != nil {
+			p.close()
+			return nil, fmt.Errorf("adding fd to epoll: %w", err)
+		}
+	}
+
+	return p, nil
+}
+
+// wait blocks until the connection is readable, the poller is woken, or
+// timeout milliseconds have passed. A negative timeout waits indefinitely.
+// It returns true if the connection is readable.
+func (p *poller) wait(timeout int) (bool, error) {
+	n, err := syscall.EpollWait(p.epfd, p.events[:], timeout)
+	if err == syscall.EINTR {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("waiting for events: %w", err)
+	}
+
+	readable := false
+	for _, event := range p.events[:n] {
+		switch int(event.Fd) {
+		case p.wakefd:
+			buf := [8]byte{}
+			syscall.Read(p.wakefd, buf[:])
+		case p.connfd:
+			// Hangups and errors are reported by reading the socket.
+			readable = true
+		}
+	}
+
+	return readable, nil
+}
+
+// wake causes a concurrent or subsequent call to wait to return. It is safe
+// to call from any goroutine.
+func (p *poller) wake() {
+	one := uint64(1)
+	syscall.Write(p.wakefd, (*[8]byte)(unsafe.Pointer(&one))[:])
+}
+
+func (p *poller) close() {
+	syscall.Close(p.wakefd)
+	syscall.Close(p.epfd)
+}
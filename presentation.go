@@ -0,0 +1,266 @@
+package jtk
+
+import (
+	"errors"
+	"math"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// ErrPresentationUnsupported is returned when requesting presentation feedback
+// from a compositor that does not support wp_presentation.
+var ErrPresentationUnsupported = errors.New("compositor does not support wp_presentation")
+
+// PresentationFlags describe how a frame was presented.
+type PresentationFlags uint32
+
+// Presentation flags, as defined by wp_presentation_feedback.kind.
+const (
+	// PresentationVsync means presentation was synchronized to the vertical
+	// retrace, so there was no tearing.
+	PresentationVsync = PresentationFlags(wayland.WpPresentationFeedbackKindVsync)
+
+	// PresentationHwClock means the timestamp comes from the display hardware
+	// clock, rather than being sampled in software.
+	PresentationHwClock = PresentationFlags(wayland.WpPresentationFeedbackKindHwClock)
+
+	// PresentationHwCompletion means the display hardware signalled that it
+	// started using the new image content.
+	PresentationHwCompletion = PresentationFlags(wayland.WpPresentationFeedbackKindHwCompletion)
+
+	// PresentationZeroCopy means the buffer was scanned out directly, without
+	// being copied by the compositor.
+	PresentationZeroCopy = PresentationFlags(wayland.WpPresentationFeedbackKindZeroCopy)
+)
+
+// PresentationFeedback is the outcome of a single commit of a window.
+type PresentationFeedback struct {
+	// Commit is the sequence number of the commit, counting from 1 for the
+	// first commit of the window with feedback enabled.
+	Commit uint64
+
+	// Discarded is true if the content was never displayed, e.g. because it
+	// was replaced by a later commit before being shown. Only Commit and
+	// Committed are valid for discarded frames.
+	Discarded bool
+
+	// ClockID is the clock used for Timestamp and Committed, as reported by
+	// wp_presentation.clock_id. It is usually CLOCK_MONOTONIC.
+	ClockID uint32
+
+	// Committed is the time of the commit, on the presentation clock.
+	Committed time.Duration
+
+	// Timestamp is the time the frame was turned into light, on the
+	// presentation clock.
+	Timestamp time.Duration
+
+	// Refresh is the predicted time until the next refresh, or zero if the
+	// output does not have a constant refresh rate.
+	Refresh time.Duration
+
+	// MSC is the media stream counter of the output, typically a vertical
+	// retrace counter. It is zero if the output has no such counter.
+	MSC uint64
+
+	// Flags describe how the frame was presented.
+	Flags PresentationFlags
+}
+
+// Latency returns the time between the commit and the frame being presented.
+func (f PresentationFeedback) Latency() time.Duration {
+	if f.Discarded || f.Committed == 0 {
+		return 0
+	}
+	return f.Timestamp - f.Committed
+}
+
+// initPresentation binds wp_presentation, if supported, and waits for its
+// clock ID, which is sent right after binding.
+func (app *Application) initPresentation() error {
+	presentation := app.conn.Globals().WpPresentation()
+	if presentation == nil {
+		return nil
+	}
+	app.wpPresentation = presentation
+	app.conn.RegisterHandler(presentation.ID(), wayland.HandlerFunc(func(event wayland.Event) {
+		switch t := event.(type) {
+		case *wayland.WpPresentationClockIDEvent:
+			app.presentationClock = t.ClkID
+		}
+	}))
+	return app.conn.Roundtrip()
+}
+
+// OnPresentation sets a function to be called with presentation feedback for
+// every commit of the window. Passing nil disables feedback. It returns
+// ErrPresentationUnsupported if the compositor does not support it.
+func (w *Window) OnPresentation(fn func(PresentationFeedback)) error {
+	if fn != nil && w.app.wpPresentation == nil {
+		return ErrPresentationUnsupported
+	}
+	w.onPresentation = fn
+	return nil
+}
+
+// requestPresentation requests feedback for the next commit.
+func (w *Window) requestPresentation() error {
+	app := w.app
+	feedback, err := app.wpPresentation.Feedback(app.conn, w.surface.ID())
+	if err != nil {
+		return err
+	}
+
+	w.commits++
+	result := PresentationFeedback{
+		Commit:    w.commits,
+		ClockID:   app.presentationClock,
+		Committed: clockTime(app.presentationClock),
+	}
+
+	app.conn.RegisterHandler(feedback.ID(), wayland.HandlerFunc(func(event wayland.Event) {
+		switch t := event.(type) {
+		case *wayland.WpPresentationFeedbackPresentedEvent:
+			result.Timestamp = time.Duration(uint64(t.TvSecHi)<<32|uint64(t.TvSecLo))*time.Second + time.Duration(t.TvNsec)
+			result.Refresh = time.Duration(t.Refresh)
+			result.MSC = uint64(t.SeqHi)<<32 | uint64(t.SeqLo)
+			result.Flags = PresentationFlags(t.Flags)
+		case *wayland.WpPresentationFeedbackDiscardedEvent:
+			result.Discarded = true
+		default:
+			return
+		}
+		if w.onPresentation != nil && !w.closed {
+			w.onPresentation(result)
+		}
+	}))
+
+	return nil
+}
+
+// clockTime returns the current time of a POSIX clock, or zero if it can not
+// be read.
+func clockTime(clock uint32) time.Duration {
+	ts := syscall.Timespec{}
+	_, _, errno := syscall.Syscall(syscall.SYS_CLOCK_GETTIME, uintptr(clock), uintptr(unsafe.Pointer(&ts)), 0)
+	if errno != 0 {
+		return 0
+	}
+	return time.Duration(ts.Nano())
+}
+
+// PresentationStats computes rolling statistics over the most recent
+// presentation feedback of a window.
+type PresentationStats struct {
+	samples []PresentationFeedback
+	next    int
+	full    bool
+}
+
+// PresentationSummary summarizes presentation feedback.
+type PresentationSummary struct {
+	// Frames is the number of frames considered.
+	Frames int
+
+	// Discarded is the number of frames that were never displayed.
+	Discarded int
+
+	// Dropped is the number of refresh cycles that were missed between
+	// consecutive presented frames, according to their MSC.
+	Dropped int
+
+	// MeanInterval is the mean time between consecutive presented frames.
+	MeanInterval time.Duration
+
+	// Jitter is the standard deviation of the time between consecutive
+	// presented frames.
+	Jitter time.Duration
+
+	// MeanLatency is the mean time between commit and presentation.
+	MeanLatency time.Duration
+}
+
+// NewPresentationStats returns statistics over the last n frames.
+func NewPresentationStats(n int) *PresentationStats {
+	if n < 2 {
+		n = 2
+	}
+	return &PresentationStats{samples: make([]PresentationFeedback, n)}
+}
+
+// Add adds feedback for a frame, replacing the oldest frame if the window is
+// full.
+func (s *PresentationStats) Add(f PresentationFeedback) {
+	s.samples[s.next] = f
+	s.next++
+	if s.next == len(s.samples) {
+		s.next = 0
+		s.full = true
+	}
+}
+
+// Summary summarizes the frames in the window.
+func (s *PresentationStats) Summary() PresentationSummary {
+	sum := PresentationSummary{}
+
+	var (
+		prev      *PresentationFeedback
+		intervals []time.Duration
+		latency   time.Duration
+		presented int
+	)
+
+	n := s.next
+	start := 0
+	if s.full {
+		n = len(s.samples)
+		start = s.next
+	}
+
+	for i := 0; i < n; i++ {
+		f := &s.samples[(start+i)%len(s.samples)]
+		sum.Frames++
+
+		if f.Discarded {
+			sum.Discarded++
+			continue
+		}
+
+		presented++
+		latency += f.Latency()
+
+		if prev != nil {
+			intervals = append(intervals, f.Timestamp-prev.Timestamp)
+			if f.MSC != 0 && prev.MSC != 0 && f.MSC > prev.MSC+1 {
+				sum.Dropped += int(f.MSC - prev.MSC - 1)
+			}
+		}
+		prev = f
+	}
+
+	if presented > 0 {
+		sum.MeanLatency = latency / time.Duration(presented)
+	}
+
+	if len(intervals) > 0 {
+		total := time.Duration(0)
+		for _, d := range intervals {
+			total += d
+		}
+		mean := float64(total) / float64(len(intervals))
+
+		variance := 0.0
+		for _, d := range intervals {
+			variance += (float64(d) - mean) * (float64(d) - mean)
+		}
+		variance /= float64(len(intervals))
+
+		sum.MeanInterval = time.Duration(mean)
+		sum.Jitter = time.Duration(math.Sqrt(variance))
+	}
+
+	return sum
+}
@@ -0,0 +1,63 @@
+package jtk
+
+import "image"
+
+// maxRegionRects is the number of rectangles a region holds before it is
+// simplified to its bounding box. Beyond this point, tracking damage precisely
+// costs more than redrawing a little too much.
+const maxRegionRects = 16
+
+// region is a set of pixels, described as a union of rectangles that may
+// overlap.
+type region []image.Rectangle
+
+// add adds a rectangle to the region.
+func (r *region) add(rect image.Rectangle) {
+	if rect.Empty() {
+		return
+	}
+
+	for _, other := range *r {
+		if rect.In(other) {
+			return
+		}
+	}
+
+	rects := (*r)[:0]
+	for _, other := range *r {
+		if !other.In(rect) {
+			rects = append(rects, other)
+		}
+	}
+	rects = append(rects, rect)
+
+	if len(rects) > maxRegionRects {
+		rects = region{rects.bounds()}
+	}
+	*r = rects
+}
+
+// union adds all rectangles of another region to the region.
+func (r *region) union(other region) {
+	for _, rect := range other {
+		r.add(rect)
+	}
+}
+
+// bounds returns the bounding box of the region.
+func (r region) bounds() image.Rectangle {
+	b := image.Rectangle{}
+	for _, rect := range r {
+		b = b.Union(rect)
+	}
+	return b
+}
+
+// intersect returns the part of the region within rect.
+func (r region) intersect(rect image.Rectangle) region {
+	out := region{}
+	for _, other := range r {
+		out.add(other.Intersect(rect))
+	}
+	return out
+}
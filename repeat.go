@@ -0,0 +1,76 @@
+package jtk
+
+import "time"
+
+// keyRepeater repeats the last key pressed while it is held, since Wayland
+// leaves key repeat to clients. The compositor sets the rate and delay with
+// wl_keyboard.repeat_info.
+type keyRepeater struct {
+	clock clock
+
+	// rate is the number of repeats per second, and zero disables repeat.
+	// delay is the time between pressing a key and the first repeat.
+	rate  int32
+	delay time.Duration
+
+	// emit delivers a repeated key event.
+	emit func(e *KeyEvent)
+
+	// event is the press being repeated, and timer the next repeat, or nil.
+	event KeyEvent
+	timer stopper
+	count int
+}
+
+// setRate sets the rate and delay, as sent with wl_keyboard.repeat_info. A
+// key that is already repeating stops.
+func (r *keyRepeater) setRate(rate, delay int32) {
+	r.stop()
+	if rate < 0 {
+		rate = 0
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	r.rate, r.delay = rate, time.Duration(delay)*time.Millisecond
+}
+
+// press starts repeating a key, replacing the key that was repeating, if any.
+func (r *keyRepeater) press(e *KeyEvent) {
+	r.stop()
+	if r.rate == 0 {
+		return
+	}
+	r.event = *e
+	r.event.Repeat = true
+	r.count = 0
+	r.timer = r.clock.afterFunc(r.delay, r.fire)
+}
+
+// release stops repeating a key if it is the one repeating.
+func (r *keyRepeater) release(key uint32) {
+	if r.timer != nil && r.event.Key == key {
+		r.stop()
+	}
+}
+
+// stop stops repeating.
+func (r *keyRepeater) stop() {
+	if r.timer != nil {
+		r.timer.Stop()
+		r.timer = nil
+	}
+}
+
+func (r *keyRepeater) fire() {
+	period := time.Second / time.Duration(r.rate)
+	r.count++
+
+	// Repeats are timestamped as if they happened on schedule, relative to
+	// the press, in the compositor's clock.
+	e := r.event
+	e.Time += uint32((r.delay + time.Duration(r.count-1)*period) / time.Millisecond)
+
+	r.timer = r.clock.afterFunc(period, r.fire)
+	r.emit(&e)
+}
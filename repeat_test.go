@@ -0,0 +1,110 @@
+package jtk
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestRepeater returns a key repeater on a fake clock, and a function that
+// returns the times of the repeats emitted since it was last called.
+func newTestRepeater(t *testing.T, rate, delay int32) (*keyRepeater, *fakeClock, func() []uint32) {
+	c := &fakeClock{}
+	var times []uint32
+	r := &keyRepeater{clock: c, emit: func(e *KeyEvent) {
+		if !e.Repeat || !e.Pressed || e.Key != 30 || e.Serial != 7 {
+			t.Errorf("repeated event is %+v, want a repeat of key 30 with serial 7", *e)
+		}
+		times = append(times, e.Time)
+	}}
+	r.setRate(rate, delay)
+	return r, c, func() []uint32 {
+		got := times
+		times = nil
+		return got
+	}
+}
+
+func pressKey(r *keyRepeater, key, ms uint32) {
+	r.press(&KeyEvent{Serial: 7, Time: ms, Key: key, Pressed: true})
+}
+
+func TestKeyRepeatTiming(t *testing.T) {
+	// 25 repeats per second is one every 40ms.
+	r, c, repeats := newTestRepeater(t, 25, 600)
+	pressKey(r, 30, 1000)
+
+	c.advance(599 * time.Millisecond)
+	if got := repeats(); len(got) != 0 {
+		t.Fatalf("repeats %v before the delay", got)
+	}
+	c.advance(time.Millisecond)
+	if got, want := repeats(), []uint32{1600}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("repeats after the delay are %v, want %v", got, want)
+	}
+	c.advance(39 * time.Millisecond)
+	if got := repeats(); len(got) != 0 {
+		t.Fatalf("repeats %v within the period", got)
+	}
+	c.advance(121 * time.Millisecond)
+	if got, want := repeats(), []uint32{1640, 1680, 1720, 1760}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("repeats are %v, want %v", got, want)
+	}
+}
+
+func TestKeyRepeatDisabled(t *testing.T) {
+	r, c, repeats := newTestRepeater(t, 0, 600)
+	pressKey(r, 30, 1000)
+	c.advance(10 * time.Second)
+	if got := repeats(); len(got) != 0 {
+		t.Errorf("repeats %v with a rate of zero", got)
+	}
+	if n := c.pending(); n != 0 {
+		t.Errorf("%d timers are pending with a rate of zero", n)
+	}
+}
+
+func TestKeyRepeatRelease(t *testing.T) {
+	r, c, repeats := newTestRepeater(t, 10, 200)
+	pressKey(r, 30, 0)
+	c.advance(200 * time.Millisecond)
+
+	// Releasing another key, such as a modifier, doesn't stop the repeat.
+	r.release(42)
+	c.advance(100 * time.Millisecond)
+	if got, want := repeats(), []uint32{200, 300}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("repeats are %v, want %v", got, want)
+	}
+
+	r.release(30)
+	c.advance(time.Second)
+	if got := repeats(); len(got) != 0 {
+		t.Errorf("repeats %v after the key was released", got)
+	}
+	if n := c.pending(); n != 0 {
+		t.Errorf("%d timers are pending after the key was released", n)
+	}
+}
+
+func TestKeyRepeatSetRate(t *testing.T) {
+	r, c, repeats := newTestRepeater(t, 10, 200)
+	pressKey(r, 30, 0)
+	c.advance(250 * time.Millisecond)
+	if got, want := repeats(), []uint32{200}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("repeats are %v, want %v", got, want)
+	}
+
+	// Changing the rate stops the key that is repeating.
+	r.setRate(50, 100)
+	c.advance(time.Second)
+	if got := repeats(); len(got) != 0 {
+		t.Fatalf("repeats %v after the rate changed", got)
+	}
+
+	// The next press repeats at the new rate.
+	pressKey(r, 30, 2000)
+	c.advance(160 * time.Millisecond)
+	if got, want := repeats(), []uint32{2100, 2120, 2140, 2160}; !reflect.DeepEqual(got, want) {
+		t.Errorf("repeats at the new rate are %v, want %v", got, want)
+	}
+}
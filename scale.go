@@ -0,0 +1,258 @@
+package jtk
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// Window sizes are logical, while frames, damage and buffers are in buffer
+// pixels: the logical size multiplied by the window's scale, rounded.
+//
+// The scale is chosen, in order of preference, from the window options, the
+// JTK_SCALE environment variable, the scale preferred by the compositor
+// through wp_fractional_scale_v1, and the highest scale of the outputs the
+// window is shown on, so that it is sharp everywhere.
+//
+// When wp_viewporter is available, the buffer is mapped to the logical size
+// with wp_viewport.set_destination, which allows any scale. Otherwise, the
+// scale is rounded up to an integer and set with wl_surface.set_buffer_scale.
+
+// fractionalScaleDenominator is the denominator of scales sent by
+// wp_fractional_scale_v1.
+const fractionalScaleDenominator = 120
+
+// Scale returns the scale at which the window is drawn: the number of buffer
+// pixels per logical pixel, in each direction.
+func (w *Window) Scale() float64 {
+	return w.scale
+}
+
+// SetScale overrides the scale at which the window is drawn. Zero restores
+// the automatic scale; see WindowOptions.Scale.
+func (w *Window) SetScale(scale float64) {
+	if scale < 0 {
+		scale = 0
+	}
+	w.opts.Scale = scale
+	w.updateScale()
+}
+
+// OnScale sets a function to be called when the scale of the window changes,
+// e.g. because it was moved to another output. The window is redrawn at the
+// new scale afterwards.
+func (w *Window) OnScale(fn func(scale float64)) {
+	w.onScale = fn
+}
+
+// initScale creates the viewport and fractional scale objects for the window,
+// if supported, and sets the initial scale.
+func (w *Window) initScale() error {
+	conn := w.app.conn
+
+	w.scaler = newSurfaceScaler(w.surface)
+	if err := w.scaler.createViewport(conn); err != nil {
+		return err
+	}
+
+	// Fractional scales are useless without a viewport to map them.
+	if manager := conn.Globals().WpFractionalScaleManagerV1(); manager != nil && w.scaler.viewport != nil {
+		fractionalScale, err := manager.GetFractionalScale(conn, w.surface.ID())
+		if err != nil {
+			return fmt.Errorf("creating fractional scale: %w", err)
+		}
+		w.fractionalScale = fractionalScale
+		conn.RegisterHandler(fractionalScale.ID(), wayland.HandlerFunc(w.handleFractionalScale))
+	}
+
+	w.updateScale()
+	return nil
+}
+
+// destroyScale destroys the viewport and fractional scale objects. Both are
+// destroyed even if destroying the first fails.
+func (w *Window) destroyScale() error {
+	conn := w.app.conn
+	var err error
+	if w.fractionalScale != nil {
+		conn.UnregisterHandlers(w.fractionalScale.ID())
+		err = w.fractionalScale.Destroy(conn)
+		w.fractionalScale = nil
+	}
+	if serr := w.scaler.destroy(conn); err == nil {
+		err = serr
+	}
+	return err
+}
+
+func (w *Window) handleSurface(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WlSurfaceEnterEvent:
+		o := w.app.output(t.Output)
+		if o == nil {
+			return
+		}
+		for _, other := range w.outputs {
+			if other == o {
+				return
+			}
+		}
+		w.outputs = append(w.outputs, o)
+		w.updateScale()
+	case *wayland.WlSurfaceLeaveEvent:
+		if o := w.app.output(t.Output); o != nil {
+			w.leaveOutput(o)
+		}
+	}
+}
+
+func (w *Window) handleFractionalScale(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WpFractionalScaleV1PreferredScaleEvent:
+		w.preferredScale = float64(t.Scale) / fractionalScaleDenominator
+		w.updateScale()
+	}
+}
+
+// leaveOutput removes an output from the outputs the window is shown on.
+func (w *Window) leaveOutput(o *Output) {
+	for i, other := range w.outputs {
+		if other == o {
+			w.outputs = append(w.outputs[:i], w.outputs[i+1:]...)
+			w.updateScale()
+			return
+		}
+	}
+}
+
+// updateScale recomputes the scale of the window, and redraws the window if
+// it changed.
+func (w *Window) updateScale() {
+	if w.closed {
+		return
+	}
+
+	scale := w.wantedScale()
+	if w.scaler.viewport == nil {
+		// Only integer buffer scales are possible, and only with wl_surface
+		// version 3.
+		scale = math.Ceil(scale)
+		if w.surface.Version() < 3 {
+			scale = 1
+		}
+	}
+	if scale == w.scale {
+		return
+	}
+
+	w.scale = scale
+	if w.onScale != nil {
+		w.onScale(scale)
+	}
+	w.Invalidate()
+}
+
+// wantedScale returns the scale the window should be drawn at, before taking
+// into account what the compositor supports.
+func (w *Window) wantedScale() float64 {
+	switch {
+	case w.opts.Scale > 0:
+		return w.opts.Scale
+	case w.app.scale > 0:
+		return w.app.scale
+	case w.preferredScale > 0:
+		return w.preferredScale
+	case len(w.outputs) == 0:
+		// Keep the current scale while the window isn't shown anywhere.
+		return w.scale
+	}
+
+	scale := 1
+	for _, o := range w.outputs {
+		if o.scale > scale {
+			scale = o.scale
+		}
+	}
+	return float64(scale)
+}
+
+// applyScale tells the compositor how the buffer of a frame maps to the
+// surface, if it changed since the last frame.
+func (w *Window) applyScale(f *Frame) error {
+	return w.scaler.apply(w.app.conn, f.Scale, f.size)
+}
+
+// surfaceScaler maps buffers drawn at a scale onto a surface, remembering what
+// was last sent to avoid repeating requests.
+type surfaceScaler struct {
+	surface  *wayland.WlSurface
+	viewport *wayland.WpViewport
+
+	bufferScale int
+	destination image.Point
+}
+
+func newSurfaceScaler(surface *wayland.WlSurface) surfaceScaler {
+	return surfaceScaler{surface: surface, bufferScale: 1, destination: image.Pt(-1, -1)}
+}
+
+// apply maps the next buffer, drawn at scale, onto size logical pixels: with
+// the viewport if there is one, and with the buffer scale otherwise, in which
+// case scale must be an integer.
+func (s *surfaceScaler) apply(conn *wayland.Display, scale float64, size image.Point) error {
+	bufferScale, destination := 1, image.Pt(-1, -1)
+	if s.viewport != nil {
+		if scale != 1 {
+			destination = size
+		}
+	} else {
+		bufferScale = int(scale)
+	}
+
+	if bufferScale != s.bufferScale {
+		if err := s.surface.SetBufferScale(conn, int32(bufferScale)); err != nil {
+			return err
+		}
+		s.bufferScale = bufferScale
+	}
+	if s.viewport != nil && destination != s.destination {
+		if err := s.viewport.SetDestination(conn, int32(destination.X), int32(destination.Y)); err != nil {
+			return err
+		}
+		s.destination = destination
+	}
+	return nil
+}
+
+// createViewport creates a viewport for the surface, if wp_viewporter is
+// supported.
+func (s *surfaceScaler) createViewport(conn *wayland.Display) error {
+	viewporter := conn.Globals().WpViewporter()
+	if viewporter == nil {
+		return nil
+	}
+	viewport, err := viewporter.GetViewport(conn, s.surface.ID())
+	if err != nil {
+		return fmt.Errorf("creating viewport: %w", err)
+	}
+	s.viewport = viewport
+	return nil
+}
+
+// destroy destroys the viewport, if any.
+func (s *surfaceScaler) destroy(conn *wayland.Display) error {
+	if s.viewport == nil {
+		return nil
+	}
+	viewport := s.viewport
+	s.viewport = nil
+	return viewport.Destroy(conn)
+}
+
+// scaleSize scales a logical size to buffer pixels, rounding halfway away
+// from zero, as required for toplevel surfaces by wp_fractional_scale_v1.
+func scaleSize(size int, scale float64) int {
+	return int(math.Round(float64(size) * scale))
+}
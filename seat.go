@@ -0,0 +1,756 @@
+package jtk
+
+import (
+	"fmt"
+	"syscall"
+
+	"github.com/jchv/jtk/internal/wayland"
+	"github.com/jchv/jtk/xkb"
+)
+
+// seat is a group of input devices, as advertised by wl_seat. Devices are
+// created and released as the seat's capabilities change, e.g. when a mouse is
+// plugged in or removed.
+type seat struct {
+	app  *Application
+	seat *wayland.WlSeat
+
+	pointer      *wayland.WlPointer
+	pointerFocus pointerTarget
+	pointerX     float64
+	pointerY     float64
+	frame        pointerFrame
+
+	// enterSerial is the serial of the last wl_pointer.enter, which the
+	// cursor is set with; see cursor.go.
+	enterSerial uint32
+	cursor      cursorSurface
+
+	// relativePointer reports relative motion; see constraint.go.
+	relativePointer *wayland.ZwpRelativePointerV1
+
+	// Touchpad gestures; see gesture.go.
+	swipe         *wayland.ZwpPointerGestureSwipeV1
+	pinch         *wayland.ZwpPointerGesturePinchV1
+	hold          *wayland.ZwpPointerGestureHoldV1
+	gestureTarget pointerTarget
+	gestureEvent  GestureEvent
+
+	keyboard      *wayland.WlKeyboard
+	keyboardFocus *Window
+	keymap        *xkb.Keymap
+	xkbState      *xkb.State
+	modifiers     Modifiers
+	repeat        keyRepeater
+
+	// modState is the last wl_keyboard.modifiers state: depressed, latched
+	// and locked modifiers, and group.
+	modState [4]uint32
+
+	touch       *wayland.WlTouch
+	touches     []*touchPoint
+	touchSerial uint32
+	touchTime   uint32
+
+	// emulating is the touch point that emulates the pointer, if any.
+	emulating *touchPoint
+
+	// inputSerial is the serial of the last key press, button press or touch,
+	// which requests such as setting the selection must be tied to.
+	inputSerial uint32
+
+	// Data transfer; see data.go.
+	dataDevice *wayland.WlDataDevice
+	offers     map[wayland.ObjectID]*dataOffer
+	selection  *dataOffer
+	clipboard  *dataSource
+	drag       *dragSource
+	dragOffer  *DragOffer
+	dragTarget *Window
+
+	// Tablets; see tablet.go.
+	tabletSeat *wayland.ZwpTabletSeatV2
+	tablets    []*Tablet
+	tools      []*TabletTool
+	pads       []*TabletPad
+}
+
+// pointerTarget receives pointer events for a surface. Coordinates are
+// logical, relative to the surface.
+type pointerTarget interface {
+	pointer(s *seat, e *PointerEvent)
+}
+
+// touchTarget receives touch events for a surface. It returns false if it
+// doesn't handle them, so that the pointer is emulated instead.
+type touchTarget interface {
+	touch(s *seat, e *TouchEvent) bool
+}
+
+// touchPoint is a touch point that is down, along with the surface it went
+// down on.
+type touchPoint struct {
+	TouchPoint
+	target  pointerTarget
+	serial  uint32
+	changed bool
+}
+
+// pointerFrame accumulates the pointer events that make up a wl_pointer.frame.
+type pointerFrame struct {
+	leave       bool
+	leaveSerial uint32
+
+	enter        bool
+	enterSerial  uint32
+	enterSurface wayland.ObjectID
+
+	motion bool
+	time   uint32
+	x, y   float64
+
+	relative       bool
+	relativeMotion RelativeMotionEvent
+
+	buttons []PointerEvent
+
+	axis     bool
+	axisTime uint32
+	source   AxisSource
+	dx, dy   float64
+	value120 [2]int32
+	stop     [2]bool
+}
+
+func newSeat(app *Application, proxy *wayland.WlSeat) *seat {
+	s := &seat{app: app, seat: proxy}
+	s.repeat = keyRepeater{clock: app, emit: s.emitRepeat}
+
+	// Before wl_keyboard version 4, there is no repeat_info; use the usual
+	// defaults.
+	s.repeat.setRate(25, 600)
+	app.conn.RegisterHandler(proxy.ID(), wayland.HandlerFunc(s.handle))
+	s.initTablets()
+	s.initDataDevice()
+	return s
+}
+
+func (s *seat) handle(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WlSeatCapabilitiesEvent:
+		caps := wayland.WlSeatCapability(t.Capabilities)
+		s.setPointer(caps&wayland.WlSeatCapabilityPointer != 0)
+		s.setKeyboard(caps&wayland.WlSeatCapabilityKeyboard != 0)
+		s.setTouch(caps&wayland.WlSeatCapabilityTouch != 0)
+	}
+}
+
+// setPointer creates or releases the pointer device.
+func (s *seat) setPointer(present bool) {
+	conn := s.app.conn
+	if present && s.pointer == nil {
+		pointer, err := s.seat.GetPointer(conn)
+		if err != nil {
+			return
+		}
+		s.pointer = pointer
+		conn.RegisterHandler(pointer.ID(), wayland.HandlerFunc(s.handlePointer))
+		s.initGestures()
+		s.initRelativePointer()
+	} else if !present && s.pointer != nil {
+		s.destroyGestures()
+		s.destroyRelativePointer()
+		for _, w := range s.app.windows {
+			w.ReleasePointer()
+		}
+		if s.pointerFocus != nil {
+			s.pointerFocus.pointer(s, &PointerEvent{Type: PointerLeave, X: s.pointerX, Y: s.pointerY})
+			s.pointerFocus = nil
+		}
+		s.frame = pointerFrame{}
+		s.cursor.destroy(conn)
+		conn.UnregisterHandlers(s.pointer.ID())
+		if s.pointer.Version() >= 3 {
+			s.pointer.Release(conn)
+		}
+		s.pointer = nil
+	}
+}
+
+func (s *seat) handlePointer(event wayland.Event) {
+	f := &s.frame
+	switch t := event.(type) {
+	case *wayland.WlPointerEnterEvent:
+		f.enter = true
+		f.enterSerial = t.Serial
+		f.enterSurface = t.Surface
+		f.x, f.y = t.SurfaceX.Float(), t.SurfaceY.Float()
+	case *wayland.WlPointerLeaveEvent:
+		// A leave always comes before the enter on another surface, if both
+		// are in the same frame.
+		f.leave = true
+		f.leaveSerial = t.Serial
+	case *wayland.WlPointerMotionEvent:
+		f.motion = true
+		f.time = t.Time
+		f.x, f.y = t.SurfaceX.Float(), t.SurfaceY.Float()
+	case *wayland.WlPointerButtonEvent:
+		s.inputSerial = t.Serial
+		f.buttons = append(f.buttons, PointerEvent{
+			Type:    PointerButton,
+			Serial:  t.Serial,
+			Time:    t.Time,
+			Button:  Button(t.Button),
+			Pressed: wayland.WlPointerButtonState(t.State) == wayland.WlPointerButtonStatePressed,
+		})
+	case *wayland.WlPointerAxisEvent:
+		f.axis = true
+		f.axisTime = t.Time
+		if wayland.WlPointerAxis(t.Axis) == wayland.WlPointerAxisHorizontalScroll {
+			f.dx += t.Value.Float()
+		} else {
+			f.dy += t.Value.Float()
+		}
+	case *wayland.WlPointerAxisSourceEvent:
+		f.axis = true
+		f.source = AxisSource(t.AxisSource) + 1
+	case *wayland.WlPointerAxisStopEvent:
+		f.axis = true
+		f.axisTime = t.Time
+		f.stop[axisIndex(t.Axis)] = true
+	case *wayland.WlPointerAxisDiscreteEvent:
+		// wl_pointer version 8 replaces discrete steps with value120, which
+		// the bindings don't have yet; express steps the same way.
+		f.axis = true
+		f.value120[axisIndex(t.Axis)] += t.Discrete * 120
+	case *wayland.WlPointerFrameEvent:
+		s.flushPointer()
+		return
+	}
+
+	// Before version 5, there are no frames: each event stands alone.
+	if s.pointer.Version() < 5 {
+		s.flushPointer()
+	}
+}
+
+// axisIndex returns the index of a wl_pointer axis in pointerFrame arrays: 0
+// for horizontal and 1 for vertical.
+func axisIndex(axis uint32) int {
+	if wayland.WlPointerAxis(axis) == wayland.WlPointerAxisHorizontalScroll {
+		return 0
+	}
+	return 1
+}
+
+// flushPointer delivers the events accumulated since the last frame.
+func (s *seat) flushPointer() {
+	f := &s.frame
+
+	if f.leave && s.pointerFocus != nil {
+		target := s.pointerFocus
+		s.pointerFocus = nil
+		s.cursor.reset()
+		target.pointer(s, &PointerEvent{Type: PointerLeave, Serial: f.leaveSerial, X: s.pointerX, Y: s.pointerY})
+	}
+
+	if f.enter {
+		s.pointerFocus = s.app.pointerTargets[f.enterSurface]
+		s.pointerX, s.pointerY = f.x, f.y
+		s.enterSerial = f.enterSerial
+		if s.pointerFocus != nil {
+			s.pointerFocus.pointer(s, &PointerEvent{Type: PointerEnter, Serial: f.enterSerial, X: f.x, Y: f.y})
+		}
+		s.updateCursor()
+	} else if f.motion {
+		s.pointerX, s.pointerY = f.x, f.y
+		if s.pointerFocus != nil {
+			s.pointerFocus.pointer(s, &PointerEvent{Type: PointerMotion, Time: f.time, X: f.x, Y: f.y})
+		}
+		s.updateCursor()
+	}
+
+	if w, ok := s.pointerFocus.(*Window); ok && f.relative {
+		w.relativeMotion(s, &f.relativeMotion)
+	}
+
+	// The target may go away while handling events, e.g. when a button closes
+	// the window, so check for it before each one.
+	for i := range f.buttons {
+		if s.pointerFocus == nil {
+			break
+		}
+		e := f.buttons[i]
+		e.X, e.Y = s.pointerX, s.pointerY
+		s.pointerFocus.pointer(s, &e)
+	}
+
+	if f.axis && s.pointerFocus != nil {
+		s.pointerFocus.pointer(s, &PointerEvent{
+			Type:      PointerAxis,
+			Time:      f.axisTime,
+			X:         s.pointerX,
+			Y:         s.pointerY,
+			Source:    f.source,
+			DX:        f.dx,
+			DY:        f.dy,
+			Value120X: f.value120[0],
+			Value120Y: f.value120[1],
+			StopX:     f.stop[0],
+			StopY:     f.stop[1],
+		})
+	}
+
+	*f = pointerFrame{buttons: f.buttons[:0]}
+}
+
+// initRelativePointer creates the relative pointer object, if
+// zwp_relative_pointer_manager_v1 is supported.
+func (s *seat) initRelativePointer() {
+	manager := s.app.conn.Globals().ZwpRelativePointerManagerV1()
+	if manager == nil {
+		return
+	}
+	relativePointer, err := manager.GetRelativePointer(s.app.conn, s.pointer.ID())
+	if err != nil {
+		return
+	}
+	s.relativePointer = relativePointer
+	s.app.conn.RegisterHandler(relativePointer.ID(), wayland.HandlerFunc(s.handleRelativePointer))
+}
+
+// destroyRelativePointer destroys the relative pointer object, if any.
+func (s *seat) destroyRelativePointer() {
+	if s.relativePointer == nil {
+		return
+	}
+	conn := s.app.conn
+	conn.UnregisterHandlers(s.relativePointer.ID())
+	s.relativePointer.Destroy(conn)
+	s.relativePointer = nil
+}
+
+func (s *seat) handleRelativePointer(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.ZwpRelativePointerV1RelativeMotionEvent:
+		// Relative motion is part of the wl_pointer frame, along with the
+		// absolute motion, if any.
+		f := &s.frame
+		f.relative = true
+		e := &f.relativeMotion
+		e.Time = uint64(t.UtimeHi)<<32 | uint64(t.UtimeLo)
+		e.DX += t.Dx.Float()
+		e.DY += t.Dy.Float()
+		e.UnacceleratedDX += t.DxUnaccel.Float()
+		e.UnacceleratedDY += t.DyUnaccel.Float()
+		if s.pointer.Version() < 5 {
+			s.flushPointer()
+		}
+	}
+}
+
+// removeTarget forgets a pointer target that is going away, and the keyboard
+// and pad focus if it is a window.
+func (s *seat) removeTarget(target pointerTarget) {
+	if s.pointerFocus == target {
+		s.pointerFocus = nil
+	}
+	if s.gestureTarget == target {
+		s.gestureTarget = nil
+	}
+	if w, ok := target.(*Window); ok && s.keyboardFocus == w {
+		s.keyboardFocus = nil
+		s.repeat.stop()
+	}
+	if w, ok := target.(*Window); ok && s.dragTarget == w {
+		s.dragTarget = nil
+	}
+	for _, p := range s.touches {
+		if p.target == target {
+			p.target = nil
+		}
+	}
+	if s.emulating != nil && s.emulating.target == nil {
+		s.emulating = nil
+	}
+	for _, tool := range s.tools {
+		if tool.target == target {
+			tool.target, tool.emulating = nil, false
+		}
+	}
+	for _, pad := range s.pads {
+		if w, ok := target.(*Window); ok && pad.focus == w {
+			pad.focus = nil
+		}
+	}
+}
+
+// setKeyboard creates or releases the keyboard device.
+func (s *seat) setKeyboard(present bool) {
+	conn := s.app.conn
+	if present && s.keyboard == nil {
+		keyboard, err := s.seat.GetKeyboard(conn)
+		if err != nil {
+			return
+		}
+		s.keyboard = keyboard
+		conn.RegisterHandler(keyboard.ID(), wayland.HandlerFunc(s.handleKeyboard))
+	} else if !present && s.keyboard != nil {
+		s.setKeyboardFocus(nil)
+		conn.UnregisterHandlers(s.keyboard.ID())
+		if s.keyboard.Version() >= 3 {
+			s.keyboard.Release(conn)
+		}
+		s.keyboard = nil
+		s.keymap, s.xkbState = nil, nil
+		s.modifiers, s.modState = 0, [4]uint32{}
+	}
+}
+
+func (s *seat) handleKeyboard(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WlKeyboardKeymapEvent:
+		s.repeat.stop()
+		s.keymap, s.xkbState = nil, nil
+		if wayland.WlKeyboardKeymapFormat(t.Format) != wayland.WlKeyboardKeymapFormatXkbV1 {
+			syscall.Close(int(t.FD))
+			return
+		}
+		keymap, err := loadKeymap(int(t.FD), int(t.Size))
+		syscall.Close(int(t.FD))
+		if err != nil {
+			// Without a keymap, keys still arrive, but without keysyms or
+			// text.
+			return
+		}
+		s.keymap, s.xkbState = keymap, xkb.NewState(keymap)
+	case *wayland.WlKeyboardEnterEvent:
+		s.inputSerial = t.Serial
+		for _, w := range s.app.windows {
+			if w.surface.ID() == t.Surface {
+				s.setKeyboardFocus(w)
+				break
+			}
+		}
+	case *wayland.WlKeyboardLeaveEvent:
+		s.setKeyboardFocus(nil)
+	case *wayland.WlKeyboardKeyEvent:
+		s.inputSerial = t.Serial
+		e := &KeyEvent{
+			Serial:    t.Serial,
+			Time:      t.Time,
+			Key:       t.Key,
+			Pressed:   wayland.WlKeyboardKeyState(t.State) == wayland.WlKeyboardKeyStatePressed,
+			Modifiers: s.modifiers,
+		}
+		if s.xkbState != nil {
+			// xkb keycodes are offset from Linux input event codes by 8.
+			e.Keysym = s.xkbState.Keysym(t.Key + 8)
+			e.Text = s.xkbState.Text(t.Key + 8)
+		}
+		if s.keyboardFocus == nil {
+			return
+		}
+		if !e.Pressed {
+			s.repeat.release(e.Key)
+		} else if s.keymap != nil && s.keymap.Repeats(t.Key+8) {
+			s.repeat.press(e)
+		}
+		s.keyboardFocus.key(e)
+	case *wayland.WlKeyboardModifiersEvent:
+		// The repeating key would produce something else now, so rather than
+		// switch mid-repeat, stop.
+		state := [4]uint32{t.ModsDepressed, t.ModsLatched, t.ModsLocked, t.Group}
+		if state != s.modState {
+			s.modState = state
+			s.repeat.stop()
+		}
+		if s.xkbState == nil {
+			return
+		}
+		s.xkbState.UpdateMask(t.ModsDepressed, t.ModsLatched, t.ModsLocked, t.Group)
+		s.modifiers = 0
+		for _, mod := range modifierNames {
+			for _, name := range mod.names {
+				if s.xkbState.ModActive(name) {
+					s.modifiers |= mod.mod
+					break
+				}
+			}
+		}
+	case *wayland.WlKeyboardRepeatInfoEvent:
+		s.repeat.setRate(t.Rate, t.Delay)
+	}
+}
+
+// emitRepeat delivers a repeated key to the focused window.
+func (s *seat) emitRepeat(e *KeyEvent) {
+	if s.keyboardFocus == nil {
+		s.repeat.stop()
+		return
+	}
+	s.keyboardFocus.key(e)
+}
+
+// modifierNames lists the xkb modifiers that make up each of Modifiers, in
+// order of preference: keymaps normally map the virtual modifiers Alt and
+// Super, and otherwise the real modifiers Mod1 and Mod4 are used by
+// convention.
+var modifierNames = []struct {
+	mod   Modifiers
+	names []string
+}{
+	{ModShift, []string{"Shift"}},
+	{ModControl, []string{"Control"}},
+	{ModAlt, []string{"Alt", "Mod1"}},
+	{ModSuper, []string{"Super", "Mod4"}},
+	{ModCapsLock, []string{"Lock"}},
+	{ModNumLock, []string{"NumLock", "Mod2"}},
+}
+
+// setKeyboardFocus moves the keyboard focus to a window, or nowhere.
+func (s *seat) setKeyboardFocus(w *Window) {
+	if s.keyboardFocus == w {
+		return
+	}
+	s.repeat.stop()
+	if old := s.keyboardFocus; old != nil {
+		s.keyboardFocus = nil
+		old.focus(false)
+	}
+	s.keyboardFocus = w
+	if w != nil {
+		w.focus(true)
+	}
+}
+
+// loadKeymap maps a keymap sent by the compositor and compiles it.
+func loadKeymap(fd, size int) (*xkb.Keymap, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("empty keymap")
+	}
+	// The compositor may share the same read-only memory with every client,
+	// so it must be mapped privately.
+	data, err := syscall.Mmap(fd, 0, size, syscall.PROT_READ, syscall.MAP_PRIVATE)
+	if err != nil {
+		return nil, fmt.Errorf("mapping keymap: %w", err)
+	}
+	defer syscall.Munmap(data)
+	return xkb.ParseKeymap(data)
+}
+
+// setTouch creates or releases the touch device.
+func (s *seat) setTouch(present bool) {
+	conn := s.app.conn
+	if present && s.touch == nil {
+		touch, err := s.seat.GetTouch(conn)
+		if err != nil {
+			return
+		}
+		s.touch = touch
+		conn.RegisterHandler(touch.ID(), wayland.HandlerFunc(s.handleTouch))
+	} else if !present && s.touch != nil {
+		s.cancelTouch()
+		conn.UnregisterHandlers(s.touch.ID())
+		if s.touch.Version() >= 3 {
+			s.touch.Release(conn)
+		}
+		s.touch = nil
+	}
+}
+
+func (s *seat) handleTouch(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.WlTouchDownEvent:
+		p := &touchPoint{
+			TouchPoint: TouchPoint{ID: t.ID, State: TouchDown, X: t.X.Float(), Y: t.Y.Float()},
+			target:     s.app.pointerTargets[t.Surface],
+			serial:     t.Serial,
+			changed:    true,
+		}
+		s.touches = append(s.touches, p)
+		s.touchSerial, s.touchTime = t.Serial, t.Time
+		s.inputSerial = t.Serial
+	case *wayland.WlTouchUpEvent:
+		p := s.touchPoint(t.ID)
+		if p == nil {
+			return
+		}
+		if p.State == TouchDown && p.changed {
+			// The point went down and up in the same frame; deliver the down
+			// first, so that it isn't missed.
+			s.flushTouch()
+		}
+		p.State, p.serial, p.changed = TouchUp, t.Serial, true
+		s.touchSerial, s.touchTime = t.Serial, t.Time
+	case *wayland.WlTouchMotionEvent:
+		if p := s.touchPoint(t.ID); p != nil {
+			p.X, p.Y = t.X.Float(), t.Y.Float()
+			s.touchMoved(p)
+			s.touchTime = t.Time
+		}
+	case *wayland.WlTouchShapeEvent:
+		if p := s.touchPoint(t.ID); p != nil {
+			p.Major, p.Minor = t.Major.Float(), t.Minor.Float()
+			s.touchMoved(p)
+		}
+	case *wayland.WlTouchOrientationEvent:
+		if p := s.touchPoint(t.ID); p != nil {
+			p.Orientation = t.Orientation.Float()
+			s.touchMoved(p)
+		}
+	case *wayland.WlTouchFrameEvent:
+		s.flushTouch()
+	case *wayland.WlTouchCancelEvent:
+		s.cancelTouch()
+	}
+}
+
+// touchPoint returns the point that is down with an ID, or nil.
+func (s *seat) touchPoint(id int32) *touchPoint {
+	for _, p := range s.touches {
+		if p.ID == id && p.State != TouchUp {
+			return p
+		}
+	}
+	return nil
+}
+
+// touchMoved marks a point as changed, without hiding that it just went
+// down.
+func (s *seat) touchMoved(p *touchPoint) {
+	if p.State == TouchStationary {
+		p.State = TouchMotion
+	}
+	p.changed = true
+}
+
+// flushTouch delivers the changes since the last frame, to each target in
+// turn.
+func (s *seat) flushTouch() {
+	var targets []pointerTarget
+	for _, p := range s.touches {
+		if !p.changed || p.target == nil {
+			continue
+		}
+		seen := false
+		for _, target := range targets {
+			seen = seen || target == p.target
+		}
+		if !seen {
+			targets = append(targets, p.target)
+		}
+	}
+	for _, target := range targets {
+		s.deliverTouch(target, false)
+	}
+
+	// Forget lifted points, and settle the rest.
+	touches := s.touches[:0]
+	for _, p := range s.touches {
+		if p.State != TouchUp {
+			p.State, p.changed = TouchStationary, false
+			touches = append(touches, p)
+		}
+	}
+	for i := len(touches); i < len(s.touches); i++ {
+		s.touches[i] = nil
+	}
+	s.touches = touches
+}
+
+// cancelTouch cancels every point, and forgets them.
+func (s *seat) cancelTouch() {
+	var targets []pointerTarget
+	for _, p := range s.touches {
+		seen := p.target == nil
+		for _, target := range targets {
+			seen = seen || target == p.target
+		}
+		if !seen {
+			targets = append(targets, p.target)
+		}
+	}
+	for _, target := range targets {
+		s.deliverTouch(target, true)
+	}
+	s.touches = nil
+	s.emulating = nil
+}
+
+// deliverTouch delivers the points on a target, or emulates the pointer if the
+// target doesn't handle touch.
+func (s *seat) deliverTouch(target pointerTarget, cancel bool) {
+	e := &TouchEvent{Serial: s.touchSerial, Time: s.touchTime}
+	for _, p := range s.touches {
+		if p.target != target {
+			continue
+		}
+		point := p.TouchPoint
+		if cancel {
+			point.State = TouchCancelled
+		}
+		e.Points = append(e.Points, point)
+	}
+	if len(e.Points) == 0 {
+		return
+	}
+	if w, ok := target.(*Window); ok && w.recognizer != nil {
+		w.recognizer.touch(s, e)
+		if w.closed {
+			return
+		}
+	}
+	if t, ok := target.(touchTarget); ok && t.touch(s, e) {
+		return
+	}
+
+	if s.emulating == nil && !cancel {
+		for _, p := range s.touches {
+			if p.target == target && p.State == TouchDown {
+				s.emulating = p
+				break
+			}
+		}
+		if s.emulating == nil {
+			return
+		}
+		p := s.emulating
+		target.pointer(s, &PointerEvent{Type: PointerEnter, Serial: p.serial, X: p.X, Y: p.Y, Emulated: true})
+		target.pointer(s, &PointerEvent{
+			Type:     PointerButton,
+			Serial:   p.serial,
+			Time:     s.touchTime,
+			X:        p.X,
+			Y:        p.Y,
+			Button:   ButtonLeft,
+			Pressed:  true,
+			Emulated: true,
+		})
+		return
+	}
+
+	p := s.emulating
+	if p == nil || p.target != target {
+		return
+	}
+	switch {
+	case cancel:
+		s.emulating = nil
+		target.pointer(s, &PointerEvent{Type: PointerLeave, X: p.X, Y: p.Y, Emulated: true})
+	case p.State == TouchMotion:
+		target.pointer(s, &PointerEvent{Type: PointerMotion, Time: s.touchTime, X: p.X, Y: p.Y, Emulated: true})
+	case p.State == TouchUp:
+		s.emulating = nil
+		target.pointer(s, &PointerEvent{
+			Type:     PointerButton,
+			Serial:   p.serial,
+			Time:     s.touchTime,
+			X:        p.X,
+			Y:        p.Y,
+			Button:   ButtonLeft,
+			Emulated: true,
+		})
+		target.pointer(s, &PointerEvent{Type: PointerLeave, Serial: p.serial, X: p.X, Y: p.Y, Emulated: true})
+	}
+}
@@ -0,0 +1,373 @@
+package jtk
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"os"
+	"syscall"
+
+	"github.com/jchv/jtk/draw"
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// PixelFormat is a shared memory pixel format.
+type PixelFormat uint32
+
+// Pixel formats supported by buffer pools. Every compositor supports these.
+const (
+	// FormatARGB8888 is 32-bit ARGB with premultiplied alpha.
+	FormatARGB8888 = PixelFormat(wayland.WlShmFormatArgb8888)
+
+	// FormatXRGB8888 is 32-bit RGB, with the alpha byte unused.
+	FormatXRGB8888 = PixelFormat(wayland.WlShmFormatXrgb8888)
+)
+
+// Buffer offsets within the pool are aligned to this many bytes.
+const shmAlign = 64
+
+// maxPoolBuffers is the number of buffers a pool keeps for reuse. Three allow
+// drawing a frame while the compositor holds one buffer for display and
+// another queued.
+const maxPoolBuffers = 3
+
+// ErrUnsupportedFormat is returned when allocating a buffer with a pixel
+// format that buffer pools do not support.
+var ErrUnsupportedFormat = errors.New("unsupported pixel format")
+
+// BufferPool allocates buffers from a single growable wl_shm_pool. Buffers
+// are reused once the compositor releases them, so that a buffer is never
+// drawn to while the compositor may be reading it.
+type BufferPool struct {
+	app     *Application
+	file    *os.File
+	pool    *wayland.WlShmPool
+	mapping *shmMapping
+	size    int
+
+	// free contains the unallocated spans of the pool, sorted by offset.
+	free []shmSpan
+
+	buffers []*Buffer
+}
+
+// shmMapping is a memory mapping of the pool file. When the pool grows, it is
+// mapped again; earlier mappings are kept until no buffers use them.
+type shmMapping struct {
+	data []byte
+	refs int
+}
+
+type shmSpan struct {
+	offset, size int
+}
+
+// Buffer is a wl_buffer allocated from a BufferPool.
+type Buffer struct {
+	pool    *BufferPool
+	buffer  *wayland.WlBuffer
+	mapping *shmMapping
+	span    shmSpan
+	format  PixelFormat
+	image   *draw.ARGB
+
+	// acquired is true between Acquire and Present or Discard.
+	acquired bool
+
+	// busy is true while the compositor may be reading the buffer.
+	busy bool
+
+	// stale is true if the buffer should be destroyed once released.
+	stale bool
+
+	// seq identifies the frame last presented from the buffer, or is zero if
+	// the buffer has never been presented. It is maintained by Window for
+	// tracking the age of the buffer's contents.
+	seq uint64
+}
+
+// NewBufferPool creates a new, empty buffer pool.
+func NewBufferPool(app *Application) *BufferPool {
+	return &BufferPool{app: app}
+}
+
+// Acquire returns a buffer of the given size and format that is not in use by
+// the compositor, allocating one if necessary. Of the buffers not in use, the
+// one presented most recently is returned, so that as little as possible of
+// it needs redrawing.
+//
+// Buffers of other sizes or formats are destroyed once not in use. Buffers of
+// the same size and format are kept for reuse, with the least recently
+// presented destroyed first while the pool holds more than maxPoolBuffers.
+// The buffer must be given back by attaching it to a surface or calling
+// Discard.
+func (p *BufferPool) Acquire(width, height int, format PixelFormat) (*Buffer, error) {
+	if format != FormatARGB8888 && format != FormatXRGB8888 {
+		return nil, ErrUnsupportedFormat
+	}
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid buffer size %dx%d", width, height)
+	}
+
+	var found *Buffer
+	for _, b := range append([]*Buffer(nil), p.buffers...) {
+		matches := b.image.Rect.Dx() == width && b.image.Rect.Dy() == height && b.format == format
+		switch {
+		case b.acquired || b.busy:
+			if !matches {
+				b.stale = true
+			}
+		case !matches:
+			p.destroyBuffer(b)
+		case found == nil || b.seq > found.seq:
+			found = b
+		}
+	}
+
+	if found == nil {
+		var err error
+		if found, err = p.allocate(width, height, format); err != nil {
+			return nil, err
+		}
+	}
+	found.acquired = true
+	p.evict()
+	return found, nil
+}
+
+// evict destroys buffers that are not in use, least recently presented first,
+// while the pool holds more than maxPoolBuffers.
+func (p *BufferPool) evict() {
+	for len(p.buffers) > maxPoolBuffers {
+		var oldest *Buffer
+		for _, b := range p.buffers {
+			if !b.acquired && !b.busy && (oldest == nil || b.seq < oldest.seq) {
+				oldest = b
+			}
+		}
+		if oldest == nil {
+			return
+		}
+		p.destroyBuffer(oldest)
+	}
+}
+
+func (p *BufferPool) allocate(width, height int, format PixelFormat) (*Buffer, error) {
+	stride := width * 4
+	size := stride * height
+
+	span, err := p.alloc(size)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Buffer{
+		pool:    p,
+		mapping: p.mapping,
+		span:    span,
+		format:  format,
+		image: &draw.ARGB{
+			Pix:    p.mapping.data[span.offset : span.offset+size : span.offset+size],
+			Stride: stride,
+			Rect:   image.Rect(0, 0, width, height),
+			Opaque: format == FormatXRGB8888,
+		},
+	}
+
+	b.buffer, err = p.pool.CreateBuffer(p.app.conn, int32(span.offset), int32(width), int32(height), int32(stride), uint32(format))
+	if err != nil {
+		p.release(span)
+		return nil, fmt.Errorf("creating buffer: %w", err)
+	}
+
+	b.mapping.refs++
+	p.app.conn.RegisterHandler(b.buffer.ID(), wayland.HandlerFunc(b.handle))
+	p.buffers = append(p.buffers, b)
+
+	return b, nil
+}
+
+func (b *Buffer) handle(event wayland.Event) {
+	switch event.(type) {
+	case *wayland.WlBufferReleaseEvent:
+		b.busy = false
+		if b.stale && !b.acquired {
+			b.pool.destroyBuffer(b)
+		}
+	}
+}
+
+// alloc allocates a span from the pool, growing the pool if necessary.
+func (p *BufferPool) alloc(size int) (shmSpan, error) {
+	size = (size + shmAlign - 1) &^ (shmAlign - 1)
+
+	for {
+		for i, s := range p.free {
+			if s.size < size {
+				continue
+			}
+			if s.size == size {
+				p.free = append(p.free[:i], p.free[i+1:]...)
+			} else {
+				p.free[i] = shmSpan{s.offset + size, s.size - size}
+			}
+			return shmSpan{s.offset, size}, nil
+		}
+
+		if err := p.grow(size); err != nil {
+			return shmSpan{}, err
+		}
+	}
+}
+
+// release returns a span to the pool, merging it with adjacent free spans.
+func (p *BufferPool) release(span shmSpan) {
+	i := 0
+	for i < len(p.free) && p.free[i].offset < span.offset {
+		i++
+	}
+	p.free = append(p.free, shmSpan{})
+	copy(p.free[i+1:], p.free[i:])
+	p.free[i] = span
+
+	if i+1 < len(p.free) && p.free[i].offset+p.free[i].size == p.free[i+1].offset {
+		p.free[i].size += p.free[i+1].size
+		p.free = append(p.free[:i+1], p.free[i+2:]...)
+	}
+	if i > 0 && p.free[i-1].offset+p.free[i-1].size == p.free[i].offset {
+		p.free[i-1].size += p.free[i].size
+		p.free = append(p.free[:i], p.free[i+1:]...)
+	}
+}
+
+// grow grows the pool so that at least need more bytes are available at the
+// end.
+func (p *BufferPool) grow(need int) error {
+	newsize := p.size * 2
+	if newsize < p.size+need {
+		newsize = p.size + need
+	}
+	pagesize := os.Getpagesize()
+	newsize = (newsize + pagesize - 1) &^ (pagesize - 1)
+
+	if p.file == nil {
+		file, err := shmCreate(int64(newsize))
+		if err != nil {
+			return fmt.Errorf("creating shared memory file: %w", err)
+		}
+		p.file = file
+	} else if err := p.file.Truncate(int64(newsize)); err != nil {
+		return fmt.Errorf("growing shared memory file: %w", err)
+	}
+
+	data, err := syscall.Mmap(int(p.file.Fd()), 0, newsize, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		return fmt.Errorf("mapping shared memory: %w", err)
+	}
+
+	if p.pool == nil {
+		p.pool, err = p.app.shm.CreatePool(p.app.conn, wayland.FD(p.file.Fd()), int32(newsize))
+		if err != nil {
+			syscall.Munmap(data)
+			return fmt.Errorf("creating shm pool: %w", err)
+		}
+	} else if err := p.pool.Resize(p.app.conn, int32(newsize)); err != nil {
+		syscall.Munmap(data)
+		return fmt.Errorf("resizing shm pool: %w", err)
+	}
+
+	old := p.mapping
+	p.mapping = &shmMapping{data: data}
+	p.unmap(old)
+	p.release(shmSpan{p.size, newsize - p.size})
+	p.size = newsize
+
+	return nil
+}
+
+// unmap unmaps a mapping if it is no longer current or used by any buffer.
+func (p *BufferPool) unmap(m *shmMapping) {
+	if m != nil && m != p.mapping && m.refs == 0 {
+		syscall.Munmap(m.data)
+	}
+}
+
+func (p *BufferPool) destroyBuffer(b *Buffer) {
+	for i, other := range p.buffers {
+		if other == b {
+			p.buffers = append(p.buffers[:i], p.buffers[i+1:]...)
+			break
+		}
+	}
+
+	p.app.conn.UnregisterHandlers(b.buffer.ID())
+	b.buffer.Destroy(p.app.conn)
+	p.release(b.span)
+
+	b.mapping.refs--
+	p.unmap(b.mapping)
+	b.image = nil
+}
+
+// Destroy destroys the pool and all of its buffers.
+func (p *BufferPool) Destroy() {
+	for len(p.buffers) > 0 {
+		p.destroyBuffer(p.buffers[0])
+	}
+	if p.pool != nil {
+		p.pool.Destroy(p.app.conn)
+		p.pool = nil
+	}
+	if p.mapping != nil {
+		syscall.Munmap(p.mapping.data)
+		p.mapping = nil
+	}
+	if p.file != nil {
+		p.file.Close()
+		p.file = nil
+	}
+	p.free = nil
+	p.size = 0
+}
+
+// Image returns an image for drawing into the buffer. It must not be used
+// after the buffer has been presented or discarded.
+func (b *Buffer) Image() *draw.ARGB {
+	return b.image
+}
+
+// Format returns the pixel format of the buffer.
+func (b *Buffer) Format() PixelFormat {
+	return b.format
+}
+
+// Discard gives back a buffer acquired from the pool without presenting it.
+func (b *Buffer) Discard() {
+	b.acquired = false
+	if b.stale && !b.busy {
+		b.pool.destroyBuffer(b)
+	}
+}
+
+// attach attaches the buffer to a surface. The buffer is considered in use by
+// the compositor until it is released.
+func (b *Buffer) attach(surface *wayland.WlSurface) error {
+	return b.attachAt(surface, 0, 0)
+}
+
+// attachAt attaches the buffer to a surface, moving the surface by x and y
+// logical pixels, as drag icons do to place their hotspot.
+func (b *Buffer) attachAt(surface *wayland.WlSurface, x, y int32) error {
+	if !b.acquired {
+		return errors.New("buffer is not acquired")
+	}
+
+	id := b.buffer.ID()
+	if err := surface.Attach(b.pool.app.conn, &id, x, y); err != nil {
+		return err
+	}
+
+	b.acquired = false
+	b.busy = true
+	return nil
+}
@@ -0,0 +1,909 @@
+package jtk
+
+import (
+	"encoding/binary"
+
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// Tablet events are delivered to the window that a tool is in proximity of,
+// such as a pen hovering over a graphics tablet. The changes that the
+// compositor groups together with wp_tablet_tool.frame, such as moving while
+// pressing harder, are delivered as one event.
+//
+// Windows without a tablet handler receive pointer events instead, emulated
+// from each tool: the pointer enters when the tool comes into proximity, the
+// left button is pressed while the tip touches the tablet, and the pointer
+// leaves when the tool goes out of proximity. Client-side decorations are
+// always operated this way.
+//
+// Pads, the buttons, rings and strips on the tablet itself, send their events
+// to the window they are focused on, which is usually the window with keyboard
+// focus.
+
+// ToolType is the physical type of a TabletTool.
+type ToolType int
+
+// Tool types.
+const (
+	ToolPen ToolType = iota
+	ToolEraser
+	ToolBrush
+	ToolPencil
+	ToolAirbrush
+	ToolFinger
+
+	// ToolMouse is a mouse that is used on a tablet, and reports absolute
+	// positions.
+	ToolMouse
+
+	// ToolLens is a mouse with a lens for precise positioning.
+	ToolLens
+)
+
+func (t ToolType) String() string {
+	switch t {
+	case ToolPen:
+		return "pen"
+	case ToolEraser:
+		return "eraser"
+	case ToolBrush:
+		return "brush"
+	case ToolPencil:
+		return "pencil"
+	case ToolAirbrush:
+		return "airbrush"
+	case ToolFinger:
+		return "finger"
+	case ToolMouse:
+		return "mouse"
+	case ToolLens:
+		return "lens"
+	}
+	return "unknown"
+}
+
+// ToolCapabilities is a set of axes that a TabletTool reports, beyond its
+// position.
+type ToolCapabilities uint32
+
+// Tool capabilities.
+const (
+	ToolTilt ToolCapabilities = 1 << iota
+	ToolPressure
+	ToolDistance
+	ToolRotation
+	ToolSlider
+	ToolWheel
+)
+
+// Stylus buttons, from linux/input-event-codes.h. Other tools, such as tablet
+// mice, use the usual pointer buttons.
+const (
+	ButtonStylus  Button = 0x14b
+	ButtonStylus2 Button = 0x14c
+	ButtonStylus3 Button = 0x149
+)
+
+// Tablet is a graphics tablet.
+type Tablet struct {
+	tablet *wayland.ZwpTabletV2
+
+	name      string
+	vendorID  uint32
+	productID uint32
+	paths     []string
+}
+
+// Name returns the name of the tablet, if the compositor sent one.
+func (t *Tablet) Name() string {
+	return t.name
+}
+
+// USBID returns the USB vendor and product IDs of the tablet, or zero if the
+// compositor didn't send them.
+func (t *Tablet) USBID() (vendor, product uint32) {
+	return t.vendorID, t.productID
+}
+
+// Paths returns the device paths of the tablet, such as /dev/input/event12.
+func (t *Tablet) Paths() []string {
+	return append([]string(nil), t.paths...)
+}
+
+// TabletTool is a tool used with tablets, such as a pen or an eraser. The
+// same tool is reported with the same *TabletTool for as long as the
+// application runs, even across tablets.
+type TabletTool struct {
+	seat *seat
+	tool *wayland.ZwpTabletToolV2
+
+	typ            ToolType
+	caps           ToolCapabilities
+	hardwareSerial uint64
+	hardwareID     uint64
+
+	// target is the surface the tool is in proximity of, and tablet the
+	// tablet it is used with.
+	target pointerTarget
+	tablet *Tablet
+
+	// state holds the axes, which only change when sent, and frame the
+	// changes since the last wp_tablet_tool.frame. serial is the last
+	// serial sent.
+	state  TabletEvent
+	frame  tabletFrame
+	serial uint32
+
+	// emulating is set while the tool emulates the pointer on its target.
+	emulating bool
+}
+
+// tabletFrame accumulates the changes that make up a wp_tablet_tool.frame.
+type tabletFrame struct {
+	proximityIn  bool
+	proximityOut bool
+	down, up     bool
+	motion       bool
+	wheel        float64
+	clicks       int32
+	buttons      []TabletButton
+}
+
+// Type returns the physical type of the tool.
+func (t *TabletTool) Type() ToolType {
+	return t.typ
+}
+
+// Capabilities returns the axes the tool reports.
+func (t *TabletTool) Capabilities() ToolCapabilities {
+	return t.caps
+}
+
+// HardwareSerial returns the serial number of the tool, which identifies it
+// across sessions and applications, or zero if it has none. Tools without a
+// serial number can't be told apart from others of the same type.
+func (t *TabletTool) HardwareSerial() uint64 {
+	return t.hardwareSerial
+}
+
+// HardwareID returns the Wacom tool ID of the tool, which identifies its
+// model, or zero if it has none.
+func (t *TabletTool) HardwareID() uint64 {
+	return t.hardwareID
+}
+
+// TabletButton is a tool button that was pressed or released.
+type TabletButton struct {
+	Serial  uint32
+	Button  Button
+	Pressed bool
+}
+
+// TabletEvent is a change to a tool on a window.
+type TabletEvent struct {
+	Tool   *TabletTool
+	Tablet *Tablet
+
+	// Serial identifies the last time the tool came into proximity, touched
+	// the tablet or changed buttons, for requests that need to be tied to
+	// user input.
+	Serial uint32
+
+	// Time is the time of the event, in milliseconds, with an unspecified
+	// base.
+	Time uint32
+
+	// ProximityIn is set when the tool comes into proximity of the window,
+	// and ProximityOut when it leaves. No more events are sent to the
+	// window until it comes back.
+	ProximityIn  bool
+	ProximityOut bool
+
+	// Down is set when the tip of the tool touches the tablet, and Up when
+	// it is lifted. Contact is whether it is touching the tablet, and stays
+	// set for the events in between.
+	Down, Up bool
+	Contact  bool
+
+	// X and Y are the position of the tool, in logical pixels relative to
+	// the top-left corner of the window's content.
+	X, Y float64
+
+	// Pressure is the pressure of the tip, from 0 to 1, and Distance the
+	// distance of the tool from the tablet, from 0 (touching) to 1 (at the
+	// limit of proximity). Their precision depends on the tablet.
+	Pressure float64
+	Distance float64
+
+	// TiltX and TiltY are the angles of the tool from the normal of the
+	// tablet, in degrees, with positive values tilting the top of the tool
+	// to the right and towards the user.
+	TiltX, TiltY float64
+
+	// Rotation is the rotation of the tool around its axis, in degrees
+	// clockwise from its neutral position.
+	Rotation float64
+
+	// Slider is the position of a slider on the tool, such as the finger
+	// wheel of an airbrush, from -1 to 1.
+	Slider float64
+
+	// Wheel is how far a wheel on the tool turned since the last event, in
+	// degrees, and WheelClicks in logical steps. Positive values turn the
+	// wheel down or away from the user.
+	Wheel       float64
+	WheelClicks int
+
+	// Buttons are the buttons that changed, in order.
+	Buttons []TabletButton
+}
+
+// OnTablet sets a function to be called for tablet tool events on the
+// window. Until it is set, tools emulate the pointer.
+func (w *Window) OnTablet(fn func(TabletEvent)) {
+	w.onTablet = fn
+}
+
+func (w *Window) tablet(s *seat, e *TabletEvent) bool {
+	if w.onTablet == nil {
+		return false
+	}
+	w.onTablet(*e)
+	return true
+}
+
+// tabletTarget receives tablet tool events for a surface. It returns false if
+// it doesn't handle them, so that the pointer is emulated instead.
+type tabletTarget interface {
+	tablet(s *seat, e *TabletEvent) bool
+}
+
+// Tablets returns the graphics tablets known to the application.
+func (app *Application) Tablets() []*Tablet {
+	if app.seat == nil {
+		return nil
+	}
+	return append([]*Tablet(nil), app.seat.tablets...)
+}
+
+// TabletTools returns the tools that have been used with tablets since the
+// application started.
+func (app *Application) TabletTools() []*TabletTool {
+	if app.seat == nil {
+		return nil
+	}
+	return append([]*TabletTool(nil), app.seat.tools...)
+}
+
+// initTablets creates the tablet seat, if the compositor supports tablets.
+// Tablets, tools and pads are then announced through it, independently of the
+// seat's capabilities.
+func (s *seat) initTablets() {
+	manager := s.app.conn.Globals().ZwpTabletManagerV2()
+	if manager == nil {
+		return
+	}
+	tabletSeat, err := manager.GetTabletSeat(s.app.conn, s.seat.ID())
+	if err != nil {
+		return
+	}
+	s.tabletSeat = tabletSeat
+	s.app.conn.RegisterHandler(tabletSeat.ID(), wayland.HandlerFunc(s.handleTabletSeat))
+}
+
+func (s *seat) handleTabletSeat(event wayland.Event) {
+	conn := s.app.conn
+	switch t := event.(type) {
+	case *wayland.ZwpTabletSeatV2TabletAddedEvent:
+		tablet := &Tablet{tablet: t.IDProxy()}
+		conn.RegisterHandler(tablet.tablet.ID(), wayland.HandlerFunc(func(event wayland.Event) {
+			s.handleTablet(tablet, event)
+		}))
+	case *wayland.ZwpTabletSeatV2ToolAddedEvent:
+		tool := &TabletTool{seat: s, tool: t.IDProxy()}
+		conn.RegisterHandler(tool.tool.ID(), wayland.HandlerFunc(tool.handle))
+	case *wayland.ZwpTabletSeatV2PadAddedEvent:
+		pad := &TabletPad{seat: s, pad: t.IDProxy()}
+		conn.RegisterHandler(pad.pad.ID(), wayland.HandlerFunc(pad.handle))
+	}
+}
+
+func (s *seat) handleTablet(tablet *Tablet, event wayland.Event) {
+	conn := s.app.conn
+	switch t := event.(type) {
+	case *wayland.ZwpTabletV2NameEvent:
+		tablet.name = t.Name
+	case *wayland.ZwpTabletV2IDEvent:
+		tablet.vendorID, tablet.productID = t.Vid, t.Pid
+	case *wayland.ZwpTabletV2PathEvent:
+		tablet.paths = append(tablet.paths, t.Path)
+	case *wayland.ZwpTabletV2DoneEvent:
+		s.tablets = append(s.tablets, tablet)
+	case *wayland.ZwpTabletV2RemovedEvent:
+		for i, other := range s.tablets {
+			if other == tablet {
+				s.tablets = append(s.tablets[:i], s.tablets[i+1:]...)
+				break
+			}
+		}
+		conn.UnregisterHandlers(tablet.tablet.ID())
+		tablet.tablet.Destroy(conn)
+		conn.UnregisterProxy(tablet.tablet)
+	}
+}
+
+// tablet returns the tablet with an object ID, or nil.
+func (s *seat) tablet(id wayland.ObjectID) *Tablet {
+	for _, tablet := range s.tablets {
+		if tablet.tablet.ID() == id {
+			return tablet
+		}
+	}
+	return nil
+}
+
+func (t *TabletTool) handle(event wayland.Event) {
+	s := t.seat
+	f := &t.frame
+	st := &t.state
+	switch ev := event.(type) {
+	case *wayland.ZwpTabletToolV2TypeEvent:
+		t.typ = ToolType(ev.ToolType - uint32(wayland.ZwpTabletToolV2TypePen))
+	case *wayland.ZwpTabletToolV2HardwareSerialEvent:
+		t.hardwareSerial = uint64(ev.HardwareSerialHi)<<32 | uint64(ev.HardwareSerialLo)
+	case *wayland.ZwpTabletToolV2HardwareIDWacomEvent:
+		t.hardwareID = uint64(ev.HardwareIDHi)<<32 | uint64(ev.HardwareIDLo)
+	case *wayland.ZwpTabletToolV2CapabilityEvent:
+		t.caps |= 1 << (ev.Capability - uint32(wayland.ZwpTabletToolV2CapabilityTilt))
+	case *wayland.ZwpTabletToolV2DoneEvent:
+		s.tools = append(s.tools, t)
+	case *wayland.ZwpTabletToolV2RemovedEvent:
+		t.remove()
+	case *wayland.ZwpTabletToolV2ProximityInEvent:
+		f.proximityIn = true
+		t.serial = ev.Serial
+		t.target = s.app.pointerTargets[ev.Surface]
+		t.tablet = s.tablet(ev.Tablet)
+
+		// Axes start over with each proximity; ones the tool doesn't have
+		// stay at zero.
+		*st = TabletEvent{}
+	case *wayland.ZwpTabletToolV2ProximityOutEvent:
+		f.proximityOut = true
+	case *wayland.ZwpTabletToolV2DownEvent:
+		f.down = true
+		t.serial = ev.Serial
+		t.seat.inputSerial = ev.Serial
+		st.Contact = true
+	case *wayland.ZwpTabletToolV2UpEvent:
+		f.up = true
+		st.Contact = false
+	case *wayland.ZwpTabletToolV2MotionEvent:
+		f.motion = true
+		st.X, st.Y = ev.X.Float(), ev.Y.Float()
+	case *wayland.ZwpTabletToolV2PressureEvent:
+		st.Pressure = float64(ev.Pressure) / 65535
+	case *wayland.ZwpTabletToolV2DistanceEvent:
+		st.Distance = float64(ev.Distance) / 65535
+	case *wayland.ZwpTabletToolV2TiltEvent:
+		st.TiltX, st.TiltY = ev.TiltX.Float(), ev.TiltY.Float()
+	case *wayland.ZwpTabletToolV2RotationEvent:
+		st.Rotation = ev.Degrees.Float()
+	case *wayland.ZwpTabletToolV2SliderEvent:
+		st.Slider = float64(ev.Position) / 65535
+	case *wayland.ZwpTabletToolV2WheelEvent:
+		f.wheel += ev.Degrees.Float()
+		f.clicks += ev.Clicks
+	case *wayland.ZwpTabletToolV2ButtonEvent:
+		t.serial = ev.Serial
+		t.seat.inputSerial = ev.Serial
+		f.buttons = append(f.buttons, TabletButton{
+			Serial:  ev.Serial,
+			Button:  Button(ev.Button),
+			Pressed: wayland.ZwpTabletToolV2ButtonState(ev.State) == wayland.ZwpTabletToolV2ButtonStatePressed,
+		})
+	case *wayland.ZwpTabletToolV2FrameEvent:
+		t.flush(ev.Time)
+	}
+}
+
+// flush delivers the changes since the last frame.
+func (t *TabletTool) flush(time uint32) {
+	f := &t.frame
+	if target := t.target; target != nil {
+		e := t.state
+		e.Tool, e.Tablet = t, t.tablet
+		e.Serial, e.Time = t.serial, time
+		e.ProximityIn, e.ProximityOut = f.proximityIn, f.proximityOut
+		e.Down, e.Up = f.down, f.up
+		e.Wheel, e.WheelClicks = f.wheel, int(f.clicks)
+		e.Buttons = f.buttons
+		t.deliver(target, &e)
+	}
+	if f.proximityOut {
+		t.target, t.emulating = nil, false
+	}
+	*f = tabletFrame{buttons: f.buttons[:0]}
+}
+
+// deliver delivers an event to a target, or emulates the pointer if the target
+// doesn't handle tablets.
+func (t *TabletTool) deliver(target pointerTarget, e *TabletEvent) {
+	s := t.seat
+	if tt, ok := target.(tabletTarget); ok && tt.tablet(s, e) {
+		return
+	}
+
+	emit := func(p PointerEvent) {
+		// The target may go away while handling events, e.g. when a button
+		// closes the window.
+		if t.target != target {
+			return
+		}
+		switch p.Type {
+		case PointerEnter, PointerLeave:
+			p.Serial = e.Serial
+		case PointerButton:
+			if p.Serial == 0 {
+				p.Serial = e.Serial
+			}
+			p.Time = e.Time
+		default:
+			p.Time = e.Time
+		}
+		p.X, p.Y, p.Emulated = e.X, e.Y, true
+		target.pointer(s, &p)
+	}
+	if !t.emulating {
+		if e.ProximityOut {
+			return
+		}
+		t.emulating = true
+		emit(PointerEvent{Type: PointerEnter})
+	} else if t.frame.motion {
+		emit(PointerEvent{Type: PointerMotion})
+	}
+	if e.Down {
+		emit(PointerEvent{Type: PointerButton, Button: ButtonLeft, Pressed: true})
+	}
+	for _, b := range e.Buttons {
+		emit(PointerEvent{Type: PointerButton, Serial: b.Serial, Button: emulatedButton(b.Button), Pressed: b.Pressed})
+	}
+	if e.Wheel != 0 || e.WheelClicks != 0 {
+		emit(PointerEvent{Type: PointerAxis, Source: AxisSourceWheel, DY: e.Wheel, Value120Y: int32(e.WheelClicks * 120)})
+	}
+	if e.Up {
+		emit(PointerEvent{Type: PointerButton, Button: ButtonLeft})
+	}
+	if e.ProximityOut {
+		emit(PointerEvent{Type: PointerLeave})
+	}
+}
+
+// emulatedButton returns the pointer button that a tool button emulates.
+// Stylus buttons act as the middle and right buttons, as is conventional.
+func emulatedButton(b Button) Button {
+	switch b {
+	case ButtonStylus:
+		return ButtonMiddle
+	case ButtonStylus2:
+		return ButtonRight
+	case ButtonStylus3:
+		return ButtonSide
+	}
+	return b
+}
+
+// remove forgets a tool that the compositor removed.
+func (t *TabletTool) remove() {
+	s := t.seat
+	conn := s.app.conn
+	for i, other := range s.tools {
+		if other == t {
+			s.tools = append(s.tools[:i], s.tools[i+1:]...)
+			break
+		}
+	}
+	t.target = nil
+	conn.UnregisterHandlers(t.tool.ID())
+	t.tool.Destroy(conn)
+	conn.UnregisterProxy(t.tool)
+}
+
+// PadEventType is the type of a PadEvent.
+type PadEventType int
+
+// Pad event types.
+const (
+	// PadEnter is sent when the pad is focused on the window, e.g. to set
+	// feedback for its controls.
+	PadEnter PadEventType = iota
+
+	// PadLeave is sent when the pad is no longer focused on the window.
+	PadLeave
+
+	// PadButton is sent when a button is pressed or released.
+	PadButton
+
+	// PadRing is sent when a finger moves on a ring, or is lifted.
+	PadRing
+
+	// PadStrip is sent when a finger moves on a strip, or is lifted.
+	PadStrip
+
+	// PadModeSwitch is sent when the mode of a group changes. Controls
+	// usually do something different in each mode, and their feedback
+	// should be updated.
+	PadModeSwitch
+)
+
+func (t PadEventType) String() string {
+	switch t {
+	case PadEnter:
+		return "enter"
+	case PadLeave:
+		return "leave"
+	case PadButton:
+		return "button"
+	case PadRing:
+		return "ring"
+	case PadStrip:
+		return "strip"
+	case PadModeSwitch:
+		return "mode-switch"
+	}
+	return "unknown"
+}
+
+// PadEvent is an event from a tablet pad.
+type PadEvent struct {
+	Type PadEventType
+	Pad  *TabletPad
+
+	// Time is the time of button, ring, strip and mode switch events, in
+	// milliseconds, with an unspecified base.
+	Time uint32
+
+	// Button and Pressed are the button that changed, counting from 0, and
+	// its new state, for button events.
+	Button  int
+	Pressed bool
+
+	// Index is the ring or strip that changed, counting from 0 across all
+	// groups, for ring and strip events.
+	Index int
+
+	// Position is the position of the finger on a ring, in degrees
+	// clockwise from the top, or on a strip, from 0 (top or left) to 1
+	// (bottom or right). It is not set when Stop is.
+	Position float64
+
+	// Stop is set when the finger was lifted from a ring or strip, e.g. to
+	// start kinetic scrolling.
+	Stop bool
+
+	// Finger is set when the ring or strip is known to be operated by a
+	// finger.
+	Finger bool
+
+	// Group is the group that the control belongs to, and Mode the current
+	// mode of the group, or the new mode for mode switch events.
+	Group int
+	Mode  int
+}
+
+// TabletPad is the set of buttons, rings and strips on a tablet. Controls are
+// arranged in groups, each of which has a current mode.
+type TabletPad struct {
+	seat *seat
+	pad  *wayland.ZwpTabletPadV2
+
+	buttons int
+	paths   []string
+	groups  []*padGroup
+	rings   []*padControl
+	strips  []*padControl
+
+	tablet *Tablet
+	focus  *Window
+}
+
+// padGroup is a group of pad controls that share a mode.
+type padGroup struct {
+	group   *wayland.ZwpTabletPadGroupV2
+	buttons []int
+	modes   int
+	mode    int
+
+	// serial is the serial of the last mode switch, which feedback must
+	// refer to.
+	serial uint32
+}
+
+// padControl is a ring or strip, along with the changes since the last
+// frame.
+type padControl struct {
+	pad   *TabletPad
+	ring  *wayland.ZwpTabletPadRingV2
+	strip *wayland.ZwpTabletPadStripV2
+	group int
+	index int
+
+	position float64
+	moved    bool
+	stop     bool
+	finger   bool
+}
+
+// Buttons returns the number of buttons on the pad.
+func (p *TabletPad) Buttons() int {
+	return p.buttons
+}
+
+// Rings returns the number of rings on the pad.
+func (p *TabletPad) Rings() int {
+	return len(p.rings)
+}
+
+// Strips returns the number of strips on the pad.
+func (p *TabletPad) Strips() int {
+	return len(p.strips)
+}
+
+// Groups returns the number of groups of controls on the pad.
+func (p *TabletPad) Groups() int {
+	return len(p.groups)
+}
+
+// Modes returns the number of modes of a group, or zero if it has no modes.
+func (p *TabletPad) Modes(group int) int {
+	if group < 0 || group >= len(p.groups) {
+		return 0
+	}
+	return p.groups[group].modes
+}
+
+// Mode returns the current mode of a group.
+func (p *TabletPad) Mode(group int) int {
+	if group < 0 || group >= len(p.groups) {
+		return 0
+	}
+	return p.groups[group].mode
+}
+
+// Paths returns the device paths of the pad.
+func (p *TabletPad) Paths() []string {
+	return append([]string(nil), p.paths...)
+}
+
+// Tablet returns the tablet the pad is attached to, or nil if it isn't known
+// yet.
+func (p *TabletPad) Tablet() *Tablet {
+	return p.tablet
+}
+
+// SetButtonFeedback sets a short description of what a button does in the
+// current mode of its group, for the compositor to show, e.g. in an on-screen
+// overlay. It should be set when the pad enters the window, and whenever the
+// mode changes.
+func (p *TabletPad) SetButtonFeedback(button int, description string) {
+	if p.focus == nil || button < 0 || button >= p.buttons {
+		return
+	}
+	p.pad.SetFeedback(p.seat.app.conn, uint32(button), description, p.groups[p.buttonGroup(button)].serial)
+}
+
+// SetRingFeedback sets a short description of what a ring does in the current
+// mode of its group, like SetButtonFeedback.
+func (p *TabletPad) SetRingFeedback(ring int, description string) {
+	if p.focus == nil || ring < 0 || ring >= len(p.rings) {
+		return
+	}
+	c := p.rings[ring]
+	c.ring.SetFeedback(p.seat.app.conn, description, p.groups[c.group].serial)
+}
+
+// SetStripFeedback sets a short description of what a strip does in the
+// current mode of its group, like SetButtonFeedback.
+func (p *TabletPad) SetStripFeedback(strip int, description string) {
+	if p.focus == nil || strip < 0 || strip >= len(p.strips) {
+		return
+	}
+	c := p.strips[strip]
+	c.strip.SetFeedback(p.seat.app.conn, description, p.groups[c.group].serial)
+}
+
+// buttonGroup returns the group of a button. Every button belongs to exactly
+// one group, but the compositor may not say which if there is only one.
+func (p *TabletPad) buttonGroup(button int) int {
+	for i, g := range p.groups {
+		for _, b := range g.buttons {
+			if b == button {
+				return i
+			}
+		}
+	}
+	return 0
+}
+
+// OnPad sets a function to be called for tablet pad events on the window.
+func (w *Window) OnPad(fn func(PadEvent)) {
+	w.onPad = fn
+}
+
+func (w *Window) padEvent(e *PadEvent) {
+	if w.onPad != nil {
+		w.onPad(*e)
+	}
+}
+
+func (p *TabletPad) handle(event wayland.Event) {
+	s := p.seat
+	conn := s.app.conn
+	switch t := event.(type) {
+	case *wayland.ZwpTabletPadV2GroupEvent:
+		g := &padGroup{group: t.PadGroupProxy()}
+		index := len(p.groups)
+		p.groups = append(p.groups, g)
+		conn.RegisterHandler(g.group.ID(), wayland.HandlerFunc(func(event wayland.Event) {
+			p.handleGroup(index, event)
+		}))
+	case *wayland.ZwpTabletPadV2PathEvent:
+		p.paths = append(p.paths, t.Path)
+	case *wayland.ZwpTabletPadV2ButtonsEvent:
+		p.buttons = int(t.Buttons)
+	case *wayland.ZwpTabletPadV2DoneEvent:
+		s.pads = append(s.pads, p)
+		if len(p.groups) == 0 {
+			// Pads without groups still have one mode for all buttons.
+			p.groups = append(p.groups, &padGroup{})
+		}
+	case *wayland.ZwpTabletPadV2ButtonEvent:
+		if p.focus == nil {
+			return
+		}
+		button := int(t.Button)
+		group := p.buttonGroup(button)
+		p.focus.padEvent(&PadEvent{
+			Type:    PadButton,
+			Pad:     p,
+			Time:    t.Time,
+			Button:  button,
+			Pressed: wayland.ZwpTabletPadV2ButtonState(t.State) == wayland.ZwpTabletPadV2ButtonStatePressed,
+			Group:   group,
+			Mode:    p.groups[group].mode,
+		})
+	case *wayland.ZwpTabletPadV2EnterEvent:
+		p.tablet = s.tablet(t.Tablet)
+		for _, w := range s.app.windows {
+			if w.surface.ID() == t.Surface {
+				p.setFocus(w)
+				break
+			}
+		}
+	case *wayland.ZwpTabletPadV2LeaveEvent:
+		p.setFocus(nil)
+	case *wayland.ZwpTabletPadV2RemovedEvent:
+		for i, other := range s.pads {
+			if other == p {
+				s.pads = append(s.pads[:i], s.pads[i+1:]...)
+				break
+			}
+		}
+		p.destroy()
+	}
+}
+
+// setFocus moves the focus of the pad to a window, or nowhere.
+func (p *TabletPad) setFocus(w *Window) {
+	if p.focus == w {
+		return
+	}
+	if old := p.focus; old != nil {
+		p.focus = nil
+		old.padEvent(&PadEvent{Type: PadLeave, Pad: p})
+	}
+	p.focus = w
+	if w != nil {
+		w.padEvent(&PadEvent{Type: PadEnter, Pad: p})
+	}
+}
+
+func (p *TabletPad) handleGroup(index int, event wayland.Event) {
+	conn := p.seat.app.conn
+	g := p.groups[index]
+	switch t := event.(type) {
+	case *wayland.ZwpTabletPadGroupV2ButtonsEvent:
+		g.buttons = g.buttons[:0]
+		for i := 0; i+4 <= len(t.Buttons); i += 4 {
+			g.buttons = append(g.buttons, int(binary.LittleEndian.Uint32(t.Buttons[i:])))
+		}
+	case *wayland.ZwpTabletPadGroupV2RingEvent:
+		c := &padControl{pad: p, ring: t.RingProxy(), group: index, index: len(p.rings)}
+		p.rings = append(p.rings, c)
+		conn.RegisterHandler(c.ring.ID(), wayland.HandlerFunc(c.handle))
+	case *wayland.ZwpTabletPadGroupV2StripEvent:
+		c := &padControl{pad: p, strip: t.StripProxy(), group: index, index: len(p.strips)}
+		p.strips = append(p.strips, c)
+		conn.RegisterHandler(c.strip.ID(), wayland.HandlerFunc(c.handle))
+	case *wayland.ZwpTabletPadGroupV2ModesEvent:
+		g.modes = int(t.Modes)
+	case *wayland.ZwpTabletPadGroupV2ModeSwitchEvent:
+		g.mode, g.serial = int(t.Mode), t.Serial
+		if p.focus != nil {
+			p.focus.padEvent(&PadEvent{Type: PadModeSwitch, Pad: p, Time: t.Time, Group: index, Mode: g.mode})
+		}
+	}
+}
+
+func (c *padControl) handle(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.ZwpTabletPadRingV2SourceEvent:
+		c.finger = wayland.ZwpTabletPadRingV2Source(t.Source) == wayland.ZwpTabletPadRingV2SourceFinger
+	case *wayland.ZwpTabletPadStripV2SourceEvent:
+		c.finger = wayland.ZwpTabletPadStripV2Source(t.Source) == wayland.ZwpTabletPadStripV2SourceFinger
+	case *wayland.ZwpTabletPadRingV2AngleEvent:
+		c.position, c.moved = t.Degrees.Float(), true
+	case *wayland.ZwpTabletPadStripV2PositionEvent:
+		c.position, c.moved = float64(t.Position)/65535, true
+	case *wayland.ZwpTabletPadRingV2StopEvent, *wayland.ZwpTabletPadStripV2StopEvent:
+		c.stop = true
+	case *wayland.ZwpTabletPadRingV2FrameEvent:
+		c.flush(PadRing, t.Time)
+	case *wayland.ZwpTabletPadStripV2FrameEvent:
+		c.flush(PadStrip, t.Time)
+	}
+}
+
+// flush delivers the changes to a ring or strip since the last frame.
+func (c *padControl) flush(typ PadEventType, time uint32) {
+	p := c.pad
+	moved, stop, finger := c.moved, c.stop, c.finger
+	c.moved, c.stop, c.finger = false, false, false
+	if p.focus == nil || !moved && !stop {
+		return
+	}
+	e := &PadEvent{
+		Type:   typ,
+		Pad:    p,
+		Time:   time,
+		Index:  c.index,
+		Stop:   stop,
+		Finger: finger,
+		Group:  c.group,
+		Mode:   p.groups[c.group].mode,
+	}
+	if !stop {
+		e.Position = c.position
+	}
+	p.focus.padEvent(e)
+}
+
+// destroy destroys a pad and its controls.
+func (p *TabletPad) destroy() {
+	p.setFocus(nil)
+	conn := p.seat.app.conn
+	for _, c := range p.rings {
+		conn.UnregisterHandlers(c.ring.ID())
+		c.ring.Destroy(conn)
+		conn.UnregisterProxy(c.ring)
+	}
+	for _, c := range p.strips {
+		conn.UnregisterHandlers(c.strip.ID())
+		c.strip.Destroy(conn)
+		conn.UnregisterProxy(c.strip)
+	}
+	for _, g := range p.groups {
+		if g.group == nil {
+			continue
+		}
+		conn.UnregisterHandlers(g.group.ID())
+		g.group.Destroy(conn)
+		conn.UnregisterProxy(g.group)
+	}
+	p.rings, p.strips, p.groups = nil, nil, nil
+	conn.UnregisterHandlers(p.pad.ID())
+	p.pad.Destroy(conn)
+	conn.UnregisterProxy(p.pad)
+}
@@ -0,0 +1,152 @@
+package jtk
+
+import (
+	"container/heap"
+	"time"
+)
+
+// Timer is a function scheduled to run on the application goroutine, either
+// once or periodically.
+type Timer struct {
+	app    *Application
+	when   time.Time
+	period time.Duration
+	fn     func()
+
+	// index is the position of the timer in the heap, or -1 if the timer is
+	// not scheduled.
+	index int
+}
+
+// AfterFunc schedules fn to run on the application goroutine after d has
+// elapsed. It is safe to call from any goroutine.
+func (app *Application) AfterFunc(d time.Duration, fn func()) *Timer {
+	return app.schedule(&Timer{app: app, when: time.Now().Add(d), fn: fn, index: -1})
+}
+
+// TickFunc schedules fn to run on the application goroutine every d, until the
+// returned timer is stopped. If the application falls behind, ticks are
+// dropped rather than run back-to-back. It is safe to call from any goroutine.
+func (app *Application) TickFunc(d time.Duration, fn func()) *Timer {
+	if d <= 0 {
+		panic("jtk: non-positive interval for TickFunc")
+	}
+	return app.schedule(&Timer{app: app, when: time.Now().Add(d), period: d, fn: fn, index: -1})
+}
+
+func (app *Application) schedule(t *Timer) *Timer {
+	app.mu.Lock()
+	heap.Push(&app.timers, t)
+	app.mu.Unlock()
+
+	app.poller.wake()
+
+	return t
+}
+
+// Stop prevents the timer from running again. It returns false if the timer
+// was already stopped or, for timers created by AfterFunc, already ran.
+func (t *Timer) Stop() bool {
+	t.app.mu.Lock()
+	defer t.app.mu.Unlock()
+
+	if t.index < 0 {
+		return false
+	}
+	heap.Remove(&t.app.timers, t.index)
+	return true
+}
+
+// clock schedules functions to run on the application goroutine. The
+// application implements it with timers; code that depends on the passage of
+// time, such as key repeat, takes a clock so that time can be simulated.
+type clock interface {
+	afterFunc(d time.Duration, fn func()) stopper
+}
+
+// stopper is a scheduled function that can be cancelled, such as a Timer.
+type stopper interface {
+	Stop() bool
+}
+
+func (app *Application) afterFunc(d time.Duration, fn func()) stopper {
+	return app.AfterFunc(d, fn)
+}
+
+// runTimers runs all expired timers.
+func (app *Application) runTimers() {
+	now := time.Now()
+
+	for {
+		app.mu.Lock()
+		if len(app.timers) == 0 || app.timers[0].when.After(now) {
+			app.mu.Unlock()
+			return
+		}
+
+		t := app.timers[0]
+		if t.period > 0 {
+			t.when = t.when.Add(t.period)
+			if !t.when.After(now) {
+				t.when = now.Add(t.period)
+			}
+			heap.Fix(&app.timers, 0)
+		} else {
+			heap.Pop(&app.timers)
+		}
+		app.mu.Unlock()
+
+		t.fn()
+	}
+}
+
+// timeout returns the number of milliseconds until the next timer expires, or
+// -1 if there are no timers.
+func (app *Application) timeout() int {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	if len(app.timers) == 0 {
+		return -1
+	}
+
+	d := time.Until(app.timers[0].when)
+	if d <= 0 {
+		return 0
+	}
+
+	// Round up, so that we do not wake up just before the timer expires.
+	return int((d + time.Millisecond - 1) / time.Millisecond)
+}
+
+// timerHeap is a min-heap of timers ordered by expiry, for container/heap.
+type timerHeap []*Timer
+
+func (h timerHeap) Len() int {
+	return len(h)
+}
+
+func (h timerHeap) Less(i, j int) bool {
+	return h[i].when.Before(h[j].when)
+}
+
+func (h timerHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *timerHeap) Push(x interface{}) {
+	t := x.(*Timer)
+	t.index = len(*h)
+	*h = append(*h, t)
+}
+
+func (h *timerHeap) Pop() interface{} {
+	old := *h
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	t.index = -1
+	*h = old[:len(old)-1]
+	return t
+}
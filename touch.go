@@ -0,0 +1,105 @@
+package jtk
+
+// Touch events are delivered to the window that each touch point went down
+// on, for as long as the point stays down, even if it moves outside the
+// window. The changes that the compositor groups together with wl_touch.frame,
+// such as several fingers moving at once, are delivered as one event.
+//
+// Windows without a touch handler receive pointer events instead, emulated
+// from the first touch point: the pointer enters and the left button is
+// pressed when it goes down, and the button is released and the pointer
+// leaves when it goes up. Client-side decorations are always operated this
+// way.
+
+// TouchState is the state of a touch point in a TouchEvent.
+type TouchState int
+
+// Touch point states.
+const (
+	// TouchDown means the point went down in this event.
+	TouchDown TouchState = iota
+
+	// TouchMotion means the point moved, or its shape changed.
+	TouchMotion
+
+	// TouchStationary means the point is still down, but didn't change.
+	TouchStationary
+
+	// TouchUp means the point was lifted. It is not included in later
+	// events.
+	TouchUp
+
+	// TouchCancelled means the compositor took over the point, e.g. because
+	// it recognized a gesture of its own. Neither it nor the rest of the
+	// sequence should have any effect.
+	TouchCancelled
+)
+
+func (s TouchState) String() string {
+	switch s {
+	case TouchDown:
+		return "down"
+	case TouchMotion:
+		return "motion"
+	case TouchStationary:
+		return "stationary"
+	case TouchUp:
+		return "up"
+	case TouchCancelled:
+		return "cancelled"
+	}
+	return "unknown"
+}
+
+// TouchPoint is a finger, or another contact, on a touchscreen.
+type TouchPoint struct {
+	// ID identifies the point while it is down. IDs may be reused once it is
+	// lifted.
+	ID int32
+
+	State TouchState
+
+	// X and Y are the position of the point, in logical pixels relative to
+	// the top-left corner of the window's content.
+	X, Y float64
+
+	// Major and Minor are the lengths of the axes of the ellipse of contact,
+	// and Orientation the angle of the major axis from the y axis, in
+	// degrees clockwise. They are zero if the compositor doesn't send them.
+	Major, Minor float64
+	Orientation  float64
+}
+
+// TouchEvent is a change to the touch points on a window.
+type TouchEvent struct {
+	// Serial identifies the last point that went down or up in this event,
+	// for requests that need to be tied to user input.
+	Serial uint32
+
+	// Time is the time of the event, in milliseconds, with an unspecified
+	// base. It is zero if only shapes changed.
+	Time uint32
+
+	// Points are all the points on the window, in the order they went down,
+	// including ones that were lifted or cancelled in this event.
+	Points []TouchPoint
+}
+
+// Cancelled returns whether the event cancels the touch sequence.
+func (e *TouchEvent) Cancelled() bool {
+	return len(e.Points) > 0 && e.Points[0].State == TouchCancelled
+}
+
+// OnTouch sets a function to be called for touch events on the window. Until
+// it is set, touch points emulate the pointer.
+func (w *Window) OnTouch(fn func(TouchEvent)) {
+	w.onTouch = fn
+}
+
+func (w *Window) touch(s *seat, e *TouchEvent) bool {
+	if w.onTouch == nil {
+		return false
+	}
+	w.onTouch(*e)
+	return true
+}
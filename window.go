@@ -1 +1,447 @@
 package jtk
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jchv/jtk/internal/wayland"
+)
+
+// ErrNotConfigured is returned when drawing to a window that has not yet been
+// configured by the compositor.
+var ErrNotConfigured = errors.New("window has not been configured")
+
+// ErrWindowClosed is returned when using a window that has been closed.
+var ErrWindowClosed = errors.New("window is closed")
+
+// Default window size, used when neither the options nor the compositor
+// specify one.
+const (
+	defaultWidth  = 640
+	defaultHeight = 480
+)
+
+// WindowOptions contains options for creating a window.
+type WindowOptions struct {
+	// Title is the initial window title.
+	Title string
+
+	// AppID identifies the application, e.g. for matching a .desktop file.
+	AppID string
+
+	// Width and Height are the initial size of the window, used when the
+	// compositor leaves the size up to the client.
+	Width, Height int
+
+	// MinWidth, MinHeight, MaxWidth and MaxHeight constrain the size of the
+	// window. Zero means unconstrained.
+	MinWidth, MinHeight int
+	MaxWidth, MaxHeight int
+
+	// Scale overrides the scale at which the window is drawn, which may be
+	// fractional. Zero means automatic: the value of the JTK_SCALE
+	// environment variable if set, otherwise the scale preferred by the
+	// compositor.
+	Scale float64
+
+	// Decorations selects how the window is decorated. By default, the
+	// compositor draws the decorations if it can, and the window draws them
+	// itself otherwise.
+	Decorations DecorationMode
+}
+
+// WindowState contains the state of a window, as configured by the
+// compositor. The size excludes client-side decorations.
+type WindowState struct {
+	Width, Height int
+
+	Maximized  bool
+	Fullscreen bool
+	Resizing   bool
+	Activated  bool
+
+	TiledLeft   bool
+	TiledRight  bool
+	TiledTop    bool
+	TiledBottom bool
+}
+
+// Window is a top-level window. Like everything else belonging to an
+// Application, it must only be used on the application goroutine.
+type Window struct {
+	app        *Application
+	surface    *wayland.WlSurface
+	xdgSurface *wayland.XdgSurface
+	toplevel   *wayland.XdgToplevel
+
+	opts        WindowOptions
+	state       WindowState
+	pending     WindowState
+	configured  bool
+	closed      bool
+	buffers     *BufferPool
+	onConfigure func(WindowState)
+	onClose     func()
+	onPointer   func(PointerEvent)
+	onKey       func(KeyEvent)
+	onTouch     func(TouchEvent)
+	onGesture   func(GestureEvent)
+	onTablet    func(TabletEvent)
+	onPad       func(PadEvent)
+	recognizer  *gestureRecognizer
+	onFocus     func(bool)
+	cursorName  Cursor
+
+	// Pointer constraints; see constraint.go.
+	constraint       *pointerConstraint
+	onConstraint     func(PointerConstraint)
+	onRelativeMotion func(RelativeMotionEvent)
+
+	// Drag and drop; see dnd.go.
+	onDrag func(DragEvent)
+
+	// Rendering state; see frame.go.
+	frameCallback *wayland.WlCallback
+	frameClock    frameClock
+	dirty         bool
+	redrawQueued  bool
+	onDraw        func(*Frame)
+	animations    []func(time.Time)
+
+	// Output and scale tracking; see scale.go.
+	outputs         []*Output
+	scaler          surfaceScaler
+	fractionalScale *wayland.WpFractionalScaleV1
+	preferredScale  float64
+	scale           float64
+	onScale         func(float64)
+
+	// Decorations; see decoration.go.
+	toplevelDecoration *wayland.ZxdgToplevelDecorationV1
+	clientSide         bool
+	decoration         *decoration
+	decorationTheme    DecorationTheme
+
+	// Damage tracking; see frame.go.
+	damage   region
+	frameSeq uint64
+	history  []frameDamage
+	last     *Buffer
+
+	// Presentation feedback; see presentation.go.
+	onPresentation func(PresentationFeedback)
+	commits        uint64
+}
+
+// NewWindow creates a new top-level window. The window cannot be drawn to
+// until it has been configured by the compositor; see OnConfigure.
+func NewWindow(app *Application, opts WindowOptions) (*Window, error) {
+	if opts.Width <= 0 {
+		opts.Width = defaultWidth
+	}
+	if opts.Height <= 0 {
+		opts.Height = defaultHeight
+	}
+
+	w := &Window{
+		app:             app,
+		opts:            opts,
+		buffers:         NewBufferPool(app),
+		scale:           1,
+		decorationTheme: DefaultDecorationTheme(),
+	}
+
+	var err error
+	if w.surface, err = app.compositor.CreateSurface(app.conn); err != nil {
+		return nil, fmt.Errorf("creating surface: %w", err)
+	}
+	if w.xdgSurface, err = app.wmBase.GetXdgSurface(app.conn, w.surface.ID()); err != nil {
+		return nil, fmt.Errorf("creating xdg surface: %w", err)
+	}
+	if w.toplevel, err = w.xdgSurface.GetToplevel(app.conn); err != nil {
+		return nil, fmt.Errorf("creating toplevel: %w", err)
+	}
+	if err := w.initScale(); err != nil {
+		return nil, err
+	}
+	if err := w.initDecorations(); err != nil {
+		return nil, err
+	}
+
+	app.conn.RegisterHandler(w.surface.ID(), wayland.HandlerFunc(w.handleSurface))
+	app.pointerTargets[w.surface.ID()] = w
+	app.conn.RegisterHandler(w.xdgSurface.ID(), wayland.HandlerFunc(w.handleXdgSurface))
+	app.conn.RegisterHandler(w.toplevel.ID(), wayland.HandlerFunc(w.handleToplevel))
+
+	if opts.Title != "" {
+		w.toplevel.SetTitle(app.conn, opts.Title)
+	}
+	if opts.AppID != "" {
+		w.toplevel.SetAppID(app.conn, opts.AppID)
+	}
+	if opts.MinWidth > 0 || opts.MinHeight > 0 || opts.MaxWidth > 0 || opts.MaxHeight > 0 {
+		w.sendSizeLimits()
+	}
+
+	// The initial commit, without a buffer, prompts the compositor to send the
+	// first configure.
+	if err := w.surface.Commit(app.conn); err != nil {
+		return nil, fmt.Errorf("committing surface: %w", err)
+	}
+
+	app.windows = append(app.windows, w)
+	return w, nil
+}
+
+func (w *Window) handleToplevel(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.XdgToplevelConfigureEvent:
+		w.pending = WindowState{
+			Width:  int(t.Width),
+			Height: int(t.Height),
+		}
+		for i := 0; i+4 <= len(t.States); i += 4 {
+			switch wayland.XdgToplevelState(binary.LittleEndian.Uint32(t.States[i:])) {
+			case wayland.XdgToplevelStateMaximized:
+				w.pending.Maximized = true
+			case wayland.XdgToplevelStateFullscreen:
+				w.pending.Fullscreen = true
+			case wayland.XdgToplevelStateResizing:
+				w.pending.Resizing = true
+			case wayland.XdgToplevelStateActivated:
+				w.pending.Activated = true
+			case wayland.XdgToplevelStateTiledLeft:
+				w.pending.TiledLeft = true
+			case wayland.XdgToplevelStateTiledRight:
+				w.pending.TiledRight = true
+			case wayland.XdgToplevelStateTiledTop:
+				w.pending.TiledTop = true
+			case wayland.XdgToplevelStateTiledBottom:
+				w.pending.TiledBottom = true
+			}
+		}
+
+	case *wayland.XdgToplevelCloseEvent:
+		w.requestClose()
+	}
+}
+
+// requestClose handles a request from the user to close the window.
+func (w *Window) requestClose() {
+	if w.onClose != nil {
+		w.onClose()
+	} else {
+		w.Close()
+	}
+}
+
+func (w *Window) handleXdgSurface(event wayland.Event) {
+	switch t := event.(type) {
+	case *wayland.XdgSurfaceConfigureEvent:
+		if w.closed {
+			return
+		}
+
+		// A zero size means the client decides; keep the current size, or the
+		// requested size before the first configure.
+		// The compositor's size includes the title bar, if any.
+		state := w.pending
+		if titleBar := w.titleBarHeight(state); state.Height > 0 {
+			state.Height -= titleBar
+			if state.Height < 1 {
+				state.Height = 1
+			}
+		}
+		if state.Width <= 0 {
+			state.Width = w.state.Width
+			if state.Width <= 0 {
+				state.Width = w.opts.Width
+			}
+		}
+		if state.Height <= 0 {
+			state.Height = w.state.Height
+			if state.Height <= 0 {
+				state.Height = w.opts.Height
+			}
+		}
+		w.state = state
+		w.configured = true
+		if err := w.xdgSurface.AckConfigure(w.app.conn, t.Serial); err != nil {
+			w.app.fail(fmt.Errorf("acknowledging configure: %w", err))
+			return
+		}
+		if err := w.updateDecorations(); err != nil {
+			w.app.fail(fmt.Errorf("updating decorations: %w", err))
+			return
+		}
+
+		if w.onConfigure != nil {
+			w.onConfigure(state)
+		}
+
+		// The new configuration must be drawn in full, even if a frame
+		// callback is still pending.
+		w.damage.add(w.bounds())
+		w.dirty = true
+		w.queueRedraw()
+	}
+}
+
+// OnConfigure sets a function to be called each time the compositor
+// configures the window, after the configure has been acknowledged. The window
+// is redrawn afterwards.
+func (w *Window) OnConfigure(fn func(WindowState)) {
+	w.onConfigure = fn
+}
+
+// OnClose sets a function to be called when the user requests that the window
+// be closed. If no function is set, the window is closed.
+func (w *Window) OnClose(fn func()) {
+	w.onClose = fn
+}
+
+// State returns the current state of the window.
+func (w *Window) State() WindowState {
+	return w.state
+}
+
+// SetTitle sets the window title.
+func (w *Window) SetTitle(title string) error {
+	return w.request(func() error {
+		w.opts.Title = title
+		if w.decoration != nil {
+			w.decoration.invalidate()
+		}
+		return w.toplevel.SetTitle(w.app.conn, title)
+	})
+}
+
+// SetAppID sets the application ID of the window.
+func (w *Window) SetAppID(appID string) error {
+	return w.request(func() error {
+		w.opts.AppID = appID
+		return w.toplevel.SetAppID(w.app.conn, appID)
+	})
+}
+
+// SetMinSize sets the minimum size of the window. Zero means unconstrained.
+func (w *Window) SetMinSize(width, height int) error {
+	return w.request(func() error {
+		w.opts.MinWidth, w.opts.MinHeight = width, height
+		return w.sendSizeLimits()
+	})
+}
+
+// SetMaxSize sets the maximum size of the window. Zero means unconstrained.
+func (w *Window) SetMaxSize(width, height int) error {
+	return w.request(func() error {
+		w.opts.MaxWidth, w.opts.MaxHeight = width, height
+		return w.sendSizeLimits()
+	})
+}
+
+// Maximize requests that the window be maximized.
+func (w *Window) Maximize() error {
+	return w.request(func() error {
+		return w.toplevel.SetMaximized(w.app.conn)
+	})
+}
+
+// Unmaximize requests that the window be unmaximized.
+func (w *Window) Unmaximize() error {
+	return w.request(func() error {
+		return w.toplevel.UnsetMaximized(w.app.conn)
+	})
+}
+
+// Fullscreen requests that the window be made fullscreen on output. If output
+// is nil, the compositor chooses the output.
+func (w *Window) Fullscreen(output *Output) error {
+	return w.request(func() error {
+		if output == nil {
+			return w.toplevel.SetFullscreen(w.app.conn, nil)
+		}
+		id := output.output.ID()
+		return w.toplevel.SetFullscreen(w.app.conn, &id)
+	})
+}
+
+// Unfullscreen requests that the window leave fullscreen.
+func (w *Window) Unfullscreen() error {
+	return w.request(func() error {
+		return w.toplevel.UnsetFullscreen(w.app.conn)
+	})
+}
+
+// Minimize requests that the window be minimized. There is no way to tell
+// whether the window was actually minimized.
+func (w *Window) Minimize() error {
+	return w.request(func() error {
+		return w.toplevel.SetMinimized(w.app.conn)
+	})
+}
+
+// request runs fn, unless the window is closed.
+func (w *Window) request(fn func() error) error {
+	if w.closed {
+		return ErrWindowClosed
+	}
+	return fn()
+}
+
+// Close destroys the window.
+func (w *Window) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	for i, other := range w.app.windows {
+		if other == w {
+			w.app.windows = append(w.app.windows[:i], w.app.windows[i+1:]...)
+			break
+		}
+	}
+
+	// Every step of the teardown is taken even if an earlier one fails, so
+	// that nothing is leaked, and the first error is returned.
+	var err error
+	keep := func(e error) {
+		if err == nil {
+			err = e
+		}
+	}
+
+	conn := w.app.conn
+	keep(w.ReleasePointer())
+	keep(w.destroyScale())
+	conn.UnregisterHandlers(w.surface.ID())
+	delete(w.app.pointerTargets, w.surface.ID())
+	if w.app.seat != nil {
+		w.app.seat.removeTarget(w)
+	}
+	conn.UnregisterHandlers(w.toplevel.ID())
+	conn.UnregisterHandlers(w.xdgSurface.ID())
+	if w.frameCallback != nil {
+		conn.UnregisterHandlers(w.frameCallback.ID())
+		w.frameCallback = nil
+	}
+
+	w.buffers.Destroy()
+
+	if w.decoration != nil {
+		w.decoration.destroy()
+		w.decoration = nil
+	}
+	if w.toplevelDecoration != nil {
+		conn.UnregisterHandlers(w.toplevelDecoration.ID())
+		keep(w.toplevelDecoration.Destroy(conn))
+		w.toplevelDecoration = nil
+	}
+	keep(w.toplevel.Destroy(conn))
+	keep(w.xdgSurface.Destroy(conn))
+	keep(w.surface.Destroy(conn))
+	return err
+}
@@ -0,0 +1,50 @@
+package jtk
+
+import "testing"
+
+func TestCloseAfterError(t *testing.T) {
+	app, c := newFakeApplication(t)
+	w, err := NewWindow(app, WindowOptions{Width: 100, Height: 80, Decorations: DecorationsNone})
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundtrip(t, app)
+	f, err := w.NextFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Present(); err != nil {
+		t.Fatal(err)
+	}
+	roundtrip(t, app)
+	if w.scaler.viewport == nil {
+		t.Fatal("window has no viewport")
+	}
+
+	// With the connection gone, destroying the viewport is the first request
+	// to fail. The rest of the window is still torn down.
+	c.conn.Close()
+	if err := w.Close(); err == nil {
+		t.Fatal("Close succeeded without a connection")
+	}
+	if w.scaler.viewport != nil {
+		t.Error("viewport was not released")
+	}
+	if _, ok := app.pointerTargets[w.surface.ID()]; ok {
+		t.Error("window is still a pointer target")
+	}
+	for _, other := range app.windows {
+		if other == w {
+			t.Error("window is still in the application's windows")
+		}
+	}
+	if w.frameCallback != nil {
+		t.Error("frame callback was not released")
+	}
+	if n := len(w.buffers.buffers); n != 0 {
+		t.Errorf("%d buffers were not destroyed", n)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("closing again: %v", err)
+	}
+}
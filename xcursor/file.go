@@ -0,0 +1,160 @@
+// Package xcursor reads X cursor files and cursor themes, which Wayland
+// clients use to draw their own pointer cursors.
+package xcursor
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+// Image is a frame of a cursor at a nominal size.
+type Image struct {
+	// Size is the nominal size of the cursor, which the image is drawn for.
+	// Images may be larger or smaller than their nominal size.
+	Size int
+
+	Width, Height int
+
+	// XHot and YHot are the hotspot: the point of the image that is at the
+	// pointer position.
+	XHot, YHot int
+
+	// Delay is how long to show the frame before the next, for animated
+	// cursors.
+	Delay time.Duration
+
+	// Pix holds the pixels, in rows of Width pixels, in the wl_shm ARGB8888
+	// format: alpha-premultiplied, with the bytes of each pixel in B, G, R, A
+	// order.
+	Pix []byte
+}
+
+// Xcursor file constants.
+const (
+	fileMagic      = "Xcur"
+	fileHeaderSize = 16
+	tocEntrySize   = 12
+
+	chunkImage      = 0xfffd0002
+	imageHeaderSize = 36
+	imageVersion    = 1
+
+	// maxImageSize is the largest width and height the format allows.
+	maxImageSize = 0x7fff
+
+	// maxEntries limits the table of contents, which is otherwise only
+	// bounded by the file size.
+	maxEntries = 0x10000
+)
+
+var errFormat = errors.New("xcursor: not an Xcursor file")
+
+// Decode reads an Xcursor file, and returns its images in the order they
+// appear, which is the order of animation frames for each size.
+func Decode(r io.Reader) ([]*Image, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return parse(data)
+}
+
+func parse(data []byte) ([]*Image, error) {
+	if len(data) < fileHeaderSize || string(data[:4]) != fileMagic {
+		return nil, errFormat
+	}
+	le := binary.LittleEndian
+	header := le.Uint32(data[4:])
+	ntoc := le.Uint32(data[12:])
+	if header < fileHeaderSize || ntoc > maxEntries || uint64(header)+uint64(ntoc)*tocEntrySize > uint64(len(data)) {
+		return nil, errFormat
+	}
+
+	var images []*Image
+	for i := uint32(0); i < ntoc; i++ {
+		entry := data[header+i*tocEntrySize:]
+		typ, size, pos := le.Uint32(entry), le.Uint32(entry[4:]), le.Uint32(entry[8:])
+		if typ != chunkImage {
+			// Comments and unknown chunks.
+			continue
+		}
+		img, err := parseImage(data, pos, size)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, img)
+	}
+	if len(images) == 0 {
+		return nil, fmt.Errorf("xcursor: no images")
+	}
+	return images, nil
+}
+
+// parseImage parses the image chunk at pos, which the table of contents says
+// has a nominal size.
+func parseImage(data []byte, pos, size uint32) (*Image, error) {
+	le := binary.LittleEndian
+	if uint64(pos)+imageHeaderSize > uint64(len(data)) {
+		return nil, fmt.Errorf("xcursor: image at %d is out of bounds", pos)
+	}
+	chunk := data[pos:]
+	if le.Uint32(chunk) != imageHeaderSize || le.Uint32(chunk[4:]) != chunkImage ||
+		le.Uint32(chunk[8:]) != size || le.Uint32(chunk[12:]) != imageVersion {
+		return nil, fmt.Errorf("xcursor: bad image header at %d", pos)
+	}
+	width, height := le.Uint32(chunk[16:]), le.Uint32(chunk[20:])
+	xhot, yhot := le.Uint32(chunk[24:]), le.Uint32(chunk[28:])
+	delay := le.Uint32(chunk[32:])
+	if width == 0 || height == 0 || width > maxImageSize || height > maxImageSize || xhot > width || yhot > height {
+		return nil, fmt.Errorf("xcursor: bad image dimensions at %d", pos)
+	}
+	n := uint64(width) * uint64(height) * 4
+	if uint64(pos)+imageHeaderSize+n > uint64(len(data)) {
+		return nil, fmt.Errorf("xcursor: image at %d is truncated", pos)
+	}
+
+	// Pixels are stored as little-endian 32-bit ARGB values, which is already
+	// the byte order of wl_shm buffers.
+	pix := make([]byte, n)
+	copy(pix, chunk[imageHeaderSize:])
+	return &Image{
+		Size:   int(size),
+		Width:  int(width),
+		Height: int(height),
+		XHot:   int(xhot),
+		YHot:   int(yhot),
+		Delay:  time.Duration(delay) * time.Millisecond,
+		Pix:    pix,
+	}, nil
+}
+
+// Nearest returns the images of the nominal size closest to size, in order.
+// If two sizes are equally close, the larger is used, since scaling down
+// looks better than scaling up.
+func Nearest(images []*Image, size int) []*Image {
+	best := -1
+	for _, img := range images {
+		if best < 0 || distance(img.Size, size) < distance(best, size) ||
+			distance(img.Size, size) == distance(best, size) && img.Size > best {
+			best = img.Size
+		}
+	}
+	var frames []*Image
+	for _, img := range images {
+		if img.Size == best {
+			frames = append(frames, img)
+		}
+	}
+	return frames
+}
+
+func distance(a, b int) int {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
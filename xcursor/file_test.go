@@ -0,0 +1,186 @@
+package xcursor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testdata/wait is an animated cursor with a comment, three 4x4 frames of
+// nominal size 24, and two 8x8 frames of nominal size 48. The frames are red,
+// green and blue in turn.
+//
+// Offsets in the file, used to corrupt it.
+const (
+	waitTOC        = 16  // table of contents, 6 entries
+	waitFirstImage = 112 // the first image chunk, 36+4*4*4 bytes long
+)
+
+func readWait(t *testing.T) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "wait"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestDecode(t *testing.T) {
+	images, err := Decode(bytes.NewReader(readWait(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	red, green, blue := []byte{0, 0, 0xff, 0xff}, []byte{0, 0xff, 0, 0xff}, []byte{0xff, 0, 0, 0xff}
+	want := []struct {
+		size, width, height, xhot, yhot int
+		delay                           time.Duration
+		pixel                           []byte
+	}{
+		{24, 4, 4, 1, 2, 30 * time.Millisecond, red},
+		{24, 4, 4, 1, 2, 40 * time.Millisecond, green},
+		{24, 4, 4, 1, 2, 50 * time.Millisecond, blue},
+		{48, 8, 8, 2, 4, 60 * time.Millisecond, red},
+		{48, 8, 8, 2, 4, 70 * time.Millisecond, green},
+	}
+	if len(images) != len(want) {
+		t.Fatalf("decoded %d images, want %d", len(images), len(want))
+	}
+	for i, w := range want {
+		img := images[i]
+		if img.Size != w.size || img.Width != w.width || img.Height != w.height ||
+			img.XHot != w.xhot || img.YHot != w.yhot || img.Delay != w.delay {
+			t.Errorf("image %d is size %d, %dx%d, hotspot (%d, %d), delay %v; want size %d, %dx%d, hotspot (%d, %d), delay %v",
+				i, img.Size, img.Width, img.Height, img.XHot, img.YHot, img.Delay,
+				w.size, w.width, w.height, w.xhot, w.yhot, w.delay)
+		}
+		if len(img.Pix) != w.width*w.height*4 {
+			t.Errorf("image %d has %d bytes of pixels, want %d", i, len(img.Pix), w.width*w.height*4)
+			continue
+		}
+		for p := 0; p < len(img.Pix); p += 4 {
+			if !bytes.Equal(img.Pix[p:p+4], w.pixel) {
+				t.Errorf("image %d pixel %d is %x, want %x", i, p/4, img.Pix[p:p+4], w.pixel)
+				break
+			}
+		}
+	}
+}
+
+func TestNearest(t *testing.T) {
+	images, err := Decode(bytes.NewReader(readWait(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		size     int
+		nominal  int
+		frames   int
+		duration time.Duration
+	}{
+		{24, 24, 3, 120 * time.Millisecond},
+		{16, 24, 3, 120 * time.Millisecond},
+		{35, 24, 3, 120 * time.Millisecond},
+		// Equally close sizes prefer the larger.
+		{36, 48, 2, 130 * time.Millisecond},
+		{48, 48, 2, 130 * time.Millisecond},
+		{96, 48, 2, 130 * time.Millisecond},
+	}
+	for _, test := range tests {
+		frames := Nearest(images, test.size)
+		if len(frames) != test.frames {
+			t.Errorf("Nearest(%d) returned %d frames, want %d", test.size, len(frames), test.frames)
+			continue
+		}
+		var duration time.Duration
+		for _, img := range frames {
+			if img.Size != test.nominal {
+				t.Errorf("Nearest(%d) returned a frame of size %d, want %d", test.size, img.Size, test.nominal)
+			}
+			duration += img.Delay
+		}
+		if duration != test.duration {
+			t.Errorf("Nearest(%d) frames last %v, want %v", test.size, duration, test.duration)
+		}
+	}
+
+	if frames := Nearest(nil, 24); frames != nil {
+		t.Errorf("Nearest of no images returned %d frames", len(frames))
+	}
+}
+
+func TestDecodeCorrupt(t *testing.T) {
+	le := binary.LittleEndian
+	tests := []struct {
+		name    string
+		corrupt func(data []byte) []byte
+	}{
+		{"empty", func(data []byte) []byte { return nil }},
+		{"truncated header", func(data []byte) []byte { return data[:10] }},
+		{"truncated table of contents", func(data []byte) []byte { return data[:waitTOC+20] }},
+		{"truncated image header", func(data []byte) []byte { return data[:waitFirstImage+20] }},
+		{"truncated pixels", func(data []byte) []byte { return data[:waitFirstImage+50] }},
+		{"truncated last frame", func(data []byte) []byte { return data[:len(data)-1] }},
+		{"bad magic", func(data []byte) []byte {
+			copy(data, "Xcux")
+			return data
+		}},
+		{"short header size", func(data []byte) []byte {
+			le.PutUint32(data[4:], 8)
+			return data
+		}},
+		{"huge table of contents", func(data []byte) []byte {
+			le.PutUint32(data[12:], 0xffffffff)
+			return data
+		}},
+		{"image out of bounds", func(data []byte) []byte {
+			le.PutUint32(data[waitTOC+12+8:], uint32(len(data)))
+			return data
+		}},
+		{"image position overflows", func(data []byte) []byte {
+			le.PutUint32(data[waitTOC+12+8:], 0xfffffff0)
+			return data
+		}},
+		{"size differs from table of contents", func(data []byte) []byte {
+			le.PutUint32(data[waitTOC+12+4:], 32)
+			return data
+		}},
+		{"bad image header size", func(data []byte) []byte {
+			le.PutUint32(data[waitFirstImage:], 40)
+			return data
+		}},
+		{"bad image version", func(data []byte) []byte {
+			le.PutUint32(data[waitFirstImage+12:], 2)
+			return data
+		}},
+		{"zero width", func(data []byte) []byte {
+			le.PutUint32(data[waitFirstImage+16:], 0)
+			return data
+		}},
+		{"huge height", func(data []byte) []byte {
+			le.PutUint32(data[waitFirstImage+20:], 0x10000)
+			return data
+		}},
+		{"hotspot outside the image", func(data []byte) []byte {
+			le.PutUint32(data[waitFirstImage+24:], 5)
+			return data
+		}},
+		{"no images", func(data []byte) []byte {
+			le.PutUint32(data[12:], 1)
+			return data
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := test.corrupt(readWait(t))
+			images, err := Decode(bytes.NewReader(data))
+			if err == nil {
+				t.Errorf("decoded %d images, want an error", len(images))
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package xcursor
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrNotFound is returned by Theme.Load when no theme has the cursor.
+var ErrNotFound = errors.New("xcursor: cursor not found")
+
+// defaultPath is libXcursor's search path, used when XCURSOR_PATH is unset.
+const defaultPath = "~/.local/share/icons:~/.icons:/usr/share/icons:/usr/share/pixmaps"
+
+// defaultSize is the nominal cursor size used when XCURSOR_SIZE is unset.
+const defaultSize = 24
+
+// maxInherits limits how deep theme inheritance is followed.
+const maxInherits = 16
+
+// Theme is a cursor theme, which is a directory of cursor files in each
+// directory of the search path, and the themes it inherits from.
+type Theme struct {
+	name string
+	size int
+	path []string
+
+	// cache holds the cursors that were loaded, by name. Cursors that weren't
+	// found are cached as nil.
+	cache map[string][]*Image
+}
+
+// LoadTheme returns a theme by name, with a default size for its cursors. If
+// name is empty, XCURSOR_THEME is used, or the "default" theme. If size is 0,
+// XCURSOR_SIZE is used, or 24.
+//
+// Themes are read lazily: a theme that doesn't exist has no cursors other than
+// those of the "default" theme.
+func LoadTheme(name string, size int) *Theme {
+	if name == "" {
+		name = os.Getenv("XCURSOR_THEME")
+	}
+	if name == "" {
+		name = "default"
+	}
+	if size <= 0 {
+		size, _ = strconv.Atoi(os.Getenv("XCURSOR_SIZE"))
+	}
+	if size <= 0 {
+		size = defaultSize
+	}
+	return &Theme{
+		name:  name,
+		size:  size,
+		path:  SearchPath(),
+		cache: make(map[string][]*Image),
+	}
+}
+
+// Name returns the name of the theme.
+func (t *Theme) Name() string {
+	return t.name
+}
+
+// Size returns the default nominal size of the theme's cursors.
+func (t *Theme) Size() int {
+	return t.size
+}
+
+// Load returns the frames of a cursor at the nominal size closest to size, or
+// the theme's size if size is 0. It looks in the theme, then the themes it
+// inherits from, then the "default" theme.
+func (t *Theme) Load(name string, size int) ([]*Image, error) {
+	if size <= 0 {
+		size = t.size
+	}
+	images, ok := t.cache[name]
+	if !ok {
+		images = t.find(name)
+		t.cache[name] = images
+	}
+	if images == nil {
+		return nil, ErrNotFound
+	}
+	return Nearest(images, size), nil
+}
+
+// find loads a cursor from the first theme that has it.
+func (t *Theme) find(name string) []*Image {
+	seen := make(map[string]bool)
+	if images := t.findIn(t.name, name, seen, 0); images != nil {
+		return images
+	}
+	return t.findIn("default", name, seen, 0)
+}
+
+func (t *Theme) findIn(theme, name string, seen map[string]bool, depth int) []*Image {
+	if seen[theme] || depth > maxInherits || theme == "" || strings.ContainsRune(theme, '/') {
+		return nil
+	}
+	seen[theme] = true
+
+	for _, dir := range t.path {
+		if images := loadFile(filepath.Join(dir, theme, "cursors", name)); images != nil {
+			return images
+		}
+	}
+	for _, parent := range t.inherits(theme) {
+		if images := t.findIn(parent, name, seen, depth+1); images != nil {
+			return images
+		}
+	}
+	return nil
+}
+
+// inherits returns the themes a theme inherits from, from the first
+// index.theme file in the search path.
+func (t *Theme) inherits(theme string) []string {
+	for _, dir := range t.path {
+		f, err := os.Open(filepath.Join(dir, theme, "index.theme"))
+		if err != nil {
+			continue
+		}
+		defer f.Close()
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "Inherits") {
+				continue
+			}
+			value := strings.TrimSpace(strings.TrimPrefix(line, "Inherits"))
+			if !strings.HasPrefix(value, "=") {
+				continue
+			}
+			return strings.FieldsFunc(value[1:], func(r rune) bool {
+				return r == ',' || r == ';' || r == ' ' || r == '\t'
+			})
+		}
+		return nil
+	}
+	return nil
+}
+
+func loadFile(path string) []*Image {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	images, err := Decode(f)
+	if err != nil {
+		return nil
+	}
+	return images
+}
+
+// SearchPath returns the directories cursor themes are looked up in, from
+// XCURSOR_PATH or libXcursor's default, with ~ expanded to the home
+// directory.
+func SearchPath() []string {
+	path := os.Getenv("XCURSOR_PATH")
+	if path == "" {
+		path = defaultPath
+	}
+	home, _ := os.UserHomeDir()
+	var dirs []string
+	for _, dir := range strings.Split(path, ":") {
+		if dir == "~" || strings.HasPrefix(dir, "~/") {
+			if home == "" {
+				continue
+			}
+			dir = home + dir[1:]
+		}
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
@@ -0,0 +1,415 @@
+package xkb
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Keymap is a compiled keymap. It is immutable, and may be shared by several
+// States.
+type Keymap struct {
+	// mods are the names of the modifiers, by index: the eight real modifiers
+	// followed by the virtual modifiers.
+	mods []string
+
+	// mapping is the real modifiers each modifier maps to.
+	mapping []uint32
+
+	keys       map[uint32]*key
+	groupNames []string
+}
+
+// keyType maps modifiers to shift levels. Masks are resolved to real
+// modifiers.
+type keyType struct {
+	name    string
+	mods    uint32
+	entries []typeEntry
+	levels  int
+}
+
+type typeEntry struct {
+	mods     uint32
+	preserve uint32
+	level    int
+}
+
+type key struct {
+	name    string
+	groups  []keyGroup
+	repeats bool
+
+	// modmap is the real modifiers the key is bound to, and vmodmap the
+	// virtual modifiers, as a mask of modifier indices.
+	modmap  uint32
+	vmodmap uint32
+
+	outOfRange int
+	redirect   int
+}
+
+type keyGroup struct {
+	typ    *keyType
+	levels [][]Keysym
+}
+
+// How to handle groups beyond the groups of a key.
+const (
+	rangeWrap = iota
+	rangeSaturate
+	rangeRedirect
+)
+
+// realMask is the mask of the real modifiers.
+const realMask = 0xff
+
+// Indices of the real modifiers.
+const (
+	modShift = iota
+	modLock
+	modControl
+)
+
+// ParseKeymap compiles a keymap in the XKB text format, as sent by Wayland
+// compositors. A trailing NUL byte, which wl_keyboard.keymap includes, is
+// ignored.
+func ParseKeymap(src []byte) (*Keymap, error) {
+	for len(src) > 0 && src[len(src)-1] == 0 {
+		src = src[:len(src)-1]
+	}
+	toks, err := lex(string(src))
+	if err != nil {
+		return nil, fmt.Errorf("xkb: %w", err)
+	}
+	p := newParser(toks)
+	if err := p.parseKeymap(); err != nil {
+		return nil, fmt.Errorf("xkb: %w", err)
+	}
+	return compile(p)
+}
+
+func compile(p *parser) (*Keymap, error) {
+	km := &Keymap{
+		mods:       p.mods,
+		mapping:    make([]uint32, len(p.mods)),
+		keys:       make(map[uint32]*key),
+		groupNames: p.groups,
+	}
+	for i := range realMods {
+		km.mapping[i] = 1 << i
+	}
+	for i, value := range p.vmodValues {
+		km.mapping[i] = value & realMask
+	}
+
+	types := make(map[string]*typeDef, len(p.types))
+	for _, t := range p.types {
+		types[t.name] = t
+	}
+
+	// Symbols.
+	for _, def := range p.keys {
+		k := km.lookup(p, def.name)
+		if k == nil {
+			continue
+		}
+		k.outOfRange, k.redirect = def.outOfRange, def.redirect
+		if def.hasVmods {
+			k.vmodmap = def.vmods &^ realMask
+		}
+		k.groups = make([]keyGroup, len(def.groups))
+		for i, g := range def.groups {
+			name := g.typeName
+			if name == "" {
+				name = def.defaultType
+			}
+			if name == "" {
+				name = automaticType(g.levels)
+			}
+			k.groups[i].levels = g.levels
+			if t := types[name]; t != nil {
+				k.groups[i].typ = &keyType{name: t.name, levels: t.numLevel}
+			} else {
+				// Without a type, only the first level is reachable.
+				k.groups[i].typ = &keyType{name: name, levels: 1}
+			}
+		}
+		for len(k.groups) > 0 && len(k.groups[len(k.groups)-1].levels) == 0 {
+			k.groups = k.groups[:len(k.groups)-1]
+		}
+
+		// Keys repeat unless their interpret says otherwise, except those
+		// without a keysym on their first level.
+		switch def.repeat {
+		case repeatYes:
+			k.repeats = true
+		case repeatNo:
+			k.repeats = false
+		default:
+			k.repeats = len(k.groups) > 0 && len(k.groups[0].levels) > 0 && len(k.groups[0].levels[0]) > 0
+		}
+	}
+
+	// Real modifiers bound to keys.
+	for _, def := range p.modmaps {
+		for _, name := range def.keys {
+			if k := km.lookup(p, name); k != nil {
+				k.modmap |= 1 << def.mod
+			}
+		}
+		for _, sym := range def.syms {
+			if k := km.keyWithSym(sym); k != nil {
+				k.modmap |= 1 << def.mod
+			}
+		}
+	}
+
+	// Bind interprets to keys, which decides which virtual modifiers keys are
+	// bound to, and whether they repeat.
+	interps := append([]*interpretDef(nil), p.interps...)
+	sort.SliceStable(interps, func(i, j int) bool {
+		a, b := interps[i], interps[j]
+		if (a.sym != NoSymbol) != (b.sym != NoSymbol) {
+			return a.sym != NoSymbol
+		}
+		return a.pred < b.pred
+	})
+	for _, def := range p.keys {
+		k := km.lookup(p, def.name)
+		if k == nil {
+			continue
+		}
+		var vmodmap uint32
+		for g := range k.groups {
+			for level := range k.groups[g].levels {
+				interp := findInterpret(interps, k, g, level)
+				if interp == nil {
+					continue
+				}
+				if g == 0 && level == 0 && def.repeat == repeatUndefined {
+					k.repeats = interp.repeat
+				}
+				if (g == 0 && level == 0 || !interp.levelOneOnly) && interp.vmod >= 0 && interp.vmod < 32 {
+					vmodmap |= 1 << interp.vmod
+				}
+			}
+		}
+		if !def.hasVmods {
+			k.vmodmap = vmodmap
+		}
+	}
+
+	// Virtual modifiers map to the real modifiers of the keys bound to them.
+	for _, k := range km.keys {
+		for i := len(realMods); i < len(km.mods) && i < 32; i++ {
+			if k.vmodmap&(1<<i) != 0 {
+				km.mapping[i] |= k.modmap
+			}
+		}
+	}
+
+	// Resolve types now that the mapping is known. Entries whose modifiers
+	// don't map to anything can never match, and are dropped.
+	for _, k := range km.keys {
+		for i := range k.groups {
+			t := k.groups[i].typ
+			def := types[t.name]
+			if def == nil {
+				continue
+			}
+			t.mods = km.resolve(def.mods)
+			for _, e := range def.entries {
+				mods := km.resolve(e.mods)
+				if e.mods != 0 && mods == 0 {
+					continue
+				}
+				t.entries = append(t.entries, typeEntry{
+					mods:     mods,
+					preserve: km.resolve(e.preserve),
+					level:    e.level,
+				})
+			}
+		}
+	}
+
+	return km, nil
+}
+
+// lookup returns the key with a name or alias, creating it if needed, or nil
+// if there is no such key.
+func (km *Keymap) lookup(p *parser, name string) *key {
+	if real, ok := p.aliases[name]; ok {
+		name = real
+	}
+	code, ok := p.keycodes[name]
+	if !ok {
+		return nil
+	}
+	k := km.keys[code]
+	if k == nil {
+		k = &key{name: name}
+		km.keys[code] = k
+	}
+	return k
+}
+
+// keyWithSym returns the key that produces a keysym with the lowest group
+// and level, and then keycode.
+func (km *Keymap) keyWithSym(sym Keysym) *key {
+	var best *key
+	var bestCode uint32
+	bestGroup, bestLevel := -1, -1
+	for code, k := range km.keys {
+		for g, group := range k.groups {
+			for level, syms := range group.levels {
+				if len(syms) == 0 || syms[0] != sym {
+					continue
+				}
+				better := best == nil || g < bestGroup ||
+					g == bestGroup && (level < bestLevel || level == bestLevel && code < bestCode)
+				if better {
+					best, bestCode, bestGroup, bestLevel = k, code, g, level
+				}
+			}
+		}
+	}
+	return best
+}
+
+// findInterpret returns the first interpret that matches a level of a key.
+func findInterpret(interps []*interpretDef, k *key, group, level int) *interpretDef {
+	syms := k.groups[group].levels[level]
+	if len(syms) != 1 {
+		return nil
+	}
+	for _, interp := range interps {
+		if interp.sym != NoSymbol && interp.sym != syms[0] {
+			continue
+		}
+		mods := k.modmap
+		if interp.levelOneOnly && level != 0 {
+			mods = 0
+		}
+		want := interp.mods & realMask
+		var match bool
+		switch interp.pred {
+		case matchNoneOf:
+			match = want&mods == 0
+		case matchAnyOfOrNone:
+			match = mods == 0 || want&mods != 0
+		case matchAnyOf:
+			match = want&mods != 0
+		case matchAllOf:
+			match = want&mods == want
+		case matchExactly:
+			match = want == mods
+		}
+		if match {
+			return interp
+		}
+	}
+	return nil
+}
+
+// automaticType returns the type of a group without an explicit type, based
+// on its symbols.
+func automaticType(levels [][]Keysym) string {
+	sym := func(level int) Keysym {
+		if level < len(levels) && len(levels[level]) > 0 {
+			return levels[level][0]
+		}
+		return NoSymbol
+	}
+	lowerUpper := func(lower, upper Keysym) bool {
+		return lower != NoSymbol && upper != NoSymbol && lower.IsLower() && upper.IsUpper()
+	}
+
+	switch n := len(levels); {
+	case n <= 1:
+		return "ONE_LEVEL"
+	case n == 2:
+		switch {
+		case lowerUpper(sym(0), sym(1)):
+			return "ALPHABETIC"
+		case sym(0).IsKeypad() || sym(1).IsKeypad():
+			return "KEYPAD"
+		}
+		return "TWO_LEVEL"
+	case n <= 4:
+		switch {
+		case lowerUpper(sym(0), sym(1)):
+			if lowerUpper(sym(2), sym(3)) {
+				return "FOUR_LEVEL_ALPHABETIC"
+			}
+			return "FOUR_LEVEL_SEMIALPHABETIC"
+		case sym(0).IsKeypad() || sym(1).IsKeypad():
+			return "FOUR_LEVEL_KEYPAD"
+		}
+		return "FOUR_LEVEL"
+	}
+	return "FOUR_LEVEL"
+}
+
+// resolve maps a mask of modifier indices to real modifiers.
+func (km *Keymap) resolve(mask uint32) uint32 {
+	real := mask & realMask
+	for i := len(realMods); i < len(km.mapping) && i < 32; i++ {
+		if mask&(1<<i) != 0 {
+			real |= km.mapping[i]
+		}
+	}
+	return real
+}
+
+// Mods returns the names of the modifiers of the keymap, by index, as used in
+// modifier masks: the real modifiers Shift, Lock, Control and Mod1 to Mod5,
+// followed by virtual modifiers such as NumLock and Alt.
+func (km *Keymap) Mods() []string {
+	return append([]string(nil), km.mods...)
+}
+
+// ModMask returns the mask of real modifiers a modifier maps to, or zero if
+// there is no modifier with that name or it is not bound.
+func (km *Keymap) ModMask(name string) uint32 {
+	for i, mod := range km.mods {
+		if mod == name && i < 32 {
+			return km.resolve(1 << i)
+		}
+	}
+	return 0
+}
+
+// Groups returns the number of groups, also called layouts, of the keymap.
+func (km *Keymap) Groups() int {
+	n := len(km.groupNames)
+	for _, k := range km.keys {
+		if len(k.groups) > n {
+			n = len(k.groups)
+		}
+	}
+	return n
+}
+
+// GroupName returns the name of a group, such as "English (US)", or "" if it
+// has none.
+func (km *Keymap) GroupName(group int) string {
+	if group < 0 || group >= len(km.groupNames) {
+		return ""
+	}
+	return km.groupNames[group]
+}
+
+// Repeats returns whether a key should repeat when held down.
+func (km *Keymap) Repeats(keycode uint32) bool {
+	k := km.keys[keycode]
+	return k != nil && k.repeats
+}
+
+// KeyName returns the name of a key, such as "AE01", or "" if there is no key
+// with that keycode.
+func (km *Keymap) KeyName(keycode uint32) string {
+	if k := km.keys[keycode]; k != nil {
+		return k.name
+	}
+	return ""
+}
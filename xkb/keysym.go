@@ -0,0 +1,191 @@
+// Package xkb interprets XKB keymaps, which describe how the keys of a
+// keyboard produce symbols and text, without libxkbcommon.
+//
+// Keymaps are compiled from the text format that Wayland compositors send
+// with wl_keyboard.keymap: a complete xkb_keymap, as written by libxkbcommon,
+// without includes. A State then tracks the modifiers and layout sent by the
+// compositor, and translates keycodes to keysyms and text.
+package xkb
+
+//go:generate go run ../cmd/keysymgen ../third_party/xorgproto/include/X11/keysymdef.h ../third_party/xorgproto/include/X11/XF86keysym.h ../third_party/xorgproto/include/X11/Sunkeysym.h ../third_party/xorgproto/include/X11/DECkeysym.h ../third_party/xorgproto/include/X11/HPkeysym.h
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Keysym is a symbol produced by a key, such as a character or a function
+// like KeyReturn.
+type Keysym uint32
+
+// NoSymbol is the absence of a keysym.
+const NoSymbol Keysym = 0
+
+// unicodeOffset is added to a Unicode code point to get a keysym that
+// directly encodes it.
+const unicodeOffset = 0x01000000
+
+type keysymName struct {
+	name string
+	sym  Keysym
+}
+
+type keysymRune struct {
+	sym    Keysym
+	r      rune
+	legacy bool
+}
+
+// KeysymFromName returns the keysym with a name, as used in keymaps: a name
+// defined in the X11 headers, such as "Return" or "adiaeresis", a Unicode
+// code point such as "U20AC", or a number such as "0x1008ff13". It returns
+// NoSymbol if the name is not valid.
+func KeysymFromName(name string) Keysym {
+	i := sort.Search(len(keysymNames), func(i int) bool { return keysymNames[i].name >= name })
+	if i < len(keysymNames) && keysymNames[i].name == name {
+		return keysymNames[i].sym
+	}
+
+	if len(name) > 1 && name[0] == 'U' {
+		r, err := strconv.ParseUint(name[1:], 16, 32)
+		if err != nil || r > unicode.MaxRune {
+			return NoSymbol
+		}
+		if isLatin1(rune(r)) {
+			return Keysym(r)
+		}
+		return Keysym(r + unicodeOffset)
+	}
+	if strings.HasPrefix(name, "0x") {
+		v, err := strconv.ParseUint(name[2:], 16, 32)
+		if err != nil {
+			return NoSymbol
+		}
+		return Keysym(v)
+	}
+	return NoSymbol
+}
+
+// Name returns the name of the keysym, in the format accepted by
+// KeysymFromName.
+func (k Keysym) Name() string {
+	i := sort.Search(len(keysymValues), func(i int) bool { return keysymValues[i].sym >= k })
+	if i < len(keysymValues) && keysymValues[i].sym == k {
+		return keysymValues[i].name
+	}
+	if k >= unicodeOffset+0x100 && k <= unicodeOffset+unicode.MaxRune {
+		return fmt.Sprintf("U%04X", uint32(k-unicodeOffset))
+	}
+	return fmt.Sprintf("%#x", uint32(k))
+}
+
+func (k Keysym) String() string {
+	return k.Name()
+}
+
+// Rune returns the character the keysym produces, or zero if it doesn't
+// produce one. Keys such as Return and Escape produce control characters.
+func (k Keysym) Rune() rune {
+	switch {
+	case isLatin1(rune(k)):
+		return rune(k)
+	case k == KeyKP_Space:
+		return ' '
+	case k >= KeyBackSpace && k <= KeyClear, k >= KeyKP_Multiply && k <= KeyKP_9,
+		k == KeyReturn, k == KeyEscape, k == KeyDelete, k == KeyKP_Tab,
+		k == KeyKP_Enter, k == KeyKP_Equal:
+		return rune(k & 0x7f)
+	case k >= unicodeOffset+0x100 && k <= unicodeOffset+unicode.MaxRune:
+		return rune(k - unicodeOffset)
+	}
+
+	i := sort.Search(len(keysymRunes), func(i int) bool { return keysymRunes[i].sym >= k })
+	if i < len(keysymRunes) && keysymRunes[i].sym == k {
+		return keysymRunes[i].r
+	}
+	return 0
+}
+
+// runeKeysyms maps characters back to keysyms that are not Unicode or Latin-1
+// keysyms. It is built on first use.
+var runeKeysyms map[rune]Keysym
+
+// KeysymFromRune returns the keysym for a character: a named keysym if there
+// is one, and otherwise one that directly encodes the code point.
+func KeysymFromRune(r rune) Keysym {
+	if isLatin1(r) {
+		return Keysym(r)
+	}
+	if runeKeysyms == nil {
+		runeKeysyms = make(map[rune]Keysym, len(keysymRunes))
+		for _, e := range keysymRunes {
+			if _, ok := runeKeysyms[e.r]; !ok && !e.legacy {
+				runeKeysyms[e.r] = e.sym
+			}
+		}
+	}
+	if k, ok := runeKeysyms[r]; ok {
+		return k
+	}
+	if r < 0x100 {
+		// Control characters have no keysyms.
+		return NoSymbol
+	}
+	return Keysym(r + unicodeOffset)
+}
+
+// isLatin1 returns whether a character is a printable Latin-1 character,
+// whose keysym has the same value.
+func isLatin1(r rune) bool {
+	return r >= 0x20 && r <= 0x7e || r >= 0xa0 && r <= 0xff
+}
+
+// ToUpper returns the uppercase version of a keysym, or the keysym itself if
+// it has none.
+func (k Keysym) ToUpper() Keysym {
+	return k.convertCase(unicode.ToUpper)
+}
+
+// ToLower returns the lowercase version of a keysym, or the keysym itself if
+// it has none.
+func (k Keysym) ToLower() Keysym {
+	return k.convertCase(unicode.ToLower)
+}
+
+func (k Keysym) convertCase(fn func(rune) rune) Keysym {
+	r := k.Rune()
+	if r == 0 || r < 0x20 {
+		return k
+	}
+	if c := fn(r); c != r {
+		if other := KeysymFromRune(c); other != NoSymbol {
+			return other
+		}
+	}
+	return k
+}
+
+// IsLower returns whether a keysym is a lowercase letter.
+func (k Keysym) IsLower() bool {
+	return k.ToUpper() != k
+}
+
+// IsUpper returns whether a keysym is an uppercase letter.
+func (k Keysym) IsUpper() bool {
+	return k.ToLower() != k
+}
+
+// IsKeypad returns whether a keysym is on the numeric keypad.
+func (k Keysym) IsKeypad() bool {
+	return k >= KeyKP_Space && k <= KeyKP_Equal
+}
+
+// IsModifier returns whether a keysym is a modifier, such as Shift_L.
+func (k Keysym) IsModifier() bool {
+	return k >= KeyShift_L && k <= KeyHyper_R ||
+		k >= KeyISO_Lock && k <= KeyISO_Level5_Lock ||
+		k == KeyMode_switch || k == KeyNum_Lock
+}
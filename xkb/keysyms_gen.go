@@ -0,0 +1,8316 @@
+// THIS FILE IS GENERATED BY KEYSYMGEN - DO NOT EDIT
+//
+// Generated from keysymdef.h, XF86keysym.h, Sunkeysym.h, DECkeysym.h, HPkeysym.h.
+
+package xkb
+
+// Keysyms, named as in the X11 headers.
+const (
+	KeyVoidSymbol                  Keysym = 0xffffff
+	KeyBackSpace                   Keysym = 0xff08
+	KeyTab                         Keysym = 0xff09
+	KeyLinefeed                    Keysym = 0xff0a
+	KeyClear                       Keysym = 0xff0b
+	KeyReturn                      Keysym = 0xff0d
+	KeyPause                       Keysym = 0xff13
+	KeyScroll_Lock                 Keysym = 0xff14
+	KeySys_Req                     Keysym = 0xff15
+	KeyEscape                      Keysym = 0xff1b
+	KeyDelete                      Keysym = 0xffff
+	KeyMulti_key                   Keysym = 0xff20
+	KeyCodeinput                   Keysym = 0xff37
+	KeySingleCandidate             Keysym = 0xff3c
+	KeyMultipleCandidate           Keysym = 0xff3d
+	KeyPreviousCandidate           Keysym = 0xff3e
+	KeyKanji                       Keysym = 0xff21
+	KeyMuhenkan                    Keysym = 0xff22
+	KeyHenkan_Mode                 Keysym = 0xff23
+	KeyHenkan                      Keysym = 0xff23
+	KeyRomaji                      Keysym = 0xff24
+	KeyHiragana                    Keysym = 0xff25
+	KeyKatakana                    Keysym = 0xff26
+	KeyHiragana_Katakana           Keysym = 0xff27
+	KeyZenkaku                     Keysym = 0xff28
+	KeyHankaku                     Keysym = 0xff29
+	KeyZenkaku_Hankaku             Keysym = 0xff2a
+	KeyTouroku                     Keysym = 0xff2b
+	KeyMassyo                      Keysym = 0xff2c
+	KeyKana_Lock                   Keysym = 0xff2d
+	KeyKana_Shift                  Keysym = 0xff2e
+	KeyEisu_Shift                  Keysym = 0xff2f
+	KeyEisu_toggle                 Keysym = 0xff30
+	KeyKanji_Bangou                Keysym = 0xff37
+	KeyZen_Koho                    Keysym = 0xff3d
+	KeyMae_Koho                    Keysym = 0xff3e
+	KeyHome                        Keysym = 0xff50
+	KeyLeft                        Keysym = 0xff51
+	KeyUp                          Keysym = 0xff52
+	KeyRight                       Keysym = 0xff53
+	KeyDown                        Keysym = 0xff54
+	KeyPrior                       Keysym = 0xff55
+	KeyPage_Up                     Keysym = 0xff55
+	KeyNext                        Keysym = 0xff56
+	KeyPage_Down                   Keysym = 0xff56
+	KeyEnd                         Keysym = 0xff57
+	KeyBegin                       Keysym = 0xff58
+	KeySelect                      Keysym = 0xff60
+	KeyPrint                       Keysym = 0xff61
+	KeyExecute                     Keysym = 0xff62
+	KeyInsert                      Keysym = 0xff63
+	KeyUndo                        Keysym = 0xff65
+	KeyRedo                        Keysym = 0xff66
+	KeyMenu                        Keysym = 0xff67
+	KeyFind                        Keysym = 0xff68
+	KeyCancel                      Keysym = 0xff69
+	KeyHelp                        Keysym = 0xff6a
+	KeyBreak                       Keysym = 0xff6b
+	KeyMode_switch                 Keysym = 0xff7e
+	Keyscript_switch               Keysym = 0xff7e
+	KeyNum_Lock                    Keysym = 0xff7f
+	KeyKP_Space                    Keysym = 0xff80
+	KeyKP_Tab                      Keysym = 0xff89
+	KeyKP_Enter                    Keysym = 0xff8d
+	KeyKP_F1                       Keysym = 0xff91
+	KeyKP_F2                       Keysym = 0xff92
+	KeyKP_F3                       Keysym = 0xff93
+	KeyKP_F4                       Keysym = 0xff94
+	KeyKP_Home                     Keysym = 0xff95
+	KeyKP_Left                     Keysym = 0xff96
+	KeyKP_Up                       Keysym = 0xff97
+	KeyKP_Right                    Keysym = 0xff98
+	KeyKP_Down                     Keysym = 0xff99
+	KeyKP_Prior                    Keysym = 0xff9a
+	KeyKP_Page_Up                  Keysym = 0xff9a
+	KeyKP_Next                     Keysym = 0xff9b
+	KeyKP_Page_Down                Keysym = 0xff9b
+	KeyKP_End                      Keysym = 0xff9c
+	KeyKP_Begin                    Keysym = 0xff9d
+	KeyKP_Insert                   Keysym = 0xff9e
+	KeyKP_Delete                   Keysym = 0xff9f
+	KeyKP_Equal                    Keysym = 0xffbd
+	KeyKP_Multiply                 Keysym = 0xffaa
+	KeyKP_Add                      Keysym = 0xffab
+	KeyKP_Separator                Keysym = 0xffac
+	KeyKP_Subtract                 Keysym = 0xffad
+	KeyKP_Decimal                  Keysym = 0xffae
+	KeyKP_Divide                   Keysym = 0xffaf
+	KeyKP_0                        Keysym = 0xffb0
+	KeyKP_1                        Keysym = 0xffb1
+	KeyKP_2                        Keysym = 0xffb2
+	KeyKP_3                        Keysym = 0xffb3
+	KeyKP_4                        Keysym = 0xffb4
+	KeyKP_5                        Keysym = 0xffb5
+	KeyKP_6                        Keysym = 0xffb6
+	KeyKP_7                        Keysym = 0xffb7
+	KeyKP_8                        Keysym = 0xffb8
+	KeyKP_9                        Keysym = 0xffb9
+	KeyF1                          Keysym = 0xffbe
+	KeyF2                          Keysym = 0xffbf
+	KeyF3                          Keysym = 0xffc0
+	KeyF4                          Keysym = 0xffc1
+	KeyF5                          Keysym = 0xffc2
+	KeyF6                          Keysym = 0xffc3
+	KeyF7                          Keysym = 0xffc4
+	KeyF8                          Keysym = 0xffc5
+	KeyF9                          Keysym = 0xffc6
+	KeyF10                         Keysym = 0xffc7
+	KeyF11                         Keysym = 0xffc8
+	KeyL1                          Keysym = 0xffc8
+	KeyF12                         Keysym = 0xffc9
+	KeyL2                          Keysym = 0xffc9
+	KeyF13                         Keysym = 0xffca
+	KeyL3                          Keysym = 0xffca
+	KeyF14                         Keysym = 0xffcb
+	KeyL4                          Keysym = 0xffcb
+	KeyF15                         Keysym = 0xffcc
+	KeyL5                          Keysym = 0xffcc
+	KeyF16                         Keysym = 0xffcd
+	KeyL6                          Keysym = 0xffcd
+	KeyF17                         Keysym = 0xffce
+	KeyL7                          Keysym = 0xffce
+	KeyF18                         Keysym = 0xffcf
+	KeyL8                          Keysym = 0xffcf
+	KeyF19                         Keysym = 0xffd0
+	KeyL9                          Keysym = 0xffd0
+	KeyF20                         Keysym = 0xffd1
+	KeyL10                         Keysym = 0xffd1
+	KeyF21                         Keysym = 0xffd2
+	KeyR1                          Keysym = 0xffd2
+	KeyF22                         Keysym = 0xffd3
+	KeyR2                          Keysym = 0xffd3
+	KeyF23                         Keysym = 0xffd4
+	KeyR3                          Keysym = 0xffd4
+	KeyF24                         Keysym = 0xffd5
+	KeyR4                          Keysym = 0xffd5
+	KeyF25                         Keysym = 0xffd6
+	KeyR5                          Keysym = 0xffd6
+	KeyF26                         Keysym = 0xffd7
+	KeyR6                          Keysym = 0xffd7
+	KeyF27                         Keysym = 0xffd8
+	KeyR7                          Keysym = 0xffd8
+	KeyF28                         Keysym = 0xffd9
+	KeyR8                          Keysym = 0xffd9
+	KeyF29                         Keysym = 0xffda
+	KeyR9                          Keysym = 0xffda
+	KeyF30                         Keysym = 0xffdb
+	KeyR10                         Keysym = 0xffdb
+	KeyF31                         Keysym = 0xffdc
+	KeyR11                         Keysym = 0xffdc
+	KeyF32                         Keysym = 0xffdd
+	KeyR12                         Keysym = 0xffdd
+	KeyF33                         Keysym = 0xffde
+	KeyR13                         Keysym = 0xffde
+	KeyF34                         Keysym = 0xffdf
+	KeyR14                         Keysym = 0xffdf
+	KeyF35                         Keysym = 0xffe0
+	KeyR15                         Keysym = 0xffe0
+	KeyShift_L                     Keysym = 0xffe1
+	KeyShift_R                     Keysym = 0xffe2
+	KeyControl_L                   Keysym = 0xffe3
+	KeyControl_R                   Keysym = 0xffe4
+	KeyCaps_Lock                   Keysym = 0xffe5
+	KeyShift_Lock                  Keysym = 0xffe6
+	KeyMeta_L                      Keysym = 0xffe7
+	KeyMeta_R                      Keysym = 0xffe8
+	KeyAlt_L                       Keysym = 0xffe9
+	KeyAlt_R                       Keysym = 0xffea
+	KeySuper_L                     Keysym = 0xffeb
+	KeySuper_R                     Keysym = 0xffec
+	KeyHyper_L                     Keysym = 0xffed
+	KeyHyper_R                     Keysym = 0xffee
+	KeyISO_Lock                    Keysym = 0xfe01
+	KeyISO_Level2_Latch            Keysym = 0xfe02
+	KeyISO_Level3_Shift            Keysym = 0xfe03
+	KeyISO_Level3_Latch            Keysym = 0xfe04
+	KeyISO_Level3_Lock             Keysym = 0xfe05
+	KeyISO_Level5_Shift            Keysym = 0xfe11
+	KeyISO_Level5_Latch            Keysym = 0xfe12
+	KeyISO_Level5_Lock             Keysym = 0xfe13
+	KeyISO_Group_Shift             Keysym = 0xff7e
+	KeyISO_Group_Latch             Keysym = 0xfe06
+	KeyISO_Group_Lock              Keysym = 0xfe07
+	KeyISO_Next_Group              Keysym = 0xfe08
+	KeyISO_Next_Group_Lock         Keysym = 0xfe09
+	KeyISO_Prev_Group              Keysym = 0xfe0a
+	KeyISO_Prev_Group_Lock         Keysym = 0xfe0b
+	KeyISO_First_Group             Keysym = 0xfe0c
+	KeyISO_First_Group_Lock        Keysym = 0xfe0d
+	KeyISO_Last_Group              Keysym = 0xfe0e
+	KeyISO_Last_Group_Lock         Keysym = 0xfe0f
+	KeyISO_Left_Tab                Keysym = 0xfe20
+	KeyISO_Move_Line_Up            Keysym = 0xfe21
+	KeyISO_Move_Line_Down          Keysym = 0xfe22
+	KeyISO_Partial_Line_Up         Keysym = 0xfe23
+	KeyISO_Partial_Line_Down       Keysym = 0xfe24
+	KeyISO_Partial_Space_Left      Keysym = 0xfe25
+	KeyISO_Partial_Space_Right     Keysym = 0xfe26
+	KeyISO_Set_Margin_Left         Keysym = 0xfe27
+	KeyISO_Set_Margin_Right        Keysym = 0xfe28
+	KeyISO_Release_Margin_Left     Keysym = 0xfe29
+	KeyISO_Release_Margin_Right    Keysym = 0xfe2a
+	KeyISO_Release_Both_Margins    Keysym = 0xfe2b
+	KeyISO_Fast_Cursor_Left        Keysym = 0xfe2c
+	KeyISO_Fast_Cursor_Right       Keysym = 0xfe2d
+	KeyISO_Fast_Cursor_Up          Keysym = 0xfe2e
+	KeyISO_Fast_Cursor_Down        Keysym = 0xfe2f
+	KeyISO_Continuous_Underline    Keysym = 0xfe30
+	KeyISO_Discontinuous_Underline Keysym = 0xfe31
+	KeyISO_Emphasize               Keysym = 0xfe32
+	KeyISO_Center_Object           Keysym = 0xfe33
+	KeyISO_Enter                   Keysym = 0xfe34
+	Keydead_grave                  Keysym = 0xfe50
+	Keydead_acute                  Keysym = 0xfe51
+	Keydead_circumflex             Keysym = 0xfe52
+	Keydead_tilde                  Keysym = 0xfe53
+	Keydead_perispomeni            Keysym = 0xfe53
+	Keydead_macron                 Keysym = 0xfe54
+	Keydead_breve                  Keysym = 0xfe55
+	Keydead_abovedot               Keysym = 0xfe56
+	Keydead_diaeresis              Keysym = 0xfe57
+	Keydead_abovering              Keysym = 0xfe58
+	Keydead_doubleacute            Keysym = 0xfe59
+	Keydead_caron                  Keysym = 0xfe5a
+	Keydead_cedilla                Keysym = 0xfe5b
+	Keydead_ogonek                 Keysym = 0xfe5c
+	Keydead_iota                   Keysym = 0xfe5d
+	Keydead_voiced_sound           Keysym = 0xfe5e
+	Keydead_semivoiced_sound       Keysym = 0xfe5f
+	Keydead_belowdot               Keysym = 0xfe60
+	Keydead_hook                   Keysym = 0xfe61
+	Keydead_horn                   Keysym = 0xfe62
+	Keydead_stroke                 Keysym = 0xfe63
+	Keydead_abovecomma             Keysym = 0xfe64
+	Keydead_psili                  Keysym = 0xfe64
+	Keydead_abovereversedcomma     Keysym = 0xfe65
+	Keydead_dasia                  Keysym = 0xfe65
+	Keydead_doublegrave            Keysym = 0xfe66
+	Keydead_belowring              Keysym = 0xfe67
+	Keydead_belowmacron            Keysym = 0xfe68
+	Keydead_belowcircumflex        Keysym = 0xfe69
+	Keydead_belowtilde             Keysym = 0xfe6a
+	Keydead_belowbreve             Keysym = 0xfe6b
+	Keydead_belowdiaeresis         Keysym = 0xfe6c
+	Keydead_invertedbreve          Keysym = 0xfe6d
+	Keydead_belowcomma             Keysym = 0xfe6e
+	Keydead_currency               Keysym = 0xfe6f
+	Keydead_lowline                Keysym = 0xfe90
+	Keydead_aboveverticalline      Keysym = 0xfe91
+	Keydead_belowverticalline      Keysym = 0xfe92
+	Keydead_longsolidusoverlay     Keysym = 0xfe93
+	Keydead_a                      Keysym = 0xfe80
+	Keydead_A                      Keysym = 0xfe81
+	Keydead_e                      Keysym = 0xfe82
+	Keydead_E                      Keysym = 0xfe83
+	Keydead_i                      Keysym = 0xfe84
+	Keydead_I                      Keysym = 0xfe85
+	Keydead_o                      Keysym = 0xfe86
+	Keydead_O                      Keysym = 0xfe87
+	Keydead_u                      Keysym = 0xfe88
+	Keydead_U                      Keysym = 0xfe89
+	Keydead_small_schwa            Keysym = 0xfe8a
+	Keydead_capital_schwa          Keysym = 0xfe8b
+	Keydead_greek                  Keysym = 0xfe8c
+	KeyFirst_Virtual_Screen        Keysym = 0xfed0
+	KeyPrev_Virtual_Screen         Keysym = 0xfed1
+	KeyNext_Virtual_Screen         Keysym = 0xfed2
+	KeyLast_Virtual_Screen         Keysym = 0xfed4
+	KeyTerminate_Server            Keysym = 0xfed5
+	KeyAccessX_Enable              Keysym = 0xfe70
+	KeyAccessX_Feedback_Enable     Keysym = 0xfe71
+	KeyRepeatKeys_Enable           Keysym = 0xfe72
+	KeySlowKeys_Enable             Keysym = 0xfe73
+	KeyBounceKeys_Enable           Keysym = 0xfe74
+	KeyStickyKeys_Enable           Keysym = 0xfe75
+	KeyMouseKeys_Enable            Keysym = 0xfe76
+	KeyMouseKeys_Accel_Enable      Keysym = 0xfe77
+	KeyOverlay1_Enable             Keysym = 0xfe78
+	KeyOverlay2_Enable             Keysym = 0xfe79
+	KeyAudibleBell_Enable          Keysym = 0xfe7a
+	KeyPointer_Left                Keysym = 0xfee0
+	KeyPointer_Right               Keysym = 0xfee1
+	KeyPointer_Up                  Keysym = 0xfee2
+	KeyPointer_Down                Keysym = 0xfee3
+	KeyPointer_UpLeft              Keysym = 0xfee4
+	KeyPointer_UpRight             Keysym = 0xfee5
+	KeyPointer_DownLeft            Keysym = 0xfee6
+	KeyPointer_DownRight           Keysym = 0xfee7
+	KeyPointer_Button_Dflt         Keysym = 0xfee8
+	KeyPointer_Button1             Keysym = 0xfee9
+	KeyPointer_Button2             Keysym = 0xfeea
+	KeyPointer_Button3             Keysym = 0xfeeb
+	KeyPointer_Button4             Keysym = 0xfeec
+	KeyPointer_Button5             Keysym = 0xfeed
+	KeyPointer_DblClick_Dflt       Keysym = 0xfeee
+	KeyPointer_DblClick1           Keysym = 0xfeef
+	KeyPointer_DblClick2           Keysym = 0xfef0
+	KeyPointer_DblClick3           Keysym = 0xfef1
+	KeyPointer_DblClick4           Keysym = 0xfef2
+	KeyPointer_DblClick5           Keysym = 0xfef3
+	KeyPointer_Drag_Dflt           Keysym = 0xfef4
+	KeyPointer_Drag1               Keysym = 0xfef5
+	KeyPointer_Drag2               Keysym = 0xfef6
+	KeyPointer_Drag3               Keysym = 0xfef7
+	KeyPointer_Drag4               Keysym = 0xfef8
+	KeyPointer_Drag5               Keysym = 0xfefd
+	KeyPointer_EnableKeys          Keysym = 0xfef9
+	KeyPointer_Accelerate          Keysym = 0xfefa
+	KeyPointer_DfltBtnNext         Keysym = 0xfefb
+	KeyPointer_DfltBtnPrev         Keysym = 0xfefc
+	Keych                          Keysym = 0xfea0
+	KeyCh                          Keysym = 0xfea1
+	KeyCH                          Keysym = 0xfea2
+	Keyc_h                         Keysym = 0xfea3
+	KeyC_h                         Keysym = 0xfea4
+	KeyC_H                         Keysym = 0xfea5
+	Key3270_Duplicate              Keysym = 0xfd01
+	Key3270_FieldMark              Keysym = 0xfd02
+	Key3270_Right2                 Keysym = 0xfd03
+	Key3270_Left2                  Keysym = 0xfd04
+	Key3270_BackTab                Keysym = 0xfd05
+	Key3270_EraseEOF               Keysym = 0xfd06
+	Key3270_EraseInput             Keysym = 0xfd07
+	Key3270_Reset                  Keysym = 0xfd08
+	Key3270_Quit                   Keysym = 0xfd09
+	Key3270_PA1                    Keysym = 0xfd0a
+	Key3270_PA2                    Keysym = 0xfd0b
+	Key3270_PA3                    Keysym = 0xfd0c
+	Key3270_Test                   Keysym = 0xfd0d
+	Key3270_Attn                   Keysym = 0xfd0e
+	Key3270_CursorBlink            Keysym = 0xfd0f
+	Key3270_AltCursor              Keysym = 0xfd10
+	Key3270_KeyClick               Keysym = 0xfd11
+	Key3270_Jump                   Keysym = 0xfd12
+	Key3270_Ident                  Keysym = 0xfd13
+	Key3270_Rule                   Keysym = 0xfd14
+	Key3270_Copy                   Keysym = 0xfd15
+	Key3270_Play                   Keysym = 0xfd16
+	Key3270_Setup                  Keysym = 0xfd17
+	Key3270_Record                 Keysym = 0xfd18
+	Key3270_ChangeScreen           Keysym = 0xfd19
+	Key3270_DeleteWord             Keysym = 0xfd1a
+	Key3270_ExSelect               Keysym = 0xfd1b
+	Key3270_CursorSelect           Keysym = 0xfd1c
+	Key3270_PrintScreen            Keysym = 0xfd1d
+	Key3270_Enter                  Keysym = 0xfd1e
+	Keyspace                       Keysym = 0x20
+	Keyexclam                      Keysym = 0x21
+	Keyquotedbl                    Keysym = 0x22
+	Keynumbersign                  Keysym = 0x23
+	Keydollar                      Keysym = 0x24
+	Keypercent                     Keysym = 0x25
+	Keyampersand                   Keysym = 0x26
+	Keyapostrophe                  Keysym = 0x27
+	Keyquoteright                  Keysym = 0x27
+	Keyparenleft                   Keysym = 0x28
+	Keyparenright                  Keysym = 0x29
+	Keyasterisk                    Keysym = 0x2a
+	Keyplus                        Keysym = 0x2b
+	Keycomma                       Keysym = 0x2c
+	Keyminus                       Keysym = 0x2d
+	Keyperiod                      Keysym = 0x2e
+	Keyslash                       Keysym = 0x2f
+	Key0                           Keysym = 0x30
+	Key1                           Keysym = 0x31
+	Key2                           Keysym = 0x32
+	Key3                           Keysym = 0x33
+	Key4                           Keysym = 0x34
+	Key5                           Keysym = 0x35
+	Key6                           Keysym = 0x36
+	Key7                           Keysym = 0x37
+	Key8                           Keysym = 0x38
+	Key9                           Keysym = 0x39
+	Keycolon                       Keysym = 0x3a
+	Keysemicolon                   Keysym = 0x3b
+	Keyless                        Keysym = 0x3c
+	Keyequal                       Keysym = 0x3d
+	Keygreater                     Keysym = 0x3e
+	Keyquestion                    Keysym = 0x3f
+	Keyat                          Keysym = 0x40
+	KeyA                           Keysym = 0x41
+	KeyB                           Keysym = 0x42
+	KeyC                           Keysym = 0x43
+	KeyD                           Keysym = 0x44
+	KeyE                           Keysym = 0x45
+	KeyF                           Keysym = 0x46
+	KeyG                           Keysym = 0x47
+	KeyH                           Keysym = 0x48
+	KeyI                           Keysym = 0x49
+	KeyJ                           Keysym = 0x4a
+	KeyK                           Keysym = 0x4b
+	KeyL                           Keysym = 0x4c
+	KeyM                           Keysym = 0x4d
+	KeyN                           Keysym = 0x4e
+	KeyO                           Keysym = 0x4f
+	KeyP                           Keysym = 0x50
+	KeyQ                           Keysym = 0x51
+	KeyR                           Keysym = 0x52
+	KeyS                           Keysym = 0x53
+	KeyT                           Keysym = 0x54
+	KeyU                           Keysym = 0x55
+	KeyV                           Keysym = 0x56
+	KeyW                           Keysym = 0x57
+	KeyX                           Keysym = 0x58
+	KeyY                           Keysym = 0x59
+	KeyZ                           Keysym = 0x5a
+	Keybracketleft                 Keysym = 0x5b
+	Keybackslash                   Keysym = 0x5c
+	Keybracketright                Keysym = 0x5d
+	Keyasciicircum                 Keysym = 0x5e
+	Keyunderscore                  Keysym = 0x5f
+	Keygrave                       Keysym = 0x60
+	Keyquoteleft                   Keysym = 0x60
+	Keya                           Keysym = 0x61
+	Keyb                           Keysym = 0x62
+	Keyc                           Keysym = 0x63
+	Keyd                           Keysym = 0x64
+	Keye                           Keysym = 0x65
+	Keyf                           Keysym = 0x66
+	Keyg                           Keysym = 0x67
+	Keyh                           Keysym = 0x68
+	Keyi                           Keysym = 0x69
+	Keyj                           Keysym = 0x6a
+	Keyk                           Keysym = 0x6b
+	Keyl                           Keysym = 0x6c
+	Keym                           Keysym = 0x6d
+	Keyn                           Keysym = 0x6e
+	Keyo                           Keysym = 0x6f
+	Keyp                           Keysym = 0x70
+	Keyq                           Keysym = 0x71
+	Keyr                           Keysym = 0x72
+	Keys                           Keysym = 0x73
+	Keyt                           Keysym = 0x74
+	Keyu                           Keysym = 0x75
+	Keyv                           Keysym = 0x76
+	Keyw                           Keysym = 0x77
+	Keyx                           Keysym = 0x78
+	Keyy                           Keysym = 0x79
+	Keyz                           Keysym = 0x7a
+	Keybraceleft                   Keysym = 0x7b
+	Keybar                         Keysym = 0x7c
+	Keybraceright                  Keysym = 0x7d
+	Keyasciitilde                  Keysym = 0x7e
+	Keynobreakspace                Keysym = 0xa0
+	Keyexclamdown                  Keysym = 0xa1
+	Keycent                        Keysym = 0xa2
+	Keysterling                    Keysym = 0xa3
+	Keycurrency                    Keysym = 0xa4
+	Keyyen                         Keysym = 0xa5
+	Keybrokenbar                   Keysym = 0xa6
+	Keysection                     Keysym = 0xa7
+	Keydiaeresis                   Keysym = 0xa8
+	Keycopyright                   Keysym = 0xa9
+	Keyordfeminine                 Keysym = 0xaa
+	Keyguillemotleft               Keysym = 0xab
+	Keynotsign                     Keysym = 0xac
+	Keyhyphen                      Keysym = 0xad
+	Keyregistered                  Keysym = 0xae
+	Keymacron                      Keysym = 0xaf
+	Keydegree                      Keysym = 0xb0
+	Keyplusminus                   Keysym = 0xb1
+	Keytwosuperior                 Keysym = 0xb2
+	Keythreesuperior               Keysym = 0xb3
+	Keyacute                       Keysym = 0xb4
+	Keymu                          Keysym = 0xb5
+	Keyparagraph                   Keysym = 0xb6
+	Keyperiodcentered              Keysym = 0xb7
+	Keycedilla                     Keysym = 0xb8
+	Keyonesuperior                 Keysym = 0xb9
+	Keymasculine                   Keysym = 0xba
+	Keyguillemotright              Keysym = 0xbb
+	Keyonequarter                  Keysym = 0xbc
+	Keyonehalf                     Keysym = 0xbd
+	Keythreequarters               Keysym = 0xbe
+	Keyquestiondown                Keysym = 0xbf
+	KeyAgrave                      Keysym = 0xc0
+	KeyAacute                      Keysym = 0xc1
+	KeyAcircumflex                 Keysym = 0xc2
+	KeyAtilde                      Keysym = 0xc3
+	KeyAdiaeresis                  Keysym = 0xc4
+	KeyAring                       Keysym = 0xc5
+	KeyAE                          Keysym = 0xc6
+	KeyCcedilla                    Keysym = 0xc7
+	KeyEgrave                      Keysym = 0xc8
+	KeyEacute                      Keysym = 0xc9
+	KeyEcircumflex                 Keysym = 0xca
+	KeyEdiaeresis                  Keysym = 0xcb
+	KeyIgrave                      Keysym = 0xcc
+	KeyIacute                      Keysym = 0xcd
+	KeyIcircumflex                 Keysym = 0xce
+	KeyIdiaeresis                  Keysym = 0xcf
+	KeyETH                         Keysym = 0xd0
+	KeyEth                         Keysym = 0xd0
+	KeyNtilde                      Keysym = 0xd1
+	KeyOgrave                      Keysym = 0xd2
+	KeyOacute                      Keysym = 0xd3
+	KeyOcircumflex                 Keysym = 0xd4
+	KeyOtilde                      Keysym = 0xd5
+	KeyOdiaeresis                  Keysym = 0xd6
+	Keymultiply                    Keysym = 0xd7
+	KeyOslash                      Keysym = 0xd8
+	KeyOoblique                    Keysym = 0xd8
+	KeyUgrave                      Keysym = 0xd9
+	KeyUacute                      Keysym = 0xda
+	KeyUcircumflex                 Keysym = 0xdb
+	KeyUdiaeresis                  Keysym = 0xdc
+	KeyYacute                      Keysym = 0xdd
+	KeyTHORN                       Keysym = 0xde
+	KeyThorn                       Keysym = 0xde
+	Keyssharp                      Keysym = 0xdf
+	Keyagrave                      Keysym = 0xe0
+	Keyaacute                      Keysym = 0xe1
+	Keyacircumflex                 Keysym = 0xe2
+	Keyatilde                      Keysym = 0xe3
+	Keyadiaeresis                  Keysym = 0xe4
+	Keyaring                       Keysym = 0xe5
+	Keyae                          Keysym = 0xe6
+	Keyccedilla                    Keysym = 0xe7
+	Keyegrave                      Keysym = 0xe8
+	Keyeacute                      Keysym = 0xe9
+	Keyecircumflex                 Keysym = 0xea
+	Keyediaeresis                  Keysym = 0xeb
+	Keyigrave                      Keysym = 0xec
+	Keyiacute                      Keysym = 0xed
+	Keyicircumflex                 Keysym = 0xee
+	Keyidiaeresis                  Keysym = 0xef
+	Keyeth                         Keysym = 0xf0
+	Keyntilde                      Keysym = 0xf1
+	Keyograve                      Keysym = 0xf2
+	Keyoacute                      Keysym = 0xf3
+	Keyocircumflex                 Keysym = 0xf4
+	Keyotilde                      Keysym = 0xf5
+	Keyodiaeresis                  Keysym = 0xf6
+	Keydivision                    Keysym = 0xf7
+	Keyoslash                      Keysym = 0xf8
+	Keyooblique                    Keysym = 0xf8
+	Keyugrave                      Keysym = 0xf9
+	Keyuacute                      Keysym = 0xfa
+	Keyucircumflex                 Keysym = 0xfb
+	Keyudiaeresis                  Keysym = 0xfc
+	Keyyacute                      Keysym = 0xfd
+	Keythorn                       Keysym = 0xfe
+	Keyydiaeresis                  Keysym = 0xff
+	KeyAogonek                     Keysym = 0x1a1
+	Keybreve                       Keysym = 0x1a2
+	KeyLstroke                     Keysym = 0x1a3
+	KeyLcaron                      Keysym = 0x1a5
+	KeySacute                      Keysym = 0x1a6
+	KeyScaron                      Keysym = 0x1a9
+	KeyScedilla                    Keysym = 0x1aa
+	KeyTcaron                      Keysym = 0x1ab
+	KeyZacute                      Keysym = 0x1ac
+	KeyZcaron                      Keysym = 0x1ae
+	KeyZabovedot                   Keysym = 0x1af
+	Keyaogonek                     Keysym = 0x1b1
+	Keyogonek                      Keysym = 0x1b2
+	Keylstroke                     Keysym = 0x1b3
+	Keylcaron                      Keysym = 0x1b5
+	Keysacute                      Keysym = 0x1b6
+	Keycaron                       Keysym = 0x1b7
+	Keyscaron                      Keysym = 0x1b9
+	Keyscedilla                    Keysym = 0x1ba
+	Keytcaron                      Keysym = 0x1bb
+	Keyzacute                      Keysym = 0x1bc
+	Keydoubleacute                 Keysym = 0x1bd
+	Keyzcaron                      Keysym = 0x1be
+	Keyzabovedot                   Keysym = 0x1bf
+	KeyRacute                      Keysym = 0x1c0
+	KeyAbreve                      Keysym = 0x1c3
+	KeyLacute                      Keysym = 0x1c5
+	KeyCacute                      Keysym = 0x1c6
+	KeyCcaron                      Keysym = 0x1c8
+	KeyEogonek                     Keysym = 0x1ca
+	KeyEcaron                      Keysym = 0x1cc
+	KeyDcaron                      Keysym = 0x1cf
+	KeyDstroke                     Keysym = 0x1d0
+	KeyNacute                      Keysym = 0x1d1
+	KeyNcaron                      Keysym = 0x1d2
+	KeyOdoubleacute                Keysym = 0x1d5
+	KeyRcaron                      Keysym = 0x1d8
+	KeyUring                       Keysym = 0x1d9
+	KeyUdoubleacute                Keysym = 0x1db
+	KeyTcedilla                    Keysym = 0x1de
+	Keyracute                      Keysym = 0x1e0
+	Keyabreve                      Keysym = 0x1e3
+	Keylacute                      Keysym = 0x1e5
+	Keycacute                      Keysym = 0x1e6
+	Keyccaron                      Keysym = 0x1e8
+	Keyeogonek                     Keysym = 0x1ea
+	Keyecaron                      Keysym = 0x1ec
+	Keydcaron                      Keysym = 0x1ef
+	Keydstroke                     Keysym = 0x1f0
+	Keynacute                      Keysym = 0x1f1
+	Keyncaron                      Keysym = 0x1f2
+	Keyodoubleacute                Keysym = 0x1f5
+	Keyrcaron                      Keysym = 0x1f8
+	Keyuring                       Keysym = 0x1f9
+	Keyudoubleacute                Keysym = 0x1fb
+	Keytcedilla                    Keysym = 0x1fe
+	Keyabovedot                    Keysym = 0x1ff
+	KeyHstroke                     Keysym = 0x2a1
+	KeyHcircumflex                 Keysym = 0x2a6
+	KeyIabovedot                   Keysym = 0x2a9
+	KeyGbreve                      Keysym = 0x2ab
+	KeyJcircumflex                 Keysym = 0x2ac
+	Keyhstroke                     Keysym = 0x2b1
+	Keyhcircumflex                 Keysym = 0x2b6
+	Keyidotless                    Keysym = 0x2b9
+	Keygbreve                      Keysym = 0x2bb
+	Keyjcircumflex                 Keysym = 0x2bc
+	KeyCabovedot                   Keysym = 0x2c5
+	KeyCcircumflex                 Keysym = 0x2c6
+	KeyGabovedot                   Keysym = 0x2d5
+	KeyGcircumflex                 Keysym = 0x2d8
+	KeyUbreve                      Keysym = 0x2dd
+	KeyScircumflex                 Keysym = 0x2de
+	Keycabovedot                   Keysym = 0x2e5
+	Keyccircumflex                 Keysym = 0x2e6
+	Keygabovedot                   Keysym = 0x2f5
+	Keygcircumflex                 Keysym = 0x2f8
+	Keyubreve                      Keysym = 0x2fd
+	Keyscircumflex                 Keysym = 0x2fe
+	Keykra                         Keysym = 0x3a2
+	Keykappa                       Keysym = 0x3a2
+	KeyRcedilla                    Keysym = 0x3a3
+	KeyItilde                      Keysym = 0x3a5
+	KeyLcedilla                    Keysym = 0x3a6
+	KeyEmacron                     Keysym = 0x3aa
+	KeyGcedilla                    Keysym = 0x3ab
+	KeyTslash                      Keysym = 0x3ac
+	Keyrcedilla                    Keysym = 0x3b3
+	Keyitilde                      Keysym = 0x3b5
+	Keylcedilla                    Keysym = 0x3b6
+	Keyemacron                     Keysym = 0x3ba
+	Keygcedilla                    Keysym = 0x3bb
+	Keytslash                      Keysym = 0x3bc
+	KeyENG                         Keysym = 0x3bd
+	Keyeng                         Keysym = 0x3bf
+	KeyAmacron                     Keysym = 0x3c0
+	KeyIogonek                     Keysym = 0x3c7
+	KeyEabovedot                   Keysym = 0x3cc
+	KeyImacron                     Keysym = 0x3cf
+	KeyNcedilla                    Keysym = 0x3d1
+	KeyOmacron                     Keysym = 0x3d2
+	KeyKcedilla                    Keysym = 0x3d3
+	KeyUogonek                     Keysym = 0x3d9
+	KeyUtilde                      Keysym = 0x3dd
+	KeyUmacron                     Keysym = 0x3de
+	Keyamacron                     Keysym = 0x3e0
+	Keyiogonek                     Keysym = 0x3e7
+	Keyeabovedot                   Keysym = 0x3ec
+	Keyimacron                     Keysym = 0x3ef
+	Keyncedilla                    Keysym = 0x3f1
+	Keyomacron                     Keysym = 0x3f2
+	Keykcedilla                    Keysym = 0x3f3
+	Keyuogonek                     Keysym = 0x3f9
+	Keyutilde                      Keysym = 0x3fd
+	Keyumacron                     Keysym = 0x3fe
+	KeyWcircumflex                 Keysym = 0x1000174
+	Keywcircumflex                 Keysym = 0x1000175
+	KeyYcircumflex                 Keysym = 0x1000176
+	Keyycircumflex                 Keysym = 0x1000177
+	KeyBabovedot                   Keysym = 0x1001e02
+	Keybabovedot                   Keysym = 0x1001e03
+	KeyDabovedot                   Keysym = 0x1001e0a
+	Keydabovedot                   Keysym = 0x1001e0b
+	KeyFabovedot                   Keysym = 0x1001e1e
+	Keyfabovedot                   Keysym = 0x1001e1f
+	KeyMabovedot                   Keysym = 0x1001e40
+	Keymabovedot                   Keysym = 0x1001e41
+	KeyPabovedot                   Keysym = 0x1001e56
+	Keypabovedot                   Keysym = 0x1001e57
+	KeySabovedot                   Keysym = 0x1001e60
+	Keysabovedot                   Keysym = 0x1001e61
+	KeyTabovedot                   Keysym = 0x1001e6a
+	Keytabovedot                   Keysym = 0x1001e6b
+	KeyWgrave                      Keysym = 0x1001e80
+	Keywgrave                      Keysym = 0x1001e81
+	KeyWacute                      Keysym = 0x1001e82
+	Keywacute                      Keysym = 0x1001e83
+	KeyWdiaeresis                  Keysym = 0x1001e84
+	Keywdiaeresis                  Keysym = 0x1001e85
+	KeyYgrave                      Keysym = 0x1001ef2
+	Keyygrave                      Keysym = 0x1001ef3
+	KeyOE                          Keysym = 0x13bc
+	Keyoe                          Keysym = 0x13bd
+	KeyYdiaeresis                  Keysym = 0x13be
+	Keyoverline                    Keysym = 0x47e
+	Keykana_fullstop               Keysym = 0x4a1
+	Keykana_openingbracket         Keysym = 0x4a2
+	Keykana_closingbracket         Keysym = 0x4a3
+	Keykana_comma                  Keysym = 0x4a4
+	Keykana_conjunctive            Keysym = 0x4a5
+	Keykana_middledot              Keysym = 0x4a5
+	Keykana_WO                     Keysym = 0x4a6
+	Keykana_a                      Keysym = 0x4a7
+	Keykana_i                      Keysym = 0x4a8
+	Keykana_u                      Keysym = 0x4a9
+	Keykana_e                      Keysym = 0x4aa
+	Keykana_o                      Keysym = 0x4ab
+	Keykana_ya                     Keysym = 0x4ac
+	Keykana_yu                     Keysym = 0x4ad
+	Keykana_yo                     Keysym = 0x4ae
+	Keykana_tsu                    Keysym = 0x4af
+	Keykana_tu                     Keysym = 0x4af
+	Keyprolongedsound              Keysym = 0x4b0
+	Keykana_A                      Keysym = 0x4b1
+	Keykana_I                      Keysym = 0x4b2
+	Keykana_U                      Keysym = 0x4b3
+	Keykana_E                      Keysym = 0x4b4
+	Keykana_O                      Keysym = 0x4b5
+	Keykana_KA                     Keysym = 0x4b6
+	Keykana_KI                     Keysym = 0x4b7
+	Keykana_KU                     Keysym = 0x4b8
+	Keykana_KE                     Keysym = 0x4b9
+	Keykana_KO                     Keysym = 0x4ba
+	Keykana_SA                     Keysym = 0x4bb
+	Keykana_SHI                    Keysym = 0x4bc
+	Keykana_SU                     Keysym = 0x4bd
+	Keykana_SE                     Keysym = 0x4be
+	Keykana_SO                     Keysym = 0x4bf
+	Keykana_TA                     Keysym = 0x4c0
+	Keykana_CHI                    Keysym = 0x4c1
+	Keykana_TI                     Keysym = 0x4c1
+	Keykana_TSU                    Keysym = 0x4c2
+	Keykana_TU                     Keysym = 0x4c2
+	Keykana_TE                     Keysym = 0x4c3
+	Keykana_TO                     Keysym = 0x4c4
+	Keykana_NA                     Keysym = 0x4c5
+	Keykana_NI                     Keysym = 0x4c6
+	Keykana_NU                     Keysym = 0x4c7
+	Keykana_NE                     Keysym = 0x4c8
+	Keykana_NO                     Keysym = 0x4c9
+	Keykana_HA                     Keysym = 0x4ca
+	Keykana_HI                     Keysym = 0x4cb
+	Keykana_FU                     Keysym = 0x4cc
+	Keykana_HU                     Keysym = 0x4cc
+	Keykana_HE                     Keysym = 0x4cd
+	Keykana_HO                     Keysym = 0x4ce
+	Keykana_MA                     Keysym = 0x4cf
+	Keykana_MI                     Keysym = 0x4d0
+	Keykana_MU                     Keysym = 0x4d1
+	Keykana_ME                     Keysym = 0x4d2
+	Keykana_MO                     Keysym = 0x4d3
+	Keykana_YA                     Keysym = 0x4d4
+	Keykana_YU                     Keysym = 0x4d5
+	Keykana_YO                     Keysym = 0x4d6
+	Keykana_RA                     Keysym = 0x4d7
+	Keykana_RI                     Keysym = 0x4d8
+	Keykana_RU                     Keysym = 0x4d9
+	Keykana_RE                     Keysym = 0x4da
+	Keykana_RO                     Keysym = 0x4db
+	Keykana_WA                     Keysym = 0x4dc
+	Keykana_N                      Keysym = 0x4dd
+	Keyvoicedsound                 Keysym = 0x4de
+	Keysemivoicedsound             Keysym = 0x4df
+	Keykana_switch                 Keysym = 0xff7e
+	KeyFarsi_0                     Keysym = 0x10006f0
+	KeyFarsi_1                     Keysym = 0x10006f1
+	KeyFarsi_2                     Keysym = 0x10006f2
+	KeyFarsi_3                     Keysym = 0x10006f3
+	KeyFarsi_4                     Keysym = 0x10006f4
+	KeyFarsi_5                     Keysym = 0x10006f5
+	KeyFarsi_6                     Keysym = 0x10006f6
+	KeyFarsi_7                     Keysym = 0x10006f7
+	KeyFarsi_8                     Keysym = 0x10006f8
+	KeyFarsi_9                     Keysym = 0x10006f9
+	KeyArabic_percent              Keysym = 0x100066a
+	KeyArabic_superscript_alef     Keysym = 0x1000670
+	KeyArabic_tteh                 Keysym = 0x1000679
+	KeyArabic_peh                  Keysym = 0x100067e
+	KeyArabic_tcheh                Keysym = 0x1000686
+	KeyArabic_ddal                 Keysym = 0x1000688
+	KeyArabic_rreh                 Keysym = 0x1000691
+	KeyArabic_comma                Keysym = 0x5ac
+	KeyArabic_fullstop             Keysym = 0x10006d4
+	KeyArabic_0                    Keysym = 0x1000660
+	KeyArabic_1                    Keysym = 0x1000661
+	KeyArabic_2                    Keysym = 0x1000662
+	KeyArabic_3                    Keysym = 0x1000663
+	KeyArabic_4                    Keysym = 0x1000664
+	KeyArabic_5                    Keysym = 0x1000665
+	KeyArabic_6                    Keysym = 0x1000666
+	KeyArabic_7                    Keysym = 0x1000667
+	KeyArabic_8                    Keysym = 0x1000668
+	KeyArabic_9                    Keysym = 0x1000669
+	KeyArabic_semicolon            Keysym = 0x5bb
+	KeyArabic_question_mark        Keysym = 0x5bf
+	KeyArabic_hamza                Keysym = 0x5c1
+	KeyArabic_maddaonalef          Keysym = 0x5c2
+	KeyArabic_hamzaonalef          Keysym = 0x5c3
+	KeyArabic_hamzaonwaw           Keysym = 0x5c4
+	KeyArabic_hamzaunderalef       Keysym = 0x5c5
+	KeyArabic_hamzaonyeh           Keysym = 0x5c6
+	KeyArabic_alef                 Keysym = 0x5c7
+	KeyArabic_beh                  Keysym = 0x5c8
+	KeyArabic_tehmarbuta           Keysym = 0x5c9
+	KeyArabic_teh                  Keysym = 0x5ca
+	KeyArabic_theh                 Keysym = 0x5cb
+	KeyArabic_jeem                 Keysym = 0x5cc
+	KeyArabic_hah                  Keysym = 0x5cd
+	KeyArabic_khah                 Keysym = 0x5ce
+	KeyArabic_dal                  Keysym = 0x5cf
+	KeyArabic_thal                 Keysym = 0x5d0
+	KeyArabic_ra                   Keysym = 0x5d1
+	KeyArabic_zain                 Keysym = 0x5d2
+	KeyArabic_seen                 Keysym = 0x5d3
+	KeyArabic_sheen                Keysym = 0x5d4
+	KeyArabic_sad                  Keysym = 0x5d5
+	KeyArabic_dad                  Keysym = 0x5d6
+	KeyArabic_tah                  Keysym = 0x5d7
+	KeyArabic_zah                  Keysym = 0x5d8
+	KeyArabic_ain                  Keysym = 0x5d9
+	KeyArabic_ghain                Keysym = 0x5da
+	KeyArabic_tatweel              Keysym = 0x5e0
+	KeyArabic_feh                  Keysym = 0x5e1
+	KeyArabic_qaf                  Keysym = 0x5e2
+	KeyArabic_kaf                  Keysym = 0x5e3
+	KeyArabic_lam                  Keysym = 0x5e4
+	KeyArabic_meem                 Keysym = 0x5e5
+	KeyArabic_noon                 Keysym = 0x5e6
+	KeyArabic_ha                   Keysym = 0x5e7
+	KeyArabic_heh                  Keysym = 0x5e7
+	KeyArabic_waw                  Keysym = 0x5e8
+	KeyArabic_alefmaksura          Keysym = 0x5e9
+	KeyArabic_yeh                  Keysym = 0x5ea
+	KeyArabic_fathatan             Keysym = 0x5eb
+	KeyArabic_dammatan             Keysym = 0x5ec
+	KeyArabic_kasratan             Keysym = 0x5ed
+	KeyArabic_fatha                Keysym = 0x5ee
+	KeyArabic_damma                Keysym = 0x5ef
+	KeyArabic_kasra                Keysym = 0x5f0
+	KeyArabic_shadda               Keysym = 0x5f1
+	KeyArabic_sukun                Keysym = 0x5f2
+	KeyArabic_madda_above          Keysym = 0x1000653
+	KeyArabic_hamza_above          Keysym = 0x1000654
+	KeyArabic_hamza_below          Keysym = 0x1000655
+	KeyArabic_jeh                  Keysym = 0x1000698
+	KeyArabic_veh                  Keysym = 0x10006a4
+	KeyArabic_keheh                Keysym = 0x10006a9
+	KeyArabic_gaf                  Keysym = 0x10006af
+	KeyArabic_noon_ghunna          Keysym = 0x10006ba
+	KeyArabic_heh_doachashmee      Keysym = 0x10006be
+	KeyFarsi_yeh                   Keysym = 0x10006cc
+	KeyArabic_farsi_yeh            Keysym = 0x10006cc
+	KeyArabic_yeh_baree            Keysym = 0x10006d2
+	KeyArabic_heh_goal             Keysym = 0x10006c1
+	KeyArabic_switch               Keysym = 0xff7e
+	KeyCyrillic_GHE_bar            Keysym = 0x1000492
+	KeyCyrillic_ghe_bar            Keysym = 0x1000493
+	KeyCyrillic_ZHE_descender      Keysym = 0x1000496
+	KeyCyrillic_zhe_descender      Keysym = 0x1000497
+	KeyCyrillic_KA_descender       Keysym = 0x100049a
+	KeyCyrillic_ka_descender       Keysym = 0x100049b
+	KeyCyrillic_KA_vertstroke      Keysym = 0x100049c
+	KeyCyrillic_ka_vertstroke      Keysym = 0x100049d
+	KeyCyrillic_EN_descender       Keysym = 0x10004a2
+	KeyCyrillic_en_descender       Keysym = 0x10004a3
+	KeyCyrillic_U_straight         Keysym = 0x10004ae
+	KeyCyrillic_u_straight         Keysym = 0x10004af
+	KeyCyrillic_U_straight_bar     Keysym = 0x10004b0
+	KeyCyrillic_u_straight_bar     Keysym = 0x10004b1
+	KeyCyrillic_HA_descender       Keysym = 0x10004b2
+	KeyCyrillic_ha_descender       Keysym = 0x10004b3
+	KeyCyrillic_CHE_descender      Keysym = 0x10004b6
+	KeyCyrillic_che_descender      Keysym = 0x10004b7
+	KeyCyrillic_CHE_vertstroke     Keysym = 0x10004b8
+	KeyCyrillic_che_vertstroke     Keysym = 0x10004b9
+	KeyCyrillic_SHHA               Keysym = 0x10004ba
+	KeyCyrillic_shha               Keysym = 0x10004bb
+	KeyCyrillic_SCHWA              Keysym = 0x10004d8
+	KeyCyrillic_schwa              Keysym = 0x10004d9
+	KeyCyrillic_I_macron           Keysym = 0x10004e2
+	KeyCyrillic_i_macron           Keysym = 0x10004e3
+	KeyCyrillic_O_bar              Keysym = 0x10004e8
+	KeyCyrillic_o_bar              Keysym = 0x10004e9
+	KeyCyrillic_U_macron           Keysym = 0x10004ee
+	KeyCyrillic_u_macron           Keysym = 0x10004ef
+	KeySerbian_dje                 Keysym = 0x6a1
+	KeyMacedonia_gje               Keysym = 0x6a2
+	KeyCyrillic_io                 Keysym = 0x6a3
+	KeyUkrainian_ie                Keysym = 0x6a4
+	KeyUkranian_je                 Keysym = 0x6a4
+	KeyMacedonia_dse               Keysym = 0x6a5
+	KeyUkrainian_i                 Keysym = 0x6a6
+	KeyUkranian_i                  Keysym = 0x6a6
+	KeyUkrainian_yi                Keysym = 0x6a7
+	KeyUkranian_yi                 Keysym = 0x6a7
+	KeyCyrillic_je                 Keysym = 0x6a8
+	KeySerbian_je                  Keysym = 0x6a8
+	KeyCyrillic_lje                Keysym = 0x6a9
+	KeySerbian_lje                 Keysym = 0x6a9
+	KeyCyrillic_nje                Keysym = 0x6aa
+	KeySerbian_nje                 Keysym = 0x6aa
+	KeySerbian_tshe                Keysym = 0x6ab
+	KeyMacedonia_kje               Keysym = 0x6ac
+	KeyUkrainian_ghe_with_upturn   Keysym = 0x6ad
+	KeyByelorussian_shortu         Keysym = 0x6ae
+	KeyCyrillic_dzhe               Keysym = 0x6af
+	KeySerbian_dze                 Keysym = 0x6af
+	Keynumerosign                  Keysym = 0x6b0
+	KeySerbian_DJE                 Keysym = 0x6b1
+	KeyMacedonia_GJE               Keysym = 0x6b2
+	KeyCyrillic_IO                 Keysym = 0x6b3
+	KeyUkrainian_IE                Keysym = 0x6b4
+	KeyUkranian_JE                 Keysym = 0x6b4
+	KeyMacedonia_DSE               Keysym = 0x6b5
+	KeyUkrainian_I                 Keysym = 0x6b6
+	KeyUkranian_I                  Keysym = 0x6b6
+	KeyUkrainian_YI                Keysym = 0x6b7
+	KeyUkranian_YI                 Keysym = 0x6b7
+	KeyCyrillic_JE                 Keysym = 0x6b8
+	KeySerbian_JE                  Keysym = 0x6b8
+	KeyCyrillic_LJE                Keysym = 0x6b9
+	KeySerbian_LJE                 Keysym = 0x6b9
+	KeyCyrillic_NJE                Keysym = 0x6ba
+	KeySerbian_NJE                 Keysym = 0x6ba
+	KeySerbian_TSHE                Keysym = 0x6bb
+	KeyMacedonia_KJE               Keysym = 0x6bc
+	KeyUkrainian_GHE_WITH_UPTURN   Keysym = 0x6bd
+	KeyByelorussian_SHORTU         Keysym = 0x6be
+	KeyCyrillic_DZHE               Keysym = 0x6bf
+	KeySerbian_DZE                 Keysym = 0x6bf
+	KeyCyrillic_yu                 Keysym = 0x6c0
+	KeyCyrillic_a                  Keysym = 0x6c1
+	KeyCyrillic_be                 Keysym = 0x6c2
+	KeyCyrillic_tse                Keysym = 0x6c3
+	KeyCyrillic_de                 Keysym = 0x6c4
+	KeyCyrillic_ie                 Keysym = 0x6c5
+	KeyCyrillic_ef                 Keysym = 0x6c6
+	KeyCyrillic_ghe                Keysym = 0x6c7
+	KeyCyrillic_ha                 Keysym = 0x6c8
+	KeyCyrillic_i                  Keysym = 0x6c9
+	KeyCyrillic_shorti             Keysym = 0x6ca
+	KeyCyrillic_ka                 Keysym = 0x6cb
+	KeyCyrillic_el                 Keysym = 0x6cc
+	KeyCyrillic_em                 Keysym = 0x6cd
+	KeyCyrillic_en                 Keysym = 0x6ce
+	KeyCyrillic_o                  Keysym = 0x6cf
+	KeyCyrillic_pe                 Keysym = 0x6d0
+	KeyCyrillic_ya                 Keysym = 0x6d1
+	KeyCyrillic_er                 Keysym = 0x6d2
+	KeyCyrillic_es                 Keysym = 0x6d3
+	KeyCyrillic_te                 Keysym = 0x6d4
+	KeyCyrillic_u                  Keysym = 0x6d5
+	KeyCyrillic_zhe                Keysym = 0x6d6
+	KeyCyrillic_ve                 Keysym = 0x6d7
+	KeyCyrillic_softsign           Keysym = 0x6d8
+	KeyCyrillic_yeru               Keysym = 0x6d9
+	KeyCyrillic_ze                 Keysym = 0x6da
+	KeyCyrillic_sha                Keysym = 0x6db
+	KeyCyrillic_e                  Keysym = 0x6dc
+	KeyCyrillic_shcha              Keysym = 0x6dd
+	KeyCyrillic_che                Keysym = 0x6de
+	KeyCyrillic_hardsign           Keysym = 0x6df
+	KeyCyrillic_YU                 Keysym = 0x6e0
+	KeyCyrillic_A                  Keysym = 0x6e1
+	KeyCyrillic_BE                 Keysym = 0x6e2
+	KeyCyrillic_TSE                Keysym = 0x6e3
+	KeyCyrillic_DE                 Keysym = 0x6e4
+	KeyCyrillic_IE                 Keysym = 0x6e5
+	KeyCyrillic_EF                 Keysym = 0x6e6
+	KeyCyrillic_GHE                Keysym = 0x6e7
+	KeyCyrillic_HA                 Keysym = 0x6e8
+	KeyCyrillic_I                  Keysym = 0x6e9
+	KeyCyrillic_SHORTI             Keysym = 0x6ea
+	KeyCyrillic_KA                 Keysym = 0x6eb
+	KeyCyrillic_EL                 Keysym = 0x6ec
+	KeyCyrillic_EM                 Keysym = 0x6ed
+	KeyCyrillic_EN                 Keysym = 0x6ee
+	KeyCyrillic_O                  Keysym = 0x6ef
+	KeyCyrillic_PE                 Keysym = 0x6f0
+	KeyCyrillic_YA                 Keysym = 0x6f1
+	KeyCyrillic_ER                 Keysym = 0x6f2
+	KeyCyrillic_ES                 Keysym = 0x6f3
+	KeyCyrillic_TE                 Keysym = 0x6f4
+	KeyCyrillic_U                  Keysym = 0x6f5
+	KeyCyrillic_ZHE                Keysym = 0x6f6
+	KeyCyrillic_VE                 Keysym = 0x6f7
+	KeyCyrillic_SOFTSIGN           Keysym = 0x6f8
+	KeyCyrillic_YERU               Keysym = 0x6f9
+	KeyCyrillic_ZE                 Keysym = 0x6fa
+	KeyCyrillic_SHA                Keysym = 0x6fb
+	KeyCyrillic_E                  Keysym = 0x6fc
+	KeyCyrillic_SHCHA              Keysym = 0x6fd
+	KeyCyrillic_CHE                Keysym = 0x6fe
+	KeyCyrillic_HARDSIGN           Keysym = 0x6ff
+	KeyGreek_ALPHAaccent           Keysym = 0x7a1
+	KeyGreek_EPSILONaccent         Keysym = 0x7a2
+	KeyGreek_ETAaccent             Keysym = 0x7a3
+	KeyGreek_IOTAaccent            Keysym = 0x7a4
+	KeyGreek_IOTAdieresis          Keysym = 0x7a5
+	KeyGreek_IOTAdiaeresis         Keysym = 0x7a5
+	KeyGreek_OMICRONaccent         Keysym = 0x7a7
+	KeyGreek_UPSILONaccent         Keysym = 0x7a8
+	KeyGreek_UPSILONdieresis       Keysym = 0x7a9
+	KeyGreek_OMEGAaccent           Keysym = 0x7ab
+	KeyGreek_accentdieresis        Keysym = 0x7ae
+	KeyGreek_horizbar              Keysym = 0x7af
+	KeyGreek_alphaaccent           Keysym = 0x7b1
+	KeyGreek_epsilonaccent         Keysym = 0x7b2
+	KeyGreek_etaaccent             Keysym = 0x7b3
+	KeyGreek_iotaaccent            Keysym = 0x7b4
+	KeyGreek_iotadieresis          Keysym = 0x7b5
+	KeyGreek_iotaaccentdieresis    Keysym = 0x7b6
+	KeyGreek_omicronaccent         Keysym = 0x7b7
+	KeyGreek_upsilonaccent         Keysym = 0x7b8
+	KeyGreek_upsilondieresis       Keysym = 0x7b9
+	KeyGreek_upsilonaccentdieresis Keysym = 0x7ba
+	KeyGreek_omegaaccent           Keysym = 0x7bb
+	KeyGreek_ALPHA                 Keysym = 0x7c1
+	KeyGreek_BETA                  Keysym = 0x7c2
+	KeyGreek_GAMMA                 Keysym = 0x7c3
+	KeyGreek_DELTA                 Keysym = 0x7c4
+	KeyGreek_EPSILON               Keysym = 0x7c5
+	KeyGreek_ZETA                  Keysym = 0x7c6
+	KeyGreek_ETA                   Keysym = 0x7c7
+	KeyGreek_THETA                 Keysym = 0x7c8
+	KeyGreek_IOTA                  Keysym = 0x7c9
+	KeyGreek_KAPPA                 Keysym = 0x7ca
+	KeyGreek_LAMDA                 Keysym = 0x7cb
+	KeyGreek_LAMBDA                Keysym = 0x7cb
+	KeyGreek_MU                    Keysym = 0x7cc
+	KeyGreek_NU                    Keysym = 0x7cd
+	KeyGreek_XI                    Keysym = 0x7ce
+	KeyGreek_OMICRON               Keysym = 0x7cf
+	KeyGreek_PI                    Keysym = 0x7d0
+	KeyGreek_RHO                   Keysym = 0x7d1
+	KeyGreek_SIGMA                 Keysym = 0x7d2
+	KeyGreek_TAU                   Keysym = 0x7d4
+	KeyGreek_UPSILON               Keysym = 0x7d5
+	KeyGreek_PHI                   Keysym = 0x7d6
+	KeyGreek_CHI                   Keysym = 0x7d7
+	KeyGreek_PSI                   Keysym = 0x7d8
+	KeyGreek_OMEGA                 Keysym = 0x7d9
+	KeyGreek_alpha                 Keysym = 0x7e1
+	KeyGreek_beta                  Keysym = 0x7e2
+	KeyGreek_gamma                 Keysym = 0x7e3
+	KeyGreek_delta                 Keysym = 0x7e4
+	KeyGreek_epsilon               Keysym = 0x7e5
+	KeyGreek_zeta                  Keysym = 0x7e6
+	KeyGreek_eta                   Keysym = 0x7e7
+	KeyGreek_theta                 Keysym = 0x7e8
+	KeyGreek_iota                  Keysym = 0x7e9
+	KeyGreek_kappa                 Keysym = 0x7ea
+	KeyGreek_lamda                 Keysym = 0x7eb
+	KeyGreek_lambda                Keysym = 0x7eb
+	KeyGreek_mu                    Keysym = 0x7ec
+	KeyGreek_nu                    Keysym = 0x7ed
+	KeyGreek_xi                    Keysym = 0x7ee
+	KeyGreek_omicron               Keysym = 0x7ef
+	KeyGreek_pi                    Keysym = 0x7f0
+	KeyGreek_rho                   Keysym = 0x7f1
+	KeyGreek_sigma                 Keysym = 0x7f2
+	KeyGreek_finalsmallsigma       Keysym = 0x7f3
+	KeyGreek_tau                   Keysym = 0x7f4
+	KeyGreek_upsilon               Keysym = 0x7f5
+	KeyGreek_phi                   Keysym = 0x7f6
+	KeyGreek_chi                   Keysym = 0x7f7
+	KeyGreek_psi                   Keysym = 0x7f8
+	KeyGreek_omega                 Keysym = 0x7f9
+	KeyGreek_switch                Keysym = 0xff7e
+	Keyleftradical                 Keysym = 0x8a1
+	Keytopleftradical              Keysym = 0x8a2
+	Keyhorizconnector              Keysym = 0x8a3
+	Keytopintegral                 Keysym = 0x8a4
+	Keybotintegral                 Keysym = 0x8a5
+	Keyvertconnector               Keysym = 0x8a6
+	Keytopleftsqbracket            Keysym = 0x8a7
+	Keybotleftsqbracket            Keysym = 0x8a8
+	Keytoprightsqbracket           Keysym = 0x8a9
+	Keybotrightsqbracket           Keysym = 0x8aa
+	Keytopleftparens               Keysym = 0x8ab
+	Keybotleftparens               Keysym = 0x8ac
+	Keytoprightparens              Keysym = 0x8ad
+	Keybotrightparens              Keysym = 0x8ae
+	Keyleftmiddlecurlybrace        Keysym = 0x8af
+	Keyrightmiddlecurlybrace       Keysym = 0x8b0
+	Keytopleftsummation            Keysym = 0x8b1
+	Keybotleftsummation            Keysym = 0x8b2
+	Keytopvertsummationconnector   Keysym = 0x8b3
+	Keybotvertsummationconnector   Keysym = 0x8b4
+	Keytoprightsummation           Keysym = 0x8b5
+	Keybotrightsummation           Keysym = 0x8b6
+	Keyrightmiddlesummation        Keysym = 0x8b7
+	Keylessthanequal               Keysym = 0x8bc
+	Keynotequal                    Keysym = 0x8bd
+	Keygreaterthanequal            Keysym = 0x8be
+	Keyintegral                    Keysym = 0x8bf
+	Keytherefore                   Keysym = 0x8c0
+	Keyvariation                   Keysym = 0x8c1
+	Keyinfinity                    Keysym = 0x8c2
+	Keynabla                       Keysym = 0x8c5
+	Keyapproximate                 Keysym = 0x8c8
+	Keysimilarequal                Keysym = 0x8c9
+	Keyifonlyif                    Keysym = 0x8cd
+	Keyimplies                     Keysym = 0x8ce
+	Keyidentical                   Keysym = 0x8cf
+	Keyradical                     Keysym = 0x8d6
+	Keyincludedin                  Keysym = 0x8da
+	Keyincludes                    Keysym = 0x8db
+	Keyintersection                Keysym = 0x8dc
+	Keyunion                       Keysym = 0x8dd
+	Keylogicaland                  Keysym = 0x8de
+	Keylogicalor                   Keysym = 0x8df
+	Keypartialderivative           Keysym = 0x8ef
+	Keyfunction                    Keysym = 0x8f6
+	Keyleftarrow                   Keysym = 0x8fb
+	Keyuparrow                     Keysym = 0x8fc
+	Keyrightarrow                  Keysym = 0x8fd
+	Keydownarrow                   Keysym = 0x8fe
+	Keyblank                       Keysym = 0x9df
+	Keysoliddiamond                Keysym = 0x9e0
+	Keycheckerboard                Keysym = 0x9e1
+	Keyht                          Keysym = 0x9e2
+	Keyff                          Keysym = 0x9e3
+	Keycr                          Keysym = 0x9e4
+	Keylf                          Keysym = 0x9e5
+	Keynl                          Keysym = 0x9e8
+	Keyvt                          Keysym = 0x9e9
+	Keylowrightcorner              Keysym = 0x9ea
+	Keyuprightcorner               Keysym = 0x9eb
+	Keyupleftcorner                Keysym = 0x9ec
+	Keylowleftcorner               Keysym = 0x9ed
+	Keycrossinglines               Keysym = 0x9ee
+	Keyhorizlinescan1              Keysym = 0x9ef
+	Keyhorizlinescan3              Keysym = 0x9f0
+	Keyhorizlinescan5              Keysym = 0x9f1
+	Keyhorizlinescan7              Keysym = 0x9f2
+	Keyhorizlinescan9              Keysym = 0x9f3
+	Keyleftt                       Keysym = 0x9f4
+	Keyrightt                      Keysym = 0x9f5
+	Keybott                        Keysym = 0x9f6
+	Keytopt                        Keysym = 0x9f7
+	Keyvertbar                     Keysym = 0x9f8
+	Keyemspace                     Keysym = 0xaa1
+	Keyenspace                     Keysym = 0xaa2
+	Keyem3space                    Keysym = 0xaa3
+	Keyem4space                    Keysym = 0xaa4
+	Keydigitspace                  Keysym = 0xaa5
+	Keypunctspace                  Keysym = 0xaa6
+	Keythinspace                   Keysym = 0xaa7
+	Keyhairspace                   Keysym = 0xaa8
+	Keyemdash                      Keysym = 0xaa9
+	Keyendash                      Keysym = 0xaaa
+	Keysignifblank                 Keysym = 0xaac
+	Keyellipsis                    Keysym = 0xaae
+	Keydoubbaselinedot             Keysym = 0xaaf
+	Keyonethird                    Keysym = 0xab0
+	Keytwothirds                   Keysym = 0xab1
+	Keyonefifth                    Keysym = 0xab2
+	Keytwofifths                   Keysym = 0xab3
+	Keythreefifths                 Keysym = 0xab4
+	Keyfourfifths                  Keysym = 0xab5
+	Keyonesixth                    Keysym = 0xab6
+	Keyfivesixths                  Keysym = 0xab7
+	Keycareof                      Keysym = 0xab8
+	Keyfigdash                     Keysym = 0xabb
+	Keyleftanglebracket            Keysym = 0xabc
+	Keydecimalpoint                Keysym = 0xabd
+	Keyrightanglebracket           Keysym = 0xabe
+	Keymarker                      Keysym = 0xabf
+	Keyoneeighth                   Keysym = 0xac3
+	Keythreeeighths                Keysym = 0xac4
+	Keyfiveeighths                 Keysym = 0xac5
+	Keyseveneighths                Keysym = 0xac6
+	Keytrademark                   Keysym = 0xac9
+	Keysignaturemark               Keysym = 0xaca
+	Keytrademarkincircle           Keysym = 0xacb
+	Keyleftopentriangle            Keysym = 0xacc
+	Keyrightopentriangle           Keysym = 0xacd
+	Keyemopencircle                Keysym = 0xace
+	Keyemopenrectangle             Keysym = 0xacf
+	Keyleftsinglequotemark         Keysym = 0xad0
+	Keyrightsinglequotemark        Keysym = 0xad1
+	Keyleftdoublequotemark         Keysym = 0xad2
+	Keyrightdoublequotemark        Keysym = 0xad3
+	Keyprescription                Keysym = 0xad4
+	Keypermille                    Keysym = 0xad5
+	Keyminutes                     Keysym = 0xad6
+	Keyseconds                     Keysym = 0xad7
+	Keylatincross                  Keysym = 0xad9
+	Keyhexagram                    Keysym = 0xada
+	Keyfilledrectbullet            Keysym = 0xadb
+	Keyfilledlefttribullet         Keysym = 0xadc
+	Keyfilledrighttribullet        Keysym = 0xadd
+	Keyemfilledcircle              Keysym = 0xade
+	Keyemfilledrect                Keysym = 0xadf
+	Keyenopencircbullet            Keysym = 0xae0
+	Keyenopensquarebullet          Keysym = 0xae1
+	Keyopenrectbullet              Keysym = 0xae2
+	Keyopentribulletup             Keysym = 0xae3
+	Keyopentribulletdown           Keysym = 0xae4
+	Keyopenstar                    Keysym = 0xae5
+	Keyenfilledcircbullet          Keysym = 0xae6
+	Keyenfilledsqbullet            Keysym = 0xae7
+	Keyfilledtribulletup           Keysym = 0xae8
+	Keyfilledtribulletdown         Keysym = 0xae9
+	Keyleftpointer                 Keysym = 0xaea
+	Keyrightpointer                Keysym = 0xaeb
+	Keyclub                        Keysym = 0xaec
+	Keydiamond                     Keysym = 0xaed
+	Keyheart                       Keysym = 0xaee
+	Keymaltesecross                Keysym = 0xaf0
+	Keydagger                      Keysym = 0xaf1
+	Keydoubledagger                Keysym = 0xaf2
+	Keycheckmark                   Keysym = 0xaf3
+	Keyballotcross                 Keysym = 0xaf4
+	Keymusicalsharp                Keysym = 0xaf5
+	Keymusicalflat                 Keysym = 0xaf6
+	Keymalesymbol                  Keysym = 0xaf7
+	Keyfemalesymbol                Keysym = 0xaf8
+	Keytelephone                   Keysym = 0xaf9
+	Keytelephonerecorder           Keysym = 0xafa
+	Keyphonographcopyright         Keysym = 0xafb
+	Keycaret                       Keysym = 0xafc
+	Keysinglelowquotemark          Keysym = 0xafd
+	Keydoublelowquotemark          Keysym = 0xafe
+	Keycursor                      Keysym = 0xaff
+	Keyleftcaret                   Keysym = 0xba3
+	Keyrightcaret                  Keysym = 0xba6
+	Keydowncaret                   Keysym = 0xba8
+	Keyupcaret                     Keysym = 0xba9
+	Keyoverbar                     Keysym = 0xbc0
+	Keydowntack                    Keysym = 0xbc2
+	Keyupshoe                      Keysym = 0xbc3
+	Keydownstile                   Keysym = 0xbc4
+	Keyunderbar                    Keysym = 0xbc6
+	Keyjot                         Keysym = 0xbca
+	Keyquad                        Keysym = 0xbcc
+	Keyuptack                      Keysym = 0xbce
+	Keycircle                      Keysym = 0xbcf
+	Keyupstile                     Keysym = 0xbd3
+	Keydownshoe                    Keysym = 0xbd6
+	Keyrightshoe                   Keysym = 0xbd8
+	Keyleftshoe                    Keysym = 0xbda
+	Keylefttack                    Keysym = 0xbdc
+	Keyrighttack                   Keysym = 0xbfc
+	Keyhebrew_doublelowline        Keysym = 0xcdf
+	Keyhebrew_aleph                Keysym = 0xce0
+	Keyhebrew_bet                  Keysym = 0xce1
+	Keyhebrew_beth                 Keysym = 0xce1
+	Keyhebrew_gimel                Keysym = 0xce2
+	Keyhebrew_gimmel               Keysym = 0xce2
+	Keyhebrew_dalet                Keysym = 0xce3
+	Keyhebrew_daleth               Keysym = 0xce3
+	Keyhebrew_he                   Keysym = 0xce4
+	Keyhebrew_waw                  Keysym = 0xce5
+	Keyhebrew_zain                 Keysym = 0xce6
+	Keyhebrew_zayin                Keysym = 0xce6
+	Keyhebrew_chet                 Keysym = 0xce7
+	Keyhebrew_het                  Keysym = 0xce7
+	Keyhebrew_tet                  Keysym = 0xce8
+	Keyhebrew_teth                 Keysym = 0xce8
+	Keyhebrew_yod                  Keysym = 0xce9
+	Keyhebrew_finalkaph            Keysym = 0xcea
+	Keyhebrew_kaph                 Keysym = 0xceb
+	Keyhebrew_lamed                Keysym = 0xcec
+	Keyhebrew_finalmem             Keysym = 0xced
+	Keyhebrew_mem                  Keysym = 0xcee
+	Keyhebrew_finalnun             Keysym = 0xcef
+	Keyhebrew_nun                  Keysym = 0xcf0
+	Keyhebrew_samech               Keysym = 0xcf1
+	Keyhebrew_samekh               Keysym = 0xcf1
+	Keyhebrew_ayin                 Keysym = 0xcf2
+	Keyhebrew_finalpe              Keysym = 0xcf3
+	Keyhebrew_pe                   Keysym = 0xcf4
+	Keyhebrew_finalzade            Keysym = 0xcf5
+	Keyhebrew_finalzadi            Keysym = 0xcf5
+	Keyhebrew_zade                 Keysym = 0xcf6
+	Keyhebrew_zadi                 Keysym = 0xcf6
+	Keyhebrew_qoph                 Keysym = 0xcf7
+	Keyhebrew_kuf                  Keysym = 0xcf7
+	Keyhebrew_resh                 Keysym = 0xcf8
+	Keyhebrew_shin                 Keysym = 0xcf9
+	Keyhebrew_taw                  Keysym = 0xcfa
+	Keyhebrew_taf                  Keysym = 0xcfa
+	KeyHebrew_switch               Keysym = 0xff7e
+	KeyThai_kokai                  Keysym = 0xda1
+	KeyThai_khokhai                Keysym = 0xda2
+	KeyThai_khokhuat               Keysym = 0xda3
+	KeyThai_khokhwai               Keysym = 0xda4
+	KeyThai_khokhon                Keysym = 0xda5
+	KeyThai_khorakhang             Keysym = 0xda6
+	KeyThai_ngongu                 Keysym = 0xda7
+	KeyThai_chochan                Keysym = 0xda8
+	KeyThai_choching               Keysym = 0xda9
+	KeyThai_chochang               Keysym = 0xdaa
+	KeyThai_soso                   Keysym = 0xdab
+	KeyThai_chochoe                Keysym = 0xdac
+	KeyThai_yoying                 Keysym = 0xdad
+	KeyThai_dochada                Keysym = 0xdae
+	KeyThai_topatak                Keysym = 0xdaf
+	KeyThai_thothan                Keysym = 0xdb0
+	KeyThai_thonangmontho          Keysym = 0xdb1
+	KeyThai_thophuthao             Keysym = 0xdb2
+	KeyThai_nonen                  Keysym = 0xdb3
+	KeyThai_dodek                  Keysym = 0xdb4
+	KeyThai_totao                  Keysym = 0xdb5
+	KeyThai_thothung               Keysym = 0xdb6
+	KeyThai_thothahan              Keysym = 0xdb7
+	KeyThai_thothong               Keysym = 0xdb8
+	KeyThai_nonu                   Keysym = 0xdb9
+	KeyThai_bobaimai               Keysym = 0xdba
+	KeyThai_popla                  Keysym = 0xdbb
+	KeyThai_phophung               Keysym = 0xdbc
+	KeyThai_fofa                   Keysym = 0xdbd
+	KeyThai_phophan                Keysym = 0xdbe
+	KeyThai_fofan                  Keysym = 0xdbf
+	KeyThai_phosamphao             Keysym = 0xdc0
+	KeyThai_moma                   Keysym = 0xdc1
+	KeyThai_yoyak                  Keysym = 0xdc2
+	KeyThai_rorua                  Keysym = 0xdc3
+	KeyThai_ru                     Keysym = 0xdc4
+	KeyThai_loling                 Keysym = 0xdc5
+	KeyThai_lu                     Keysym = 0xdc6
+	KeyThai_wowaen                 Keysym = 0xdc7
+	KeyThai_sosala                 Keysym = 0xdc8
+	KeyThai_sorusi                 Keysym = 0xdc9
+	KeyThai_sosua                  Keysym = 0xdca
+	KeyThai_hohip                  Keysym = 0xdcb
+	KeyThai_lochula                Keysym = 0xdcc
+	KeyThai_oang                   Keysym = 0xdcd
+	KeyThai_honokhuk               Keysym = 0xdce
+	KeyThai_paiyannoi              Keysym = 0xdcf
+	KeyThai_saraa                  Keysym = 0xdd0
+	KeyThai_maihanakat             Keysym = 0xdd1
+	KeyThai_saraaa                 Keysym = 0xdd2
+	KeyThai_saraam                 Keysym = 0xdd3
+	KeyThai_sarai                  Keysym = 0xdd4
+	KeyThai_saraii                 Keysym = 0xdd5
+	KeyThai_saraue                 Keysym = 0xdd6
+	KeyThai_sarauee                Keysym = 0xdd7
+	KeyThai_sarau                  Keysym = 0xdd8
+	KeyThai_sarauu                 Keysym = 0xdd9
+	KeyThai_phinthu                Keysym = 0xdda
+	KeyThai_maihanakat_maitho      Keysym = 0xdde
+	KeyThai_baht                   Keysym = 0xddf
+	KeyThai_sarae                  Keysym = 0xde0
+	KeyThai_saraae                 Keysym = 0xde1
+	KeyThai_sarao                  Keysym = 0xde2
+	KeyThai_saraaimaimuan          Keysym = 0xde3
+	KeyThai_saraaimaimalai         Keysym = 0xde4
+	KeyThai_lakkhangyao            Keysym = 0xde5
+	KeyThai_maiyamok               Keysym = 0xde6
+	KeyThai_maitaikhu              Keysym = 0xde7
+	KeyThai_maiek                  Keysym = 0xde8
+	KeyThai_maitho                 Keysym = 0xde9
+	KeyThai_maitri                 Keysym = 0xdea
+	KeyThai_maichattawa            Keysym = 0xdeb
+	KeyThai_thanthakhat            Keysym = 0xdec
+	KeyThai_nikhahit               Keysym = 0xded
+	KeyThai_leksun                 Keysym = 0xdf0
+	KeyThai_leknung                Keysym = 0xdf1
+	KeyThai_leksong                Keysym = 0xdf2
+	KeyThai_leksam                 Keysym = 0xdf3
+	KeyThai_leksi                  Keysym = 0xdf4
+	KeyThai_lekha                  Keysym = 0xdf5
+	KeyThai_lekhok                 Keysym = 0xdf6
+	KeyThai_lekchet                Keysym = 0xdf7
+	KeyThai_lekpaet                Keysym = 0xdf8
+	KeyThai_lekkao                 Keysym = 0xdf9
+	KeyHangul                      Keysym = 0xff31
+	KeyHangul_Start                Keysym = 0xff32
+	KeyHangul_End                  Keysym = 0xff33
+	KeyHangul_Hanja                Keysym = 0xff34
+	KeyHangul_Jamo                 Keysym = 0xff35
+	KeyHangul_Romaja               Keysym = 0xff36
+	KeyHangul_Codeinput            Keysym = 0xff37
+	KeyHangul_Jeonja               Keysym = 0xff38
+	KeyHangul_Banja                Keysym = 0xff39
+	KeyHangul_PreHanja             Keysym = 0xff3a
+	KeyHangul_PostHanja            Keysym = 0xff3b
+	KeyHangul_SingleCandidate      Keysym = 0xff3c
+	KeyHangul_MultipleCandidate    Keysym = 0xff3d
+	KeyHangul_PreviousCandidate    Keysym = 0xff3e
+	KeyHangul_Special              Keysym = 0xff3f
+	KeyHangul_switch               Keysym = 0xff7e
+	KeyHangul_Kiyeog               Keysym = 0xea1
+	KeyHangul_SsangKiyeog          Keysym = 0xea2
+	KeyHangul_KiyeogSios           Keysym = 0xea3
+	KeyHangul_Nieun                Keysym = 0xea4
+	KeyHangul_NieunJieuj           Keysym = 0xea5
+	KeyHangul_NieunHieuh           Keysym = 0xea6
+	KeyHangul_Dikeud               Keysym = 0xea7
+	KeyHangul_SsangDikeud          Keysym = 0xea8
+	KeyHangul_Rieul                Keysym = 0xea9
+	KeyHangul_RieulKiyeog          Keysym = 0xeaa
+	KeyHangul_RieulMieum           Keysym = 0xeab
+	KeyHangul_RieulPieub           Keysym = 0xeac
+	KeyHangul_RieulSios            Keysym = 0xead
+	KeyHangul_RieulTieut           Keysym = 0xeae
+	KeyHangul_RieulPhieuf          Keysym = 0xeaf
+	KeyHangul_RieulHieuh           Keysym = 0xeb0
+	KeyHangul_Mieum                Keysym = 0xeb1
+	KeyHangul_Pieub                Keysym = 0xeb2
+	KeyHangul_SsangPieub           Keysym = 0xeb3
+	KeyHangul_PieubSios            Keysym = 0xeb4
+	KeyHangul_Sios                 Keysym = 0xeb5
+	KeyHangul_SsangSios            Keysym = 0xeb6
+	KeyHangul_Ieung                Keysym = 0xeb7
+	KeyHangul_Jieuj                Keysym = 0xeb8
+	KeyHangul_SsangJieuj           Keysym = 0xeb9
+	KeyHangul_Cieuc                Keysym = 0xeba
+	KeyHangul_Khieuq               Keysym = 0xebb
+	KeyHangul_Tieut                Keysym = 0xebc
+	KeyHangul_Phieuf               Keysym = 0xebd
+	KeyHangul_Hieuh                Keysym = 0xebe
+	KeyHangul_A                    Keysym = 0xebf
+	KeyHangul_AE                   Keysym = 0xec0
+	KeyHangul_YA                   Keysym = 0xec1
+	KeyHangul_YAE                  Keysym = 0xec2
+	KeyHangul_EO                   Keysym = 0xec3
+	KeyHangul_E                    Keysym = 0xec4
+	KeyHangul_YEO                  Keysym = 0xec5
+	KeyHangul_YE                   Keysym = 0xec6
+	KeyHangul_O                    Keysym = 0xec7
+	KeyHangul_WA                   Keysym = 0xec8
+	KeyHangul_WAE                  Keysym = 0xec9
+	KeyHangul_OE                   Keysym = 0xeca
+	KeyHangul_YO                   Keysym = 0xecb
+	KeyHangul_U                    Keysym = 0xecc
+	KeyHangul_WEO                  Keysym = 0xecd
+	KeyHangul_WE                   Keysym = 0xece
+	KeyHangul_WI                   Keysym = 0xecf
+	KeyHangul_YU                   Keysym = 0xed0
+	KeyHangul_EU                   Keysym = 0xed1
+	KeyHangul_YI                   Keysym = 0xed2
+	KeyHangul_I                    Keysym = 0xed3
+	KeyHangul_J_Kiyeog             Keysym = 0xed4
+	KeyHangul_J_SsangKiyeog        Keysym = 0xed5
+	KeyHangul_J_KiyeogSios         Keysym = 0xed6
+	KeyHangul_J_Nieun              Keysym = 0xed7
+	KeyHangul_J_NieunJieuj         Keysym = 0xed8
+	KeyHangul_J_NieunHieuh         Keysym = 0xed9
+	KeyHangul_J_Dikeud             Keysym = 0xeda
+	KeyHangul_J_Rieul              Keysym = 0xedb
+	KeyHangul_J_RieulKiyeog        Keysym = 0xedc
+	KeyHangul_J_RieulMieum         Keysym = 0xedd
+	KeyHangul_J_RieulPieub         Keysym = 0xede
+	KeyHangul_J_RieulSios          Keysym = 0xedf
+	KeyHangul_J_RieulTieut         Keysym = 0xee0
+	KeyHangul_J_RieulPhieuf        Keysym = 0xee1
+	KeyHangul_J_RieulHieuh         Keysym = 0xee2
+	KeyHangul_J_Mieum              Keysym = 0xee3
+	KeyHangul_J_Pieub              Keysym = 0xee4
+	KeyHangul_J_PieubSios          Keysym = 0xee5
+	KeyHangul_J_Sios               Keysym = 0xee6
+	KeyHangul_J_SsangSios          Keysym = 0xee7
+	KeyHangul_J_Ieung              Keysym = 0xee8
+	KeyHangul_J_Jieuj              Keysym = 0xee9
+	KeyHangul_J_Cieuc              Keysym = 0xeea
+	KeyHangul_J_Khieuq             Keysym = 0xeeb
+	KeyHangul_J_Tieut              Keysym = 0xeec
+	KeyHangul_J_Phieuf             Keysym = 0xeed
+	KeyHangul_J_Hieuh              Keysym = 0xeee
+	KeyHangul_RieulYeorinHieuh     Keysym = 0xeef
+	KeyHangul_SunkyeongeumMieum    Keysym = 0xef0
+	KeyHangul_SunkyeongeumPieub    Keysym = 0xef1
+	KeyHangul_PanSios              Keysym = 0xef2
+	KeyHangul_KkogjiDalrinIeung    Keysym = 0xef3
+	KeyHangul_SunkyeongeumPhieuf   Keysym = 0xef4
+	KeyHangul_YeorinHieuh          Keysym = 0xef5
+	KeyHangul_AraeA                Keysym = 0xef6
+	KeyHangul_AraeAE               Keysym = 0xef7
+	KeyHangul_J_PanSios            Keysym = 0xef8
+	KeyHangul_J_KkogjiDalrinIeung  Keysym = 0xef9
+	KeyHangul_J_YeorinHieuh        Keysym = 0xefa
+	KeyKorean_Won                  Keysym = 0xeff
+	KeyArmenian_ligature_ew        Keysym = 0x1000587
+	KeyArmenian_full_stop          Keysym = 0x1000589
+	KeyArmenian_verjaket           Keysym = 0x1000589
+	KeyArmenian_separation_mark    Keysym = 0x100055d
+	KeyArmenian_but                Keysym = 0x100055d
+	KeyArmenian_hyphen             Keysym = 0x100058a
+	KeyArmenian_yentamna           Keysym = 0x100058a
+	KeyArmenian_exclam             Keysym = 0x100055c
+	KeyArmenian_amanak             Keysym = 0x100055c
+	KeyArmenian_accent             Keysym = 0x100055b
+	KeyArmenian_shesht             Keysym = 0x100055b
+	KeyArmenian_question           Keysym = 0x100055e
+	KeyArmenian_paruyk             Keysym = 0x100055e
+	KeyArmenian_AYB                Keysym = 0x1000531
+	KeyArmenian_ayb                Keysym = 0x1000561
+	KeyArmenian_BEN                Keysym = 0x1000532
+	KeyArmenian_ben                Keysym = 0x1000562
+	KeyArmenian_GIM                Keysym = 0x1000533
+	KeyArmenian_gim                Keysym = 0x1000563
+	KeyArmenian_DA                 Keysym = 0x1000534
+	KeyArmenian_da                 Keysym = 0x1000564
+	KeyArmenian_YECH               Keysym = 0x1000535
+	KeyArmenian_yech               Keysym = 0x1000565
+	KeyArmenian_ZA                 Keysym = 0x1000536
+	KeyArmenian_za                 Keysym = 0x1000566
+	KeyArmenian_E                  Keysym = 0x1000537
+	KeyArmenian_e                  Keysym = 0x1000567
+	KeyArmenian_AT                 Keysym = 0x1000538
+	KeyArmenian_at                 Keysym = 0x1000568
+	KeyArmenian_TO                 Keysym = 0x1000539
+	KeyArmenian_to                 Keysym = 0x1000569
+	KeyArmenian_ZHE                Keysym = 0x100053a
+	KeyArmenian_zhe                Keysym = 0x100056a
+	KeyArmenian_INI                Keysym = 0x100053b
+	KeyArmenian_ini                Keysym = 0x100056b
+	KeyArmenian_LYUN               Keysym = 0x100053c
+	KeyArmenian_lyun               Keysym = 0x100056c
+	KeyArmenian_KHE                Keysym = 0x100053d
+	KeyArmenian_khe                Keysym = 0x100056d
+	KeyArmenian_TSA                Keysym = 0x100053e
+	KeyArmenian_tsa                Keysym = 0x100056e
+	KeyArmenian_KEN                Keysym = 0x100053f
+	KeyArmenian_ken                Keysym = 0x100056f
+	KeyArmenian_HO                 Keysym = 0x1000540
+	KeyArmenian_ho                 Keysym = 0x1000570
+	KeyArmenian_DZA                Keysym = 0x1000541
+	KeyArmenian_dza                Keysym = 0x1000571
+	KeyArmenian_GHAT               Keysym = 0x1000542
+	KeyArmenian_ghat               Keysym = 0x1000572
+	KeyArmenian_TCHE               Keysym = 0x1000543
+	KeyArmenian_tche               Keysym = 0x1000573
+	KeyArmenian_MEN                Keysym = 0x1000544
+	KeyArmenian_men                Keysym = 0x1000574
+	KeyArmenian_HI                 Keysym = 0x1000545
+	KeyArmenian_hi                 Keysym = 0x1000575
+	KeyArmenian_NU                 Keysym = 0x1000546
+	KeyArmenian_nu                 Keysym = 0x1000576
+	KeyArmenian_SHA                Keysym = 0x1000547
+	KeyArmenian_sha                Keysym = 0x1000577
+	KeyArmenian_VO                 Keysym = 0x1000548
+	KeyArmenian_vo                 Keysym = 0x1000578
+	KeyArmenian_CHA                Keysym = 0x1000549
+	KeyArmenian_cha                Keysym = 0x1000579
+	KeyArmenian_PE                 Keysym = 0x100054a
+	KeyArmenian_pe                 Keysym = 0x100057a
+	KeyArmenian_JE                 Keysym = 0x100054b
+	KeyArmenian_je                 Keysym = 0x100057b
+	KeyArmenian_RA                 Keysym = 0x100054c
+	KeyArmenian_ra                 Keysym = 0x100057c
+	KeyArmenian_SE                 Keysym = 0x100054d
+	KeyArmenian_se                 Keysym = 0x100057d
+	KeyArmenian_VEV                Keysym = 0x100054e
+	KeyArmenian_vev                Keysym = 0x100057e
+	KeyArmenian_TYUN               Keysym = 0x100054f
+	KeyArmenian_tyun               Keysym = 0x100057f
+	KeyArmenian_RE                 Keysym = 0x1000550
+	KeyArmenian_re                 Keysym = 0x1000580
+	KeyArmenian_TSO                Keysym = 0x1000551
+	KeyArmenian_tso                Keysym = 0x1000581
+	KeyArmenian_VYUN               Keysym = 0x1000552
+	KeyArmenian_vyun               Keysym = 0x1000582
+	KeyArmenian_PYUR               Keysym = 0x1000553
+	KeyArmenian_pyur               Keysym = 0x1000583
+	KeyArmenian_KE                 Keysym = 0x1000554
+	KeyArmenian_ke                 Keysym = 0x1000584
+	KeyArmenian_O                  Keysym = 0x1000555
+	KeyArmenian_o                  Keysym = 0x1000585
+	KeyArmenian_FE                 Keysym = 0x1000556
+	KeyArmenian_fe                 Keysym = 0x1000586
+	KeyArmenian_apostrophe         Keysym = 0x100055a
+	KeyGeorgian_an                 Keysym = 0x10010d0
+	KeyGeorgian_ban                Keysym = 0x10010d1
+	KeyGeorgian_gan                Keysym = 0x10010d2
+	KeyGeorgian_don                Keysym = 0x10010d3
+	KeyGeorgian_en                 Keysym = 0x10010d4
+	KeyGeorgian_vin                Keysym = 0x10010d5
+	KeyGeorgian_zen                Keysym = 0x10010d6
+	KeyGeorgian_tan                Keysym = 0x10010d7
+	KeyGeorgian_in                 Keysym = 0x10010d8
+	KeyGeorgian_kan                Keysym = 0x10010d9
+	KeyGeorgian_las                Keysym = 0x10010da
+	KeyGeorgian_man                Keysym = 0x10010db
+	KeyGeorgian_nar                Keysym = 0x10010dc
+	KeyGeorgian_on                 Keysym = 0x10010dd
+	KeyGeorgian_par                Keysym = 0x10010de
+	KeyGeorgian_zhar               Keysym = 0x10010df
+	KeyGeorgian_rae                Keysym = 0x10010e0
+	KeyGeorgian_san                Keysym = 0x10010e1
+	KeyGeorgian_tar                Keysym = 0x10010e2
+	KeyGeorgian_un                 Keysym = 0x10010e3
+	KeyGeorgian_phar               Keysym = 0x10010e4
+	KeyGeorgian_khar               Keysym = 0x10010e5
+	KeyGeorgian_ghan               Keysym = 0x10010e6
+	KeyGeorgian_qar                Keysym = 0x10010e7
+	KeyGeorgian_shin               Keysym = 0x10010e8
+	KeyGeorgian_chin               Keysym = 0x10010e9
+	KeyGeorgian_can                Keysym = 0x10010ea
+	KeyGeorgian_jil                Keysym = 0x10010eb
+	KeyGeorgian_cil                Keysym = 0x10010ec
+	KeyGeorgian_char               Keysym = 0x10010ed
+	KeyGeorgian_xan                Keysym = 0x10010ee
+	KeyGeorgian_jhan               Keysym = 0x10010ef
+	KeyGeorgian_hae                Keysym = 0x10010f0
+	KeyGeorgian_he                 Keysym = 0x10010f1
+	KeyGeorgian_hie                Keysym = 0x10010f2
+	KeyGeorgian_we                 Keysym = 0x10010f3
+	KeyGeorgian_har                Keysym = 0x10010f4
+	KeyGeorgian_hoe                Keysym = 0x10010f5
+	KeyGeorgian_fi                 Keysym = 0x10010f6
+	KeyXabovedot                   Keysym = 0x1001e8a
+	KeyIbreve                      Keysym = 0x100012c
+	KeyZstroke                     Keysym = 0x10001b5
+	KeyGcaron                      Keysym = 0x10001e6
+	KeyOcaron                      Keysym = 0x10001d1
+	KeyObarred                     Keysym = 0x100019f
+	Keyxabovedot                   Keysym = 0x1001e8b
+	Keyibreve                      Keysym = 0x100012d
+	Keyzstroke                     Keysym = 0x10001b6
+	Keygcaron                      Keysym = 0x10001e7
+	Keyocaron                      Keysym = 0x10001d2
+	Keyobarred                     Keysym = 0x1000275
+	KeySCHWA                       Keysym = 0x100018f
+	Keyschwa                       Keysym = 0x1000259
+	KeyEZH                         Keysym = 0x10001b7
+	Keyezh                         Keysym = 0x1000292
+	KeyLbelowdot                   Keysym = 0x1001e36
+	Keylbelowdot                   Keysym = 0x1001e37
+	KeyAbelowdot                   Keysym = 0x1001ea0
+	Keyabelowdot                   Keysym = 0x1001ea1
+	KeyAhook                       Keysym = 0x1001ea2
+	Keyahook                       Keysym = 0x1001ea3
+	KeyAcircumflexacute            Keysym = 0x1001ea4
+	Keyacircumflexacute            Keysym = 0x1001ea5
+	KeyAcircumflexgrave            Keysym = 0x1001ea6
+	Keyacircumflexgrave            Keysym = 0x1001ea7
+	KeyAcircumflexhook             Keysym = 0x1001ea8
+	Keyacircumflexhook             Keysym = 0x1001ea9
+	KeyAcircumflextilde            Keysym = 0x1001eaa
+	Keyacircumflextilde            Keysym = 0x1001eab
+	KeyAcircumflexbelowdot         Keysym = 0x1001eac
+	Keyacircumflexbelowdot         Keysym = 0x1001ead
+	KeyAbreveacute                 Keysym = 0x1001eae
+	Keyabreveacute                 Keysym = 0x1001eaf
+	KeyAbrevegrave                 Keysym = 0x1001eb0
+	Keyabrevegrave                 Keysym = 0x1001eb1
+	KeyAbrevehook                  Keysym = 0x1001eb2
+	Keyabrevehook                  Keysym = 0x1001eb3
+	KeyAbrevetilde                 Keysym = 0x1001eb4
+	Keyabrevetilde                 Keysym = 0x1001eb5
+	KeyAbrevebelowdot              Keysym = 0x1001eb6
+	Keyabrevebelowdot              Keysym = 0x1001eb7
+	KeyEbelowdot                   Keysym = 0x1001eb8
+	Keyebelowdot                   Keysym = 0x1001eb9
+	KeyEhook                       Keysym = 0x1001eba
+	Keyehook                       Keysym = 0x1001ebb
+	KeyEtilde                      Keysym = 0x1001ebc
+	Keyetilde                      Keysym = 0x1001ebd
+	KeyEcircumflexacute            Keysym = 0x1001ebe
+	Keyecircumflexacute            Keysym = 0x1001ebf
+	KeyEcircumflexgrave            Keysym = 0x1001ec0
+	Keyecircumflexgrave            Keysym = 0x1001ec1
+	KeyEcircumflexhook             Keysym = 0x1001ec2
+	Keyecircumflexhook             Keysym = 0x1001ec3
+	KeyEcircumflextilde            Keysym = 0x1001ec4
+	Keyecircumflextilde            Keysym = 0x1001ec5
+	KeyEcircumflexbelowdot         Keysym = 0x1001ec6
+	Keyecircumflexbelowdot         Keysym = 0x1001ec7
+	KeyIhook                       Keysym = 0x1001ec8
+	Keyihook                       Keysym = 0x1001ec9
+	KeyIbelowdot                   Keysym = 0x1001eca
+	Keyibelowdot                   Keysym = 0x1001ecb
+	KeyObelowdot                   Keysym = 0x1001ecc
+	Keyobelowdot                   Keysym = 0x1001ecd
+	KeyOhook                       Keysym = 0x1001ece
+	Keyohook                       Keysym = 0x1001ecf
+	KeyOcircumflexacute            Keysym = 0x1001ed0
+	Keyocircumflexacute            Keysym = 0x1001ed1
+	KeyOcircumflexgrave            Keysym = 0x1001ed2
+	Keyocircumflexgrave            Keysym = 0x1001ed3
+	KeyOcircumflexhook             Keysym = 0x1001ed4
+	Keyocircumflexhook             Keysym = 0x1001ed5
+	KeyOcircumflextilde            Keysym = 0x1001ed6
+	Keyocircumflextilde            Keysym = 0x1001ed7
+	KeyOcircumflexbelowdot         Keysym = 0x1001ed8
+	Keyocircumflexbelowdot         Keysym = 0x1001ed9
+	KeyOhornacute                  Keysym = 0x1001eda
+	Keyohornacute                  Keysym = 0x1001edb
+	KeyOhorngrave                  Keysym = 0x1001edc
+	Keyohorngrave                  Keysym = 0x1001edd
+	KeyOhornhook                   Keysym = 0x1001ede
+	Keyohornhook                   Keysym = 0x1001edf
+	KeyOhorntilde                  Keysym = 0x1001ee0
+	Keyohorntilde                  Keysym = 0x1001ee1
+	KeyOhornbelowdot               Keysym = 0x1001ee2
+	Keyohornbelowdot               Keysym = 0x1001ee3
+	KeyUbelowdot                   Keysym = 0x1001ee4
+	Keyubelowdot                   Keysym = 0x1001ee5
+	KeyUhook                       Keysym = 0x1001ee6
+	Keyuhook                       Keysym = 0x1001ee7
+	KeyUhornacute                  Keysym = 0x1001ee8
+	Keyuhornacute                  Keysym = 0x1001ee9
+	KeyUhorngrave                  Keysym = 0x1001eea
+	Keyuhorngrave                  Keysym = 0x1001eeb
+	KeyUhornhook                   Keysym = 0x1001eec
+	Keyuhornhook                   Keysym = 0x1001eed
+	KeyUhorntilde                  Keysym = 0x1001eee
+	Keyuhorntilde                  Keysym = 0x1001eef
+	KeyUhornbelowdot               Keysym = 0x1001ef0
+	Keyuhornbelowdot               Keysym = 0x1001ef1
+	KeyYbelowdot                   Keysym = 0x1001ef4
+	Keyybelowdot                   Keysym = 0x1001ef5
+	KeyYhook                       Keysym = 0x1001ef6
+	Keyyhook                       Keysym = 0x1001ef7
+	KeyYtilde                      Keysym = 0x1001ef8
+	Keyytilde                      Keysym = 0x1001ef9
+	KeyOhorn                       Keysym = 0x10001a0
+	Keyohorn                       Keysym = 0x10001a1
+	KeyUhorn                       Keysym = 0x10001af
+	Keyuhorn                       Keysym = 0x10001b0
+	Keycombining_tilde             Keysym = 0x1000303
+	Keycombining_grave             Keysym = 0x1000300
+	Keycombining_acute             Keysym = 0x1000301
+	Keycombining_hook              Keysym = 0x1000309
+	Keycombining_belowdot          Keysym = 0x1000323
+	KeyEcuSign                     Keysym = 0x10020a0
+	KeyColonSign                   Keysym = 0x10020a1
+	KeyCruzeiroSign                Keysym = 0x10020a2
+	KeyFFrancSign                  Keysym = 0x10020a3
+	KeyLiraSign                    Keysym = 0x10020a4
+	KeyMillSign                    Keysym = 0x10020a5
+	KeyNairaSign                   Keysym = 0x10020a6
+	KeyPesetaSign                  Keysym = 0x10020a7
+	KeyRupeeSign                   Keysym = 0x10020a8
+	KeyWonSign                     Keysym = 0x10020a9
+	KeyNewSheqelSign               Keysym = 0x10020aa
+	KeyDongSign                    Keysym = 0x10020ab
+	KeyEuroSign                    Keysym = 0x20ac
+	Keyzerosuperior                Keysym = 0x1002070
+	Keyfoursuperior                Keysym = 0x1002074
+	Keyfivesuperior                Keysym = 0x1002075
+	Keysixsuperior                 Keysym = 0x1002076
+	Keysevensuperior               Keysym = 0x1002077
+	Keyeightsuperior               Keysym = 0x1002078
+	Keyninesuperior                Keysym = 0x1002079
+	Keyzerosubscript               Keysym = 0x1002080
+	Keyonesubscript                Keysym = 0x1002081
+	Keytwosubscript                Keysym = 0x1002082
+	Keythreesubscript              Keysym = 0x1002083
+	Keyfoursubscript               Keysym = 0x1002084
+	Keyfivesubscript               Keysym = 0x1002085
+	Keysixsubscript                Keysym = 0x1002086
+	Keysevensubscript              Keysym = 0x1002087
+	Keyeightsubscript              Keysym = 0x1002088
+	Keyninesubscript               Keysym = 0x1002089
+	Keypartdifferential            Keysym = 0x1002202
+	Keyemptyset                    Keysym = 0x1002205
+	Keyelementof                   Keysym = 0x1002208
+	Keynotelementof                Keysym = 0x1002209
+	Keycontainsas                  Keysym = 0x100220b
+	Keysquareroot                  Keysym = 0x100221a
+	Keycuberoot                    Keysym = 0x100221b
+	Keyfourthroot                  Keysym = 0x100221c
+	Keydintegral                   Keysym = 0x100222c
+	Keytintegral                   Keysym = 0x100222d
+	Keybecause                     Keysym = 0x1002235
+	Keyapproxeq                    Keysym = 0x1002248
+	Keynotapproxeq                 Keysym = 0x1002247
+	Keynotidentical                Keysym = 0x1002262
+	Keystricteq                    Keysym = 0x1002263
+	Keybraille_dot_1               Keysym = 0xfff1
+	Keybraille_dot_2               Keysym = 0xfff2
+	Keybraille_dot_3               Keysym = 0xfff3
+	Keybraille_dot_4               Keysym = 0xfff4
+	Keybraille_dot_5               Keysym = 0xfff5
+	Keybraille_dot_6               Keysym = 0xfff6
+	Keybraille_dot_7               Keysym = 0xfff7
+	Keybraille_dot_8               Keysym = 0xfff8
+	Keybraille_dot_9               Keysym = 0xfff9
+	Keybraille_dot_10              Keysym = 0xfffa
+	Keybraille_blank               Keysym = 0x1002800
+	Keybraille_dots_1              Keysym = 0x1002801
+	Keybraille_dots_2              Keysym = 0x1002802
+	Keybraille_dots_12             Keysym = 0x1002803
+	Keybraille_dots_3              Keysym = 0x1002804
+	Keybraille_dots_13             Keysym = 0x1002805
+	Keybraille_dots_23             Keysym = 0x1002806
+	Keybraille_dots_123            Keysym = 0x1002807
+	Keybraille_dots_4              Keysym = 0x1002808
+	Keybraille_dots_14             Keysym = 0x1002809
+	Keybraille_dots_24             Keysym = 0x100280a
+	Keybraille_dots_124            Keysym = 0x100280b
+	Keybraille_dots_34             Keysym = 0x100280c
+	Keybraille_dots_134            Keysym = 0x100280d
+	Keybraille_dots_234            Keysym = 0x100280e
+	Keybraille_dots_1234           Keysym = 0x100280f
+	Keybraille_dots_5              Keysym = 0x1002810
+	Keybraille_dots_15             Keysym = 0x1002811
+	Keybraille_dots_25             Keysym = 0x1002812
+	Keybraille_dots_125            Keysym = 0x1002813
+	Keybraille_dots_35             Keysym = 0x1002814
+	Keybraille_dots_135            Keysym = 0x1002815
+	Keybraille_dots_235            Keysym = 0x1002816
+	Keybraille_dots_1235           Keysym = 0x1002817
+	Keybraille_dots_45             Keysym = 0x1002818
+	Keybraille_dots_145            Keysym = 0x1002819
+	Keybraille_dots_245            Keysym = 0x100281a
+	Keybraille_dots_1245           Keysym = 0x100281b
+	Keybraille_dots_345            Keysym = 0x100281c
+	Keybraille_dots_1345           Keysym = 0x100281d
+	Keybraille_dots_2345           Keysym = 0x100281e
+	Keybraille_dots_12345          Keysym = 0x100281f
+	Keybraille_dots_6              Keysym = 0x1002820
+	Keybraille_dots_16             Keysym = 0x1002821
+	Keybraille_dots_26             Keysym = 0x1002822
+	Keybraille_dots_126            Keysym = 0x1002823
+	Keybraille_dots_36             Keysym = 0x1002824
+	Keybraille_dots_136            Keysym = 0x1002825
+	Keybraille_dots_236            Keysym = 0x1002826
+	Keybraille_dots_1236           Keysym = 0x1002827
+	Keybraille_dots_46             Keysym = 0x1002828
+	Keybraille_dots_146            Keysym = 0x1002829
+	Keybraille_dots_246            Keysym = 0x100282a
+	Keybraille_dots_1246           Keysym = 0x100282b
+	Keybraille_dots_346            Keysym = 0x100282c
+	Keybraille_dots_1346           Keysym = 0x100282d
+	Keybraille_dots_2346           Keysym = 0x100282e
+	Keybraille_dots_12346          Keysym = 0x100282f
+	Keybraille_dots_56             Keysym = 0x1002830
+	Keybraille_dots_156            Keysym = 0x1002831
+	Keybraille_dots_256            Keysym = 0x1002832
+	Keybraille_dots_1256           Keysym = 0x1002833
+	Keybraille_dots_356            Keysym = 0x1002834
+	Keybraille_dots_1356           Keysym = 0x1002835
+	Keybraille_dots_2356           Keysym = 0x1002836
+	Keybraille_dots_12356          Keysym = 0x1002837
+	Keybraille_dots_456            Keysym = 0x1002838
+	Keybraille_dots_1456           Keysym = 0x1002839
+	Keybraille_dots_2456           Keysym = 0x100283a
+	Keybraille_dots_12456          Keysym = 0x100283b
+	Keybraille_dots_3456           Keysym = 0x100283c
+	Keybraille_dots_13456          Keysym = 0x100283d
+	Keybraille_dots_23456          Keysym = 0x100283e
+	Keybraille_dots_123456         Keysym = 0x100283f
+	Keybraille_dots_7              Keysym = 0x1002840
+	Keybraille_dots_17             Keysym = 0x1002841
+	Keybraille_dots_27             Keysym = 0x1002842
+	Keybraille_dots_127            Keysym = 0x1002843
+	Keybraille_dots_37             Keysym = 0x1002844
+	Keybraille_dots_137            Keysym = 0x1002845
+	Keybraille_dots_237            Keysym = 0x1002846
+	Keybraille_dots_1237           Keysym = 0x1002847
+	Keybraille_dots_47             Keysym = 0x1002848
+	Keybraille_dots_147            Keysym = 0x1002849
+	Keybraille_dots_247            Keysym = 0x100284a
+	Keybraille_dots_1247           Keysym = 0x100284b
+	Keybraille_dots_347            Keysym = 0x100284c
+	Keybraille_dots_1347           Keysym = 0x100284d
+	Keybraille_dots_2347           Keysym = 0x100284e
+	Keybraille_dots_12347          Keysym = 0x100284f
+	Keybraille_dots_57             Keysym = 0x1002850
+	Keybraille_dots_157            Keysym = 0x1002851
+	Keybraille_dots_257            Keysym = 0x1002852
+	Keybraille_dots_1257           Keysym = 0x1002853
+	Keybraille_dots_357            Keysym = 0x1002854
+	Keybraille_dots_1357           Keysym = 0x1002855
+	Keybraille_dots_2357           Keysym = 0x1002856
+	Keybraille_dots_12357          Keysym = 0x1002857
+	Keybraille_dots_457            Keysym = 0x1002858
+	Keybraille_dots_1457           Keysym = 0x1002859
+	Keybraille_dots_2457           Keysym = 0x100285a
+	Keybraille_dots_12457          Keysym = 0x100285b
+	Keybraille_dots_3457           Keysym = 0x100285c
+	Keybraille_dots_13457          Keysym = 0x100285d
+	Keybraille_dots_23457          Keysym = 0x100285e
+	Keybraille_dots_123457         Keysym = 0x100285f
+	Keybraille_dots_67             Keysym = 0x1002860
+	Keybraille_dots_167            Keysym = 0x1002861
+	Keybraille_dots_267            Keysym = 0x1002862
+	Keybraille_dots_1267           Keysym = 0x1002863
+	Keybraille_dots_367            Keysym = 0x1002864
+	Keybraille_dots_1367           Keysym = 0x1002865
+	Keybraille_dots_2367           Keysym = 0x1002866
+	Keybraille_dots_12367          Keysym = 0x1002867
+	Keybraille_dots_467            Keysym = 0x1002868
+	Keybraille_dots_1467           Keysym = 0x1002869
+	Keybraille_dots_2467           Keysym = 0x100286a
+	Keybraille_dots_12467          Keysym = 0x100286b
+	Keybraille_dots_3467           Keysym = 0x100286c
+	Keybraille_dots_13467          Keysym = 0x100286d
+	Keybraille_dots_23467          Keysym = 0x100286e
+	Keybraille_dots_123467         Keysym = 0x100286f
+	Keybraille_dots_567            Keysym = 0x1002870
+	Keybraille_dots_1567           Keysym = 0x1002871
+	Keybraille_dots_2567           Keysym = 0x1002872
+	Keybraille_dots_12567          Keysym = 0x1002873
+	Keybraille_dots_3567           Keysym = 0x1002874
+	Keybraille_dots_13567          Keysym = 0x1002875
+	Keybraille_dots_23567          Keysym = 0x1002876
+	Keybraille_dots_123567         Keysym = 0x1002877
+	Keybraille_dots_4567           Keysym = 0x1002878
+	Keybraille_dots_14567          Keysym = 0x1002879
+	Keybraille_dots_24567          Keysym = 0x100287a
+	Keybraille_dots_124567         Keysym = 0x100287b
+	Keybraille_dots_34567          Keysym = 0x100287c
+	Keybraille_dots_134567         Keysym = 0x100287d
+	Keybraille_dots_234567         Keysym = 0x100287e
+	Keybraille_dots_1234567        Keysym = 0x100287f
+	Keybraille_dots_8              Keysym = 0x1002880
+	Keybraille_dots_18             Keysym = 0x1002881
+	Keybraille_dots_28             Keysym = 0x1002882
+	Keybraille_dots_128            Keysym = 0x1002883
+	Keybraille_dots_38             Keysym = 0x1002884
+	Keybraille_dots_138            Keysym = 0x1002885
+	Keybraille_dots_238            Keysym = 0x1002886
+	Keybraille_dots_1238           Keysym = 0x1002887
+	Keybraille_dots_48             Keysym = 0x1002888
+	Keybraille_dots_148            Keysym = 0x1002889
+	Keybraille_dots_248            Keysym = 0x100288a
+	Keybraille_dots_1248           Keysym = 0x100288b
+	Keybraille_dots_348            Keysym = 0x100288c
+	Keybraille_dots_1348           Keysym = 0x100288d
+	Keybraille_dots_2348           Keysym = 0x100288e
+	Keybraille_dots_12348          Keysym = 0x100288f
+	Keybraille_dots_58             Keysym = 0x1002890
+	Keybraille_dots_158            Keysym = 0x1002891
+	Keybraille_dots_258            Keysym = 0x1002892
+	Keybraille_dots_1258           Keysym = 0x1002893
+	Keybraille_dots_358            Keysym = 0x1002894
+	Keybraille_dots_1358           Keysym = 0x1002895
+	Keybraille_dots_2358           Keysym = 0x1002896
+	Keybraille_dots_12358          Keysym = 0x1002897
+	Keybraille_dots_458            Keysym = 0x1002898
+	Keybraille_dots_1458           Keysym = 0x1002899
+	Keybraille_dots_2458           Keysym = 0x100289a
+	Keybraille_dots_12458          Keysym = 0x100289b
+	Keybraille_dots_3458           Keysym = 0x100289c
+	Keybraille_dots_13458          Keysym = 0x100289d
+	Keybraille_dots_23458          Keysym = 0x100289e
+	Keybraille_dots_123458         Keysym = 0x100289f
+	Keybraille_dots_68             Keysym = 0x10028a0
+	Keybraille_dots_168            Keysym = 0x10028a1
+	Keybraille_dots_268            Keysym = 0x10028a2
+	Keybraille_dots_1268           Keysym = 0x10028a3
+	Keybraille_dots_368            Keysym = 0x10028a4
+	Keybraille_dots_1368           Keysym = 0x10028a5
+	Keybraille_dots_2368           Keysym = 0x10028a6
+	Keybraille_dots_12368          Keysym = 0x10028a7
+	Keybraille_dots_468            Keysym = 0x10028a8
+	Keybraille_dots_1468           Keysym = 0x10028a9
+	Keybraille_dots_2468           Keysym = 0x10028aa
+	Keybraille_dots_12468          Keysym = 0x10028ab
+	Keybraille_dots_3468           Keysym = 0x10028ac
+	Keybraille_dots_13468          Keysym = 0x10028ad
+	Keybraille_dots_23468          Keysym = 0x10028ae
+	Keybraille_dots_123468         Keysym = 0x10028af
+	Keybraille_dots_568            Keysym = 0x10028b0
+	Keybraille_dots_1568           Keysym = 0x10028b1
+	Keybraille_dots_2568           Keysym = 0x10028b2
+	Keybraille_dots_12568          Keysym = 0x10028b3
+	Keybraille_dots_3568           Keysym = 0x10028b4
+	Keybraille_dots_13568          Keysym = 0x10028b5
+	Keybraille_dots_23568          Keysym = 0x10028b6
+	Keybraille_dots_123568         Keysym = 0x10028b7
+	Keybraille_dots_4568           Keysym = 0x10028b8
+	Keybraille_dots_14568          Keysym = 0x10028b9
+	Keybraille_dots_24568          Keysym = 0x10028ba
+	Keybraille_dots_124568         Keysym = 0x10028bb
+	Keybraille_dots_34568          Keysym = 0x10028bc
+	Keybraille_dots_134568         Keysym = 0x10028bd
+	Keybraille_dots_234568         Keysym = 0x10028be
+	Keybraille_dots_1234568        Keysym = 0x10028bf
+	Keybraille_dots_78             Keysym = 0x10028c0
+	Keybraille_dots_178            Keysym = 0x10028c1
+	Keybraille_dots_278            Keysym = 0x10028c2
+	Keybraille_dots_1278           Keysym = 0x10028c3
+	Keybraille_dots_378            Keysym = 0x10028c4
+	Keybraille_dots_1378           Keysym = 0x10028c5
+	Keybraille_dots_2378           Keysym = 0x10028c6
+	Keybraille_dots_12378          Keysym = 0x10028c7
+	Keybraille_dots_478            Keysym = 0x10028c8
+	Keybraille_dots_1478           Keysym = 0x10028c9
+	Keybraille_dots_2478           Keysym = 0x10028ca
+	Keybraille_dots_12478          Keysym = 0x10028cb
+	Keybraille_dots_3478           Keysym = 0x10028cc
+	Keybraille_dots_13478          Keysym = 0x10028cd
+	Keybraille_dots_23478          Keysym = 0x10028ce
+	Keybraille_dots_123478         Keysym = 0x10028cf
+	Keybraille_dots_578            Keysym = 0x10028d0
+	Keybraille_dots_1578           Keysym = 0x10028d1
+	Keybraille_dots_2578           Keysym = 0x10028d2
+	Keybraille_dots_12578          Keysym = 0x10028d3
+	Keybraille_dots_3578           Keysym = 0x10028d4
+	Keybraille_dots_13578          Keysym = 0x10028d5
+	Keybraille_dots_23578          Keysym = 0x10028d6
+	Keybraille_dots_123578         Keysym = 0x10028d7
+	Keybraille_dots_4578           Keysym = 0x10028d8
+	Keybraille_dots_14578          Keysym = 0x10028d9
+	Keybraille_dots_24578          Keysym = 0x10028da
+	Keybraille_dots_124578         Keysym = 0x10028db
+	Keybraille_dots_34578          Keysym = 0x10028dc
+	Keybraille_dots_134578         Keysym = 0x10028dd
+	Keybraille_dots_234578         Keysym = 0x10028de
+	Keybraille_dots_1234578        Keysym = 0x10028df
+	Keybraille_dots_678            Keysym = 0x10028e0
+	Keybraille_dots_1678           Keysym = 0x10028e1
+	Keybraille_dots_2678           Keysym = 0x10028e2
+	Keybraille_dots_12678          Keysym = 0x10028e3
+	Keybraille_dots_3678           Keysym = 0x10028e4
+	Keybraille_dots_13678          Keysym = 0x10028e5
+	Keybraille_dots_23678          Keysym = 0x10028e6
+	Keybraille_dots_123678         Keysym = 0x10028e7
+	Keybraille_dots_4678           Keysym = 0x10028e8
+	Keybraille_dots_14678          Keysym = 0x10028e9
+	Keybraille_dots_24678          Keysym = 0x10028ea
+	Keybraille_dots_124678         Keysym = 0x10028eb
+	Keybraille_dots_34678          Keysym = 0x10028ec
+	Keybraille_dots_134678         Keysym = 0x10028ed
+	Keybraille_dots_234678         Keysym = 0x10028ee
+	Keybraille_dots_1234678        Keysym = 0x10028ef
+	Keybraille_dots_5678           Keysym = 0x10028f0
+	Keybraille_dots_15678          Keysym = 0x10028f1
+	Keybraille_dots_25678          Keysym = 0x10028f2
+	Keybraille_dots_125678         Keysym = 0x10028f3
+	Keybraille_dots_35678          Keysym = 0x10028f4
+	Keybraille_dots_135678         Keysym = 0x10028f5
+	Keybraille_dots_235678         Keysym = 0x10028f6
+	Keybraille_dots_1235678        Keysym = 0x10028f7
+	Keybraille_dots_45678          Keysym = 0x10028f8
+	Keybraille_dots_145678         Keysym = 0x10028f9
+	Keybraille_dots_245678         Keysym = 0x10028fa
+	Keybraille_dots_1245678        Keysym = 0x10028fb
+	Keybraille_dots_345678         Keysym = 0x10028fc
+	Keybraille_dots_1345678        Keysym = 0x10028fd
+	Keybraille_dots_2345678        Keysym = 0x10028fe
+	Keybraille_dots_12345678       Keysym = 0x10028ff
+	KeySinh_ng                     Keysym = 0x1000d82
+	KeySinh_h2                     Keysym = 0x1000d83
+	KeySinh_a                      Keysym = 0x1000d85
+	KeySinh_aa                     Keysym = 0x1000d86
+	KeySinh_ae                     Keysym = 0x1000d87
+	KeySinh_aee                    Keysym = 0x1000d88
+	KeySinh_i                      Keysym = 0x1000d89
+	KeySinh_ii                     Keysym = 0x1000d8a
+	KeySinh_u                      Keysym = 0x1000d8b
+	KeySinh_uu                     Keysym = 0x1000d8c
+	KeySinh_ri                     Keysym = 0x1000d8d
+	KeySinh_rii                    Keysym = 0x1000d8e
+	KeySinh_lu                     Keysym = 0x1000d8f
+	KeySinh_luu                    Keysym = 0x1000d90
+	KeySinh_e                      Keysym = 0x1000d91
+	KeySinh_ee                     Keysym = 0x1000d92
+	KeySinh_ai                     Keysym = 0x1000d93
+	KeySinh_o                      Keysym = 0x1000d94
+	KeySinh_oo                     Keysym = 0x1000d95
+	KeySinh_au                     Keysym = 0x1000d96
+	KeySinh_ka                     Keysym = 0x1000d9a
+	KeySinh_kha                    Keysym = 0x1000d9b
+	KeySinh_ga                     Keysym = 0x1000d9c
+	KeySinh_gha                    Keysym = 0x1000d9d
+	KeySinh_ng2                    Keysym = 0x1000d9e
+	KeySinh_nga                    Keysym = 0x1000d9f
+	KeySinh_ca                     Keysym = 0x1000da0
+	KeySinh_cha                    Keysym = 0x1000da1
+	KeySinh_ja                     Keysym = 0x1000da2
+	KeySinh_jha                    Keysym = 0x1000da3
+	KeySinh_nya                    Keysym = 0x1000da4
+	KeySinh_jnya                   Keysym = 0x1000da5
+	KeySinh_nja                    Keysym = 0x1000da6
+	KeySinh_tta                    Keysym = 0x1000da7
+	KeySinh_ttha                   Keysym = 0x1000da8
+	KeySinh_dda                    Keysym = 0x1000da9
+	KeySinh_ddha                   Keysym = 0x1000daa
+	KeySinh_nna                    Keysym = 0x1000dab
+	KeySinh_ndda                   Keysym = 0x1000dac
+	KeySinh_tha                    Keysym = 0x1000dad
+	KeySinh_thha                   Keysym = 0x1000dae
+	KeySinh_dha                    Keysym = 0x1000daf
+	KeySinh_dhha                   Keysym = 0x1000db0
+	KeySinh_na                     Keysym = 0x1000db1
+	KeySinh_ndha                   Keysym = 0x1000db3
+	KeySinh_pa                     Keysym = 0x1000db4
+	KeySinh_pha                    Keysym = 0x1000db5
+	KeySinh_ba                     Keysym = 0x1000db6
+	KeySinh_bha                    Keysym = 0x1000db7
+	KeySinh_ma                     Keysym = 0x1000db8
+	KeySinh_mba                    Keysym = 0x1000db9
+	KeySinh_ya                     Keysym = 0x1000dba
+	KeySinh_ra                     Keysym = 0x1000dbb
+	KeySinh_la                     Keysym = 0x1000dbd
+	KeySinh_va                     Keysym = 0x1000dc0
+	KeySinh_sha                    Keysym = 0x1000dc1
+	KeySinh_ssha                   Keysym = 0x1000dc2
+	KeySinh_sa                     Keysym = 0x1000dc3
+	KeySinh_ha                     Keysym = 0x1000dc4
+	KeySinh_lla                    Keysym = 0x1000dc5
+	KeySinh_fa                     Keysym = 0x1000dc6
+	KeySinh_al                     Keysym = 0x1000dca
+	KeySinh_aa2                    Keysym = 0x1000dcf
+	KeySinh_ae2                    Keysym = 0x1000dd0
+	KeySinh_aee2                   Keysym = 0x1000dd1
+	KeySinh_i2                     Keysym = 0x1000dd2
+	KeySinh_ii2                    Keysym = 0x1000dd3
+	KeySinh_u2                     Keysym = 0x1000dd4
+	KeySinh_uu2                    Keysym = 0x1000dd6
+	KeySinh_ru2                    Keysym = 0x1000dd8
+	KeySinh_e2                     Keysym = 0x1000dd9
+	KeySinh_ee2                    Keysym = 0x1000dda
+	KeySinh_ai2                    Keysym = 0x1000ddb
+	KeySinh_o2                     Keysym = 0x1000ddc
+	KeySinh_oo2                    Keysym = 0x1000ddd
+	KeySinh_au2                    Keysym = 0x1000dde
+	KeySinh_lu2                    Keysym = 0x1000ddf
+	KeySinh_ruu2                   Keysym = 0x1000df2
+	KeySinh_luu2                   Keysym = 0x1000df3
+	KeySinh_kunddaliya             Keysym = 0x1000df4
+	KeyXF86ModeLock                Keysym = 0x1008ff01
+	KeyXF86MonBrightnessUp         Keysym = 0x1008ff02
+	KeyXF86MonBrightnessDown       Keysym = 0x1008ff03
+	KeyXF86KbdLightOnOff           Keysym = 0x1008ff04
+	KeyXF86KbdBrightnessUp         Keysym = 0x1008ff05
+	KeyXF86KbdBrightnessDown       Keysym = 0x1008ff06
+	KeyXF86MonBrightnessCycle      Keysym = 0x1008ff07
+	KeyXF86Standby                 Keysym = 0x1008ff10
+	KeyXF86AudioLowerVolume        Keysym = 0x1008ff11
+	KeyXF86AudioMute               Keysym = 0x1008ff12
+	KeyXF86AudioRaiseVolume        Keysym = 0x1008ff13
+	KeyXF86AudioPlay               Keysym = 0x1008ff14
+	KeyXF86AudioStop               Keysym = 0x1008ff15
+	KeyXF86AudioPrev               Keysym = 0x1008ff16
+	KeyXF86AudioNext               Keysym = 0x1008ff17
+	KeyXF86HomePage                Keysym = 0x1008ff18
+	KeyXF86Mail                    Keysym = 0x1008ff19
+	KeyXF86Start                   Keysym = 0x1008ff1a
+	KeyXF86Search                  Keysym = 0x1008ff1b
+	KeyXF86AudioRecord             Keysym = 0x1008ff1c
+	KeyXF86Calculator              Keysym = 0x1008ff1d
+	KeyXF86Memo                    Keysym = 0x1008ff1e
+	KeyXF86ToDoList                Keysym = 0x1008ff1f
+	KeyXF86Calendar                Keysym = 0x1008ff20
+	KeyXF86PowerDown               Keysym = 0x1008ff21
+	KeyXF86ContrastAdjust          Keysym = 0x1008ff22
+	KeyXF86RockerUp                Keysym = 0x1008ff23
+	KeyXF86RockerDown              Keysym = 0x1008ff24
+	KeyXF86RockerEnter             Keysym = 0x1008ff25
+	KeyXF86Back                    Keysym = 0x1008ff26
+	KeyXF86Forward                 Keysym = 0x1008ff27
+	KeyXF86Stop                    Keysym = 0x1008ff28
+	KeyXF86Refresh                 Keysym = 0x1008ff29
+	KeyXF86PowerOff                Keysym = 0x1008ff2a
+	KeyXF86WakeUp                  Keysym = 0x1008ff2b
+	KeyXF86Eject                   Keysym = 0x1008ff2c
+	KeyXF86ScreenSaver             Keysym = 0x1008ff2d
+	KeyXF86WWW                     Keysym = 0x1008ff2e
+	KeyXF86Sleep                   Keysym = 0x1008ff2f
+	KeyXF86Favorites               Keysym = 0x1008ff30
+	KeyXF86AudioPause              Keysym = 0x1008ff31
+	KeyXF86AudioMedia              Keysym = 0x1008ff32
+	KeyXF86MyComputer              Keysym = 0x1008ff33
+	KeyXF86VendorHome              Keysym = 0x1008ff34
+	KeyXF86LightBulb               Keysym = 0x1008ff35
+	KeyXF86Shop                    Keysym = 0x1008ff36
+	KeyXF86History                 Keysym = 0x1008ff37
+	KeyXF86OpenURL                 Keysym = 0x1008ff38
+	KeyXF86AddFavorite             Keysym = 0x1008ff39
+	KeyXF86HotLinks                Keysym = 0x1008ff3a
+	KeyXF86BrightnessAdjust        Keysym = 0x1008ff3b
+	KeyXF86Finance                 Keysym = 0x1008ff3c
+	KeyXF86Community               Keysym = 0x1008ff3d
+	KeyXF86AudioRewind             Keysym = 0x1008ff3e
+	KeyXF86BackForward             Keysym = 0x1008ff3f
+	KeyXF86Launch0                 Keysym = 0x1008ff40
+	KeyXF86Launch1                 Keysym = 0x1008ff41
+	KeyXF86Launch2                 Keysym = 0x1008ff42
+	KeyXF86Launch3                 Keysym = 0x1008ff43
+	KeyXF86Launch4                 Keysym = 0x1008ff44
+	KeyXF86Launch5                 Keysym = 0x1008ff45
+	KeyXF86Launch6                 Keysym = 0x1008ff46
+	KeyXF86Launch7                 Keysym = 0x1008ff47
+	KeyXF86Launch8                 Keysym = 0x1008ff48
+	KeyXF86Launch9                 Keysym = 0x1008ff49
+	KeyXF86LaunchA                 Keysym = 0x1008ff4a
+	KeyXF86LaunchB                 Keysym = 0x1008ff4b
+	KeyXF86LaunchC                 Keysym = 0x1008ff4c
+	KeyXF86LaunchD                 Keysym = 0x1008ff4d
+	KeyXF86LaunchE                 Keysym = 0x1008ff4e
+	KeyXF86LaunchF                 Keysym = 0x1008ff4f
+	KeyXF86ApplicationLeft         Keysym = 0x1008ff50
+	KeyXF86ApplicationRight        Keysym = 0x1008ff51
+	KeyXF86Book                    Keysym = 0x1008ff52
+	KeyXF86CD                      Keysym = 0x1008ff53
+	KeyXF86Calculater              Keysym = 0x1008ff54
+	KeyXF86Clear                   Keysym = 0x1008ff55
+	KeyXF86Close                   Keysym = 0x1008ff56
+	KeyXF86Copy                    Keysym = 0x1008ff57
+	KeyXF86Cut                     Keysym = 0x1008ff58
+	KeyXF86Display                 Keysym = 0x1008ff59
+	KeyXF86DOS                     Keysym = 0x1008ff5a
+	KeyXF86Documents               Keysym = 0x1008ff5b
+	KeyXF86Excel                   Keysym = 0x1008ff5c
+	KeyXF86Explorer                Keysym = 0x1008ff5d
+	KeyXF86Game                    Keysym = 0x1008ff5e
+	KeyXF86Go                      Keysym = 0x1008ff5f
+	KeyXF86iTouch                  Keysym = 0x1008ff60
+	KeyXF86LogOff                  Keysym = 0x1008ff61
+	KeyXF86Market                  Keysym = 0x1008ff62
+	KeyXF86Meeting                 Keysym = 0x1008ff63
+	KeyXF86MenuKB                  Keysym = 0x1008ff65
+	KeyXF86MenuPB                  Keysym = 0x1008ff66
+	KeyXF86MySites                 Keysym = 0x1008ff67
+	KeyXF86New                     Keysym = 0x1008ff68
+	KeyXF86News                    Keysym = 0x1008ff69
+	KeyXF86OfficeHome              Keysym = 0x1008ff6a
+	KeyXF86Open                    Keysym = 0x1008ff6b
+	KeyXF86Option                  Keysym = 0x1008ff6c
+	KeyXF86Paste                   Keysym = 0x1008ff6d
+	KeyXF86Phone                   Keysym = 0x1008ff6e
+	KeyXF86Q                       Keysym = 0x1008ff70
+	KeyXF86Reply                   Keysym = 0x1008ff72
+	KeyXF86Reload                  Keysym = 0x1008ff73
+	KeyXF86RotateWindows           Keysym = 0x1008ff74
+	KeyXF86RotationPB              Keysym = 0x1008ff75
+	KeyXF86RotationKB              Keysym = 0x1008ff76
+	KeyXF86Save                    Keysym = 0x1008ff77
+	KeyXF86ScrollUp                Keysym = 0x1008ff78
+	KeyXF86ScrollDown              Keysym = 0x1008ff79
+	KeyXF86ScrollClick             Keysym = 0x1008ff7a
+	KeyXF86Send                    Keysym = 0x1008ff7b
+	KeyXF86Spell                   Keysym = 0x1008ff7c
+	KeyXF86SplitScreen             Keysym = 0x1008ff7d
+	KeyXF86Support                 Keysym = 0x1008ff7e
+	KeyXF86TaskPane                Keysym = 0x1008ff7f
+	KeyXF86Terminal                Keysym = 0x1008ff80
+	KeyXF86Tools                   Keysym = 0x1008ff81
+	KeyXF86Travel                  Keysym = 0x1008ff82
+	KeyXF86UserPB                  Keysym = 0x1008ff84
+	KeyXF86User1KB                 Keysym = 0x1008ff85
+	KeyXF86User2KB                 Keysym = 0x1008ff86
+	KeyXF86Video                   Keysym = 0x1008ff87
+	KeyXF86WheelButton             Keysym = 0x1008ff88
+	KeyXF86Word                    Keysym = 0x1008ff89
+	KeyXF86Xfer                    Keysym = 0x1008ff8a
+	KeyXF86ZoomIn                  Keysym = 0x1008ff8b
+	KeyXF86ZoomOut                 Keysym = 0x1008ff8c
+	KeyXF86Away                    Keysym = 0x1008ff8d
+	KeyXF86Messenger               Keysym = 0x1008ff8e
+	KeyXF86WebCam                  Keysym = 0x1008ff8f
+	KeyXF86MailForward             Keysym = 0x1008ff90
+	KeyXF86Pictures                Keysym = 0x1008ff91
+	KeyXF86Music                   Keysym = 0x1008ff92
+	KeyXF86Battery                 Keysym = 0x1008ff93
+	KeyXF86Bluetooth               Keysym = 0x1008ff94
+	KeyXF86WLAN                    Keysym = 0x1008ff95
+	KeyXF86UWB                     Keysym = 0x1008ff96
+	KeyXF86AudioForward            Keysym = 0x1008ff97
+	KeyXF86AudioRepeat             Keysym = 0x1008ff98
+	KeyXF86AudioRandomPlay         Keysym = 0x1008ff99
+	KeyXF86Subtitle                Keysym = 0x1008ff9a
+	KeyXF86AudioCycleTrack         Keysym = 0x1008ff9b
+	KeyXF86CycleAngle              Keysym = 0x1008ff9c
+	KeyXF86FrameBack               Keysym = 0x1008ff9d
+	KeyXF86FrameForward            Keysym = 0x1008ff9e
+	KeyXF86Time                    Keysym = 0x1008ff9f
+	KeyXF86Select                  Keysym = 0x1008ffa0
+	KeyXF86View                    Keysym = 0x1008ffa1
+	KeyXF86TopMenu                 Keysym = 0x1008ffa2
+	KeyXF86Red                     Keysym = 0x1008ffa3
+	KeyXF86Green                   Keysym = 0x1008ffa4
+	KeyXF86Yellow                  Keysym = 0x1008ffa5
+	KeyXF86Blue                    Keysym = 0x1008ffa6
+	KeyXF86Suspend                 Keysym = 0x1008ffa7
+	KeyXF86Hibernate               Keysym = 0x1008ffa8
+	KeyXF86TouchpadToggle          Keysym = 0x1008ffa9
+	KeyXF86TouchpadOn              Keysym = 0x1008ffb0
+	KeyXF86TouchpadOff             Keysym = 0x1008ffb1
+	KeyXF86AudioMicMute            Keysym = 0x1008ffb2
+	KeyXF86Keyboard                Keysym = 0x1008ffb3
+	KeyXF86WWAN                    Keysym = 0x1008ffb4
+	KeyXF86RFKill                  Keysym = 0x1008ffb5
+	KeyXF86AudioPreset             Keysym = 0x1008ffb6
+	KeyXF86RotationLockToggle      Keysym = 0x1008ffb7
+	KeyXF86FullScreen              Keysym = 0x1008ffb8
+	KeyXF86Switch_VT_1             Keysym = 0x1008fe01
+	KeyXF86Switch_VT_2             Keysym = 0x1008fe02
+	KeyXF86Switch_VT_3             Keysym = 0x1008fe03
+	KeyXF86Switch_VT_4             Keysym = 0x1008fe04
+	KeyXF86Switch_VT_5             Keysym = 0x1008fe05
+	KeyXF86Switch_VT_6             Keysym = 0x1008fe06
+	KeyXF86Switch_VT_7             Keysym = 0x1008fe07
+	KeyXF86Switch_VT_8             Keysym = 0x1008fe08
+	KeyXF86Switch_VT_9             Keysym = 0x1008fe09
+	KeyXF86Switch_VT_10            Keysym = 0x1008fe0a
+	KeyXF86Switch_VT_11            Keysym = 0x1008fe0b
+	KeyXF86Switch_VT_12            Keysym = 0x1008fe0c
+	KeyXF86Ungrab                  Keysym = 0x1008fe20
+	KeyXF86ClearGrab               Keysym = 0x1008fe21
+	KeyXF86Next_VMode              Keysym = 0x1008fe22
+	KeyXF86Prev_VMode              Keysym = 0x1008fe23
+	KeyXF86LogWindowTree           Keysym = 0x1008fe24
+	KeyXF86LogGrabInfo             Keysym = 0x1008fe25
+	KeyXF86BrightnessAuto          Keysym = 0x100810f4
+	KeyXF86DisplayOff              Keysym = 0x100810f5
+	KeyXF86Info                    Keysym = 0x10081166
+	KeyXF86AspectRatio             Keysym = 0x10081177
+	KeyXF86DVD                     Keysym = 0x10081185
+	KeyXF86Audio                   Keysym = 0x10081188
+	KeyXF86ChannelUp               Keysym = 0x10081192
+	KeyXF86ChannelDown             Keysym = 0x10081193
+	KeyXF86Break                   Keysym = 0x1008119b
+	KeyXF86VideoPhone              Keysym = 0x100811a0
+	KeyXF86ZoomReset               Keysym = 0x100811a4
+	KeyXF86Editor                  Keysym = 0x100811a6
+	KeyXF86GraphicsEditor          Keysym = 0x100811a8
+	KeyXF86Presentation            Keysym = 0x100811a9
+	KeyXF86Database                Keysym = 0x100811aa
+	KeyXF86Voicemail               Keysym = 0x100811ac
+	KeyXF86Addressbook             Keysym = 0x100811ad
+	KeyXF86DisplayToggle           Keysym = 0x100811af
+	KeyXF86SpellCheck              Keysym = 0x100811b0
+	KeyXF86ContextMenu             Keysym = 0x100811b6
+	KeyXF86MediaRepeat             Keysym = 0x100811b7
+	KeyXF8610ChannelsUp            Keysym = 0x100811b8
+	KeyXF8610ChannelsDown          Keysym = 0x100811b9
+	KeyXF86Images                  Keysym = 0x100811ba
+	KeyXF86NotificationCenter      Keysym = 0x100811bc
+	KeyXF86PickupPhone             Keysym = 0x100811bd
+	KeyXF86HangupPhone             Keysym = 0x100811be
+	KeyXF86Fn                      Keysym = 0x100811d0
+	KeyXF86Fn_Esc                  Keysym = 0x100811d1
+	KeyXF86FnRightShift            Keysym = 0x100811e5
+	KeyXF86Numeric0                Keysym = 0x10081200
+	KeyXF86Numeric1                Keysym = 0x10081201
+	KeyXF86Numeric2                Keysym = 0x10081202
+	KeyXF86Numeric3                Keysym = 0x10081203
+	KeyXF86Numeric4                Keysym = 0x10081204
+	KeyXF86Numeric5                Keysym = 0x10081205
+	KeyXF86Numeric6                Keysym = 0x10081206
+	KeyXF86Numeric7                Keysym = 0x10081207
+	KeyXF86Numeric8                Keysym = 0x10081208
+	KeyXF86Numeric9                Keysym = 0x10081209
+	KeyXF86NumericStar             Keysym = 0x1008120a
+	KeyXF86NumericPound            Keysym = 0x1008120b
+	KeyXF86NumericA                Keysym = 0x1008120c
+	KeyXF86NumericB                Keysym = 0x1008120d
+	KeyXF86NumericC                Keysym = 0x1008120e
+	KeyXF86NumericD                Keysym = 0x1008120f
+	KeyXF86CameraFocus             Keysym = 0x10081210
+	KeyXF86WPSButton               Keysym = 0x10081211
+	KeyXF86CameraZoomIn            Keysym = 0x10081215
+	KeyXF86CameraZoomOut           Keysym = 0x10081216
+	KeyXF86CameraUp                Keysym = 0x10081217
+	KeyXF86CameraDown              Keysym = 0x10081218
+	KeyXF86CameraLeft              Keysym = 0x10081219
+	KeyXF86CameraRight             Keysym = 0x1008121a
+	KeyXF86AttendantOn             Keysym = 0x1008121b
+	KeyXF86AttendantOff            Keysym = 0x1008121c
+	KeyXF86AttendantToggle         Keysym = 0x1008121d
+	KeyXF86LightsToggle            Keysym = 0x1008121e
+	KeyXF86ALSToggle               Keysym = 0x10081230
+	KeyXF86Buttonconfig            Keysym = 0x10081240
+	KeyXF86Taskmanager             Keysym = 0x10081241
+	KeyXF86Journal                 Keysym = 0x10081242
+	KeyXF86ControlPanel            Keysym = 0x10081243
+	KeyXF86AppSelect               Keysym = 0x10081244
+	KeyXF86Screensaver             Keysym = 0x10081245
+	KeyXF86VoiceCommand            Keysym = 0x10081246
+	KeyXF86Assistant               Keysym = 0x10081247
+	KeyXF86EmojiPicker             Keysym = 0x10081249
+	KeyXF86Dictate                 Keysym = 0x1008124a
+	KeyXF86BrightnessMin           Keysym = 0x10081250
+	KeyXF86BrightnessMax           Keysym = 0x10081251
+	KeyXF86KbdInputAssistPrev      Keysym = 0x10081260
+	KeyXF86KbdInputAssistNext      Keysym = 0x10081261
+	KeyXF86KbdInputAssistPrevgroup Keysym = 0x10081262
+	KeyXF86KbdInputAssistNextgroup Keysym = 0x10081263
+	KeyXF86KbdInputAssistAccept    Keysym = 0x10081264
+	KeyXF86KbdInputAssistCancel    Keysym = 0x10081265
+	KeyXF86RightUp                 Keysym = 0x10081266
+	KeyXF86RightDown               Keysym = 0x10081267
+	KeyXF86LeftUp                  Keysym = 0x10081268
+	KeyXF86LeftDown                Keysym = 0x10081269
+	KeyXF86RootMenu                Keysym = 0x1008126a
+	KeyXF86MediaTopMenu            Keysym = 0x1008126b
+	KeyXF86Numeric11               Keysym = 0x1008126c
+	KeyXF86Numeric12               Keysym = 0x1008126d
+	KeyXF86AudioDesc               Keysym = 0x1008126e
+	KeyXF863DMode                  Keysym = 0x1008126f
+	KeyXF86NextFavorite            Keysym = 0x10081270
+	KeyXF86StopRecord              Keysym = 0x10081271
+	KeyXF86PauseRecord             Keysym = 0x10081272
+	KeyXF86VOD                     Keysym = 0x10081273
+	KeyXF86Unmute                  Keysym = 0x10081274
+	KeyXF86FastReverse             Keysym = 0x10081275
+	KeyXF86SlowReverse             Keysym = 0x10081276
+	KeyXF86Data                    Keysym = 0x10081277
+	KeyXF86OnScreenKeyboard        Keysym = 0x10081278
+	KeyXF86PrivacyScreenToggle     Keysym = 0x10081279
+	KeyXF86SelectiveScreenshot     Keysym = 0x1008127a
+	KeyXF86Macro1                  Keysym = 0x10081290
+	KeyXF86Macro2                  Keysym = 0x10081291
+	KeyXF86Macro3                  Keysym = 0x10081292
+	KeyXF86Macro4                  Keysym = 0x10081293
+	KeyXF86Macro5                  Keysym = 0x10081294
+	KeyXF86Macro6                  Keysym = 0x10081295
+	KeyXF86Macro7                  Keysym = 0x10081296
+	KeyXF86Macro8                  Keysym = 0x10081297
+	KeyXF86Macro9                  Keysym = 0x10081298
+	KeyXF86Macro10                 Keysym = 0x10081299
+	KeyXF86Macro11                 Keysym = 0x1008129a
+	KeyXF86Macro12                 Keysym = 0x1008129b
+	KeyXF86Macro13                 Keysym = 0x1008129c
+	KeyXF86Macro14                 Keysym = 0x1008129d
+	KeyXF86Macro15                 Keysym = 0x1008129e
+	KeyXF86Macro16                 Keysym = 0x1008129f
+	KeyXF86Macro17                 Keysym = 0x100812a0
+	KeyXF86Macro18                 Keysym = 0x100812a1
+	KeyXF86Macro19                 Keysym = 0x100812a2
+	KeyXF86Macro20                 Keysym = 0x100812a3
+	KeyXF86Macro21                 Keysym = 0x100812a4
+	KeyXF86Macro22                 Keysym = 0x100812a5
+	KeyXF86Macro23                 Keysym = 0x100812a6
+	KeyXF86Macro24                 Keysym = 0x100812a7
+	KeyXF86Macro25                 Keysym = 0x100812a8
+	KeyXF86Macro26                 Keysym = 0x100812a9
+	KeyXF86Macro27                 Keysym = 0x100812aa
+	KeyXF86Macro28                 Keysym = 0x100812ab
+	KeyXF86Macro29                 Keysym = 0x100812ac
+	KeyXF86Macro30                 Keysym = 0x100812ad
+	KeyXF86MacroRecordStart        Keysym = 0x100812b0
+	KeyXF86MacroRecordStop         Keysym = 0x100812b1
+	KeyXF86MacroPresetCycle        Keysym = 0x100812b2
+	KeyXF86MacroPreset1            Keysym = 0x100812b3
+	KeyXF86MacroPreset2            Keysym = 0x100812b4
+	KeyXF86MacroPreset3            Keysym = 0x100812b5
+	KeyXF86KbdLcdMenu1             Keysym = 0x100812b8
+	KeyXF86KbdLcdMenu2             Keysym = 0x100812b9
+	KeyXF86KbdLcdMenu3             Keysym = 0x100812ba
+	KeyXF86KbdLcdMenu4             Keysym = 0x100812bb
+	KeyXF86KbdLcdMenu5             Keysym = 0x100812bc
+	KeySunFA_Grave                 Keysym = 0x1005ff00
+	KeySunFA_Circum                Keysym = 0x1005ff01
+	KeySunFA_Tilde                 Keysym = 0x1005ff02
+	KeySunFA_Acute                 Keysym = 0x1005ff03
+	KeySunFA_Diaeresis             Keysym = 0x1005ff04
+	KeySunFA_Cedilla               Keysym = 0x1005ff05
+	KeySunF36                      Keysym = 0x1005ff10
+	KeySunF37                      Keysym = 0x1005ff11
+	KeySunSys_Req                  Keysym = 0x1005ff60
+	KeySunPrint_Screen             Keysym = 0xff61
+	KeySunCompose                  Keysym = 0xff20
+	KeySunAltGraph                 Keysym = 0xff7e
+	KeySunPageUp                   Keysym = 0xff55
+	KeySunPageDown                 Keysym = 0xff56
+	KeySunUndo                     Keysym = 0xff65
+	KeySunAgain                    Keysym = 0xff66
+	KeySunFind                     Keysym = 0xff68
+	KeySunStop                     Keysym = 0xff69
+	KeySunProps                    Keysym = 0x1005ff70
+	KeySunFront                    Keysym = 0x1005ff71
+	KeySunCopy                     Keysym = 0x1005ff72
+	KeySunOpen                     Keysym = 0x1005ff73
+	KeySunPaste                    Keysym = 0x1005ff74
+	KeySunCut                      Keysym = 0x1005ff75
+	KeySunPowerSwitch              Keysym = 0x1005ff76
+	KeySunAudioLowerVolume         Keysym = 0x1005ff77
+	KeySunAudioMute                Keysym = 0x1005ff78
+	KeySunAudioRaiseVolume         Keysym = 0x1005ff79
+	KeySunVideoDegauss             Keysym = 0x1005ff7a
+	KeySunVideoLowerBrightness     Keysym = 0x1005ff7b
+	KeySunVideoRaiseBrightness     Keysym = 0x1005ff7c
+	KeySunPowerSwitchShift         Keysym = 0x1005ff7d
+	KeyDring_accent                Keysym = 0x1000feb0
+	KeyDcircumflex_accent          Keysym = 0x1000fe5e
+	KeyDcedilla_accent             Keysym = 0x1000fe2c
+	KeyDacute_accent               Keysym = 0x1000fe27
+	KeyDgrave_accent               Keysym = 0x1000fe60
+	KeyDtilde                      Keysym = 0x1000fe7e
+	KeyDdiaeresis                  Keysym = 0x1000fe22
+	KeyDRemove                     Keysym = 0x1000ff00
+	KeyhpClearLine                 Keysym = 0x1000ff6f
+	KeyhpInsertLine                Keysym = 0x1000ff70
+	KeyhpDeleteLine                Keysym = 0x1000ff71
+	KeyhpInsertChar                Keysym = 0x1000ff72
+	KeyhpDeleteChar                Keysym = 0x1000ff73
+	KeyhpBackTab                   Keysym = 0x1000ff74
+	KeyhpKP_BackTab                Keysym = 0x1000ff75
+	KeyhpModelock1                 Keysym = 0x1000ff48
+	KeyhpModelock2                 Keysym = 0x1000ff49
+	KeyhpReset                     Keysym = 0x1000ff6c
+	KeyhpSystem                    Keysym = 0x1000ff6d
+	KeyhpUser                      Keysym = 0x1000ff6e
+	Keyhpmute_acute                Keysym = 0x100000a8
+	Keyhpmute_grave                Keysym = 0x100000a9
+	Keyhpmute_asciicircum          Keysym = 0x100000aa
+	Keyhpmute_diaeresis            Keysym = 0x100000ab
+	Keyhpmute_asciitilde           Keysym = 0x100000ac
+	Keyhplira                      Keysym = 0x100000af
+	Keyhpguilder                   Keysym = 0x100000be
+	KeyhpYdiaeresis                Keysym = 0x100000ee
+	KeyhpIO                        Keysym = 0x100000ee
+	Keyhplongminus                 Keysym = 0x100000f6
+	Keyhpblock                     Keysym = 0x100000fc
+	KeyosfCopy                     Keysym = 0x1004ff02
+	KeyosfCut                      Keysym = 0x1004ff03
+	KeyosfPaste                    Keysym = 0x1004ff04
+	KeyosfBackTab                  Keysym = 0x1004ff07
+	KeyosfBackSpace                Keysym = 0x1004ff08
+	KeyosfClear                    Keysym = 0x1004ff0b
+	KeyosfEscape                   Keysym = 0x1004ff1b
+	KeyosfAddMode                  Keysym = 0x1004ff31
+	KeyosfPrimaryPaste             Keysym = 0x1004ff32
+	KeyosfQuickPaste               Keysym = 0x1004ff33
+	KeyosfPageLeft                 Keysym = 0x1004ff40
+	KeyosfPageUp                   Keysym = 0x1004ff41
+	KeyosfPageDown                 Keysym = 0x1004ff42
+	KeyosfPageRight                Keysym = 0x1004ff43
+	KeyosfActivate                 Keysym = 0x1004ff44
+	KeyosfMenuBar                  Keysym = 0x1004ff45
+	KeyosfLeft                     Keysym = 0x1004ff51
+	KeyosfUp                       Keysym = 0x1004ff52
+	KeyosfRight                    Keysym = 0x1004ff53
+	KeyosfDown                     Keysym = 0x1004ff54
+	KeyosfEndLine                  Keysym = 0x1004ff57
+	KeyosfBeginLine                Keysym = 0x1004ff58
+	KeyosfEndData                  Keysym = 0x1004ff59
+	KeyosfBeginData                Keysym = 0x1004ff5a
+	KeyosfPrevMenu                 Keysym = 0x1004ff5b
+	KeyosfNextMenu                 Keysym = 0x1004ff5c
+	KeyosfPrevField                Keysym = 0x1004ff5d
+	KeyosfNextField                Keysym = 0x1004ff5e
+	KeyosfSelect                   Keysym = 0x1004ff60
+	KeyosfInsert                   Keysym = 0x1004ff63
+	KeyosfUndo                     Keysym = 0x1004ff65
+	KeyosfMenu                     Keysym = 0x1004ff67
+	KeyosfCancel                   Keysym = 0x1004ff69
+	KeyosfHelp                     Keysym = 0x1004ff6a
+	KeyosfSelectAll                Keysym = 0x1004ff71
+	KeyosfDeselectAll              Keysym = 0x1004ff72
+	KeyosfReselect                 Keysym = 0x1004ff73
+	KeyosfExtend                   Keysym = 0x1004ff74
+	KeyosfRestore                  Keysym = 0x1004ff78
+	KeyosfDelete                   Keysym = 0x1004ffff
+	KeyReset                       Keysym = 0x1000ff6c
+	KeySystem                      Keysym = 0x1000ff6d
+	KeyUser                        Keysym = 0x1000ff6e
+	KeyClearLine                   Keysym = 0x1000ff6f
+	KeyInsertLine                  Keysym = 0x1000ff70
+	KeyDeleteLine                  Keysym = 0x1000ff71
+	KeyInsertChar                  Keysym = 0x1000ff72
+	KeyDeleteChar                  Keysym = 0x1000ff73
+	KeyBackTab                     Keysym = 0x1000ff74
+	KeyKP_BackTab                  Keysym = 0x1000ff75
+	KeyExt16bit_L                  Keysym = 0x1000ff76
+	KeyExt16bit_R                  Keysym = 0x1000ff77
+	Keymute_acute                  Keysym = 0x100000a8
+	Keymute_grave                  Keysym = 0x100000a9
+	Keymute_asciicircum            Keysym = 0x100000aa
+	Keymute_diaeresis              Keysym = 0x100000ab
+	Keymute_asciitilde             Keysym = 0x100000ac
+	Keylira                        Keysym = 0x100000af
+	Keyguilder                     Keysym = 0x100000be
+	KeyIO                          Keysym = 0x100000ee
+	Keylongminus                   Keysym = 0x100000f6
+	Keyblock                       Keysym = 0x100000fc
+)
+
+// keysymNames contains every keysym name, sorted by name.
+var keysymNames = [...]keysymName{
+	{"0", Key0},
+	{"1", Key1},
+	{"2", Key2},
+	{"3", Key3},
+	{"3270_AltCursor", Key3270_AltCursor},
+	{"3270_Attn", Key3270_Attn},
+	{"3270_BackTab", Key3270_BackTab},
+	{"3270_ChangeScreen", Key3270_ChangeScreen},
+	{"3270_Copy", Key3270_Copy},
+	{"3270_CursorBlink", Key3270_CursorBlink},
+	{"3270_CursorSelect", Key3270_CursorSelect},
+	{"3270_DeleteWord", Key3270_DeleteWord},
+	{"3270_Duplicate", Key3270_Duplicate},
+	{"3270_Enter", Key3270_Enter},
+	{"3270_EraseEOF", Key3270_EraseEOF},
+	{"3270_EraseInput", Key3270_EraseInput},
+	{"3270_ExSelect", Key3270_ExSelect},
+	{"3270_FieldMark", Key3270_FieldMark},
+	{"3270_Ident", Key3270_Ident},
+	{"3270_Jump", Key3270_Jump},
+	{"3270_KeyClick", Key3270_KeyClick},
+	{"3270_Left2", Key3270_Left2},
+	{"3270_PA1", Key3270_PA1},
+	{"3270_PA2", Key3270_PA2},
+	{"3270_PA3", Key3270_PA3},
+	{"3270_Play", Key3270_Play},
+	{"3270_PrintScreen", Key3270_PrintScreen},
+	{"3270_Quit", Key3270_Quit},
+	{"3270_Record", Key3270_Record},
+	{"3270_Reset", Key3270_Reset},
+	{"3270_Right2", Key3270_Right2},
+	{"3270_Rule", Key3270_Rule},
+	{"3270_Setup", Key3270_Setup},
+	{"3270_Test", Key3270_Test},
+	{"4", Key4},
+	{"5", Key5},
+	{"6", Key6},
+	{"7", Key7},
+	{"8", Key8},
+	{"9", Key9},
+	{"A", KeyA},
+	{"AE", KeyAE},
+	{"Aacute", KeyAacute},
+	{"Abelowdot", KeyAbelowdot},
+	{"Abreve", KeyAbreve},
+	{"Abreveacute", KeyAbreveacute},
+	{"Abrevebelowdot", KeyAbrevebelowdot},
+	{"Abrevegrave", KeyAbrevegrave},
+	{"Abrevehook", KeyAbrevehook},
+	{"Abrevetilde", KeyAbrevetilde},
+	{"AccessX_Enable", KeyAccessX_Enable},
+	{"AccessX_Feedback_Enable", KeyAccessX_Feedback_Enable},
+	{"Acircumflex", KeyAcircumflex},
+	{"Acircumflexacute", KeyAcircumflexacute},
+	{"Acircumflexbelowdot", KeyAcircumflexbelowdot},
+	{"Acircumflexgrave", KeyAcircumflexgrave},
+	{"Acircumflexhook", KeyAcircumflexhook},
+	{"Acircumflextilde", KeyAcircumflextilde},
+	{"Adiaeresis", KeyAdiaeresis},
+	{"Agrave", KeyAgrave},
+	{"Ahook", KeyAhook},
+	{"Alt_L", KeyAlt_L},
+	{"Alt_R", KeyAlt_R},
+	{"Amacron", KeyAmacron},
+	{"Aogonek", KeyAogonek},
+	{"Arabic_0", KeyArabic_0},
+	{"Arabic_1", KeyArabic_1},
+	{"Arabic_2", KeyArabic_2},
+	{"Arabic_3", KeyArabic_3},
+	{"Arabic_4", KeyArabic_4},
+	{"Arabic_5", KeyArabic_5},
+	{"Arabic_6", KeyArabic_6},
+	{"Arabic_7", KeyArabic_7},
+	{"Arabic_8", KeyArabic_8},
+	{"Arabic_9", KeyArabic_9},
+	{"Arabic_ain", KeyArabic_ain},
+	{"Arabic_alef", KeyArabic_alef},
+	{"Arabic_alefmaksura", KeyArabic_alefmaksura},
+	{"Arabic_beh", KeyArabic_beh},
+	{"Arabic_comma", KeyArabic_comma},
+	{"Arabic_dad", KeyArabic_dad},
+	{"Arabic_dal", KeyArabic_dal},
+	{"Arabic_damma", KeyArabic_damma},
+	{"Arabic_dammatan", KeyArabic_dammatan},
+	{"Arabic_ddal", KeyArabic_ddal},
+	{"Arabic_farsi_yeh", KeyArabic_farsi_yeh},
+	{"Arabic_fatha", KeyArabic_fatha},
+	{"Arabic_fathatan", KeyArabic_fathatan},
+	{"Arabic_feh", KeyArabic_feh},
+	{"Arabic_fullstop", KeyArabic_fullstop},
+	{"Arabic_gaf", KeyArabic_gaf},
+	{"Arabic_ghain", KeyArabic_ghain},
+	{"Arabic_ha", KeyArabic_ha},
+	{"Arabic_hah", KeyArabic_hah},
+	{"Arabic_hamza", KeyArabic_hamza},
+	{"Arabic_hamza_above", KeyArabic_hamza_above},
+	{"Arabic_hamza_below", KeyArabic_hamza_below},
+	{"Arabic_hamzaonalef", KeyArabic_hamzaonalef},
+	{"Arabic_hamzaonwaw", KeyArabic_hamzaonwaw},
+	{"Arabic_hamzaonyeh", KeyArabic_hamzaonyeh},
+	{"Arabic_hamzaunderalef", KeyArabic_hamzaunderalef},
+	{"Arabic_heh", KeyArabic_heh},
+	{"Arabic_heh_doachashmee", KeyArabic_heh_doachashmee},
+	{"Arabic_heh_goal", KeyArabic_heh_goal},
+	{"Arabic_jeem", KeyArabic_jeem},
+	{"Arabic_jeh", KeyArabic_jeh},
+	{"Arabic_kaf", KeyArabic_kaf},
+	{"Arabic_kasra", KeyArabic_kasra},
+	{"Arabic_kasratan", KeyArabic_kasratan},
+	{"Arabic_keheh", KeyArabic_keheh},
+	{"Arabic_khah", KeyArabic_khah},
+	{"Arabic_lam", KeyArabic_lam},
+	{"Arabic_madda_above", KeyArabic_madda_above},
+	{"Arabic_maddaonalef", KeyArabic_maddaonalef},
+	{"Arabic_meem", KeyArabic_meem},
+	{"Arabic_noon", KeyArabic_noon},
+	{"Arabic_noon_ghunna", KeyArabic_noon_ghunna},
+	{"Arabic_peh", KeyArabic_peh},
+	{"Arabic_percent", KeyArabic_percent},
+	{"Arabic_qaf", KeyArabic_qaf},
+	{"Arabic_question_mark", KeyArabic_question_mark},
+	{"Arabic_ra", KeyArabic_ra},
+	{"Arabic_rreh", KeyArabic_rreh},
+	{"Arabic_sad", KeyArabic_sad},
+	{"Arabic_seen", KeyArabic_seen},
+	{"Arabic_semicolon", KeyArabic_semicolon},
+	{"Arabic_shadda", KeyArabic_shadda},
+	{"Arabic_sheen", KeyArabic_sheen},
+	{"Arabic_sukun", KeyArabic_sukun},
+	{"Arabic_superscript_alef", KeyArabic_superscript_alef},
+	{"Arabic_switch", KeyArabic_switch},
+	{"Arabic_tah", KeyArabic_tah},
+	{"Arabic_tatweel", KeyArabic_tatweel},
+	{"Arabic_tcheh", KeyArabic_tcheh},
+	{"Arabic_teh", KeyArabic_teh},
+	{"Arabic_tehmarbuta", KeyArabic_tehmarbuta},
+	{"Arabic_thal", KeyArabic_thal},
+	{"Arabic_theh", KeyArabic_theh},
+	{"Arabic_tteh", KeyArabic_tteh},
+	{"Arabic_veh", KeyArabic_veh},
+	{"Arabic_waw", KeyArabic_waw},
+	{"Arabic_yeh", KeyArabic_yeh},
+	{"Arabic_yeh_baree", KeyArabic_yeh_baree},
+	{"Arabic_zah", KeyArabic_zah},
+	{"Arabic_zain", KeyArabic_zain},
+	{"Aring", KeyAring},
+	{"Armenian_AT", KeyArmenian_AT},
+	{"Armenian_AYB", KeyArmenian_AYB},
+	{"Armenian_BEN", KeyArmenian_BEN},
+	{"Armenian_CHA", KeyArmenian_CHA},
+	{"Armenian_DA", KeyArmenian_DA},
+	{"Armenian_DZA", KeyArmenian_DZA},
+	{"Armenian_E", KeyArmenian_E},
+	{"Armenian_FE", KeyArmenian_FE},
+	{"Armenian_GHAT", KeyArmenian_GHAT},
+	{"Armenian_GIM", KeyArmenian_GIM},
+	{"Armenian_HI", KeyArmenian_HI},
+	{"Armenian_HO", KeyArmenian_HO},
+	{"Armenian_INI", KeyArmenian_INI},
+	{"Armenian_JE", KeyArmenian_JE},
+	{"Armenian_KE", KeyArmenian_KE},
+	{"Armenian_KEN", KeyArmenian_KEN},
+	{"Armenian_KHE", KeyArmenian_KHE},
+	{"Armenian_LYUN", KeyArmenian_LYUN},
+	{"Armenian_MEN", KeyArmenian_MEN},
+	{"Armenian_NU", KeyArmenian_NU},
+	{"Armenian_O", KeyArmenian_O},
+	{"Armenian_PE", KeyArmenian_PE},
+	{"Armenian_PYUR", KeyArmenian_PYUR},
+	{"Armenian_RA", KeyArmenian_RA},
+	{"Armenian_RE", KeyArmenian_RE},
+	{"Armenian_SE", KeyArmenian_SE},
+	{"Armenian_SHA", KeyArmenian_SHA},
+	{"Armenian_TCHE", KeyArmenian_TCHE},
+	{"Armenian_TO", KeyArmenian_TO},
+	{"Armenian_TSA", KeyArmenian_TSA},
+	{"Armenian_TSO", KeyArmenian_TSO},
+	{"Armenian_TYUN", KeyArmenian_TYUN},
+	{"Armenian_VEV", KeyArmenian_VEV},
+	{"Armenian_VO", KeyArmenian_VO},
+	{"Armenian_VYUN", KeyArmenian_VYUN},
+	{"Armenian_YECH", KeyArmenian_YECH},
+	{"Armenian_ZA", KeyArmenian_ZA},
+	{"Armenian_ZHE", KeyArmenian_ZHE},
+	{"Armenian_accent", KeyArmenian_accent},
+	{"Armenian_amanak", KeyArmenian_amanak},
+	{"Armenian_apostrophe", KeyArmenian_apostrophe},
+	{"Armenian_at", KeyArmenian_at},
+	{"Armenian_ayb", KeyArmenian_ayb},
+	{"Armenian_ben", KeyArmenian_ben},
+	{"Armenian_but", KeyArmenian_but},
+	{"Armenian_cha", KeyArmenian_cha},
+	{"Armenian_da", KeyArmenian_da},
+	{"Armenian_dza", KeyArmenian_dza},
+	{"Armenian_e", KeyArmenian_e},
+	{"Armenian_exclam", KeyArmenian_exclam},
+	{"Armenian_fe", KeyArmenian_fe},
+	{"Armenian_full_stop", KeyArmenian_full_stop},
+	{"Armenian_ghat", KeyArmenian_ghat},
+	{"Armenian_gim", KeyArmenian_gim},
+	{"Armenian_hi", KeyArmenian_hi},
+	{"Armenian_ho", KeyArmenian_ho},
+	{"Armenian_hyphen", KeyArmenian_hyphen},
+	{"Armenian_ini", KeyArmenian_ini},
+	{"Armenian_je", KeyArmenian_je},
+	{"Armenian_ke", KeyArmenian_ke},
+	{"Armenian_ken", KeyArmenian_ken},
+	{"Armenian_khe", KeyArmenian_khe},
+	{"Armenian_ligature_ew", KeyArmenian_ligature_ew},
+	{"Armenian_lyun", KeyArmenian_lyun},
+	{"Armenian_men", KeyArmenian_men},
+	{"Armenian_nu", KeyArmenian_nu},
+	{"Armenian_o", KeyArmenian_o},
+	{"Armenian_paruyk", KeyArmenian_paruyk},
+	{"Armenian_pe", KeyArmenian_pe},
+	{"Armenian_pyur", KeyArmenian_pyur},
+	{"Armenian_question", KeyArmenian_question},
+	{"Armenian_ra", KeyArmenian_ra},
+	{"Armenian_re", KeyArmenian_re},
+	{"Armenian_se", KeyArmenian_se},
+	{"Armenian_separation_mark", KeyArmenian_separation_mark},
+	{"Armenian_sha", KeyArmenian_sha},
+	{"Armenian_shesht", KeyArmenian_shesht},
+	{"Armenian_tche", KeyArmenian_tche},
+	{"Armenian_to", KeyArmenian_to},
+	{"Armenian_tsa", KeyArmenian_tsa},
+	{"Armenian_tso", KeyArmenian_tso},
+	{"Armenian_tyun", KeyArmenian_tyun},
+	{"Armenian_verjaket", KeyArmenian_verjaket},
+	{"Armenian_vev", KeyArmenian_vev},
+	{"Armenian_vo", KeyArmenian_vo},
+	{"Armenian_vyun", KeyArmenian_vyun},
+	{"Armenian_yech", KeyArmenian_yech},
+	{"Armenian_yentamna", KeyArmenian_yentamna},
+	{"Armenian_za", KeyArmenian_za},
+	{"Armenian_zhe", KeyArmenian_zhe},
+	{"Atilde", KeyAtilde},
+	{"AudibleBell_Enable", KeyAudibleBell_Enable},
+	{"B", KeyB},
+	{"Babovedot", KeyBabovedot},
+	{"BackSpace", KeyBackSpace},
+	{"BackTab", KeyBackTab},
+	{"Begin", KeyBegin},
+	{"BounceKeys_Enable", KeyBounceKeys_Enable},
+	{"Break", KeyBreak},
+	{"Byelorussian_SHORTU", KeyByelorussian_SHORTU},
+	{"Byelorussian_shortu", KeyByelorussian_shortu},
+	{"C", KeyC},
+	{"CH", KeyCH},
+	{"C_H", KeyC_H},
+	{"C_h", KeyC_h},
+	{"Cabovedot", KeyCabovedot},
+	{"Cacute", KeyCacute},
+	{"Cancel", KeyCancel},
+	{"Caps_Lock", KeyCaps_Lock},
+	{"Ccaron", KeyCcaron},
+	{"Ccedilla", KeyCcedilla},
+	{"Ccircumflex", KeyCcircumflex},
+	{"Ch", KeyCh},
+	{"Clear", KeyClear},
+	{"ClearLine", KeyClearLine},
+	{"Codeinput", KeyCodeinput},
+	{"ColonSign", KeyColonSign},
+	{"Control_L", KeyControl_L},
+	{"Control_R", KeyControl_R},
+	{"CruzeiroSign", KeyCruzeiroSign},
+	{"Cyrillic_A", KeyCyrillic_A},
+	{"Cyrillic_BE", KeyCyrillic_BE},
+	{"Cyrillic_CHE", KeyCyrillic_CHE},
+	{"Cyrillic_CHE_descender", KeyCyrillic_CHE_descender},
+	{"Cyrillic_CHE_vertstroke", KeyCyrillic_CHE_vertstroke},
+	{"Cyrillic_DE", KeyCyrillic_DE},
+	{"Cyrillic_DZHE", KeyCyrillic_DZHE},
+	{"Cyrillic_E", KeyCyrillic_E},
+	{"Cyrillic_EF", KeyCyrillic_EF},
+	{"Cyrillic_EL", KeyCyrillic_EL},
+	{"Cyrillic_EM", KeyCyrillic_EM},
+	{"Cyrillic_EN", KeyCyrillic_EN},
+	{"Cyrillic_EN_descender", KeyCyrillic_EN_descender},
+	{"Cyrillic_ER", KeyCyrillic_ER},
+	{"Cyrillic_ES", KeyCyrillic_ES},
+	{"Cyrillic_GHE", KeyCyrillic_GHE},
+	{"Cyrillic_GHE_bar", KeyCyrillic_GHE_bar},
+	{"Cyrillic_HA", KeyCyrillic_HA},
+	{"Cyrillic_HARDSIGN", KeyCyrillic_HARDSIGN},
+	{"Cyrillic_HA_descender", KeyCyrillic_HA_descender},
+	{"Cyrillic_I", KeyCyrillic_I},
+	{"Cyrillic_IE", KeyCyrillic_IE},
+	{"Cyrillic_IO", KeyCyrillic_IO},
+	{"Cyrillic_I_macron", KeyCyrillic_I_macron},
+	{"Cyrillic_JE", KeyCyrillic_JE},
+	{"Cyrillic_KA", KeyCyrillic_KA},
+	{"Cyrillic_KA_descender", KeyCyrillic_KA_descender},
+	{"Cyrillic_KA_vertstroke", KeyCyrillic_KA_vertstroke},
+	{"Cyrillic_LJE", KeyCyrillic_LJE},
+	{"Cyrillic_NJE", KeyCyrillic_NJE},
+	{"Cyrillic_O", KeyCyrillic_O},
+	{"Cyrillic_O_bar", KeyCyrillic_O_bar},
+	{"Cyrillic_PE", KeyCyrillic_PE},
+	{"Cyrillic_SCHWA", KeyCyrillic_SCHWA},
+	{"Cyrillic_SHA", KeyCyrillic_SHA},
+	{"Cyrillic_SHCHA", KeyCyrillic_SHCHA},
+	{"Cyrillic_SHHA", KeyCyrillic_SHHA},
+	{"Cyrillic_SHORTI", KeyCyrillic_SHORTI},
+	{"Cyrillic_SOFTSIGN", KeyCyrillic_SOFTSIGN},
+	{"Cyrillic_TE", KeyCyrillic_TE},
+	{"Cyrillic_TSE", KeyCyrillic_TSE},
+	{"Cyrillic_U", KeyCyrillic_U},
+	{"Cyrillic_U_macron", KeyCyrillic_U_macron},
+	{"Cyrillic_U_straight", KeyCyrillic_U_straight},
+	{"Cyrillic_U_straight_bar", KeyCyrillic_U_straight_bar},
+	{"Cyrillic_VE", KeyCyrillic_VE},
+	{"Cyrillic_YA", KeyCyrillic_YA},
+	{"Cyrillic_YERU", KeyCyrillic_YERU},
+	{"Cyrillic_YU", KeyCyrillic_YU},
+	{"Cyrillic_ZE", KeyCyrillic_ZE},
+	{"Cyrillic_ZHE", KeyCyrillic_ZHE},
+	{"Cyrillic_ZHE_descender", KeyCyrillic_ZHE_descender},
+	{"Cyrillic_a", KeyCyrillic_a},
+	{"Cyrillic_be", KeyCyrillic_be},
+	{"Cyrillic_che", KeyCyrillic_che},
+	{"Cyrillic_che_descender", KeyCyrillic_che_descender},
+	{"Cyrillic_che_vertstroke", KeyCyrillic_che_vertstroke},
+	{"Cyrillic_de", KeyCyrillic_de},
+	{"Cyrillic_dzhe", KeyCyrillic_dzhe},
+	{"Cyrillic_e", KeyCyrillic_e},
+	{"Cyrillic_ef", KeyCyrillic_ef},
+	{"Cyrillic_el", KeyCyrillic_el},
+	{"Cyrillic_em", KeyCyrillic_em},
+	{"Cyrillic_en", KeyCyrillic_en},
+	{"Cyrillic_en_descender", KeyCyrillic_en_descender},
+	{"Cyrillic_er", KeyCyrillic_er},
+	{"Cyrillic_es", KeyCyrillic_es},
+	{"Cyrillic_ghe", KeyCyrillic_ghe},
+	{"Cyrillic_ghe_bar", KeyCyrillic_ghe_bar},
+	{"Cyrillic_ha", KeyCyrillic_ha},
+	{"Cyrillic_ha_descender", KeyCyrillic_ha_descender},
+	{"Cyrillic_hardsign", KeyCyrillic_hardsign},
+	{"Cyrillic_i", KeyCyrillic_i},
+	{"Cyrillic_i_macron", KeyCyrillic_i_macron},
+	{"Cyrillic_ie", KeyCyrillic_ie},
+	{"Cyrillic_io", KeyCyrillic_io},
+	{"Cyrillic_je", KeyCyrillic_je},
+	{"Cyrillic_ka", KeyCyrillic_ka},
+	{"Cyrillic_ka_descender", KeyCyrillic_ka_descender},
+	{"Cyrillic_ka_vertstroke", KeyCyrillic_ka_vertstroke},
+	{"Cyrillic_lje", KeyCyrillic_lje},
+	{"Cyrillic_nje", KeyCyrillic_nje},
+	{"Cyrillic_o", KeyCyrillic_o},
+	{"Cyrillic_o_bar", KeyCyrillic_o_bar},
+	{"Cyrillic_pe", KeyCyrillic_pe},
+	{"Cyrillic_schwa", KeyCyrillic_schwa},
+	{"Cyrillic_sha", KeyCyrillic_sha},
+	{"Cyrillic_shcha", KeyCyrillic_shcha},
+	{"Cyrillic_shha", KeyCyrillic_shha},
+	{"Cyrillic_shorti", KeyCyrillic_shorti},
+	{"Cyrillic_softsign", KeyCyrillic_softsign},
+	{"Cyrillic_te", KeyCyrillic_te},
+	{"Cyrillic_tse", KeyCyrillic_tse},
+	{"Cyrillic_u", KeyCyrillic_u},
+	{"Cyrillic_u_macron", KeyCyrillic_u_macron},
+	{"Cyrillic_u_straight", KeyCyrillic_u_straight},
+	{"Cyrillic_u_straight_bar", KeyCyrillic_u_straight_bar},
+	{"Cyrillic_ve", KeyCyrillic_ve},
+	{"Cyrillic_ya", KeyCyrillic_ya},
+	{"Cyrillic_yeru", KeyCyrillic_yeru},
+	{"Cyrillic_yu", KeyCyrillic_yu},
+	{"Cyrillic_ze", KeyCyrillic_ze},
+	{"Cyrillic_zhe", KeyCyrillic_zhe},
+	{"Cyrillic_zhe_descender", KeyCyrillic_zhe_descender},
+	{"D", KeyD},
+	{"DRemove", KeyDRemove},
+	{"Dabovedot", KeyDabovedot},
+	{"Dacute_accent", KeyDacute_accent},
+	{"Dcaron", KeyDcaron},
+	{"Dcedilla_accent", KeyDcedilla_accent},
+	{"Dcircumflex_accent", KeyDcircumflex_accent},
+	{"Ddiaeresis", KeyDdiaeresis},
+	{"Delete", KeyDelete},
+	{"DeleteChar", KeyDeleteChar},
+	{"DeleteLine", KeyDeleteLine},
+	{"Dgrave_accent", KeyDgrave_accent},
+	{"DongSign", KeyDongSign},
+	{"Down", KeyDown},
+	{"Dring_accent", KeyDring_accent},
+	{"Dstroke", KeyDstroke},
+	{"Dtilde", KeyDtilde},
+	{"E", KeyE},
+	{"ENG", KeyENG},
+	{"ETH", KeyETH},
+	{"EZH", KeyEZH},
+	{"Eabovedot", KeyEabovedot},
+	{"Eacute", KeyEacute},
+	{"Ebelowdot", KeyEbelowdot},
+	{"Ecaron", KeyEcaron},
+	{"Ecircumflex", KeyEcircumflex},
+	{"Ecircumflexacute", KeyEcircumflexacute},
+	{"Ecircumflexbelowdot", KeyEcircumflexbelowdot},
+	{"Ecircumflexgrave", KeyEcircumflexgrave},
+	{"Ecircumflexhook", KeyEcircumflexhook},
+	{"Ecircumflextilde", KeyEcircumflextilde},
+	{"EcuSign", KeyEcuSign},
+	{"Ediaeresis", KeyEdiaeresis},
+	{"Egrave", KeyEgrave},
+	{"Ehook", KeyEhook},
+	{"Eisu_Shift", KeyEisu_Shift},
+	{"Eisu_toggle", KeyEisu_toggle},
+	{"Emacron", KeyEmacron},
+	{"End", KeyEnd},
+	{"Eogonek", KeyEogonek},
+	{"Escape", KeyEscape},
+	{"Eth", KeyEth},
+	{"Etilde", KeyEtilde},
+	{"EuroSign", KeyEuroSign},
+	{"Execute", KeyExecute},
+	{"Ext16bit_L", KeyExt16bit_L},
+	{"Ext16bit_R", KeyExt16bit_R},
+	{"F", KeyF},
+	{"F1", KeyF1},
+	{"F10", KeyF10},
+	{"F11", KeyF11},
+	{"F12", KeyF12},
+	{"F13", KeyF13},
+	{"F14", KeyF14},
+	{"F15", KeyF15},
+	{"F16", KeyF16},
+	{"F17", KeyF17},
+	{"F18", KeyF18},
+	{"F19", KeyF19},
+	{"F2", KeyF2},
+	{"F20", KeyF20},
+	{"F21", KeyF21},
+	{"F22", KeyF22},
+	{"F23", KeyF23},
+	{"F24", KeyF24},
+	{"F25", KeyF25},
+	{"F26", KeyF26},
+	{"F27", KeyF27},
+	{"F28", KeyF28},
+	{"F29", KeyF29},
+	{"F3", KeyF3},
+	{"F30", KeyF30},
+	{"F31", KeyF31},
+	{"F32", KeyF32},
+	{"F33", KeyF33},
+	{"F34", KeyF34},
+	{"F35", KeyF35},
+	{"F4", KeyF4},
+	{"F5", KeyF5},
+	{"F6", KeyF6},
+	{"F7", KeyF7},
+	{"F8", KeyF8},
+	{"F9", KeyF9},
+	{"FFrancSign", KeyFFrancSign},
+	{"Fabovedot", KeyFabovedot},
+	{"Farsi_0", KeyFarsi_0},
+	{"Farsi_1", KeyFarsi_1},
+	{"Farsi_2", KeyFarsi_2},
+	{"Farsi_3", KeyFarsi_3},
+	{"Farsi_4", KeyFarsi_4},
+	{"Farsi_5", KeyFarsi_5},
+	{"Farsi_6", KeyFarsi_6},
+	{"Farsi_7", KeyFarsi_7},
+	{"Farsi_8", KeyFarsi_8},
+	{"Farsi_9", KeyFarsi_9},
+	{"Farsi_yeh", KeyFarsi_yeh},
+	{"Find", KeyFind},
+	{"First_Virtual_Screen", KeyFirst_Virtual_Screen},
+	{"G", KeyG},
+	{"Gabovedot", KeyGabovedot},
+	{"Gbreve", KeyGbreve},
+	{"Gcaron", KeyGcaron},
+	{"Gcedilla", KeyGcedilla},
+	{"Gcircumflex", KeyGcircumflex},
+	{"Georgian_an", KeyGeorgian_an},
+	{"Georgian_ban", KeyGeorgian_ban},
+	{"Georgian_can", KeyGeorgian_can},
+	{"Georgian_char", KeyGeorgian_char},
+	{"Georgian_chin", KeyGeorgian_chin},
+	{"Georgian_cil", KeyGeorgian_cil},
+	{"Georgian_don", KeyGeorgian_don},
+	{"Georgian_en", KeyGeorgian_en},
+	{"Georgian_fi", KeyGeorgian_fi},
+	{"Georgian_gan", KeyGeorgian_gan},
+	{"Georgian_ghan", KeyGeorgian_ghan},
+	{"Georgian_hae", KeyGeorgian_hae},
+	{"Georgian_har", KeyGeorgian_har},
+	{"Georgian_he", KeyGeorgian_he},
+	{"Georgian_hie", KeyGeorgian_hie},
+	{"Georgian_hoe", KeyGeorgian_hoe},
+	{"Georgian_in", KeyGeorgian_in},
+	{"Georgian_jhan", KeyGeorgian_jhan},
+	{"Georgian_jil", KeyGeorgian_jil},
+	{"Georgian_kan", KeyGeorgian_kan},
+	{"Georgian_khar", KeyGeorgian_khar},
+	{"Georgian_las", KeyGeorgian_las},
+	{"Georgian_man", KeyGeorgian_man},
+	{"Georgian_nar", KeyGeorgian_nar},
+	{"Georgian_on", KeyGeorgian_on},
+	{"Georgian_par", KeyGeorgian_par},
+	{"Georgian_phar", KeyGeorgian_phar},
+	{"Georgian_qar", KeyGeorgian_qar},
+	{"Georgian_rae", KeyGeorgian_rae},
+	{"Georgian_san", KeyGeorgian_san},
+	{"Georgian_shin", KeyGeorgian_shin},
+	{"Georgian_tan", KeyGeorgian_tan},
+	{"Georgian_tar", KeyGeorgian_tar},
+	{"Georgian_un", KeyGeorgian_un},
+	{"Georgian_vin", KeyGeorgian_vin},
+	{"Georgian_we", KeyGeorgian_we},
+	{"Georgian_xan", KeyGeorgian_xan},
+	{"Georgian_zen", KeyGeorgian_zen},
+	{"Georgian_zhar", KeyGeorgian_zhar},
+	{"Greek_ALPHA", KeyGreek_ALPHA},
+	{"Greek_ALPHAaccent", KeyGreek_ALPHAaccent},
+	{"Greek_BETA", KeyGreek_BETA},
+	{"Greek_CHI", KeyGreek_CHI},
+	{"Greek_DELTA", KeyGreek_DELTA},
+	{"Greek_EPSILON", KeyGreek_EPSILON},
+	{"Greek_EPSILONaccent", KeyGreek_EPSILONaccent},
+	{"Greek_ETA", KeyGreek_ETA},
+	{"Greek_ETAaccent", KeyGreek_ETAaccent},
+	{"Greek_GAMMA", KeyGreek_GAMMA},
+	{"Greek_IOTA", KeyGreek_IOTA},
+	{"Greek_IOTAaccent", KeyGreek_IOTAaccent},
+	{"Greek_IOTAdiaeresis", KeyGreek_IOTAdiaeresis},
+	{"Greek_IOTAdieresis", KeyGreek_IOTAdieresis},
+	{"Greek_KAPPA", KeyGreek_KAPPA},
+	{"Greek_LAMBDA", KeyGreek_LAMBDA},
+	{"Greek_LAMDA", KeyGreek_LAMDA},
+	{"Greek_MU", KeyGreek_MU},
+	{"Greek_NU", KeyGreek_NU},
+	{"Greek_OMEGA", KeyGreek_OMEGA},
+	{"Greek_OMEGAaccent", KeyGreek_OMEGAaccent},
+	{"Greek_OMICRON", KeyGreek_OMICRON},
+	{"Greek_OMICRONaccent", KeyGreek_OMICRONaccent},
+	{"Greek_PHI", KeyGreek_PHI},
+	{"Greek_PI", KeyGreek_PI},
+	{"Greek_PSI", KeyGreek_PSI},
+	{"Greek_RHO", KeyGreek_RHO},
+	{"Greek_SIGMA", KeyGreek_SIGMA},
+	{"Greek_TAU", KeyGreek_TAU},
+	{"Greek_THETA", KeyGreek_THETA},
+	{"Greek_UPSILON", KeyGreek_UPSILON},
+	{"Greek_UPSILONaccent", KeyGreek_UPSILONaccent},
+	{"Greek_UPSILONdieresis", KeyGreek_UPSILONdieresis},
+	{"Greek_XI", KeyGreek_XI},
+	{"Greek_ZETA", KeyGreek_ZETA},
+	{"Greek_accentdieresis", KeyGreek_accentdieresis},
+	{"Greek_alpha", KeyGreek_alpha},
+	{"Greek_alphaaccent", KeyGreek_alphaaccent},
+	{"Greek_beta", KeyGreek_beta},
+	{"Greek_chi", KeyGreek_chi},
+	{"Greek_delta", KeyGreek_delta},
+	{"Greek_epsilon", KeyGreek_epsilon},
+	{"Greek_epsilonaccent", KeyGreek_epsilonaccent},
+	{"Greek_eta", KeyGreek_eta},
+	{"Greek_etaaccent", KeyGreek_etaaccent},
+	{"Greek_finalsmallsigma", KeyGreek_finalsmallsigma},
+	{"Greek_gamma", KeyGreek_gamma},
+	{"Greek_horizbar", KeyGreek_horizbar},
+	{"Greek_iota", KeyGreek_iota},
+	{"Greek_iotaaccent", KeyGreek_iotaaccent},
+	{"Greek_iotaaccentdieresis", KeyGreek_iotaaccentdieresis},
+	{"Greek_iotadieresis", KeyGreek_iotadieresis},
+	{"Greek_kappa", KeyGreek_kappa},
+	{"Greek_lambda", KeyGreek_lambda},
+	{"Greek_lamda", KeyGreek_lamda},
+	{"Greek_mu", KeyGreek_mu},
+	{"Greek_nu", KeyGreek_nu},
+	{"Greek_omega", KeyGreek_omega},
+	{"Greek_omegaaccent", KeyGreek_omegaaccent},
+	{"Greek_omicron", KeyGreek_omicron},
+	{"Greek_omicronaccent", KeyGreek_omicronaccent},
+	{"Greek_phi", KeyGreek_phi},
+	{"Greek_pi", KeyGreek_pi},
+	{"Greek_psi", KeyGreek_psi},
+	{"Greek_rho", KeyGreek_rho},
+	{"Greek_sigma", KeyGreek_sigma},
+	{"Greek_switch", KeyGreek_switch},
+	{"Greek_tau", KeyGreek_tau},
+	{"Greek_theta", KeyGreek_theta},
+	{"Greek_upsilon", KeyGreek_upsilon},
+	{"Greek_upsilonaccent", KeyGreek_upsilonaccent},
+	{"Greek_upsilonaccentdieresis", KeyGreek_upsilonaccentdieresis},
+	{"Greek_upsilondieresis", KeyGreek_upsilondieresis},
+	{"Greek_xi", KeyGreek_xi},
+	{"Greek_zeta", KeyGreek_zeta},
+	{"H", KeyH},
+	{"Hangul", KeyHangul},
+	{"Hangul_A", KeyHangul_A},
+	{"Hangul_AE", KeyHangul_AE},
+	{"Hangul_AraeA", KeyHangul_AraeA},
+	{"Hangul_AraeAE", KeyHangul_AraeAE},
+	{"Hangul_Banja", KeyHangul_Banja},
+	{"Hangul_Cieuc", KeyHangul_Cieuc},
+	{"Hangul_Codeinput", KeyHangul_Codeinput},
+	{"Hangul_Dikeud", KeyHangul_Dikeud},
+	{"Hangul_E", KeyHangul_E},
+	{"Hangul_EO", KeyHangul_EO},
+	{"Hangul_EU", KeyHangul_EU},
+	{"Hangul_End", KeyHangul_End},
+	{"Hangul_Hanja", KeyHangul_Hanja},
+	{"Hangul_Hieuh", KeyHangul_Hieuh},
+	{"Hangul_I", KeyHangul_I},
+	{"Hangul_Ieung", KeyHangul_Ieung},
+	{"Hangul_J_Cieuc", KeyHangul_J_Cieuc},
+	{"Hangul_J_Dikeud", KeyHangul_J_Dikeud},
+	{"Hangul_J_Hieuh", KeyHangul_J_Hieuh},
+	{"Hangul_J_Ieung", KeyHangul_J_Ieung},
+	{"Hangul_J_Jieuj", KeyHangul_J_Jieuj},
+	{"Hangul_J_Khieuq", KeyHangul_J_Khieuq},
+	{"Hangul_J_Kiyeog", KeyHangul_J_Kiyeog},
+	{"Hangul_J_KiyeogSios", KeyHangul_J_KiyeogSios},
+	{"Hangul_J_KkogjiDalrinIeung", KeyHangul_J_KkogjiDalrinIeung},
+	{"Hangul_J_Mieum", KeyHangul_J_Mieum},
+	{"Hangul_J_Nieun", KeyHangul_J_Nieun},
+	{"Hangul_J_NieunHieuh", KeyHangul_J_NieunHieuh},
+	{"Hangul_J_NieunJieuj", KeyHangul_J_NieunJieuj},
+	{"Hangul_J_PanSios", KeyHangul_J_PanSios},
+	{"Hangul_J_Phieuf", KeyHangul_J_Phieuf},
+	{"Hangul_J_Pieub", KeyHangul_J_Pieub},
+	{"Hangul_J_PieubSios", KeyHangul_J_PieubSios},
+	{"Hangul_J_Rieul", KeyHangul_J_Rieul},
+	{"Hangul_J_RieulHieuh", KeyHangul_J_RieulHieuh},
+	{"Hangul_J_RieulKiyeog", KeyHangul_J_RieulKiyeog},
+	{"Hangul_J_RieulMieum", KeyHangul_J_RieulMieum},
+	{"Hangul_J_RieulPhieuf", KeyHangul_J_RieulPhieuf},
+	{"Hangul_J_RieulPieub", KeyHangul_J_RieulPieub},
+	{"Hangul_J_RieulSios", KeyHangul_J_RieulSios},
+	{"Hangul_J_RieulTieut", KeyHangul_J_RieulTieut},
+	{"Hangul_J_Sios", KeyHangul_J_Sios},
+	{"Hangul_J_SsangKiyeog", KeyHangul_J_SsangKiyeog},
+	{"Hangul_J_SsangSios", KeyHangul_J_SsangSios},
+	{"Hangul_J_Tieut", KeyHangul_J_Tieut},
+	{"Hangul_J_YeorinHieuh", KeyHangul_J_YeorinHieuh},
+	{"Hangul_Jamo", KeyHangul_Jamo},
+	{"Hangul_Jeonja", KeyHangul_Jeonja},
+	{"Hangul_Jieuj", KeyHangul_Jieuj},
+	{"Hangul_Khieuq", KeyHangul_Khieuq},
+	{"Hangul_Kiyeog", KeyHangul_Kiyeog},
+	{"Hangul_KiyeogSios", KeyHangul_KiyeogSios},
+	{"Hangul_KkogjiDalrinIeung", KeyHangul_KkogjiDalrinIeung},
+	{"Hangul_Mieum", KeyHangul_Mieum},
+	{"Hangul_MultipleCandidate", KeyHangul_MultipleCandidate},
+	{"Hangul_Nieun", KeyHangul_Nieun},
+	{"Hangul_NieunHieuh", KeyHangul_NieunHieuh},
+	{"Hangul_NieunJieuj", KeyHangul_NieunJieuj},
+	{"Hangul_O", KeyHangul_O},
+	{"Hangul_OE", KeyHangul_OE},
+	{"Hangul_PanSios", KeyHangul_PanSios},
+	{"Hangul_Phieuf", KeyHangul_Phieuf},
+	{"Hangul_Pieub", KeyHangul_Pieub},
+	{"Hangul_PieubSios", KeyHangul_PieubSios},
+	{"Hangul_PostHanja", KeyHangul_PostHanja},
+	{"Hangul_PreHanja", KeyHangul_PreHanja},
+	{"Hangul_PreviousCandidate", KeyHangul_PreviousCandidate},
+	{"Hangul_Rieul", KeyHangul_Rieul},
+	{"Hangul_RieulHieuh", KeyHangul_RieulHieuh},
+	{"Hangul_RieulKiyeog", KeyHangul_RieulKiyeog},
+	{"Hangul_RieulMieum", KeyHangul_RieulMieum},
+	{"Hangul_RieulPhieuf", KeyHangul_RieulPhieuf},
+	{"Hangul_RieulPieub", KeyHangul_RieulPieub},
+	{"Hangul_RieulSios", KeyHangul_RieulSios},
+	{"Hangul_RieulTieut", KeyHangul_RieulTieut},
+	{"Hangul_RieulYeorinHieuh", KeyHangul_RieulYeorinHieuh},
+	{"Hangul_Romaja", KeyHangul_Romaja},
+	{"Hangul_SingleCandidate", KeyHangul_SingleCandidate},
+	{"Hangul_Sios", KeyHangul_Sios},
+	{"Hangul_Special", KeyHangul_Special},
+	{"Hangul_SsangDikeud", KeyHangul_SsangDikeud},
+	{"Hangul_SsangJieuj", KeyHangul_SsangJieuj},
+	{"Hangul_SsangKiyeog", KeyHangul_SsangKiyeog},
+	{"Hangul_SsangPieub", KeyHangul_SsangPieub},
+	{"Hangul_SsangSios", KeyHangul_SsangSios},
+	{"Hangul_Start", KeyHangul_Start},
+	{"Hangul_SunkyeongeumMieum", KeyHangul_SunkyeongeumMieum},
+	{"Hangul_SunkyeongeumPhieuf", KeyHangul_SunkyeongeumPhieuf},
+	{"Hangul_SunkyeongeumPieub", KeyHangul_SunkyeongeumPieub},
+	{"Hangul_Tieut", KeyHangul_Tieut},
+	{"Hangul_U", KeyHangul_U},
+	{"Hangul_WA", KeyHangul_WA},
+	{"Hangul_WAE", KeyHangul_WAE},
+	{"Hangul_WE", KeyHangul_WE},
+	{"Hangul_WEO", KeyHangul_WEO},
+	{"Hangul_WI", KeyHangul_WI},
+	{"Hangul_YA", KeyHangul_YA},
+	{"Hangul_YAE", KeyHangul_YAE},
+	{"Hangul_YE", KeyHangul_YE},
+	{"Hangul_YEO", KeyHangul_YEO},
+	{"Hangul_YI", KeyHangul_YI},
+	{"Hangul_YO", KeyHangul_YO},
+	{"Hangul_YU", KeyHangul_YU},
+	{"Hangul_YeorinHieuh", KeyHangul_YeorinHieuh},
+	{"Hangul_switch", KeyHangul_switch},
+	{"Hankaku", KeyHankaku},
+	{"Hcircumflex", KeyHcircumflex},
+	{"Hebrew_switch", KeyHebrew_switch},
+	{"Help", KeyHelp},
+	{"Henkan", KeyHenkan},
+	{"Henkan_Mode", KeyHenkan_Mode},
+	{"Hiragana", KeyHiragana},
+	{"Hiragana_Katakana", KeyHiragana_Katakana},
+	{"Home", KeyHome},
+	{"Hstroke", KeyHstroke},
+	{"Hyper_L", KeyHyper_L},
+	{"Hyper_R", KeyHyper_R},
+	{"I", KeyI},
+	{"IO", KeyIO},
+	{"ISO_Center_Object", KeyISO_Center_Object},
+	{"ISO_Continuous_Underline", KeyISO_Continuous_Underline},
+	{"ISO_Discontinuous_Underline", KeyISO_Discontinuous_Underline},
+	{"ISO_Emphasize", KeyISO_Emphasize},
+	{"ISO_Enter", KeyISO_Enter},
+	{"ISO_Fast_Cursor_Down", KeyISO_Fast_Cursor_Down},
+	{"ISO_Fast_Cursor_Left", KeyISO_Fast_Cursor_Left},
+	{"ISO_Fast_Cursor_Right", KeyISO_Fast_Cursor_Right},
+	{"ISO_Fast_Cursor_Up", KeyISO_Fast_Cursor_Up},
+	{"ISO_First_Group", KeyISO_First_Group},
+	{"ISO_First_Group_Lock", KeyISO_First_Group_Lock},
+	{"ISO_Group_Latch", KeyISO_Group_Latch},
+	{"ISO_Group_Lock", KeyISO_Group_Lock},
+	{"ISO_Group_Shift", KeyISO_Group_Shift},
+	{"ISO_Last_Group", KeyISO_Last_Group},
+	{"ISO_Last_Group_Lock", KeyISO_Last_Group_Lock},
+	{"ISO_Left_Tab", KeyISO_Left_Tab},
+	{"ISO_Level2_Latch", KeyISO_Level2_Latch},
+	{"ISO_Level3_Latch", KeyISO_Level3_Latch},
+	{"ISO_Level3_Lock", KeyISO_Level3_Lock},
+	{"ISO_Level3_Shift", KeyISO_Level3_Shift},
+	{"ISO_Level5_Latch", KeyISO_Level5_Latch},
+	{"ISO_Level5_Lock", KeyISO_Level5_Lock},
+	{"ISO_Level5_Shift", KeyISO_Level5_Shift},
+	{"ISO_Lock", KeyISO_Lock},
+	{"ISO_Move_Line_Down", KeyISO_Move_Line_Down},
+	{"ISO_Move_Line_Up", KeyISO_Move_Line_Up},
+	{"ISO_Next_Group", KeyISO_Next_Group},
+	{"ISO_Next_Group_Lock", KeyISO_Next_Group_Lock},
+	{"ISO_Partial_Line_Down", KeyISO_Partial_Line_Down},
+	{"ISO_Partial_Line_Up", KeyISO_Partial_Line_Up},
+	{"ISO_Partial_Space_Left", KeyISO_Partial_Space_Left},
+	{"ISO_Partial_Space_Right", KeyISO_Partial_Space_Right},
+	{"ISO_Prev_Group", KeyISO_Prev_Group},
+	{"ISO_Prev_Group_Lock", KeyISO_Prev_Group_Lock},
+	{"ISO_Release_Both_Margins", KeyISO_Release_Both_Margins},
+	{"ISO_Release_Margin_Left", KeyISO_Release_Margin_Left},
+	{"ISO_Release_Margin_Right", KeyISO_Release_Margin_Right},
+	{"ISO_Set_Margin_Left", KeyISO_Set_Margin_Left},
+	{"ISO_Set_Margin_Right", KeyISO_Set_Margin_Right},
+	{"Iabovedot", KeyIabovedot},
+	{"Iacute", KeyIacute},
+	{"Ibelowdot", KeyIbelowdot},
+	{"Ibreve", KeyIbreve},
+	{"Icircumflex", KeyIcircumflex},
+	{"Idiaeresis", KeyIdiaeresis},
+	{"Igrave", KeyIgrave},
+	{"Ihook", KeyIhook},
+	{"Imacron", KeyImacron},
+	{"Insert", KeyInsert},
+	{"InsertChar", KeyInsertChar},
+	{"InsertLine", KeyInsertLine},
+	{"Iogonek", KeyIogonek},
+	{"Itilde", KeyItilde},
+	{"J", KeyJ},
+	{"Jcircumflex", KeyJcircumflex},
+	{"K", KeyK},
+	{"KP_0", KeyKP_0},
+	{"KP_1", KeyKP_1},
+	{"KP_2", KeyKP_2},
+	{"KP_3", KeyKP_3},
+	{"KP_4", KeyKP_4},
+	{"KP_5", KeyKP_5},
+	{"KP_6", KeyKP_6},
+	{"KP_7", KeyKP_7},
+	{"KP_8", KeyKP_8},
+	{"KP_9", KeyKP_9},
+	{"KP_Add", KeyKP_Add},
+	{"KP_BackTab", KeyKP_BackTab},
+	{"KP_Begin", KeyKP_Begin},
+	{"KP_Decimal", KeyKP_Decimal},
+	{"KP_Delete", KeyKP_Delete},
+	{"KP_Divide", KeyKP_Divide},
+	{"KP_Down", KeyKP_Down},
+	{"KP_End", KeyKP_End},
+	{"KP_Enter", KeyKP_Enter},
+	{"KP_Equal", KeyKP_Equal},
+	{"KP_F1", KeyKP_F1},
+	{"KP_F2", KeyKP_F2},
+	{"KP_F3", KeyKP_F3},
+	{"KP_F4", KeyKP_F4},
+	{"KP_Home", KeyKP_Home},
+	{"KP_Insert", KeyKP_Insert},
+	{"KP_Left", KeyKP_Left},
+	{"KP_Multiply", KeyKP_Multiply},
+	{"KP_Next", KeyKP_Next},
+	{"KP_Page_Down", KeyKP_Page_Down},
+	{"KP_Page_Up", KeyKP_Page_Up},
+	{"KP_Prior", KeyKP_Prior},
+	{"KP_Right", KeyKP_Right},
+	{"KP_Separator", KeyKP_Separator},
+	{"KP_Space", KeyKP_Space},
+	{"KP_Subtract", KeyKP_Subtract},
+	{"KP_Tab", KeyKP_Tab},
+	{"KP_Up", KeyKP_Up},
+	{"Kana_Lock", KeyKana_Lock},
+	{"Kana_Shift", KeyKana_Shift},
+	{"Kanji", KeyKanji},
+	{"Kanji_Bangou", KeyKanji_Bangou},
+	{"Katakana", KeyKatakana},
+	{"Kcedilla", KeyKcedilla},
+	{"Korean_Won", KeyKorean_Won},
+	{"L", KeyL},
+	{"L1", KeyL1},
+	{"L10", KeyL10},
+	{"L2", KeyL2},
+	{"L3", KeyL3},
+	{"L4", KeyL4},
+	{"L5", KeyL5},
+	{"L6", KeyL6},
+	{"L7", KeyL7},
+	{"L8", KeyL8},
+	{"L9", KeyL9},
+	{"Lacute", KeyLacute},
+	{"Last_Virtual_Screen", KeyLast_Virtual_Screen},
+	{"Lbelowdot", KeyLbelowdot},
+	{"Lcaron", KeyLcaron},
+	{"Lcedilla", KeyLcedilla},
+	{"Left", KeyLeft},
+	{"Linefeed", KeyLinefeed},
+	{"LiraSign", KeyLiraSign},
+	{"Lstroke", KeyLstroke},
+	{"M", KeyM},
+	{"Mabovedot", KeyMabovedot},
+	{"Macedonia_DSE", KeyMacedonia_DSE},
+	{"Macedonia_GJE", KeyMacedonia_GJE},
+	{"Macedonia_KJE", KeyMacedonia_KJE},
+	{"Macedonia_dse", KeyMacedonia_dse},
+	{"Macedonia_gje", KeyMacedonia_gje},
+	{"Macedonia_kje", KeyMacedonia_kje},
+	{"Mae_Koho", KeyMae_Koho},
+	{"Massyo", KeyMassyo},
+	{"Menu", KeyMenu},
+	{"Meta_L", KeyMeta_L},
+	{"Meta_R", KeyMeta_R},
+	{"MillSign", KeyMillSign},
+	{"Mode_switch", KeyMode_switch},
+	{"MouseKeys_Accel_Enable", KeyMouseKeys_Accel_Enable},
+	{"MouseKeys_Enable", KeyMouseKeys_Enable},
+	{"Muhenkan", KeyMuhenkan},
+	{"Multi_key", KeyMulti_key},
+	{"MultipleCandidate", KeyMultipleCandidate},
+	{"N", KeyN},
+	{"Nacute", KeyNacute},
+	{"NairaSign", KeyNairaSign},
+	{"Ncaron", KeyNcaron},
+	{"Ncedilla", KeyNcedilla},
+	{"NewSheqelSign", KeyNewSheqelSign},
+	{"Next", KeyNext},
+	{"Next_Virtual_Screen", KeyNext_Virtual_Screen},
+	{"Ntilde", KeyNtilde},
+	{"Num_Lock", KeyNum_Lock},
+	{"O", KeyO},
+	{"OE", KeyOE},
+	{"Oacute", KeyOacute},
+	{"Obarred", KeyObarred},
+	{"Obelowdot", KeyObelowdot},
+	{"Ocaron", KeyOcaron},
+	{"Ocircumflex", KeyOcircumflex},
+	{"Ocircumflexacute", KeyOcircumflexacute},
+	{"Ocircumflexbelowdot", KeyOcircumflexbelowdot},
+	{"Ocircumflexgrave", KeyOcircumflexgrave},
+	{"Ocircumflexhook", KeyOcircumflexhook},
+	{"Ocircumflextilde", KeyOcircumflextilde},
+	{"Odiaeresis", KeyOdiaeresis},
+	{"Odoubleacute", KeyOdoubleacute},
+	{"Ograve", KeyOgrave},
+	{"Ohook", KeyOhook},
+	{"Ohorn", KeyOhorn},
+	{"Ohornacute", KeyOhornacute},
+	{"Ohornbelowdot", KeyOhornbelowdot},
+	{"Ohorngrave", KeyOhorngrave},
+	{"Ohornhook", KeyOhornhook},
+	{"Ohorntilde", KeyOhorntilde},
+	{"Omacron", KeyOmacron},
+	{"Ooblique", KeyOoblique},
+	{"Oslash", KeyOslash},
+	{"Otilde", KeyOtilde},
+	{"Overlay1_Enable", KeyOverlay1_Enable},
+	{"Overlay2_Enable", KeyOverlay2_Enable},
+	{"P", KeyP},
+	{"Pabovedot", KeyPabovedot},
+	{"Page_Down", KeyPage_Down},
+	{"Page_Up", KeyPage_Up},
+	{"Pause", KeyPause},
+	{"PesetaSign", KeyPesetaSign},
+	{"Pointer_Accelerate", KeyPointer_Accelerate},
+	{"Pointer_Button1", KeyPointer_Button1},
+	{"Pointer_Button2", KeyPointer_Button2},
+	{"Pointer_Button3", KeyPointer_Button3},
+	{"Pointer_Button4", KeyPointer_Button4},
+	{"Pointer_Button5", KeyPointer_Button5},
+	{"Pointer_Button_Dflt", KeyPointer_Button_Dflt},
+	{"Pointer_DblClick1", KeyPointer_DblClick1},
+	{"Pointer_DblClick2", KeyPointer_DblClick2},
+	{"Pointer_DblClick3", KeyPointer_DblClick3},
+	{"Pointer_DblClick4", KeyPointer_DblClick4},
+	{"Pointer_DblClick5", KeyPointer_DblClick5},
+	{"Pointer_DblClick_Dflt", KeyPointer_DblClick_Dflt},
+	{"Pointer_DfltBtnNext", KeyPointer_DfltBtnNext},
+	{"Pointer_DfltBtnPrev", KeyPointer_DfltBtnPrev},
+	{"Pointer_Down", KeyPointer_Down},
+	{"Pointer_DownLeft", KeyPointer_DownLeft},
+	{"Pointer_DownRight", KeyPointer_DownRight},
+	{"Pointer_Drag1", KeyPointer_Drag1},
+	{"Pointer_Drag2", KeyPointer_Drag2},
+	{"Pointer_Drag3", KeyPointer_Drag3},
+	{"Pointer_Drag4", KeyPointer_Drag4},
+	{"Pointer_Drag5", KeyPointer_Drag5},
+	{"Pointer_Drag_Dflt", KeyPointer_Drag_Dflt},
+	{"Pointer_EnableKeys", KeyPointer_EnableKeys},
+	{"Pointer_Left", KeyPointer_Left},
+	{"Pointer_Right", KeyPointer_Right},
+	{"Pointer_Up", KeyPointer_Up},
+	{"Pointer_UpLeft", KeyPointer_UpLeft},
+	{"Pointer_UpRight", KeyPointer_UpRight},
+	{"Prev_Virtual_Screen", KeyPrev_Virtual_Screen},
+	{"PreviousCandidate", KeyPreviousCandidate},
+	{"Print", KeyPrint},
+	{"Prior", KeyPrior},
+	{"Q", KeyQ},
+	{"R", KeyR},
+	{"R1", KeyR1},
+	{"R10", KeyR10},
+	{"R11", KeyR11},
+	{"R12", KeyR12},
+	{"R13", KeyR13},
+	{"R14", KeyR14},
+	{"R15", KeyR15},
+	{"R2", KeyR2},
+	{"R3", KeyR3},
+	{"R4", KeyR4},
+	{"R5", KeyR5},
+	{"R6", KeyR6},
+	{"R7", KeyR7},
+	{"R8", KeyR8},
+	{"R9", KeyR9},
+	{"Racute", KeyRacute},
+	{"Rcaron", KeyRcaron},
+	{"Rcedilla", KeyRcedilla},
+	{"Redo", KeyRedo},
+	{"RepeatKeys_Enable", KeyRepeatKeys_Enable},
+	{"Reset", KeyReset},
+	{"Return", KeyReturn},
+	{"Right", KeyRight},
+	{"Romaji", KeyRomaji},
+	{"RupeeSign", KeyRupeeSign},
+	{"S", KeyS},
+	{"SCHWA", KeySCHWA},
+	{"Sabovedot", KeySabovedot},
+	{"Sacute", KeySacute},
+	{"Scaron", KeyScaron},
+	{"Scedilla", KeyScedilla},
+	{"Scircumflex", KeyScircumflex},
+	{"Scroll_Lock", KeyScroll_Lock},
+	{"Select", KeySelect},
+	{"Serbian_DJE", KeySerbian_DJE},
+	{"Serbian_DZE", KeySerbian_DZE},
+	{"Serbian_JE", KeySerbian_JE},
+	{"Serbian_LJE", KeySerbian_LJE},
+	{"Serbian_NJE", KeySerbian_NJE},
+	{"Serbian_TSHE", KeySerbian_TSHE},
+	{"Serbian_dje", KeySerbian_dje},
+	{"Serbian_dze", KeySerbian_dze},
+	{"Serbian_je", KeySerbian_je},
+	{"Serbian_lje", KeySerbian_lje},
+	{"Serbian_nje", KeySerbian_nje},
+	{"Serbian_tshe", KeySerbian_tshe},
+	{"Shift_L", KeyShift_L},
+	{"Shift_Lock", KeyShift_Lock},
+	{"Shift_R", KeyShift_R},
+	{"SingleCandidate", KeySingleCandidate},
+	{"Sinh_a", KeySinh_a},
+	{"Sinh_aa", KeySinh_aa},
+	{"Sinh_aa2", KeySinh_aa2},
+	{"Sinh_ae", KeySinh_ae},
+	{"Sinh_ae2", KeySinh_ae2},
+	{"Sinh_aee", KeySinh_aee},
+	{"Sinh_aee2", KeySinh_aee2},
+	{"Sinh_ai", KeySinh_ai},
+	{"Sinh_ai2", KeySinh_ai2},
+	{"Sinh_al", KeySinh_al},
+	{"Sinh_au", KeySinh_au},
+	{"Sinh_au2", KeySinh_au2},
+	{"Sinh_ba", KeySinh_ba},
+	{"Sinh_bha", KeySinh_bha},
+	{"Sinh_ca", KeySinh_ca},
+	{"Sinh_cha", KeySinh_cha},
+	{"Sinh_dda", KeySinh_dda},
+	{"Sinh_ddha", KeySinh_ddha},
+	{"Sinh_dha", KeySinh_dha},
+	{"Sinh_dhha", KeySinh_dhha},
+	{"Sinh_e", KeySinh_e},
+	{"Sinh_e2", KeySinh_e2},
+	{"Sinh_ee", KeySinh_ee},
+	{"Sinh_ee2", KeySinh_ee2},
+	{"Sinh_fa", KeySinh_fa},
+	{"Sinh_ga", KeySinh_ga},
+	{"Sinh_gha", KeySinh_gha},
+	{"Sinh_h2", KeySinh_h2},
+	{"Sinh_ha", KeySinh_ha},
+	{"Sinh_i", KeySinh_i},
+	{"Sinh_i2", KeySinh_i2},
+	{"Sinh_ii", KeySinh_ii},
+	{"Sinh_ii2", KeySinh_ii2},
+	{"Sinh_ja", KeySinh_ja},
+	{"Sinh_jha", KeySinh_jha},
+	{"Sinh_jnya", KeySinh_jnya},
+	{"Sinh_ka", KeySinh_ka},
+	{"Sinh_kha", KeySinh_kha},
+	{"Sinh_kunddaliya", KeySinh_kunddaliya},
+	{"Sinh_la", KeySinh_la},
+	{"Sinh_lla", KeySinh_lla},
+	{"Sinh_lu", KeySinh_lu},
+	{"Sinh_lu2", KeySinh_lu2},
+	{"Sinh_luu", KeySinh_luu},
+	{"Sinh_luu2", KeySinh_luu2},
+	{"Sinh_ma", KeySinh_ma},
+	{"Sinh_mba", KeySinh_mba},
+	{"Sinh_na", KeySinh_na},
+	{"Sinh_ndda", KeySinh_ndda},
+	{"Sinh_ndha", KeySinh_ndha},
+	{"Sinh_ng", KeySinh_ng},
+	{"Sinh_ng2", KeySinh_ng2},
+	{"Sinh_nga", KeySinh_nga},
+	{"Sinh_nja", KeySinh_nja},
+	{"Sinh_nna", KeySinh_nna},
+	{"Sinh_nya", KeySinh_nya},
+	{"Sinh_o", KeySinh_o},
+	{"Sinh_o2", KeySinh_o2},
+	{"Sinh_oo", KeySinh_oo},
+	{"Sinh_oo2", KeySinh_oo2},
+	{"Sinh_pa", KeySinh_pa},
+	{"Sinh_pha", KeySinh_pha},
+	{"Sinh_ra", KeySinh_ra},
+	{"Sinh_ri", KeySinh_ri},
+	{"Sinh_rii", KeySinh_rii},
+	{"Sinh_ru2", KeySinh_ru2},
+	{"Sinh_ruu2", KeySinh_ruu2},
+	{"Sinh_sa", KeySinh_sa},
+	{"Sinh_sha", KeySinh_sha},
+	{"Sinh_ssha", KeySinh_ssha},
+	{"Sinh_tha", KeySinh_tha},
+	{"Sinh_thha", KeySinh_thha},
+	{"Sinh_tta", KeySinh_tta},
+	{"Sinh_ttha", KeySinh_ttha},
+	{"Sinh_u", KeySinh_u},
+	{"Sinh_u2", KeySinh_u2},
+	{"Sinh_uu", KeySinh_uu},
+	{"Sinh_uu2", KeySinh_uu2},
+	{"Sinh_va", KeySinh_va},
+	{"Sinh_ya", KeySinh_ya},
+	{"SlowKeys_Enable", KeySlowKeys_Enable},
+	{"StickyKeys_Enable", KeyStickyKeys_Enable},
+	{"SunAgain", KeySunAgain},
+	{"SunAltGraph", KeySunAltGraph},
+	{"SunAudioLowerVolume", KeySunAudioLowerVolume},
+	{"SunAudioMute", KeySunAudioMute},
+	{"SunAudioRaiseVolume", KeySunAudioRaiseVolume},
+	{"SunCompose", KeySunCompose},
+	{"SunCopy", KeySunCopy},
+	{"SunCut", KeySunCut},
+	{"SunF36", KeySunF36},
+	{"SunF37", KeySunF37},
+	{"SunFA_Acute", KeySunFA_Acute},
+	{"SunFA_Cedilla", KeySunFA_Cedilla},
+	{"SunFA_Circum", KeySunFA_Circum},
+	{"SunFA_Diaeresis", KeySunFA_Diaeresis},
+	{"SunFA_Grave", KeySunFA_Grave},
+	{"SunFA_Tilde", KeySunFA_Tilde},
+	{"SunFind", KeySunFind},
+	{"SunFront", KeySunFront},
+	{"SunOpen", KeySunOpen},
+	{"SunPageDown", KeySunPageDown},
+	{"SunPageUp", KeySunPageUp},
+	{"SunPaste", KeySunPaste},
+	{"SunPowerSwitch", KeySunPowerSwitch},
+	{"SunPowerSwitchShift", KeySunPowerSwitchShift},
+	{"SunPrint_Screen", KeySunPrint_Screen},
+	{"SunProps", KeySunProps},
+	{"SunStop", KeySunStop},
+	{"SunSys_Req", KeySunSys_Req},
+	{"SunUndo", KeySunUndo},
+	{"SunVideoDegauss", KeySunVideoDegauss},
+	{"SunVideoLowerBrightness", KeySunVideoLowerBrightness},
+	{"SunVideoRaiseBrightness", KeySunVideoRaiseBrightness},
+	{"Super_L", KeySuper_L},
+	{"Super_R", KeySuper_R},
+	{"Sys_Req", KeySys_Req},
+	{"System", KeySystem},
+	{"T", KeyT},
+	{"THORN", KeyTHORN},
+	{"Tab", KeyTab},
+	{"Tabovedot", KeyTabovedot},
+	{"Tcaron", KeyTcaron},
+	{"Tcedilla", KeyTcedilla},
+	{"Terminate_Server", KeyTerminate_Server},
+	{"Thai_baht", KeyThai_baht},
+	{"Thai_bobaimai", KeyThai_bobaimai},
+	{"Thai_chochan", KeyThai_chochan},
+	{"Thai_chochang", KeyThai_chochang},
+	{"Thai_choching", KeyThai_choching},
+	{"Thai_chochoe", KeyThai_chochoe},
+	{"Thai_dochada", KeyThai_dochada},
+	{"Thai_dodek", KeyThai_dodek},
+	{"Thai_fofa", KeyThai_fofa},
+	{"Thai_fofan", KeyThai_fofan},
+	{"Thai_hohip", KeyThai_hohip},
+	{"Thai_honokhuk", KeyThai_honokhuk},
+	{"Thai_khokhai", KeyThai_khokhai},
+	{"Thai_khokhon", KeyThai_khokhon},
+	{"Thai_khokhuat", KeyThai_khokhuat},
+	{"Thai_khokhwai", KeyThai_khokhwai},
+	{"Thai_khorakhang", KeyThai_khorakhang},
+	{"Thai_kokai", KeyThai_kokai},
+	{"Thai_lakkhangyao", KeyThai_lakkhangyao},
+	{"Thai_lekchet", KeyThai_lekchet},
+	{"Thai_lekha", KeyThai_lekha},
+	{"Thai_lekhok", KeyThai_lekhok},
+	{"Thai_lekkao", KeyThai_lekkao},
+	{"Thai_leknung", KeyThai_leknung},
+	{"Thai_lekpaet", KeyThai_lekpaet},
+	{"Thai_leksam", KeyThai_leksam},
+	{"Thai_leksi", KeyThai_leksi},
+	{"Thai_leksong", KeyThai_leksong},
+	{"Thai_leksun", KeyThai_leksun},
+	{"Thai_lochula", KeyThai_lochula},
+	{"Thai_loling", KeyThai_loling},
+	{"Thai_lu", KeyThai_lu},
+	{"Thai_maichattawa", KeyThai_maichattawa},
+	{"Thai_maiek", KeyThai_maiek},
+	{"Thai_maihanakat", KeyThai_maihanakat},
+	{"Thai_maihanakat_maitho", KeyThai_maihanakat_maitho},
+	{"Thai_maitaikhu", KeyThai_maitaikhu},
+	{"Thai_maitho", KeyThai_maitho},
+	{"Thai_maitri", KeyThai_maitri},
+	{"Thai_maiyamok", KeyThai_maiyamok},
+	{"Thai_moma", KeyThai_moma},
+	{"Thai_ngongu", KeyThai_ngongu},
+	{"Thai_nikhahit", KeyThai_nikhahit},
+	{"Thai_nonen", KeyThai_nonen},
+	{"Thai_nonu", KeyThai_nonu},
+	{"Thai_oang", KeyThai_oang},
+	{"Thai_paiyannoi", KeyThai_paiyannoi},
+	{"Thai_phinthu", KeyThai_phinthu},
+	{"Thai_phophan", KeyThai_phophan},
+	{"Thai_phophung", KeyThai_phophung},
+	{"Thai_phosamphao", KeyThai_phosamphao},
+	{"Thai_popla", KeyThai_popla},
+	{"Thai_rorua", KeyThai_rorua},
+	{"Thai_ru", KeyThai_ru},
+	{"Thai_saraa", KeyThai_saraa},
+	{"Thai_saraaa", KeyThai_saraaa},
+	{"Thai_saraae", KeyThai_saraae},
+	{"Thai_saraaimaimalai", KeyThai_saraaimaimalai},
+	{"Thai_saraaimaimuan", KeyThai_saraaimaimuan},
+	{"Thai_saraam", KeyThai_saraam},
+	{"Thai_sarae", KeyThai_sarae},
+	{"Thai_sarai", KeyThai_sarai},
+	{"Thai_saraii", KeyThai_saraii},
+	{"Thai_sarao", KeyThai_sarao},
+	{"Thai_sarau", KeyThai_sarau},
+	{"Thai_saraue", KeyThai_saraue},
+	{"Thai_sarauee", KeyThai_sarauee},
+	{"Thai_sarauu", KeyThai_sarauu},
+	{"Thai_sorusi", KeyThai_sorusi},
+	{"Thai_sosala", KeyThai_sosala},
+	{"Thai_soso", KeyThai_soso},
+	{"Thai_sosua", KeyThai_sosua},
+	{"Thai_thanthakhat", KeyThai_thanthakhat},
+	{"Thai_thonangmontho", KeyThai_thonangmontho},
+	{"Thai_thophuthao", KeyThai_thophuthao},
+	{"Thai_thothahan", KeyThai_thothahan},
+	{"Thai_thothan", KeyThai_thothan},
+	{"Thai_thothong", KeyThai_thothong},
+	{"Thai_thothung", KeyThai_thothung},
+	{"Thai_topatak", KeyThai_topatak},
+	{"Thai_totao", KeyThai_totao},
+	{"Thai_wowaen", KeyThai_wowaen},
+	{"Thai_yoyak", KeyThai_yoyak},
+	{"Thai_yoying", KeyThai_yoying},
+	{"Thorn", KeyThorn},
+	{"Touroku", KeyTouroku},
+	{"Tslash", KeyTslash},
+	{"U", KeyU},
+	{"Uacute", KeyUacute},
+	{"Ubelowdot", KeyUbelowdot},
+	{"Ubreve", KeyUbreve},
+	{"Ucircumflex", KeyUcircumflex},
+	{"Udiaeresis", KeyUdiaeresis},
+	{"Udoubleacute", KeyUdoubleacute},
+	{"Ugrave", KeyUgrave},
+	{"Uhook", KeyUhook},
+	{"Uhorn", KeyUhorn},
+	{"Uhornacute", KeyUhornacute},
+	{"Uhornbelowdot", KeyUhornbelowdot},
+	{"Uhorngrave", KeyUhorngrave},
+	{"Uhornhook", KeyUhornhook},
+	{"Uhorntilde", KeyUhorntilde},
+	{"Ukrainian_GHE_WITH_UPTURN", KeyUkrainian_GHE_WITH_UPTURN},
+	{"Ukrainian_I", KeyUkrainian_I},
+	{"Ukrainian_IE", KeyUkrainian_IE},
+	{"Ukrainian_YI", KeyUkrainian_YI},
+	{"Ukrainian_ghe_with_upturn", KeyUkrainian_ghe_with_upturn},
+	{"Ukrainian_i", KeyUkrainian_i},
+	{"Ukrainian_ie", KeyUkrainian_ie},
+	{"Ukrainian_yi", KeyUkrainian_yi},
+	{"Ukranian_I", KeyUkranian_I},
+	{"Ukranian_JE", KeyUkranian_JE},
+	{"Ukranian_YI", KeyUkranian_YI},
+	{"Ukranian_i", KeyUkranian_i},
+	{"Ukranian_je", KeyUkranian_je},
+	{"Ukranian_yi", KeyUkranian_yi},
+	{"Umacron", KeyUmacron},
+	{"Undo", KeyUndo},
+	{"Uogonek", KeyUogonek},
+	{"Up", KeyUp},
+	{"Uring", KeyUring},
+	{"User", KeyUser},
+	{"Utilde", KeyUtilde},
+	{"V", KeyV},
+	{"VoidSymbol", KeyVoidSymbol},
+	{"W", KeyW},
+	{"Wacute", KeyWacute},
+	{"Wcircumflex", KeyWcircumflex},
+	{"Wdiaeresis", KeyWdiaeresis},
+	{"Wgrave", KeyWgrave},
+	{"WonSign", KeyWonSign},
+	{"X", KeyX},
+	{"XF8610ChannelsDown", KeyXF8610ChannelsDown},
+	{"XF8610ChannelsUp", KeyXF8610ChannelsUp},
+	{"XF863DMode", KeyXF863DMode},
+	{"XF86ALSToggle", KeyXF86ALSToggle},
+	{"XF86AddFavorite", KeyXF86AddFavorite},
+	{"XF86Addressbook", KeyXF86Addressbook},
+	{"XF86AppSelect", KeyXF86AppSelect},
+	{"XF86ApplicationLeft", KeyXF86ApplicationLeft},
+	{"XF86ApplicationRight", KeyXF86ApplicationRight},
+	{"XF86AspectRatio", KeyXF86AspectRatio},
+	{"XF86Assistant", KeyXF86Assistant},
+	{"XF86AttendantOff", KeyXF86AttendantOff},
+	{"XF86AttendantOn", KeyXF86AttendantOn},
+	{"XF86AttendantToggle", KeyXF86AttendantToggle},
+	{"XF86Audio", KeyXF86Audio},
+	{"XF86AudioCycleTrack", KeyXF86AudioCycleTrack},
+	{"XF86AudioDesc", KeyXF86AudioDesc},
+	{"XF86AudioForward", KeyXF86AudioForward},
+	{"XF86AudioLowerVolume", KeyXF86AudioLowerVolume},
+	{"XF86AudioMedia", KeyXF86AudioMedia},
+	{"XF86AudioMicMute", KeyXF86AudioMicMute},
+	{"XF86AudioMute", KeyXF86AudioMute},
+	{"XF86AudioNext", KeyXF86AudioNext},
+	{"XF86AudioPause", KeyXF86AudioPause},
+	{"XF86AudioPlay", KeyXF86AudioPlay},
+	{"XF86AudioPreset", KeyXF86AudioPreset},
+	{"XF86AudioPrev", KeyXF86AudioPrev},
+	{"XF86AudioRaiseVolume", KeyXF86AudioRaiseVolume},
+	{"XF86AudioRandomPlay", KeyXF86AudioRandomPlay},
+	{"XF86AudioRecord", KeyXF86AudioRecord},
+	{"XF86AudioRepeat", KeyXF86AudioRepeat},
+	{"XF86AudioRewind", KeyXF86AudioRewind},
+	{"XF86AudioStop", KeyXF86AudioStop},
+	{"XF86Away", KeyXF86Away},
+	{"XF86Back", KeyXF86Back},
+	{"XF86BackForward", KeyXF86BackForward},
+	{"XF86Battery", KeyXF86Battery},
+	{"XF86Blue", KeyXF86Blue},
+	{"XF86Bluetooth", KeyXF86Bluetooth},
+	{"XF86Book", KeyXF86Book},
+	{"XF86Break", KeyXF86Break},
+	{"XF86BrightnessAdjust", KeyXF86BrightnessAdjust},
+	{"XF86BrightnessAuto", KeyXF86BrightnessAuto},
+	{"XF86BrightnessMax", KeyXF86BrightnessMax},
+	{"XF86BrightnessMin", KeyXF86BrightnessMin},
+	{"XF86Buttonconfig", KeyXF86Buttonconfig},
+	{"XF86CD", KeyXF86CD},
+	{"XF86Calculater", KeyXF86Calculater},
+	{"XF86Calculator", KeyXF86Calculator},
+	{"XF86Calendar", KeyXF86Calendar},
+	{"XF86CameraDown", KeyXF86CameraDown},
+	{"XF86CameraFocus", KeyXF86CameraFocus},
+	{"XF86CameraLeft", KeyXF86CameraLeft},
+	{"XF86CameraRight", KeyXF86CameraRight},
+	{"XF86CameraUp", KeyXF86CameraUp},
+	{"XF86CameraZoomIn", KeyXF86CameraZoomIn},
+	{"XF86CameraZoomOut", KeyXF86CameraZoomOut},
+	{"XF86ChannelDown", KeyXF86ChannelDown},
+	{"XF86ChannelUp", KeyXF86ChannelUp},
+	{"XF86Clear", KeyXF86Clear},
+	{"XF86ClearGrab", KeyXF86ClearGrab},
+	{"XF86Close", KeyXF86Close},
+	{"XF86Community", KeyXF86Community},
+	{"XF86ContextMenu", KeyXF86ContextMenu},
+	{"XF86ContrastAdjust", KeyXF86ContrastAdjust},
+	{"XF86ControlPanel", KeyXF86ControlPanel},
+	{"XF86Copy", KeyXF86Copy},
+	{"XF86Cut", KeyXF86Cut},
+	{"XF86CycleAngle", KeyXF86CycleAngle},
+	{"XF86DOS", KeyXF86DOS},
+	{"XF86DVD", KeyXF86DVD},
+	{"XF86Data", KeyXF86Data},
+	{"XF86Database", KeyXF86Database},
+	{"XF86Dictate", KeyXF86Dictate},
+	{"XF86Display", KeyXF86Display},
+	{"XF86DisplayOff", KeyXF86DisplayOff},
+	{"XF86DisplayToggle", KeyXF86DisplayToggle},
+	{"XF86Documents", KeyXF86Documents},
+	{"XF86Editor", KeyXF86Editor},
+	{"XF86Eject", KeyXF86Eject},
+	{"XF86EmojiPicker", KeyXF86EmojiPicker},
+	{"XF86Excel", KeyXF86Excel},
+	{"XF86Explorer", KeyXF86Explorer},
+	{"XF86FastReverse", KeyXF86FastReverse},
+	{"XF86Favorites", KeyXF86Favorites},
+	{"XF86Finance", KeyXF86Finance},
+	{"XF86Fn", KeyXF86Fn},
+	{"XF86FnRightShift", KeyXF86FnRightShift},
+	{"XF86Fn_Esc", KeyXF86Fn_Esc},
+	{"XF86Forward", KeyXF86Forward},
+	{"XF86FrameBack", KeyXF86FrameBack},
+	{"XF86FrameForward", KeyXF86FrameForward},
+	{"XF86FullScreen", KeyXF86FullScreen},
+	{"XF86Game", KeyXF86Game},
+	{"XF86Go", KeyXF86Go},
+	{"XF86GraphicsEditor", KeyXF86GraphicsEditor},
+	{"XF86Green", KeyXF86Green},
+	{"XF86HangupPhone", KeyXF86HangupPhone},
+	{"XF86Hibernate", KeyXF86Hibernate},
+	{"XF86History", KeyXF86History},
+	{"XF86HomePage", KeyXF86HomePage},
+	{"XF86HotLinks", KeyXF86HotLinks},
+	{"XF86Images", KeyXF86Images},
+	{"XF86Info", KeyXF86Info},
+	{"XF86Journal", KeyXF86Journal},
+	{"XF86KbdBrightnessDown", KeyXF86KbdBrightnessDown},
+	{"XF86KbdBrightnessUp", KeyXF86KbdBrightnessUp},
+	{"XF86KbdInputAssistAccept", KeyXF86KbdInputAssistAccept},
+	{"XF86KbdInputAssistCancel", KeyXF86KbdInputAssistCancel},
+	{"XF86KbdInputAssistNext", KeyXF86KbdInputAssistNext},
+	{"XF86KbdInputAssistNextgroup", KeyXF86KbdInputAssistNextgroup},
+	{"XF86KbdInputAssistPrev", KeyXF86KbdInputAssistPrev},
+	{"XF86KbdInputAssistPrevgroup", KeyXF86KbdInputAssistPrevgroup},
+	{"XF86KbdLcdMenu1", KeyXF86KbdLcdMenu1},
+	{"XF86KbdLcdMenu2", KeyXF86KbdLcdMenu2},
+	{"XF86KbdLcdMenu3", KeyXF86KbdLcdMenu3},
+	{"XF86KbdLcdMenu4", KeyXF86KbdLcdMenu4},
+	{"XF86KbdLcdMenu5", KeyXF86KbdLcdMenu5},
+	{"XF86KbdLightOnOff", KeyXF86KbdLightOnOff},
+	{"XF86Keyboard", KeyXF86Keyboard},
+	{"XF86Launch0", KeyXF86Launch0},
+	{"XF86Launch1", KeyXF86Launch1},
+	{"XF86Launch2", KeyXF86Launch2},
+	{"XF86Launch3", KeyXF86Launch3},
+	{"XF86Launch4", KeyXF86Launch4},
+	{"XF86Launch5", KeyXF86Launch5},
+	{"XF86Launch6", KeyXF86Launch6},
+	{"XF86Launch7", KeyXF86Launch7},
+	{"XF86Launch8", KeyXF86Launch8},
+	{"XF86Launch9", KeyXF86Launch9},
+	{"XF86LaunchA", KeyXF86LaunchA},
+	{"XF86LaunchB", KeyXF86LaunchB},
+	{"XF86LaunchC", KeyXF86LaunchC},
+	{"XF86LaunchD", KeyXF86LaunchD},
+	{"XF86LaunchE", KeyXF86LaunchE},
+	{"XF86LaunchF", KeyXF86LaunchF},
+	{"XF86LeftDown", KeyXF86LeftDown},
+	{"XF86LeftUp", KeyXF86LeftUp},
+	{"XF86LightBulb", KeyXF86LightBulb},
+	{"XF86LightsToggle", KeyXF86LightsToggle},
+	{"XF86LogGrabInfo", KeyXF86LogGrabInfo},
+	{"XF86LogOff", KeyXF86LogOff},
+	{"XF86LogWindowTree", KeyXF86LogWindowTree},
+	{"XF86Macro1", KeyXF86Macro1},
+	{"XF86Macro10", KeyXF86Macro10},
+	{"XF86Macro11", KeyXF86Macro11},
+	{"XF86Macro12", KeyXF86Macro12},
+	{"XF86Macro13", KeyXF86Macro13},
+	{"XF86Macro14", KeyXF86Macro14},
+	{"XF86Macro15", KeyXF86Macro15},
+	{"XF86Macro16", KeyXF86Macro16},
+	{"XF86Macro17", KeyXF86Macro17},
+	{"XF86Macro18", KeyXF86Macro18},
+	{"XF86Macro19", KeyXF86Macro19},
+	{"XF86Macro2", KeyXF86Macro2},
+	{"XF86Macro20", KeyXF86Macro20},
+	{"XF86Macro21", KeyXF86Macro21},
+	{"XF86Macro22", KeyXF86Macro22},
+	{"XF86Macro23", KeyXF86Macro23},
+	{"XF86Macro24", KeyXF86Macro24},
+	{"XF86Macro25", KeyXF86Macro25},
+	{"XF86Macro26", KeyXF86Macro26},
+	{"XF86Macro27", KeyXF86Macro27},
+	{"XF86Macro28", KeyXF86Macro28},
+	{"XF86Macro29", KeyXF86Macro29},
+	{"XF86Macro3", KeyXF86Macro3},
+	{"XF86Macro30", KeyXF86Macro30},
+	{"XF86Macro4", KeyXF86Macro4},
+	{"XF86Macro5", KeyXF86Macro5},
+	{"XF86Macro6", KeyXF86Macro6},
+	{"XF86Macro7", KeyXF86Macro7},
+	{"XF86Macro8", KeyXF86Macro8},
+	{"XF86Macro9", KeyXF86Macro9},
+	{"XF86MacroPreset1", KeyXF86MacroPreset1},
+	{"XF86MacroPreset2", KeyXF86MacroPreset2},
+	{"XF86MacroPreset3", KeyXF86MacroPreset3},
+	{"XF86MacroPresetCycle", KeyXF86MacroPresetCycle},
+	{"XF86MacroRecordStart", KeyXF86MacroRecordStart},
+	{"XF86MacroRecordStop", KeyXF86MacroRecordStop},
+	{"XF86Mail", KeyXF86Mail},
+	{"XF86MailForward", KeyXF86MailForward},
+	{"XF86Market", KeyXF86Market},
+	{"XF86MediaRepeat", KeyXF86MediaRepeat},
+	{"XF86MediaTopMenu", KeyXF86MediaTopMenu},
+	{"XF86Meeting", KeyXF86Meeting},
+	{"XF86Memo", KeyXF86Memo},
+	{"XF86MenuKB", KeyXF86MenuKB},
+	{"XF86MenuPB", KeyXF86MenuPB},
+	{"XF86Messenger", KeyXF86Messenger},
+	{"XF86ModeLock", KeyXF86ModeLock},
+	{"XF86MonBrightnessCycle", KeyXF86MonBrightnessCycle},
+	{"XF86MonBrightnessDown", KeyXF86MonBrightnessDown},
+	{"XF86MonBrightnessUp", KeyXF86MonBrightnessUp},
+	{"XF86Music", KeyXF86Music},
+	{"XF86MyComputer", KeyXF86MyComputer},
+	{"XF86MySites", KeyXF86MySites},
+	{"XF86New", KeyXF86New},
+	{"XF86News", KeyXF86News},
+	{"XF86NextFavorite", KeyXF86NextFavorite},
+	{"XF86Next_VMode", KeyXF86Next_VMode},
+	{"XF86NotificationCenter", KeyXF86NotificationCenter},
+	{"XF86Numeric0", KeyXF86Numeric0},
+	{"XF86Numeric1", KeyXF86Numeric1},
+	{"XF86Numeric11", KeyXF86Numeric11},
+	{"XF86Numeric12", KeyXF86Numeric12},
+	{"XF86Numeric2", KeyXF86Numeric2},
+	{"XF86Numeric3", KeyXF86Numeric3},
+	{"XF86Numeric4", KeyXF86Numeric4},
+	{"XF86Numeric5", KeyXF86Numeric5},
+	{"XF86Numeric6", KeyXF86Numeric6},
+	{"XF86Numeric7", KeyXF86Numeric7},
+	{"XF86Numeric8", KeyXF86Numeric8},
+	{"XF86Numeric9", KeyXF86Numeric9},
+	{"XF86NumericA", KeyXF86NumericA},
+	{"XF86NumericB", KeyXF86NumericB},
+	{"XF86NumericC", KeyXF86NumericC},
+	{"XF86NumericD", KeyXF86NumericD},
+	{"XF86NumericPound", KeyXF86NumericPound},
+	{"XF86NumericStar", KeyXF86NumericStar},
+	{"XF86OfficeHome", KeyXF86OfficeHome},
+	{"XF86OnScreenKeyboard", KeyXF86OnScreenKeyboard},
+	{"XF86Open", KeyXF86Open},
+	{"XF86OpenURL", KeyXF86OpenURL},
+	{"XF86Option", KeyXF86Option},
+	{"XF86Paste", KeyXF86Paste},
+	{"XF86PauseRecord", KeyXF86PauseRecord},
+	{"XF86Phone", KeyXF86Phone},
+	{"XF86PickupPhone", KeyXF86PickupPhone},
+	{"XF86Pictures", KeyXF86Pictures},
+	{"XF86PowerDown", KeyXF86PowerDown},
+	{"XF86PowerOff", KeyXF86PowerOff},
+	{"XF86Presentation", KeyXF86Presentation},
+	{"XF86Prev_VMode", KeyXF86Prev_VMode},
+	{"XF86PrivacyScreenToggle", KeyXF86PrivacyScreenToggle},
+	{"XF86Q", KeyXF86Q},
+	{"XF86RFKill", KeyXF86RFKill},
+	{"XF86Red", KeyXF86Red},
+	{"XF86Refresh", KeyXF86Refresh},
+	{"XF86Reload", KeyXF86Reload},
+	{"XF86Reply", KeyXF86Reply},
+	{"XF86RightDown", KeyXF86RightDown},
+	{"XF86RightUp", KeyXF86RightUp},
+	{"XF86RockerDown", KeyXF86RockerDown},
+	{"XF86RockerEnter", KeyXF86RockerEnter},
+	{"XF86RockerUp", KeyXF86RockerUp},
+	{"XF86RootMenu", KeyXF86RootMenu},
+	{"XF86RotateWindows", KeyXF86RotateWindows},
+	{"XF86RotationKB", KeyXF86RotationKB},
+	{"XF86RotationLockToggle", KeyXF86RotationLockToggle},
+	{"XF86RotationPB", KeyXF86RotationPB},
+	{"XF86Save", KeyXF86Save},
+	{"XF86ScreenSaver", KeyXF86ScreenSaver},
+	{"XF86Screensaver", KeyXF86Screensaver},
+	{"XF86ScrollClick", KeyXF86ScrollClick},
+	{"XF86ScrollDown", KeyXF86ScrollDown},
+	{"XF86ScrollUp", KeyXF86ScrollUp},
+	{"XF86Search", KeyXF86Search},
+	{"XF86Select", KeyXF86Select},
+	{"XF86SelectiveScreenshot", KeyXF86SelectiveScreenshot},
+	{"XF86Send", KeyXF86Send},
+	{"XF86Shop", KeyXF86Shop},
+	{"XF86Sleep", KeyXF86Sleep},
+	{"XF86SlowReverse", KeyXF86SlowReverse},
+	{"XF86Spell", KeyXF86Spell},
+	{"XF86SpellCheck", KeyXF86SpellCheck},
+	{"XF86SplitScreen", KeyXF86SplitScreen},
+	{"XF86Standby", KeyXF86Standby},
+	{"XF86Start", KeyXF86Start},
+	{"XF86Stop", KeyXF86Stop},
+	{"XF86StopRecord", KeyXF86StopRecord},
+	{"XF86Subtitle", KeyXF86Subtitle},
+	{"XF86Support", KeyXF86Support},
+	{"XF86Suspend", KeyXF86Suspend},
+	{"XF86Switch_VT_1", KeyXF86Switch_VT_1},
+	{"XF86Switch_VT_10", KeyXF86Switch_VT_10},
+	{"XF86Switch_VT_11", KeyXF86Switch_VT_11},
+	{"XF86Switch_VT_12", KeyXF86Switch_VT_12},
+	{"XF86Switch_VT_2", KeyXF86Switch_VT_2},
+	{"XF86Switch_VT_3", KeyXF86Switch_VT_3},
+	{"XF86Switch_VT_4", KeyXF86Switch_VT_4},
+	{"XF86Switch_VT_5", KeyXF86Switch_VT_5},
+	{"XF86Switch_VT_6", KeyXF86Switch_VT_6},
+	{"XF86Switch_VT_7", KeyXF86Switch_VT_7},
+	{"XF86Switch_VT_8", KeyXF86Switch_VT_8},
+	{"XF86Switch_VT_9", KeyXF86Switch_VT_9},
+	{"XF86TaskPane", KeyXF86TaskPane},
+	{"XF86Taskmanager", KeyXF86Taskmanager},
+	{"XF86Terminal", KeyXF86Terminal},
+	{"XF86Time", KeyXF86Time},
+	{"XF86ToDoList", KeyXF86ToDoList},
+	{"XF86Tools", KeyXF86Tools},
+	{"XF86TopMenu", KeyXF86TopMenu},
+	{"XF86TouchpadOff", KeyXF86TouchpadOff},
+	{"XF86TouchpadOn", KeyXF86TouchpadOn},
+	{"XF86TouchpadToggle", KeyXF86TouchpadToggle},
+	{"XF86Travel", KeyXF86Travel},
+	{"XF86UWB", KeyXF86UWB},
+	{"XF86Ungrab", KeyXF86Ungrab},
+	{"XF86Unmute", KeyXF86Unmute},
+	{"XF86User1KB", KeyXF86User1KB},
+	{"XF86User2KB", KeyXF86User2KB},
+	{"XF86UserPB", KeyXF86UserPB},
+	{"XF86VOD", KeyXF86VOD},
+	{"XF86VendorHome", KeyXF86VendorHome},
+	{"XF86Video", KeyXF86Video},
+	{"XF86VideoPhone", KeyXF86VideoPhone},
+	{"XF86View", KeyXF86View},
+	{"XF86VoiceCommand", KeyXF86VoiceCommand},
+	{"XF86Voicemail", KeyXF86Voicemail},
+	{"XF86WLAN", KeyXF86WLAN},
+	{"XF86WPSButton", KeyXF86WPSButton},
+	{"XF86WWAN", KeyXF86WWAN},
+	{"XF86WWW", KeyXF86WWW},
+	{"XF86WakeUp", KeyXF86WakeUp},
+	{"XF86WebCam", KeyXF86WebCam},
+	{"XF86WheelButton", KeyXF86WheelButton},
+	{"XF86Word", KeyXF86Word},
+	{"XF86Xfer", KeyXF86Xfer},
+	{"XF86Yellow", KeyXF86Yellow},
+	{"XF86ZoomIn", KeyXF86ZoomIn},
+	{"XF86ZoomOut", KeyXF86ZoomOut},
+	{"XF86ZoomReset", KeyXF86ZoomReset},
+	{"XF86iTouch", KeyXF86iTouch},
+	{"Xabovedot", KeyXabovedot},
+	{"Y", KeyY},
+	{"Yacute", KeyYacute},
+	{"Ybelowdot", KeyYbelowdot},
+	{"Ycircumflex", KeyYcircumflex},
+	{"Ydiaeresis", KeyYdiaeresis},
+	{"Ygrave", KeyYgrave},
+	{"Yhook", KeyYhook},
+	{"Ytilde", KeyYtilde},
+	{"Z", KeyZ},
+	{"Zabovedot", KeyZabovedot},
+	{"Zacute", KeyZacute},
+	{"Zcaron", KeyZcaron},
+	{"Zen_Koho", KeyZen_Koho},
+	{"Zenkaku", KeyZenkaku},
+	{"Zenkaku_Hankaku", KeyZenkaku_Hankaku},
+	{"Zstroke", KeyZstroke},
+	{"a", Keya},
+	{"aacute", Keyaacute},
+	{"abelowdot", Keyabelowdot},
+	{"abovedot", Keyabovedot},
+	{"abreve", Keyabreve},
+	{"abreveacute", Keyabreveacute},
+	{"abrevebelowdot", Keyabrevebelowdot},
+	{"abrevegrave", Keyabrevegrave},
+	{"abrevehook", Keyabrevehook},
+	{"abrevetilde", Keyabrevetilde},
+	{"acircumflex", Keyacircumflex},
+	{"acircumflexacute", Keyacircumflexacute},
+	{"acircumflexbelowdot", Keyacircumflexbelowdot},
+	{"acircumflexgrave", Keyacircumflexgrave},
+	{"acircumflexhook", Keyacircumflexhook},
+	{"acircumflextilde", Keyacircumflextilde},
+	{"acute", Keyacute},
+	{"adiaeresis", Keyadiaeresis},
+	{"ae", Keyae},
+	{"agrave", Keyagrave},
+	{"ahook", Keyahook},
+	{"amacron", Keyamacron},
+	{"ampersand", Keyampersand},
+	{"aogonek", Keyaogonek},
+	{"apostrophe", Keyapostrophe},
+	{"approxeq", Keyapproxeq},
+	{"approximate", Keyapproximate},
+	{"aring", Keyaring},
+	{"asciicircum", Keyasciicircum},
+	{"asciitilde", Keyasciitilde},
+	{"asterisk", Keyasterisk},
+	{"at", Keyat},
+	{"atilde", Keyatilde},
+	{"b", Keyb},
+	{"babovedot", Keybabovedot},
+	{"backslash", Keybackslash},
+	{"ballotcross", Keyballotcross},
+	{"bar", Keybar},
+	{"because", Keybecause},
+	{"blank", Keyblank},
+	{"block", Keyblock},
+	{"botintegral", Keybotintegral},
+	{"botleftparens", Keybotleftparens},
+	{"botleftsqbracket", Keybotleftsqbracket},
+	{"botleftsummation", Keybotleftsummation},
+	{"botrightparens", Keybotrightparens},
+	{"botrightsqbracket", Keybotrightsqbracket},
+	{"botrightsummation", Keybotrightsummation},
+	{"bott", Keybott},
+	{"botvertsummationconnector", Keybotvertsummationconnector},
+	{"braceleft", Keybraceleft},
+	{"braceright", Keybraceright},
+	{"bracketleft", Keybracketleft},
+	{"bracketright", Keybracketright},
+	{"braille_blank", Keybraille_blank},
+	{"braille_dot_1", Keybraille_dot_1},
+	{"braille_dot_10", Keybraille_dot_10},
+	{"braille_dot_2", Keybraille_dot_2},
+	{"braille_dot_3", Keybraille_dot_3},
+	{"braille_dot_4", Keybraille_dot_4},
+	{"braille_dot_5", Keybraille_dot_5},
+	{"braille_dot_6", Keybraille_dot_6},
+	{"braille_dot_7", Keybraille_dot_7},
+	{"braille_dot_8", Keybraille_dot_8},
+	{"braille_dot_9", Keybraille_dot_9},
+	{"braille_dots_1", Keybraille_dots_1},
+	{"braille_dots_12", Keybraille_dots_12},
+	{"braille_dots_123", Keybraille_dots_123},
+	{"braille_dots_1234", Keybraille_dots_1234},
+	{"braille_dots_12345", Keybraille_dots_12345},
+	{"braille_dots_123456", Keybraille_dots_123456},
+	{"braille_dots_1234567", Keybraille_dots_1234567},
+	{"braille_dots_12345678", Keybraille_dots_12345678},
+	{"braille_dots_1234568", Keybraille_dots_1234568},
+	{"braille_dots_123457", Keybraille_dots_123457},
+	{"braille_dots_1234578", Keybraille_dots_1234578},
+	{"braille_dots_123458", Keybraille_dots_123458},
+	{"braille_dots_12346", Keybraille_dots_12346},
+	{"braille_dots_123467", Keybraille_dots_123467},
+	{"braille_dots_1234678", Keybraille_dots_1234678},
+	{"braille_dots_123468", Keybraille_dots_123468},
+	{"braille_dots_12347", Keybraille_dots_12347},
+	{"braille_dots_123478", Keybraille_dots_123478},
+	{"braille_dots_12348", Keybraille_dots_12348},
+	{"braille_dots_1235", Keybraille_dots_1235},
+	{"braille_dots_12356", Keybraille_dots_12356},
+	{"braille_dots_123567", Keybraille_dots_123567},
+	{"braille_dots_1235678", Keybraille_dots_1235678},
+	{"braille_dots_123568", Keybraille_dots_123568},
+	{"braille_dots_12357", Keybraille_dots_12357},
+	{"braille_dots_123578", Keybraille_dots_123578},
+	{"braille_dots_12358", Keybraille_dots_12358},
+	{"braille_dots_1236", Keybraille_dots_1236},
+	{"braille_dots_12367", Keybraille_dots_12367},
+	{"braille_dots_123678", Keybraille_dots_123678},
+	{"braille_dots_12368", Keybraille_dots_12368},
+	{"braille_dots_1237", Keybraille_dots_1237},
+	{"braille_dots_12378", Keybraille_dots_12378},
+	{"braille_dots_1238", Keybraille_dots_1238},
+	{"braille_dots_124", Keybraille_dots_124},
+	{"braille_dots_1245", Keybraille_dots_1245},
+	{"braille_dots_12456", Keybraille_dots_12456},
+	{"braille_dots_124567", Keybraille_dots_124567},
+	{"braille_dots_1245678", Keybraille_dots_1245678},
+	{"braille_dots_124568", Keybraille_dots_124568},
+	{"braille_dots_12457", Keybraille_dots_12457},
+	{"braille_dots_124578", Keybraille_dots_124578},
+	{"braille_dots_12458", Keybraille_dots_12458},
+	{"braille_dots_1246", Keybraille_dots_1246},
+	{"braille_dots_12467", Keybraille_dots_12467},
+	{"braille_dots_124678", Keybraille_dots_124678},
+	{"braille_dots_12468", Keybraille_dots_12468},
+	{"braille_dots_1247", Keybraille_dots_1247},
+	{"braille_dots_12478", Keybraille_dots_12478},
+	{"braille_dots_1248", Keybraille_dots_1248},
+	{"braille_dots_125", Keybraille_dots_125},
+	{"braille_dots_1256", Keybraille_dots_1256},
+	{"braille_dots_12567", Keybraille_dots_12567},
+	{"braille_dots_125678", Keybraille_dots_125678},
+	{"braille_dots_12568", Keybraille_dots_12568},
+	{"braille_dots_1257", Keybraille_dots_1257},
+	{"braille_dots_12578", Keybraille_dots_12578},
+	{"braille_dots_1258", Keybraille_dots_1258},
+	{"braille_dots_126", Keybraille_dots_126},
+	{"braille_dots_1267", Keybraille_dots_1267},
+	{"braille_dots_12678", Keybraille_dots_12678},
+	{"braille_dots_1268", Keybraille_dots_1268},
+	{"braille_dots_127", Keybraille_dots_127},
+	{"braille_dots_1278", Keybraille_dots_1278},
+	{"braille_dots_128", Keybraille_dots_128},
+	{"braille_dots_13", Keybraille_dots_13},
+	{"braille_dots_134", Keybraille_dots_134},
+	{"braille_dots_1345", Keybraille_dots_1345},
+	{"braille_dots_13456", Keybraille_dots_13456},
+	{"braille_dots_134567", Keybraille_dots_134567},
+	{"braille_dots_1345678", Keybraille_dots_1345678},
+	{"braille_dots_134568", Keybraille_dots_134568},
+	{"braille_dots_13457", Keybraille_dots_13457},
+	{"braille_dots_134578", Keybraille_dots_134578},
+	{"braille_dots_13458", Keybraille_dots_13458},
+	{"braille_dots_1346", Keybraille_dots_1346},
+	{"braille_dots_13467", Keybraille_dots_13467},
+	{"braille_dots_134678", Keybraille_dots_134678},
+	{"braille_dots_13468", Keybraille_dots_13468},
+	{"braille_dots_1347", Keybraille_dots_1347},
+	{"braille_dots_13478", Keybraille_dots_13478},
+	{"braille_dots_1348", Keybraille_dots_1348},
+	{"braille_dots_135", Keybraille_dots_135},
+	{"braille_dots_1356", Keybraille_dots_1356},
+	{"braille_dots_13567", Keybraille_dots_13567},
+	{"braille_dots_135678", Keybraille_dots_135678},
+	{"braille_dots_13568", Keybraille_dots_13568},
+	{"braille_dots_1357", Keybraille_dots_1357},
+	{"braille_dots_13578", Keybraille_dots_13578},
+	{"braille_dots_1358", Keybraille_dots_1358},
+	{"braille_dots_136", Keybraille_dots_136},
+	{"braille_dots_1367", Keybraille_dots_1367},
+	{"braille_dots_13678", Keybraille_dots_13678},
+	{"braille_dots_1368", Keybraille_dots_1368},
+	{"braille_dots_137", Keybraille_dots_137},
+	{"braille_dots_1378", Keybraille_dots_1378},
+	{"braille_dots_138", Keybraille_dots_138},
+	{"braille_dots_14", Keybraille_dots_14},
+	{"braille_dots_145", Keybraille_dots_145},
+	{"braille_dots_1456", Keybraille_dots_1456},
+	{"braille_dots_14567", Keybraille_dots_14567},
+	{"braille_dots_145678", Keybraille_dots_145678},
+	{"braille_dots_14568", Keybraille_dots_14568},
+	{"braille_dots_1457", Keybraille_dots_1457},
+	{"braille_dots_14578", Keybraille_dots_14578},
+	{"braille_dots_1458", Keybraille_dots_1458},
+	{"braille_dots_146", Keybraille_dots_146},
+	{"braille_dots_1467", Keybraille_dots_1467},
+	{"braille_dots_14678", Keybraille_dots_14678},
+	{"braille_dots_1468", Keybraille_dots_1468},
+	{"braille_dots_147", Keybraille_dots_147},
+	{"braille_dots_1478", Keybraille_dots_1478},
+	{"braille_dots_148", Keybraille_dots_148},
+	{"braille_dots_15", Keybraille_dots_15},
+	{"braille_dots_156", Keybraille_dots_156},
+	{"braille_dots_1567", Keybraille_dots_1567},
+	{"braille_dots_15678", Keybraille_dots_15678},
+	{"braille_dots_1568", Keybraille_dots_1568},
+	{"braille_dots_157", Keybraille_dots_157},
+	{"braille_dots_1578", Keybraille_dots_1578},
+	{"braille_dots_158", Keybraille_dots_158},
+	{"braille_dots_16", Keybraille_dots_16},
+	{"braille_dots_167", Keybraille_dots_167},
+	{"braille_dots_1678", Keybraille_dots_1678},
+	{"braille_dots_168", Keybraille_dots_168},
+	{"braille_dots_17", Keybraille_dots_17},
+	{"braille_dots_178", Keybraille_dots_178},
+	{"braille_dots_18", Keybraille_dots_18},
+	{"braille_dots_2", Keybraille_dots_2},
+	{"braille_dots_23", Keybraille_dots_23},
+	{"braille_dots_234", Keybraille_dots_234},
+	{"braille_dots_2345", Keybraille_dots_2345},
+	{"braille_dots_23456", Keybraille_dots_23456},
+	{"braille_dots_234567", Keybraille_dots_234567},
+	{"braille_dots_2345678", Keybraille_dots_2345678},
+	{"braille_dots_234568", Keybraille_dots_234568},
+	{"braille_dots_23457", Keybraille_dots_23457},
+	{"braille_dots_234578", Keybraille_dots_234578},
+	{"braille_dots_23458", Keybraille_dots_23458},
+	{"braille_dots_2346", Keybraille_dots_2346},
+	{"braille_dots_23467", Keybraille_dots_23467},
+	{"braille_dots_234678", Keybraille_dots_234678},
+	{"braille_dots_23468", Keybraille_dots_23468},
+	{"braille_dots_2347", Keybraille_dots_2347},
+	{"braille_dots_23478", Keybraille_dots_23478},
+	{"braille_dots_2348", Keybraille_dots_2348},
+	{"braille_dots_235", Keybraille_dots_235},
+	{"braille_dots_2356", Keybraille_dots_2356},
+	{"braille_dots_23567", Keybraille_dots_23567},
+	{"braille_dots_235678", Keybraille_dots_235678},
+	{"braille_dots_23568", Keybraille_dots_23568},
+	{"braille_dots_2357", Keybraille_dots_2357},
+	{"braille_dots_23578", Keybraille_dots_23578},
+	{"braille_dots_2358", Keybraille_dots_2358},
+	{"braille_dots_236", Keybraille_dots_236},
+	{"braille_dots_2367", Keybraille_dots_2367},
+	{"braille_dots_23678", Keybraille_dots_23678},
+	{"braille_dots_2368", Keybraille_dots_2368},
+	{"braille_dots_237", Keybraille_dots_237},
+	{"braille_dots_2378", Keybraille_dots_2378},
+	{"braille_dots_238", Keybraille_dots_238},
+	{"braille_dots_24", Keybraille_dots_24},
+	{"braille_dots_245", Keybraille_dots_245},
+	{"braille_dots_2456", Keybraille_dots_2456},
+	{"braille_dots_24567", Keybraille_dots_24567},
+	{"braille_dots_245678", Keybraille_dots_245678},
+	{"braille_dots_24568", Keybraille_dots_24568},
+	{"braille_dots_2457", Keybraille_dots_2457},
+	{"braille_dots_24578", Keybraille_dots_24578},
+	{"braille_dots_2458", Keybraille_dots_2458},
+	{"braille_dots_246", Keybraille_dots_246},
+	{"braille_dots_2467", Keybraille_dots_2467},
+	{"braille_dots_24678", Keybraille_dots_24678},
+	{"braille_dots_2468", Keybraille_dots_2468},
+	{"braille_dots_247", Keybraille_dots_247},
+	{"braille_dots_2478", Keybraille_dots_2478},
+	{"braille_dots_248", Keybraille_dots_248},
+	{"braille_dots_25", Keybraille_dots_25},
+	{"braille_dots_256", Keybraille_dots_256},
+	{"braille_dots_2567", Keybraille_dots_2567},
+	{"braille_dots_25678", Keybraille_dots_25678},
+	{"braille_dots_2568", Keybraille_dots_2568},
+	{"braille_dots_257", Keybraille_dots_257},
+	{"braille_dots_2578", Keybraille_dots_2578},
+	{"braille_dots_258", Keybraille_dots_258},
+	{"braille_dots_26", Keybraille_dots_26},
+	{"braille_dots_267", Keybraille_dots_267},
+	{"braille_dots_2678", Keybraille_dots_2678},
+	{"braille_dots_268", Keybraille_dots_268},
+	{"braille_dots_27", Keybraille_dots_27},
+	{"braille_dots_278", Keybraille_dots_278},
+	{"braille_dots_28", Keybraille_dots_28},
+	{"braille_dots_3", Keybraille_dots_3},
+	{"braille_dots_34", Keybraille_dots_34},
+	{"braille_dots_345", Keybraille_dots_345},
+	{"braille_dots_3456", Keybraille_dots_3456},
+	{"braille_dots_34567", Keybraille_dots_34567},
+	{"braille_dots_345678", Keybraille_dots_345678},
+	{"braille_dots_34568", Keybraille_dots_34568},
+	{"braille_dots_3457", Keybraille_dots_3457},
+	{"braille_dots_34578", Keybraille_dots_34578},
+	{"braille_dots_3458", Keybraille_dots_3458},
+	{"braille_dots_346", Keybraille_dots_346},
+	{"braille_dots_3467", Keybraille_dots_3467},
+	{"braille_dots_34678", Keybraille_dots_34678},
+	{"braille_dots_3468", Keybraille_dots_3468},
+	{"braille_dots_347", Keybraille_dots_347},
+	{"braille_dots_3478", Keybraille_dots_3478},
+	{"braille_dots_348", Keybraille_dots_348},
+	{"braille_dots_35", Keybraille_dots_35},
+	{"braille_dots_356", Keybraille_dots_356},
+	{"braille_dots_3567", Keybraille_dots_3567},
+	{"braille_dots_35678", Keybraille_dots_35678},
+	{"braille_dots_3568", Keybraille_dots_3568},
+	{"braille_dots_357", Keybraille_dots_357},
+	{"braille_dots_3578", Keybraille_dots_3578},
+	{"braille_dots_358", Keybraille_dots_358},
+	{"braille_dots_36", Keybraille_dots_36},
+	{"braille_dots_367", Keybraille_dots_367},
+	{"braille_dots_3678", Keybraille_dots_3678},
+	{"braille_dots_368", Keybraille_dots_368},
+	{"braille_dots_37", Keybraille_dots_37},
+	{"braille_dots_378", Keybraille_dots_378},
+	{"braille_dots_38", Keybraille_dots_38},
+	{"braille_dots_4", Keybraille_dots_4},
+	{"braille_dots_45", Keybraille_dots_45},
+	{"braille_dots_456", Keybraille_dots_456},
+	{"braille_dots_4567", Keybraille_dots_4567},
+	{"braille_dots_45678", Keybraille_dots_45678},
+	{"braille_dots_4568", Keybraille_dots_4568},
+	{"braille_dots_457", Keybraille_dots_457},
+	{"braille_dots_4578", Keybraille_dots_4578},
+	{"braille_dots_458", Keybraille_dots_458},
+	{"braille_dots_46", Keybraille_dots_46},
+	{"braille_dots_467", Keybraille_dots_467},
+	{"braille_dots_4678", Keybraille_dots_4678},
+	{"braille_dots_468", Keybraille_dots_468},
+	{"braille_dots_47", Keybraille_dots_47},
+	{"braille_dots_478", Keybraille_dots_478},
+	{"braille_dots_48", Keybraille_dots_48},
+	{"braille_dots_5", Keybraille_dots_5},
+	{"braille_dots_56", Keybraille_dots_56},
+	{"braille_dots_567", Keybraille_dots_567},
+	{"braille_dots_5678", Keybraille_dots_5678},
+	{"braille_dots_568", Keybraille_dots_568},
+	{"braille_dots_57", Keybraille_dots_57},
+	{"braille_dots_578", Keybraille_dots_578},
+	{"braille_dots_58", Keybraille_dots_58},
+	{"braille_dots_6", Keybraille_dots_6},
+	{"braille_dots_67", Keybraille_dots_67},
+	{"braille_dots_678", Keybraille_dots_678},
+	{"braille_dots_68", Keybraille_dots_68},
+	{"braille_dots_7", Keybraille_dots_7},
+	{"braille_dots_78", Keybraille_dots_78},
+	{"braille_dots_8", Keybraille_dots_8},
+	{"breve", Keybreve},
+	{"brokenbar", Keybrokenbar},
+	{"c", Keyc},
+	{"c_h", Keyc_h},
+	{"cabovedot", Keycabovedot},
+	{"cacute", Keycacute},
+	{"careof", Keycareof},
+	{"caret", Keycaret},
+	{"caron", Keycaron},
+	{"ccaron", Keyccaron},
+	{"ccedilla", Keyccedilla},
+	{"ccircumflex", Keyccircumflex},
+	{"cedilla", Keycedilla},
+	{"cent", Keycent},
+	{"ch", Keych},
+	{"checkerboard", Keycheckerboard},
+	{"checkmark", Keycheckmark},
+	{"circle", Keycircle},
+	{"club", Keyclub},
+	{"colon", Keycolon},
+	{"combining_acute", Keycombining_acute},
+	{"combining_belowdot", Keycombining_belowdot},
+	{"combining_grave", Keycombining_grave},
+	{"combining_hook", Keycombining_hook},
+	{"combining_tilde", Keycombining_tilde},
+	{"comma", Keycomma},
+	{"containsas", Keycontainsas},
+	{"copyright", Keycopyright},
+	{"cr", Keycr},
+	{"crossinglines", Keycrossinglines},
+	{"cuberoot", Keycuberoot},
+	{"currency", Keycurrency},
+	{"cursor", Keycursor},
+	{"d", Keyd},
+	{"dabovedot", Keydabovedot},
+	{"dagger", Keydagger},
+	{"dcaron", Keydcaron},
+	{"dead_A", Keydead_A},
+	{"dead_E", Keydead_E},
+	{"dead_I", Keydead_I},
+	{"dead_O", Keydead_O},
+	{"dead_U", Keydead_U},
+	{"dead_a", Keydead_a},
+	{"dead_abovecomma", Keydead_abovecomma},
+	{"dead_abovedot", Keydead_abovedot},
+	{"dead_abovereversedcomma", Keydead_abovereversedcomma},
+	{"dead_abovering", Keydead_abovering},
+	{"dead_aboveverticalline", Keydead_aboveverticalline},
+	{"dead_acute", Keydead_acute},
+	{"dead_belowbreve", Keydead_belowbreve},
+	{"dead_belowcircumflex", Keydead_belowcircumflex},
+	{"dead_belowcomma", Keydead_belowcomma},
+	{"dead_belowdiaeresis", Keydead_belowdiaeresis},
+	{"dead_belowdot", Keydead_belowdot},
+	{"dead_belowmacron", Keydead_belowmacron},
+	{"dead_belowring", Keydead_belowring},
+	{"dead_belowtilde", Keydead_belowtilde},
+	{"dead_belowverticalline", Keydead_belowverticalline},
+	{"dead_breve", Keydead_breve},
+	{"dead_capital_schwa", Keydead_capital_schwa},
+	{"dead_caron", Keydead_caron},
+	{"dead_cedilla", Keydead_cedilla},
+	{"dead_circumflex", Keydead_circumflex},
+	{"dead_currency", Keydead_currency},
+	{"dead_dasia", Keydead_dasia},
+	{"dead_diaeresis", Keydead_diaeresis},
+	{"dead_doubleacute", Keydead_doubleacute},
+	{"dead_doublegrave", Keydead_doublegrave},
+	{"dead_e", Keydead_e},
+	{"dead_grave", Keydead_grave},
+	{"dead_greek", Keydead_greek},
+	{"dead_hook", Keydead_hook},
+	{"dead_horn", Keydead_horn},
+	{"dead_i", Keydead_i},
+	{"dead_invertedbreve", Keydead_invertedbreve},
+	{"dead_iota", Keydead_iota},
+	{"dead_longsolidusoverlay", Keydead_longsolidusoverlay},
+	{"dead_lowline", Keydead_lowline},
+	{"dead_macron", Keydead_macron},
+	{"dead_o", Keydead_o},
+	{"dead_ogonek", Keydead_ogonek},
+	{"dead_perispomeni", Keydead_perispomeni},
+	{"dead_psili", Keydead_psili},
+	{"dead_semivoiced_sound", Keydead_semivoiced_sound},
+	{"dead_small_schwa", Keydead_small_schwa},
+	{"dead_stroke", Keydead_stroke},
+	{"dead_tilde", Keydead_tilde},
+	{"dead_u", Keydead_u},
+	{"dead_voiced_sound", Keydead_voiced_sound},
+	{"decimalpoint", Keydecimalpoint},
+	{"degree", Keydegree},
+	{"diaeresis", Keydiaeresis},
+	{"diamond", Keydiamond},
+	{"digitspace", Keydigitspace},
+	{"dintegral", Keydintegral},
+	{"division", Keydivision},
+	{"dollar", Keydollar},
+	{"doubbaselinedot", Keydoubbaselinedot},
+	{"doubleacute", Keydoubleacute},
+	{"doubledagger", Keydoubledagger},
+	{"doublelowquotemark", Keydoublelowquotemark},
+	{"downarrow", Keydownarrow},
+	{"downcaret", Keydowncaret},
+	{"downshoe", Keydownshoe},
+	{"downstile", Keydownstile},
+	{"downtack", Keydowntack},
+	{"dstroke", Keydstroke},
+	{"e", Keye},
+	{"eabovedot", Keyeabovedot},
+	{"eacute", Keyeacute},
+	{"ebelowdot", Keyebelowdot},
+	{"ecaron", Keyecaron},
+	{"ecircumflex", Keyecircumflex},
+	{"ecircumflexacute", Keyecircumflexacute},
+	{"ecircumflexbelowdot", Keyecircumflexbelowdot},
+	{"ecircumflexgrave", Keyecircumflexgrave},
+	{"ecircumflexhook", Keyecircumflexhook},
+	{"ecircumflextilde", Keyecircumflextilde},
+	{"ediaeresis", Keyediaeresis},
+	{"egrave", Keyegrave},
+	{"ehook", Keyehook},
+	{"eightsubscript", Keyeightsubscript},
+	{"eightsuperior", Keyeightsuperior},
+	{"elementof", Keyelementof},
+	{"ellipsis", Keyellipsis},
+	{"em3space", Keyem3space},
+	{"em4space", Keyem4space},
+	{"emacron", Keyemacron},
+	{"emdash", Keyemdash},
+	{"emfilledcircle", Keyemfilledcircle},
+	{"emfilledrect", Keyemfilledrect},
+	{"emopencircle", Keyemopencircle},
+	{"emopenrectangle", Keyemopenrectangle},
+	{"emptyset", Keyemptyset},
+	{"emspace", Keyemspace},
+	{"endash", Keyendash},
+	{"enfilledcircbullet", Keyenfilledcircbullet},
+	{"enfilledsqbullet", Keyenfilledsqbullet},
+	{"eng", Keyeng},
+	{"enopencircbullet", Keyenopencircbullet},
+	{"enopensquarebullet", Keyenopensquarebullet},
+	{"enspace", Keyenspace},
+	{"eogonek", Keyeogonek},
+	{"equal", Keyequal},
+	{"eth", Keyeth},
+	{"etilde", Keyetilde},
+	{"exclam", Keyexclam},
+	{"exclamdown", Keyexclamdown},
+	{"ezh", Keyezh},
+	{"f", Keyf},
+	{"fabovedot", Keyfabovedot},
+	{"femalesymbol", Keyfemalesymbol},
+	{"ff", Keyff},
+	{"figdash", Keyfigdash},
+	{"filledlefttribullet", Keyfilledlefttribullet},
+	{"filledrectbullet", Keyfilledrectbullet},
+	{"filledrighttribullet", Keyfilledrighttribullet},
+	{"filledtribulletdown", Keyfilledtribulletdown},
+	{"filledtribulletup", Keyfilledtribulletup},
+	{"fiveeighths", Keyfiveeighths},
+	{"fivesixths", Keyfivesixths},
+	{"fivesubscript", Keyfivesubscript},
+	{"fivesuperior", Keyfivesuperior},
+	{"fourfifths", Keyfourfifths},
+	{"foursubscript", Keyfoursubscript},
+	{"foursuperior", Keyfoursuperior},
+	{"fourthroot", Keyfourthroot},
+	{"function", Keyfunction},
+	{"g", Keyg},
+	{"gabovedot", Keygabovedot},
+	{"gbreve", Keygbreve},
+	{"gcaron", Keygcaron},
+	{"gcedilla", Keygcedilla},
+	{"gcircumflex", Keygcircumflex},
+	{"grave", Keygrave},
+	{"greater", Keygreater},
+	{"greaterthanequal", Keygreaterthanequal},
+	{"guilder", Keyguilder},
+	{"guillemotleft", Keyguillemotleft},
+	{"guillemotright", Keyguillemotright},
+	{"h", Keyh},
+	{"hairspace", Keyhairspace},
+	{"hcircumflex", Keyhcircumflex},
+	{"heart", Keyheart},
+	{"hebrew_aleph", Keyhebrew_aleph},
+	{"hebrew_ayin", Keyhebrew_ayin},
+	{"hebrew_bet", Keyhebrew_bet},
+	{"hebrew_beth", Keyhebrew_beth},
+	{"hebrew_chet", Keyhebrew_chet},
+	{"hebrew_dalet", Keyhebrew_dalet},
+	{"hebrew_daleth", Keyhebrew_daleth},
+	{"hebrew_doublelowline", Keyhebrew_doublelowline},
+	{"hebrew_finalkaph", Keyhebrew_finalkaph},
+	{"hebrew_finalmem", Keyhebrew_finalmem},
+	{"hebrew_finalnun", Keyhebrew_finalnun},
+	{"hebrew_finalpe", Keyhebrew_finalpe},
+	{"hebrew_finalzade", Keyhebrew_finalzade},
+	{"hebrew_finalzadi", Keyhebrew_finalzadi},
+	{"hebrew_gimel", Keyhebrew_gimel},
+	{"hebrew_gimmel", Keyhebrew_gimmel},
+	{"hebrew_he", Keyhebrew_he},
+	{"hebrew_het", Keyhebrew_het},
+	{"hebrew_kaph", Keyhebrew_kaph},
+	{"hebrew_kuf", Keyhebrew_kuf},
+	{"hebrew_lamed", Keyhebrew_lamed},
+	{"hebrew_mem", Keyhebrew_mem},
+	{"hebrew_nun", Keyhebrew_nun},
+	{"hebrew_pe", Keyhebrew_pe},
+	{"hebrew_qoph", Keyhebrew_qoph},
+	{"hebrew_resh", Keyhebrew_resh},
+	{"hebrew_samech", Keyhebrew_samech},
+	{"hebrew_samekh", Keyhebrew_samekh},
+	{"hebrew_shin", Keyhebrew_shin},
+	{"hebrew_taf", Keyhebrew_taf},
+	{"hebrew_taw", Keyhebrew_taw},
+	{"hebrew_tet", Keyhebrew_tet},
+	{"hebrew_teth", Keyhebrew_teth},
+	{"hebrew_waw", Keyhebrew_waw},
+	{"hebrew_yod", Keyhebrew_yod},
+	{"hebrew_zade", Keyhebrew_zade},
+	{"hebrew_zadi", Keyhebrew_zadi},
+	{"hebrew_zain", Keyhebrew_zain},
+	{"hebrew_zayin", Keyhebrew_zayin},
+	{"hexagram", Keyhexagram},
+	{"horizconnector", Keyhorizconnector},
+	{"horizlinescan1", Keyhorizlinescan1},
+	{"horizlinescan3", Keyhorizlinescan3},
+	{"horizlinescan5", Keyhorizlinescan5},
+	{"horizlinescan7", Keyhorizlinescan7},
+	{"horizlinescan9", Keyhorizlinescan9},
+	{"hpBackTab", KeyhpBackTab},
+	{"hpClearLine", KeyhpClearLine},
+	{"hpDeleteChar", KeyhpDeleteChar},
+	{"hpDeleteLine", KeyhpDeleteLine},
+	{"hpIO", KeyhpIO},
+	{"hpInsertChar", KeyhpInsertChar},
+	{"hpInsertLine", KeyhpInsertLine},
+	{"hpKP_BackTab", KeyhpKP_BackTab},
+	{"hpModelock1", KeyhpModelock1},
+	{"hpModelock2", KeyhpModelock2},
+	{"hpReset", KeyhpReset},
+	{"hpSystem", KeyhpSystem},
+	{"hpUser", KeyhpUser},
+	{"hpYdiaeresis", KeyhpYdiaeresis},
+	{"hpblock", Keyhpblock},
+	{"hpguilder", Keyhpguilder},
+	{"hplira", Keyhplira},
+	{"hplongminus", Keyhplongminus},
+	{"hpmute_acute", Keyhpmute_acute},
+	{"hpmute_asciicircum", Keyhpmute_asciicircum},
+	{"hpmute_asciitilde", Keyhpmute_asciitilde},
+	{"hpmute_diaeresis", Keyhpmute_diaeresis},
+	{"hpmute_grave", Keyhpmute_grave},
+	{"hstroke", Keyhstroke},
+	{"ht", Keyht},
+	{"hyphen", Keyhyphen},
+	{"i", Keyi},
+	{"iacute", Keyiacute},
+	{"ibelowdot", Keyibelowdot},
+	{"ibreve", Keyibreve},
+	{"icircumflex", Keyicircumflex},
+	{"identical", Keyidentical},
+	{"idiaeresis", Keyidiaeresis},
+	{"idotless", Keyidotless},
+	{"ifonlyif", Keyifonlyif},
+	{"igrave", Keyigrave},
+	{"ihook", Keyihook},
+	{"imacron", Keyimacron},
+	{"implies", Keyimplies},
+	{"includedin", Keyincludedin},
+	{"includes", Keyincludes},
+	{"infinity", Keyinfinity},
+	{"integral", Keyintegral},
+	{"intersection", Keyintersection},
+	{"iogonek", Keyiogonek},
+	{"itilde", Keyitilde},
+	{"j", Keyj},
+	{"jcircumflex", Keyjcircumflex},
+	{"jot", Keyjot},
+	{"k", Keyk},
+	{"kana_A", Keykana_A},
+	{"kana_CHI", Keykana_CHI},
+	{"kana_E", Keykana_E},
+	{"kana_FU", Keykana_FU},
+	{"kana_HA", Keykana_HA},
+	{"kana_HE", Keykana_HE},
+	{"kana_HI", Keykana_HI},
+	{"kana_HO", Keykana_HO},
+	{"kana_HU", Keykana_HU},
+	{"kana_I", Keykana_I},
+	{"kana_KA", Keykana_KA},
+	{"kana_KE", Keykana_KE},
+	{"kana_KI", Keykana_KI},
+	{"kana_KO", Keykana_KO},
+	{"kana_KU", Keykana_KU},
+	{"kana_MA", Keykana_MA},
+	{"kana_ME", Keykana_ME},
+	{"kana_MI", Keykana_MI},
+	{"kana_MO", Keykana_MO},
+	{"kana_MU", Keykana_MU},
+	{"kana_N", Keykana_N},
+	{"kana_NA", Keykana_NA},
+	{"kana_NE", Keykana_NE},
+	{"kana_NI", Keykana_NI},
+	{"kana_NO", Keykana_NO},
+	{"kana_NU", Keykana_NU},
+	{"kana_O", Keykana_O},
+	{"kana_RA", Keykana_RA},
+	{"kana_RE", Keykana_RE},
+	{"kana_RI", Keykana_RI},
+	{"kana_RO", Keykana_RO},
+	{"kana_RU", Keykana_RU},
+	{"kana_SA", Keykana_SA},
+	{"kana_SE", Keykana_SE},
+	{"kana_SHI", Keykana_SHI},
+	{"kana_SO", Keykana_SO},
+	{"kana_SU", Keykana_SU},
+	{"kana_TA", Keykana_TA},
+	{"kana_TE", Keykana_TE},
+	{"kana_TI", Keykana_TI},
+	{"kana_TO", Keykana_TO},
+	{"kana_TSU", Keykana_TSU},
+	{"kana_TU", Keykana_TU},
+	{"kana_U", Keykana_U},
+	{"kana_WA", Keykana_WA},
+	{"kana_WO", Keykana_WO},
+	{"kana_YA", Keykana_YA},
+	{"kana_YO", Keykana_YO},
+	{"kana_YU", Keykana_YU},
+	{"kana_a", Keykana_a},
+	{"kana_closingbracket", Keykana_closingbracket},
+	{"kana_comma", Keykana_comma},
+	{"kana_conjunctive", Keykana_conjunctive},
+	{"kana_e", Keykana_e},
+	{"kana_fullstop", Keykana_fullstop},
+	{"kana_i", Keykana_i},
+	{"kana_middledot", Keykana_middledot},
+	{"kana_o", Keykana_o},
+	{"kana_openingbracket", Keykana_openingbracket},
+	{"kana_switch", Keykana_switch},
+	{"kana_tsu", Keykana_tsu},
+	{"kana_tu", Keykana_tu},
+	{"kana_u", Keykana_u},
+	{"kana_ya", Keykana_ya},
+	{"kana_yo", Keykana_yo},
+	{"kana_yu", Keykana_yu},
+	{"kappa", Keykappa},
+	{"kcedilla", Keykcedilla},
+	{"kra", Keykra},
+	{"l", Keyl},
+	{"lacute", Keylacute},
+	{"latincross", Keylatincross},
+	{"lbelowdot", Keylbelowdot},
+	{"lcaron", Keylcaron},
+	{"lcedilla", Keylcedilla},
+	{"leftanglebracket", Keyleftanglebracket},
+	{"leftarrow", Keyleftarrow},
+	{"leftcaret", Keyleftcaret},
+	{"leftdoublequotemark", Keyleftdoublequotemark},
+	{"leftmiddlecurlybrace", Keyleftmiddlecurlybrace},
+	{"leftopentriangle", Keyleftopentriangle},
+	{"leftpointer", Keyleftpointer},
+	{"leftradical", Keyleftradical},
+	{"leftshoe", Keyleftshoe},
+	{"leftsinglequotemark", Keyleftsinglequotemark},
+	{"leftt", Keyleftt},
+	{"lefttack", Keylefttack},
+	{"less", Keyless},
+	{"lessthanequal", Keylessthanequal},
+	{"lf", Keylf},
+	{"lira", Keylira},
+	{"logicaland", Keylogicaland},
+	{"logicalor", Keylogicalor},
+	{"longminus", Keylongminus},
+	{"lowleftcorner", Keylowleftcorner},
+	{"lowrightcorner", Keylowrightcorner},
+	{"lstroke", Keylstroke},
+	{"m", Keym},
+	{"mabovedot", Keymabovedot},
+	{"macron", Keymacron},
+	{"malesymbol", Keymalesymbol},
+	{"maltesecross", Keymaltesecross},
+	{"marker", Keymarker},
+	{"masculine", Keymasculine},
+	{"minus", Keyminus},
+	{"minutes", Keyminutes},
+	{"mu", Keymu},
+	{"multiply", Keymultiply},
+	{"musicalflat", Keymusicalflat},
+	{"musicalsharp", Keymusicalsharp},
+	{"mute_acute", Keymute_acute},
+	{"mute_asciicircum", Keymute_asciicircum},
+	{"mute_asciitilde", Keymute_asciitilde},
+	{"mute_diaeresis", Keymute_diaeresis},
+	{"mute_grave", Keymute_grave},
+	{"n", Keyn},
+	{"nabla", Keynabla},
+	{"nacute", Keynacute},
+	{"ncaron", Keyncaron},
+	{"ncedilla", Keyncedilla},
+	{"ninesubscript", Keyninesubscript},
+	{"ninesuperior", Keyninesuperior},
+	{"nl", Keynl},
+	{"nobreakspace", Keynobreakspace},
+	{"notapproxeq", Keynotapproxeq},
+	{"notelementof", Keynotelementof},
+	{"notequal", Keynotequal},
+	{"notidentical", Keynotidentical},
+	{"notsign", Keynotsign},
+	{"ntilde", Keyntilde},
+	{"numbersign", Keynumbersign},
+	{"numerosign", Keynumerosign},
+	{"o", Keyo},
+	{"oacute", Keyoacute},
+	{"obarred", Keyobarred},
+	{"obelowdot", Keyobelowdot},
+	{"ocaron", Keyocaron},
+	{"ocircumflex", Keyocircumflex},
+	{"ocircumflexacute", Keyocircumflexacute},
+	{"ocircumflexbelowdot", Keyocircumflexbelowdot},
+	{"ocircumflexgrave", Keyocircumflexgrave},
+	{"ocircumflexhook", Keyocircumflexhook},
+	{"ocircumflextilde", Keyocircumflextilde},
+	{"odiaeresis", Keyodiaeresis},
+	{"odoubleacute", Keyodoubleacute},
+	{"oe", Keyoe},
+	{"ogonek", Keyogonek},
+	{"ograve", Keyograve},
+	{"ohook", Keyohook},
+	{"ohorn", Keyohorn},
+	{"ohornacute", Keyohornacute},
+	{"ohornbelowdot", Keyohornbelowdot},
+	{"ohorngrave", Keyohorngrave},
+	{"ohornhook", Keyohornhook},
+	{"ohorntilde", Keyohorntilde},
+	{"omacron", Keyomacron},
+	{"oneeighth", Keyoneeighth},
+	{"onefifth", Keyonefifth},
+	{"onehalf", Keyonehalf},
+	{"onequarter", Keyonequarter},
+	{"onesixth", Keyonesixth},
+	{"onesubscript", Keyonesubscript},
+	{"onesuperior", Keyonesuperior},
+	{"onethird", Keyonethird},
+	{"ooblique", Keyooblique},
+	{"openrectbullet", Keyopenrectbullet},
+	{"openstar", Keyopenstar},
+	{"opentribulletdown", Keyopentribulletdown},
+	{"opentribulletup", Keyopentribulletup},
+	{"ordfeminine", Keyordfeminine},
+	{"osfActivate", KeyosfActivate},
+	{"osfAddMode", KeyosfAddMode},
+	{"osfBackSpace", KeyosfBackSpace},
+	{"osfBackTab", KeyosfBackTab},
+	{"osfBeginData", KeyosfBeginData},
+	{"osfBeginLine", KeyosfBeginLine},
+	{"osfCancel", KeyosfCancel},
+	{"osfClear", KeyosfClear},
+	{"osfCopy", KeyosfCopy},
+	{"osfCut", KeyosfCut},
+	{"osfDelete", KeyosfDelete},
+	{"osfDeselectAll", KeyosfDeselectAll},
+	{"osfDown", KeyosfDown},
+	{"osfEndData", KeyosfEndData},
+	{"osfEndLine", KeyosfEndLine},
+	{"osfEscape", KeyosfEscape},
+	{"osfExtend", KeyosfExtend},
+	{"osfHelp", KeyosfHelp},
+	{"osfInsert", KeyosfInsert},
+	{"osfLeft", KeyosfLeft},
+	{"osfMenu", KeyosfMenu},
+	{"osfMenuBar", KeyosfMenuBar},
+	{"osfNextField", KeyosfNextField},
+	{"osfNextMenu", KeyosfNextMenu},
+	{"osfPageDown", KeyosfPageDown},
+	{"osfPageLeft", KeyosfPageLeft},
+	{"osfPageRight", KeyosfPageRight},
+	{"osfPageUp", KeyosfPageUp},
+	{"osfPaste", KeyosfPaste},
+	{"osfPrevField", KeyosfPrevField},
+	{"osfPrevMenu", KeyosfPrevMenu},
+	{"osfPrimaryPaste", KeyosfPrimaryPaste},
+	{"osfQuickPaste", KeyosfQuickPaste},
+	{"osfReselect", KeyosfReselect},
+	{"osfRestore", KeyosfRestore},
+	{"osfRight", KeyosfRight},
+	{"osfSelect", KeyosfSelect},
+	{"osfSelectAll", KeyosfSelectAll},
+	{"osfUndo", KeyosfUndo},
+	{"osfUp", KeyosfUp},
+	{"oslash", Keyoslash},
+	{"otilde", Keyotilde},
+	{"overbar", Keyoverbar},
+	{"overline", Keyoverline},
+	{"p", Keyp},
+	{"pabovedot", Keypabovedot},
+	{"paragraph", Keyparagraph},
+	{"parenleft", Keyparenleft},
+	{"parenright", Keyparenright},
+	{"partdifferential", Keypartdifferential},
+	{"partialderivative", Keypartialderivative},
+	{"percent", Keypercent},
+	{"period", Keyperiod},
+	{"periodcentered", Keyperiodcentered},
+	{"permille", Keypermille},
+	{"phonographcopyright", Keyphonographcopyright},
+	{"plus", Keyplus},
+	{"plusminus", Keyplusminus},
+	{"prescription", Keyprescription},
+	{"prolongedsound", Keyprolongedsound},
+	{"punctspace", Keypunctspace},
+	{"q", Keyq},
+	{"quad", Keyquad},
+	{"question", Keyquestion},
+	{"questiondown", Keyquestiondown},
+	{"quotedbl", Keyquotedbl},
+	{"quoteleft", Keyquoteleft},
+	{"quoteright", Keyquoteright},
+	{"r", Keyr},
+	{"racute", Keyracute},
+	{"radical", Keyradical},
+	{"rcaron", Keyrcaron},
+	{"rcedilla", Keyrcedilla},
+	{"registered", Keyregistered},
+	{"rightanglebracket", Keyrightanglebracket},
+	{"rightarrow", Keyrightarrow},
+	{"rightcaret", Keyrightcaret},
+	{"rightdoublequotemark", Keyrightdoublequotemark},
+	{"rightmiddlecurlybrace", Keyrightmiddlecurlybrace},
+	{"rightmiddlesummation", Keyrightmiddlesummation},
+	{"rightopentriangle", Keyrightopentriangle},
+	{"rightpointer", Keyrightpointer},
+	{"rightshoe", Keyrightshoe},
+	{"rightsinglequotemark", Keyrightsinglequotemark},
+	{"rightt", Keyrightt},
+	{"righttack", Keyrighttack},
+	{"s", Keys},
+	{"sabovedot", Keysabovedot},
+	{"sacute", Keysacute},
+	{"scaron", Keyscaron},
+	{"scedilla", Keyscedilla},
+	{"schwa", Keyschwa},
+	{"scircumflex", Keyscircumflex},
+	{"script_switch", Keyscript_switch},
+	{"seconds", Keyseconds},
+	{"section", Keysection},
+	{"semicolon", Keysemicolon},
+	{"semivoicedsound", Keysemivoicedsound},
+	{"seveneighths", Keyseveneighths},
+	{"sevensubscript", Keysevensubscript},
+	{"sevensuperior", Keysevensuperior},
+	{"signaturemark", Keysignaturemark},
+	{"signifblank", Keysignifblank},
+	{"similarequal", Keysimilarequal},
+	{"singlelowquotemark", Keysinglelowquotemark},
+	{"sixsubscript", Keysixsubscript},
+	{"sixsuperior", Keysixsuperior},
+	{"slash", Keyslash},
+	{"soliddiamond", Keysoliddiamond},
+	{"space", Keyspace},
+	{"squareroot", Keysquareroot},
+	{"ssharp", Keyssharp},
+	{"sterling", Keysterling},
+	{"stricteq", Keystricteq},
+	{"t", Keyt},
+	{"tabovedot", Keytabovedot},
+	{"tcaron", Keytcaron},
+	{"tcedilla", Keytcedilla},
+	{"telephone", Keytelephone},
+	{"telephonerecorder", Keytelephonerecorder},
+	{"therefore", Keytherefore},
+	{"thinspace", Keythinspace},
+	{"thorn", Keythorn},
+	{"threeeighths", Keythreeeighths},
+	{"threefifths", Keythreefifths},
+	{"threequarters", Keythreequarters},
+	{"threesubscript", Keythreesubscript},
+	{"threesuperior", Keythreesuperior},
+	{"tintegral", Keytintegral},
+	{"topintegral", Keytopintegral},
+	{"topleftparens", Keytopleftparens},
+	{"topleftradical", Keytopleftradical},
+	{"topleftsqbracket", Keytopleftsqbracket},
+	{"topleftsummation", Keytopleftsummation},
+	{"toprightparens", Keytoprightparens},
+	{"toprightsqbracket", Keytoprightsqbracket},
+	{"toprightsummation", Keytoprightsummation},
+	{"topt", Keytopt},
+	{"topvertsummationconnector", Keytopvertsummationconnector},
+	{"trademark", Keytrademark},
+	{"trademarkincircle", Keytrademarkincircle},
+	{"tslash", Keytslash},
+	{"twofifths", Keytwofifths},
+	{"twosubscript", Keytwosubscript},
+	{"twosuperior", Keytwosuperior},
+	{"twothirds", Keytwothirds},
+	{"u", Keyu},
+	{"uacute", Keyuacute},
+	{"ubelowdot", Keyubelowdot},
+	{"ubreve", Keyubreve},
+	{"ucircumflex", Keyucircumflex},
+	{"udiaeresis", Keyudiaeresis},
+	{"udoubleacute", Keyudoubleacute},
+	{"ugrave", Keyugrave},
+	{"uhook", Keyuhook},
+	{"uhorn", Keyuhorn},
+	{"uhornacute", Keyuhornacute},
+	{"uhornbelowdot", Keyuhornbelowdot},
+	{"uhorngrave", Keyuhorngrave},
+	{"uhornhook", Keyuhornhook},
+	{"uhorntilde", Keyuhorntilde},
+	{"umacron", Keyumacron},
+	{"underbar", Keyunderbar},
+	{"underscore", Keyunderscore},
+	{"union", Keyunion},
+	{"uogonek", Keyuogonek},
+	{"uparrow", Keyuparrow},
+	{"upcaret", Keyupcaret},
+	{"upleftcorner", Keyupleftcorner},
+	{"uprightcorner", Keyuprightcorner},
+	{"upshoe", Keyupshoe},
+	{"upstile", Keyupstile},
+	{"uptack", Keyuptack},
+	{"uring", Keyuring},
+	{"utilde", Keyutilde},
+	{"v", Keyv},
+	{"variation", Keyvariation},
+	{"vertbar", Keyvertbar},
+	{"vertconnector", Keyvertconnector},
+	{"voicedsound", Keyvoicedsound},
+	{"vt", Keyvt},
+	{"w", Keyw},
+	{"wacute", Keywacute},
+	{"wcircumflex", Keywcircumflex},
+	{"wdiaeresis", Keywdiaeresis},
+	{"wgrave", Keywgrave},
+	{"x", Keyx},
+	{"xabovedot", Keyxabovedot},
+	{"y", Keyy},
+	{"yacute", Keyyacute},
+	{"ybelowdot", Keyybelowdot},
+	{"ycircumflex", Keyycircumflex},
+	{"ydiaeresis", Keyydiaeresis},
+	{"yen", Keyyen},
+	{"ygrave", Keyygrave},
+	{"yhook", Keyyhook},
+	{"ytilde", Keyytilde},
+	{"z", Keyz},
+	{"zabovedot", Keyzabovedot},
+	{"zacute", Keyzacute},
+	{"zcaron", Keyzcaron},
+	{"zerosubscript", Keyzerosubscript},
+	{"zerosuperior", Keyzerosuperior},
+	{"zstroke", Keyzstroke},
+}
+
+// keysymValues contains the canonical name of each keysym, sorted by
+// keysym.
+var keysymValues = [...]keysymName{
+	{"space", Keyspace},
+	{"exclam", Keyexclam},
+	{"quotedbl", Keyquotedbl},
+	{"numbersign", Keynumbersign},
+	{"dollar", Keydollar},
+	{"percent", Keypercent},
+	{"ampersand", Keyampersand},
+	{"apostrophe", Keyapostrophe},
+	{"parenleft", Keyparenleft},
+	{"parenright", Keyparenright},
+	{"asterisk", Keyasterisk},
+	{"plus", Keyplus},
+	{"comma", Keycomma},
+	{"minus", Keyminus},
+	{"period", Keyperiod},
+	{"slash", Keyslash},
+	{"0", Key0},
+	{"1", Key1},
+	{"2", Key2},
+	{"3", Key3},
+	{"4", Key4},
+	{"5", Key5},
+	{"6", Key6},
+	{"7", Key7},
+	{"8", Key8},
+	{"9", Key9},
+	{"colon", Keycolon},
+	{"semicolon", Keysemicolon},
+	{"less", Keyless},
+	{"equal", Keyequal},
+	{"greater", Keygreater},
+	{"question", Keyquestion},
+	{"at", Keyat},
+	{"A", KeyA},
+	{"B", KeyB},
+	{"C", KeyC},
+	{"D", KeyD},
+	{"E", KeyE},
+	{"F", KeyF},
+	{"G", KeyG},
+	{"H", KeyH},
+	{"I", KeyI},
+	{"J", KeyJ},
+	{"K", KeyK},
+	{"L", KeyL},
+	{"M", KeyM},
+	{"N", KeyN},
+	{"O", KeyO},
+	{"P", KeyP},
+	{"Q", KeyQ},
+	{"R", KeyR},
+	{"S", KeyS},
+	{"T", KeyT},
+	{"U", KeyU},
+	{"V", KeyV},
+	{"W", KeyW},
+	{"X", KeyX},
+	{"Y", KeyY},
+	{"Z", KeyZ},
+	{"bracketleft", Keybracketleft},
+	{"backslash", Keybackslash},
+	{"bracketright", Keybracketright},
+	{"asciicircum", Keyasciicircum},
+	{"underscore", Keyunderscore},
+	{"grave", Keygrave},
+	{"a", Keya},
+	{"b", Keyb},
+	{"c", Keyc},
+	{"d", Keyd},
+	{"e", Keye},
+	{"f", Keyf},
+	{"g", Keyg},
+	{"h", Keyh},
+	{"i", Keyi},
+	{"j", Keyj},
+	{"k", Keyk},
+	{"l", Keyl},
+	{"m", Keym},
+	{"n", Keyn},
+	{"o", Keyo},
+	{"p", Keyp},
+	{"q", Keyq},
+	{"r", Keyr},
+	{"s", Keys},
+	{"t", Keyt},
+	{"u", Keyu},
+	{"v", Keyv},
+	{"w", Keyw},
+	{"x", Keyx},
+	{"y", Keyy},
+	{"z", Keyz},
+	{"braceleft", Keybraceleft},
+	{"bar", Keybar},
+	{"braceright", Keybraceright},
+	{"asciitilde", Keyasciitilde},
+	{"nobreakspace", Keynobreakspace},
+	{"exclamdown", Keyexclamdown},
+	{"cent", Keycent},
+	{"sterling", Keysterling},
+	{"currency", Keycurrency},
+	{"yen", Keyyen},
+	{"brokenbar", Keybrokenbar},
+	{"section", Keysection},
+	{"diaeresis", Keydiaeresis},
+	{"copyright", Keycopyright},
+	{"ordfeminine", Keyordfeminine},
+	{"guillemotleft", Keyguillemotleft},
+	{"notsign", Keynotsign},
+	{"hyphen", Keyhyphen},
+	{"registered", Keyregistered},
+	{"macron", Keymacron},
+	{"degree", Keydegree},
+	{"plusminus", Keyplusminus},
+	{"twosuperior", Keytwosuperior},
+	{"threesuperior", Keythreesuperior},
+	{"acute", Keyacute},
+	{"mu", Keymu},
+	{"paragraph", Keyparagraph},
+	{"periodcentered", Keyperiodcentered},
+	{"cedilla", Keycedilla},
+	{"onesuperior", Keyonesuperior},
+	{"masculine", Keymasculine},
+	{"guillemotright", Keyguillemotright},
+	{"onequarter", Keyonequarter},
+	{"onehalf", Keyonehalf},
+	{"threequarters", Keythreequarters},
+	{"questiondown", Keyquestiondown},
+	{"Agrave", KeyAgrave},
+	{"Aacute", KeyAacute},
+	{"Acircumflex", KeyAcircumflex},
+	{"Atilde", KeyAtilde},
+	{"Adiaeresis", KeyAdiaeresis},
+	{"Aring", KeyAring},
+	{"AE", KeyAE},
+	{"Ccedilla", KeyCcedilla},
+	{"Egrave", KeyEgrave},
+	{"Eacute", KeyEacute},
+	{"Ecircumflex", KeyEcircumflex},
+	{"Ediaeresis", KeyEdiaeresis},
+	{"Igrave", KeyIgrave},
+	{"Iacute", KeyIacute},
+	{"Icircumflex", KeyIcircumflex},
+	{"Idiaeresis", KeyIdiaeresis},
+	{"ETH", KeyETH},
+	{"Ntilde", KeyNtilde},
+	{"Ograve", KeyOgrave},
+	{"Oacute", KeyOacute},
+	{"Ocircumflex", KeyOcircumflex},
+	{"Otilde", KeyOtilde},
+	{"Odiaeresis", KeyOdiaeresis},
+	{"multiply", Keymultiply},
+	{"Oslash", KeyOslash},
+	{"Ugrave", KeyUgrave},
+	{"Uacute", KeyUacute},
+	{"Ucircumflex", KeyUcircumflex},
+	{"Udiaeresis", KeyUdiaeresis},
+	{"Yacute", KeyYacute},
+	{"THORN", KeyTHORN},
+	{"ssharp", Keyssharp},
+	{"agrave", Keyagrave},
+	{"aacute", Keyaacute},
+	{"acircumflex", Keyacircumflex},
+	{"atilde", Keyatilde},
+	{"adiaeresis", Keyadiaeresis},
+	{"aring", Keyaring},
+	{"ae", Keyae},
+	{"ccedilla", Keyccedilla},
+	{"egrave", Keyegrave},
+	{"eacute", Keyeacute},
+	{"ecircumflex", Keyecircumflex},
+	{"ediaeresis", Keyediaeresis},
+	{"igrave", Keyigrave},
+	{"iacute", Keyiacute},
+	{"icircumflex", Keyicircumflex},
+	{"idiaeresis", Keyidiaeresis},
+	{"eth", Keyeth},
+	{"ntilde", Keyntilde},
+	{"ograve", Keyograve},
+	{"oacute", Keyoacute},
+	{"ocircumflex", Keyocircumflex},
+	{"otilde", Keyotilde},
+	{"odiaeresis", Keyodiaeresis},
+	{"division", Keydivision},
+	{"oslash", Keyoslash},
+	{"ugrave", Keyugrave},
+	{"uacute", Keyuacute},
+	{"ucircumflex", Keyucircumflex},
+	{"udiaeresis", Keyudiaeresis},
+	{"yacute", Keyyacute},
+	{"thorn", Keythorn},
+	{"ydiaeresis", Keyydiaeresis},
+	{"Aogonek", KeyAogonek},
+	{"breve", Keybreve},
+	{"Lstroke", KeyLstroke},
+	{"Lcaron", KeyLcaron},
+	{"Sacute", KeySacute},
+	{"Scaron", KeyScaron},
+	{"Scedilla", KeyScedilla},
+	{"Tcaron", KeyTcaron},
+	{"Zacute", KeyZacute},
+	{"Zcaron", KeyZcaron},
+	{"Zabovedot", KeyZabovedot},
+	{"aogonek", Keyaogonek},
+	{"ogonek", Keyogonek},
+	{"lstroke", Keylstroke},
+	{"lcaron", Keylcaron},
+	{"sacute", Keysacute},
+	{"caron", Keycaron},
+	{"scaron", Keyscaron},
+	{"scedilla", Keyscedilla},
+	{"tcaron", Keytcaron},
+	{"zacute", Keyzacute},
+	{"doubleacute", Keydoubleacute},
+	{"zcaron", Keyzcaron},
+	{"zabovedot", Keyzabovedot},
+	{"Racute", KeyRacute},
+	{"Abreve", KeyAbreve},
+	{"Lacute", KeyLacute},
+	{"Cacute", KeyCacute},
+	{"Ccaron", KeyCcaron},
+	{"Eogonek", KeyEogonek},
+	{"Ecaron", KeyEcaron},
+	{"Dcaron", KeyDcaron},
+	{"Dstroke", KeyDstroke},
+	{"Nacute", KeyNacute},
+	{"Ncaron", KeyNcaron},
+	{"Odoubleacute", KeyOdoubleacute},
+	{"Rcaron", KeyRcaron},
+	{"Uring", KeyUring},
+	{"Udoubleacute", KeyUdoubleacute},
+	{"Tcedilla", KeyTcedilla},
+	{"racute", Keyracute},
+	{"abreve", Keyabreve},
+	{"lacute", Keylacute},
+	{"cacute", Keycacute},
+	{"ccaron", Keyccaron},
+	{"eogonek", Keyeogonek},
+	{"ecaron", Keyecaron},
+	{"dcaron", Keydcaron},
+	{"dstroke", Keydstroke},
+	{"nacute", Keynacute},
+	{"ncaron", Keyncaron},
+	{"odoubleacute", Keyodoubleacute},
+	{"rcaron", Keyrcaron},
+	{"uring", Keyuring},
+	{"udoubleacute", Keyudoubleacute},
+	{"tcedilla", Keytcedilla},
+	{"abovedot", Keyabovedot},
+	{"Hstroke", KeyHstroke},
+	{"Hcircumflex", KeyHcircumflex},
+	{"Iabovedot", KeyIabovedot},
+	{"Gbreve", KeyGbreve},
+	{"Jcircumflex", KeyJcircumflex},
+	{"hstroke", Keyhstroke},
+	{"hcircumflex", Keyhcircumflex},
+	{"idotless", Keyidotless},
+	{"gbreve", Keygbreve},
+	{"jcircumflex", Keyjcircumflex},
+	{"Cabovedot", KeyCabovedot},
+	{"Ccircumflex", KeyCcircumflex},
+	{"Gabovedot", KeyGabovedot},
+	{"Gcircumflex", KeyGcircumflex},
+	{"Ubreve", KeyUbreve},
+	{"Scircumflex", KeyScircumflex},
+	{"cabovedot", Keycabovedot},
+	{"ccircumflex", Keyccircumflex},
+	{"gabovedot", Keygabovedot},
+	{"gcircumflex", Keygcircumflex},
+	{"ubreve", Keyubreve},
+	{"scircumflex", Keyscircumflex},
+	{"kra", Keykra},
+	{"Rcedilla", KeyRcedilla},
+	{"Itilde", KeyItilde},
+	{"Lcedilla", KeyLcedilla},
+	{"Emacron", KeyEmacron},
+	{"Gcedilla", KeyGcedilla},
+	{"Tslash", KeyTslash},
+	{"rcedilla", Keyrcedilla},
+	{"itilde", Keyitilde},
+	{"lcedilla", Keylcedilla},
+	{"emacron", Keyemacron},
+	{"gcedilla", Keygcedilla},
+	{"tslash", Keytslash},
+	{"ENG", KeyENG},
+	{"eng", Keyeng},
+	{"Amacron", KeyAmacron},
+	{"Iogonek", KeyIogonek},
+	{"Eabovedot", KeyEabovedot},
+	{"Imacron", KeyImacron},
+	{"Ncedilla", KeyNcedilla},
+	{"Omacron", KeyOmacron},
+	{"Kcedilla", KeyKcedilla},
+	{"Uogonek", KeyUogonek},
+	{"Utilde", KeyUtilde},
+	{"Umacron", KeyUmacron},
+	{"amacron", Keyamacron},
+	{"iogonek", Keyiogonek},
+	{"eabovedot", Keyeabovedot},
+	{"imacron", Keyimacron},
+	{"ncedilla", Keyncedilla},
+	{"omacron", Keyomacron},
+	{"kcedilla", Keykcedilla},
+	{"uogonek", Keyuogonek},
+	{"utilde", Keyutilde},
+	{"umacron", Keyumacron},
+	{"overline", Keyoverline},
+	{"kana_fullstop", Keykana_fullstop},
+	{"kana_openingbracket", Keykana_openingbracket},
+	{"kana_closingbracket", Keykana_closingbracket},
+	{"kana_comma", Keykana_comma},
+	{"kana_conjunctive", Keykana_conjunctive},
+	{"kana_WO", Keykana_WO},
+	{"kana_a", Keykana_a},
+	{"kana_i", Keykana_i},
+	{"kana_u", Keykana_u},
+	{"kana_e", Keykana_e},
+	{"kana_o", Keykana_o},
+	{"kana_ya", Keykana_ya},
+	{"kana_yu", Keykana_yu},
+	{"kana_yo", Keykana_yo},
+	{"kana_tsu", Keykana_tsu},
+	{"prolongedsound", Keyprolongedsound},
+	{"kana_A", Keykana_A},
+	{"kana_I", Keykana_I},
+	{"kana_U", Keykana_U},
+	{"kana_E", Keykana_E},
+	{"kana_O", Keykana_O},
+	{"kana_KA", Keykana_KA},
+	{"kana_KI", Keykana_KI},
+	{"kana_KU", Keykana_KU},
+	{"kana_KE", Keykana_KE},
+	{"kana_KO", Keykana_KO},
+	{"kana_SA", Keykana_SA},
+	{"kana_SHI", Keykana_SHI},
+	{"kana_SU", Keykana_SU},
+	{"kana_SE", Keykana_SE},
+	{"kana_SO", Keykana_SO},
+	{"kana_TA", Keykana_TA},
+	{"kana_CHI", Keykana_CHI},
+	{"kana_TSU", Keykana_TSU},
+	{"kana_TE", Keykana_TE},
+	{"kana_TO", Keykana_TO},
+	{"kana_NA", Keykana_NA},
+	{"kana_NI", Keykana_NI},
+	{"kana_NU", Keykana_NU},
+	{"kana_NE", Keykana_NE},
+	{"kana_NO", Keykana_NO},
+	{"kana_HA", Keykana_HA},
+	{"kana_HI", Keykana_HI},
+	{"kana_FU", Keykana_FU},
+	{"kana_HE", Keykana_HE},
+	{"kana_HO", Keykana_HO},
+	{"kana_MA", Keykana_MA},
+	{"kana_MI", Keykana_MI},
+	{"kana_MU", Keykana_MU},
+	{"kana_ME", Keykana_ME},
+	{"kana_MO", Keykana_MO},
+	{"kana_YA", Keykana_YA},
+	{"kana_YU", Keykana_YU},
+	{"kana_YO", Keykana_YO},
+	{"kana_RA", Keykana_RA},
+	{"kana_RI", Keykana_RI},
+	{"kana_RU", Keykana_RU},
+	{"kana_RE", Keykana_RE},
+	{"kana_RO", Keykana_RO},
+	{"kana_WA", Keykana_WA},
+	{"kana_N", Keykana_N},
+	{"voicedsound", Keyvoicedsound},
+	{"semivoicedsound", Keysemivoicedsound},
+	{"Arabic_comma", KeyArabic_comma},
+	{"Arabic_semicolon", KeyArabic_semicolon},
+	{"Arabic_question_mark", KeyArabic_question_mark},
+	{"Arabic_hamza", KeyArabic_hamza},
+	{"Arabic_maddaonalef", KeyArabic_maddaonalef},
+	{"Arabic_hamzaonalef", KeyArabic_hamzaonalef},
+	{"Arabic_hamzaonwaw", KeyArabic_hamzaonwaw},
+	{"Arabic_hamzaunderalef", KeyArabic_hamzaunderalef},
+	{"Arabic_hamzaonyeh", KeyArabic_hamzaonyeh},
+	{"Arabic_alef", KeyArabic_alef},
+	{"Arabic_beh", KeyArabic_beh},
+	{"Arabic_tehmarbuta", KeyArabic_tehmarbuta},
+	{"Arabic_teh", KeyArabic_teh},
+	{"Arabic_theh", KeyArabic_theh},
+	{"Arabic_jeem", KeyArabic_jeem},
+	{"Arabic_hah", KeyArabic_hah},
+	{"Arabic_khah", KeyArabic_khah},
+	{"Arabic_dal", KeyArabic_dal},
+	{"Arabic_thal", KeyArabic_thal},
+	{"Arabic_ra", KeyArabic_ra},
+	{"Arabic_zain", KeyArabic_zain},
+	{"Arabic_seen", KeyArabic_seen},
+	{"Arabic_sheen", KeyArabic_sheen},
+	{"Arabic_sad", KeyArabic_sad},
+	{"Arabic_dad", KeyArabic_dad},
+	{"Arabic_tah", KeyArabic_tah},
+	{"Arabic_zah", KeyArabic_zah},
+	{"Arabic_ain", KeyArabic_ain},
+	{"Arabic_ghain", KeyArabic_ghain},
+	{"Arabic_tatweel", KeyArabic_tatweel},
+	{"Arabic_feh", KeyArabic_feh},
+	{"Arabic_qaf", KeyArabic_qaf},
+	{"Arabic_kaf", KeyArabic_kaf},
+	{"Arabic_lam", KeyArabic_lam},
+	{"Arabic_meem", KeyArabic_meem},
+	{"Arabic_noon", KeyArabic_noon},
+	{"Arabic_ha", KeyArabic_ha},
+	{"Arabic_waw", KeyArabic_waw},
+	{"Arabic_alefmaksura", KeyArabic_alefmaksura},
+	{"Arabic_yeh", KeyArabic_yeh},
+	{"Arabic_fathatan", KeyArabic_fathatan},
+	{"Arabic_dammatan", KeyArabic_dammatan},
+	{"Arabic_kasratan", KeyArabic_kasratan},
+	{"Arabic_fatha", KeyArabic_fatha},
+	{"Arabic_damma", KeyArabic_damma},
+	{"Arabic_kasra", KeyArabic_kasra},
+	{"Arabic_shadda", KeyArabic_shadda},
+	{"Arabic_sukun", KeyArabic_sukun},
+	{"Serbian_dje", KeySerbian_dje},
+	{"Macedonia_gje", KeyMacedonia_gje},
+	{"Cyrillic_io", KeyCyrillic_io},
+	{"Ukrainian_ie", KeyUkrainian_ie},
+	{"Macedonia_dse", KeyMacedonia_dse},
+	{"Ukrainian_i", KeyUkrainian_i},
+	{"Ukrainian_yi", KeyUkrainian_yi},
+	{"Cyrillic_je", KeyCyrillic_je},
+	{"Cyrillic_lje", KeyCyrillic_lje},
+	{"Cyrillic_nje", KeyCyrillic_nje},
+	{"Serbian_tshe", KeySerbian_tshe},
+	{"Macedonia_kje", KeyMacedonia_kje},
+	{"Ukrainian_ghe_with_upturn", KeyUkrainian_ghe_with_upturn},
+	{"Byelorussian_shortu", KeyByelorussian_shortu},
+	{"Cyrillic_dzhe", KeyCyrillic_dzhe},
+	{"numerosign", Keynumerosign},
+	{"Serbian_DJE", KeySerbian_DJE},
+	{"Macedonia_GJE", KeyMacedonia_GJE},
+	{"Cyrillic_IO", KeyCyrillic_IO},
+	{"Ukrainian_IE", KeyUkrainian_IE},
+	{"Macedonia_DSE", KeyMacedonia_DSE},
+	{"Ukrainian_I", KeyUkrainian_I},
+	{"Ukrainian_YI", KeyUkrainian_YI},
+	{"Cyrillic_JE", KeyCyrillic_JE},
+	{"Cyrillic_LJE", KeyCyrillic_LJE},
+	{"Cyrillic_NJE", KeyCyrillic_NJE},
+	{"Serbian_TSHE", KeySerbian_TSHE},
+	{"Macedonia_KJE", KeyMacedonia_KJE},
+	{"Ukrainian_GHE_WITH_UPTURN", KeyUkrainian_GHE_WITH_UPTURN},
+	{"Byelorussian_SHORTU", KeyByelorussian_SHORTU},
+	{"Cyrillic_DZHE", KeyCyrillic_DZHE},
+	{"Cyrillic_yu", KeyCyrillic_yu},
+	{"Cyrillic_a", KeyCyrillic_a},
+	{"Cyrillic_be", KeyCyrillic_be},
+	{"Cyrillic_tse", KeyCyrillic_tse},
+	{"Cyrillic_de", KeyCyrillic_de},
+	{"Cyrillic_ie", KeyCyrillic_ie},
+	{"Cyrillic_ef", KeyCyrillic_ef},
+	{"Cyrillic_ghe", KeyCyrillic_ghe},
+	{"Cyrillic_ha", KeyCyrillic_ha},
+	{"Cyrillic_i", KeyCyrillic_i},
+	{"Cyrillic_shorti", KeyCyrillic_shorti},
+	{"Cyrillic_ka", KeyCyrillic_ka},
+	{"Cyrillic_el", KeyCyrillic_el},
+	{"Cyrillic_em", KeyCyrillic_em},
+	{"Cyrillic_en", KeyCyrillic_en},
+	{"Cyrillic_o", KeyCyrillic_o},
+	{"Cyrillic_pe", KeyCyrillic_pe},
+	{"Cyrillic_ya", KeyCyrillic_ya},
+	{"Cyrillic_er", KeyCyrillic_er},
+	{"Cyrillic_es", KeyCyrillic_es},
+	{"Cyrillic_te", KeyCyrillic_te},
+	{"Cyrillic_u", KeyCyrillic_u},
+	{"Cyrillic_zhe", KeyCyrillic_zhe},
+	{"Cyrillic_ve", KeyCyrillic_ve},
+	{"Cyrillic_softsign", KeyCyrillic_softsign},
+	{"Cyrillic_yeru", KeyCyrillic_yeru},
+	{"Cyrillic_ze", KeyCyrillic_ze},
+	{"Cyrillic_sha", KeyCyrillic_sha},
+	{"Cyrillic_e", KeyCyrillic_e},
+	{"Cyrillic_shcha", KeyCyrillic_shcha},
+	{"Cyrillic_che", KeyCyrillic_che},
+	{"Cyrillic_hardsign", KeyCyrillic_hardsign},
+	{"Cyrillic_YU", KeyCyrillic_YU},
+	{"Cyrillic_A", KeyCyrillic_A},
+	{"Cyrillic_BE", KeyCyrillic_BE},
+	{"Cyrillic_TSE", KeyCyrillic_TSE},
+	{"Cyrillic_DE", KeyCyrillic_DE},
+	{"Cyrillic_IE", KeyCyrillic_IE},
+	{"Cyrillic_EF", KeyCyrillic_EF},
+	{"Cyrillic_GHE", KeyCyrillic_GHE},
+	{"Cyrillic_HA", KeyCyrillic_HA},
+	{"Cyrillic_I", KeyCyrillic_I},
+	{"Cyrillic_SHORTI", KeyCyrillic_SHORTI},
+	{"Cyrillic_KA", KeyCyrillic_KA},
+	{"Cyrillic_EL", KeyCyrillic_EL},
+	{"Cyrillic_EM", KeyCyrillic_EM},
+	{"Cyrillic_EN", KeyCyrillic_EN},
+	{"Cyrillic_O", KeyCyrillic_O},
+	{"Cyrillic_PE", KeyCyrillic_PE},
+	{"Cyrillic_YA", KeyCyrillic_YA},
+	{"Cyrillic_ER", KeyCyrillic_ER},
+	{"Cyrillic_ES", KeyCyrillic_ES},
+	{"Cyrillic_TE", KeyCyrillic_TE},
+	{"Cyrillic_U", KeyCyrillic_U},
+	{"Cyrillic_ZHE", KeyCyrillic_ZHE},
+	{"Cyrillic_VE", KeyCyrillic_VE},
+	{"Cyrillic_SOFTSIGN", KeyCyrillic_SOFTSIGN},
+	{"Cyrillic_YERU", KeyCyrillic_YERU},
+	{"Cyrillic_ZE", KeyCyrillic_ZE},
+	{"Cyrillic_SHA", KeyCyrillic_SHA},
+	{"Cyrillic_E", KeyCyrillic_E},
+	{"Cyrillic_SHCHA", KeyCyrillic_SHCHA},
+	{"Cyrillic_CHE", KeyCyrillic_CHE},
+	{"Cyrillic_HARDSIGN", KeyCyrillic_HARDSIGN},
+	{"Greek_ALPHAaccent", KeyGreek_ALPHAaccent},
+	{"Greek_EPSILONaccent", KeyGreek_EPSILONaccent},
+	{"Greek_ETAaccent", KeyGreek_ETAaccent},
+	{"Greek_IOTAaccent", KeyGreek_IOTAaccent},
+	{"Greek_IOTAdieresis", KeyGreek_IOTAdieresis},
+	{"Greek_OMICRONaccent", KeyGreek_OMICRONaccent},
+	{"Greek_UPSILONaccent", KeyGreek_UPSILONaccent},
+	{"Greek_UPSILONdieresis", KeyGreek_UPSILONdieresis},
+	{"Greek_OMEGAaccent", KeyGreek_OMEGAaccent},
+	{"Greek_accentdieresis", KeyGreek_accentdieresis},
+	{"Greek_horizbar", KeyGreek_horizbar},
+	{"Greek_alphaaccent", KeyGreek_alphaaccent},
+	{"Greek_epsilonaccent", KeyGreek_epsilonaccent},
+	{"Greek_etaaccent", KeyGreek_etaaccent},
+	{"Greek_iotaaccent", KeyGreek_iotaaccent},
+	{"Greek_iotadieresis", KeyGreek_iotadieresis},
+	{"Greek_iotaaccentdieresis", KeyGreek_iotaaccentdieresis},
+	{"Greek_omicronaccent", KeyGreek_omicronaccent},
+	{"Greek_upsilonaccent", KeyGreek_upsilonaccent},
+	{"Greek_upsilondieresis", KeyGreek_upsilondieresis},
+	{"Greek_upsilonaccentdieresis", KeyGreek_upsilonaccentdieresis},
+	{"Greek_omegaaccent", KeyGreek_omegaaccent},
+	{"Greek_ALPHA", KeyGreek_ALPHA},
+	{"Greek_BETA", KeyGreek_BETA},
+	{"Greek_GAMMA", KeyGreek_GAMMA},
+	{"Greek_DELTA", KeyGreek_DELTA},
+	{"Greek_EPSILON", KeyGreek_EPSILON},
+	{"Greek_ZETA", KeyGreek_ZETA},
+	{"Greek_ETA", KeyGreek_ETA},
+	{"Greek_THETA", KeyGreek_THETA},
+	{"Greek_IOTA", KeyGreek_IOTA},
+	{"Greek_KAPPA", KeyGreek_KAPPA},
+	{"Greek_LAMDA", KeyGreek_LAMDA},
+	{"Greek_MU", KeyGreek_MU},
+	{"Greek_NU", KeyGreek_NU},
+	{"Greek_XI", KeyGreek_XI},
+	{"Greek_OMICRON", KeyGreek_OMICRON},
+	{"Greek_PI", KeyGreek_PI},
+	{"Greek_RHO", KeyGreek_RHO},
+	{"Greek_SIGMA", KeyGreek_SIGMA},
+	{"Greek_TAU", KeyGreek_TAU},
+	{"Greek_UPSILON", KeyGreek_UPSILON},
+	{"Greek_PHI", KeyGreek_PHI},
+	{"Greek_CHI", KeyGreek_CHI},
+	{"Greek_PSI", KeyGreek_PSI},
+	{"Greek_OMEGA", KeyGreek_OMEGA},
+	{"Greek_alpha", KeyGreek_alpha},
+	{"Greek_beta", KeyGreek_beta},
+	{"Greek_gamma", KeyGreek_gamma},
+	{"Greek_delta", KeyGreek_delta},
+	{"Greek_epsilon", KeyGreek_epsilon},
+	{"Greek_zeta", KeyGreek_zeta},
+	{"Greek_eta", KeyGreek_eta},
+	{"Greek_theta", KeyGreek_theta},
+	{"Greek_iota", KeyGreek_iota},
+	{"Greek_kappa", KeyGreek_kappa},
+	{"Greek_lamda", KeyGreek_lamda},
+	{"Greek_mu", KeyGreek_mu},
+	{"Greek_nu", KeyGreek_nu},
+	{"Greek_xi", KeyGreek_xi},
+	{"Greek_omicron", KeyGreek_omicron},
+	{"Greek_pi", KeyGreek_pi},
+	{"Greek_rho", KeyGreek_rho},
+	{"Greek_sigma", KeyGreek_sigma},
+	{"Greek_finalsmallsigma", KeyGreek_finalsmallsigma},
+	{"Greek_tau", KeyGreek_tau},
+	{"Greek_upsilon", KeyGreek_upsilon},
+	{"Greek_phi", KeyGreek_phi},
+	{"Greek_chi", KeyGreek_chi},
+	{"Greek_psi", KeyGreek_psi},
+	{"Greek_omega", KeyGreek_omega},
+	{"leftradical", Keyleftradical},
+	{"topleftradical", Keytopleftradical},
+	{"horizconnector", Keyhorizconnector},
+	{"topintegral", Keytopintegral},
+	{"botintegral", Keybotintegral},
+	{"vertconnector", Keyvertconnector},
+	{"topleftsqbracket", Keytopleftsqbracket},
+	{"botleftsqbracket", Keybotleftsqbracket},
+	{"toprightsqbracket", Keytoprightsqbracket},
+	{"botrightsqbracket", Keybotrightsqbracket},
+	{"topleftparens", Keytopleftparens},
+	{"botleftparens", Keybotleftparens},
+	{"toprightparens", Keytoprightparens},
+	{"botrightparens", Keybotrightparens},
+	{"leftmiddlecurlybrace", Keyleftmiddlecurlybrace},
+	{"rightmiddlecurlybrace", Keyrightmiddlecurlybrace},
+	{"topleftsummation", Keytopleftsummation},
+	{"botleftsummation", Keybotleftsummation},
+	{"topvertsummationconnector", Keytopvertsummationconnector},
+	{"botvertsummationconnector", Keybotvertsummationconnector},
+	{"toprightsummation", Keytoprightsummation},
+	{"botrightsummation", Keybotrightsummation},
+	{"rightmiddlesummation", Keyrightmiddlesummation},
+	{"lessthanequal", Keylessthanequal},
+	{"notequal", Keynotequal},
+	{"greaterthanequal", Keygreaterthanequal},
+	{"integral", Keyintegral},
+	{"therefore", Keytherefore},
+	{"variation", Keyvariation},
+	{"infinity", Keyinfinity},
+	{"nabla", Keynabla},
+	{"approximate", Keyapproximate},
+	{"similarequal", Keysimilarequal},
+	{"ifonlyif", Keyifonlyif},
+	{"implies", Keyimplies},
+	{"identical", Keyidentical},
+	{"radical", Keyradical},
+	{"includedin", Keyincludedin},
+	{"includes", Keyincludes},
+	{"intersection", Keyintersection},
+	{"union", Keyunion},
+	{"logicaland", Keylogicaland},
+	{"logicalor", Keylogicalor},
+	{"partialderivative", Keypartialderivative},
+	{"function", Keyfunction},
+	{"leftarrow", Keyleftarrow},
+	{"uparrow", Keyuparrow},
+	{"rightarrow", Keyrightarrow},
+	{"downarrow", Keydownarrow},
+	{"blank", Keyblank},
+	{"soliddiamond", Keysoliddiamond},
+	{"checkerboard", Keycheckerboard},
+	{"ht", Keyht},
+	{"ff", Keyff},
+	{"cr", Keycr},
+	{"lf", Keylf},
+	{"nl", Keynl},
+	{"vt", Keyvt},
+	{"lowrightcorner", Keylowrightcorner},
+	{"uprightcorner", Keyuprightcorner},
+	{"upleftcorner", Keyupleftcorner},
+	{"lowleftcorner", Keylowleftcorner},
+	{"crossinglines", Keycrossinglines},
+	{"horizlinescan1", Keyhorizlinescan1},
+	{"horizlinescan3", Keyhorizlinescan3},
+	{"horizlinescan5", Keyhorizlinescan5},
+	{"horizlinescan7", Keyhorizlinescan7},
+	{"horizlinescan9", Keyhorizlinescan9},
+	{"leftt", Keyleftt},
+	{"rightt", Keyrightt},
+	{"bott", Keybott},
+	{"topt", Keytopt},
+	{"vertbar", Keyvertbar},
+	{"emspace", Keyemspace},
+	{"enspace", Keyenspace},
+	{"em3space", Keyem3space},
+	{"em4space", Keyem4space},
+	{"digitspace", Keydigitspace},
+	{"punctspace", Keypunctspace},
+	{"thinspace", Keythinspace},
+	{"hairspace", Keyhairspace},
+	{"emdash", Keyemdash},
+	{"endash", Keyendash},
+	{"signifblank", Keysignifblank},
+	{"ellipsis", Keyellipsis},
+	{"doubbaselinedot", Keydoubbaselinedot},
+	{"onethird", Keyonethird},
+	{"twothirds", Keytwothirds},
+	{"onefifth", Keyonefifth},
+	{"twofifths", Keytwofifths},
+	{"threefifths", Keythreefifths},
+	{"fourfifths", Keyfourfifths},
+	{"onesixth", Keyonesixth},
+	{"fivesixths", Keyfivesixths},
+	{"careof", Keycareof},
+	{"figdash", Keyfigdash},
+	{"leftanglebracket", Keyleftanglebracket},
+	{"decimalpoint", Keydecimalpoint},
+	{"rightanglebracket", Keyrightanglebracket},
+	{"marker", Keymarker},
+	{"oneeighth", Keyoneeighth},
+	{"threeeighths", Keythreeeighths},
+	{"fiveeighths", Keyfiveeighths},
+	{"seveneighths", Keyseveneighths},
+	{"trademark", Keytrademark},
+	{"signaturemark", Keysignaturemark},
+	{"trademarkincircle", Keytrademarkincircle},
+	{"leftopentriangle", Keyleftopentriangle},
+	{"rightopentriangle", Keyrightopentriangle},
+	{"emopencircle", Keyemopencircle},
+	{"emopenrectangle", Keyemopenrectangle},
+	{"leftsinglequotemark", Keyleftsinglequotemark},
+	{"rightsinglequotemark", Keyrightsinglequotemark},
+	{"leftdoublequotemark", Keyleftdoublequotemark},
+	{"rightdoublequotemark", Keyrightdoublequotemark},
+	{"prescription", Keyprescription},
+	{"permille", Keypermille},
+	{"minutes", Keyminutes},
+	{"seconds", Keyseconds},
+	{"latincross", Keylatincross},
+	{"hexagram", Keyhexagram},
+	{"filledrectbullet", Keyfilledrectbullet},
+	{"filledlefttribullet", Keyfilledlefttribullet},
+	{"filledrighttribullet", Keyfilledrighttribullet},
+	{"emfilledcircle", Keyemfilledcircle},
+	{"emfilledrect", Keyemfilledrect},
+	{"enopencircbullet", Keyenopencircbullet},
+	{"enopensquarebullet", Keyenopensquarebullet},
+	{"openrectbullet", Keyopenrectbullet},
+	{"opentribulletup", Keyopentribulletup},
+	{"opentribulletdown", Keyopentribulletdown},
+	{"openstar", Keyopenstar},
+	{"enfilledcircbullet", Keyenfilledcircbullet},
+	{"enfilledsqbullet", Keyenfilledsqbullet},
+	{"filledtribulletup", Keyfilledtribulletup},
+	{"filledtribulletdown", Keyfilledtribulletdown},
+	{"leftpointer", Keyleftpointer},
+	{"rightpointer", Keyrightpointer},
+	{"club", Keyclub},
+	{"diamond", Keydiamond},
+	{"heart", Keyheart},
+	{"maltesecross", Keymaltesecross},
+	{"dagger", Keydagger},
+	{"doubledagger", Keydoubledagger},
+	{"checkmark", Keycheckmark},
+	{"ballotcross", Keyballotcross},
+	{"musicalsharp", Keymusicalsharp},
+	{"musicalflat", Keymusicalflat},
+	{"malesymbol", Keymalesymbol},
+	{"femalesymbol", Keyfemalesymbol},
+	{"telephone", Keytelephone},
+	{"telephonerecorder", Keytelephonerecorder},
+	{"phonographcopyright", Keyphonographcopyright},
+	{"caret", Keycaret},
+	{"singlelowquotemark", Keysinglelowquotemark},
+	{"doublelowquotemark", Keydoublelowquotemark},
+	{"cursor", Keycursor},
+	{"leftcaret", Keyleftcaret},
+	{"rightcaret", Keyrightcaret},
+	{"downcaret", Keydowncaret},
+	{"upcaret", Keyupcaret},
+	{"overbar", Keyoverbar},
+	{"downtack", Keydowntack},
+	{"upshoe", Keyupshoe},
+	{"downstile", Keydownstile},
+	{"underbar", Keyunderbar},
+	{"jot", Keyjot},
+	{"quad", Keyquad},
+	{"uptack", Keyuptack},
+	{"circle", Keycircle},
+	{"upstile", Keyupstile},
+	{"downshoe", Keydownshoe},
+	{"rightshoe", Keyrightshoe},
+	{"leftshoe", Keyleftshoe},
+	{"lefttack", Keylefttack},
+	{"righttack", Keyrighttack},
+	{"hebrew_doublelowline", Keyhebrew_doublelowline},
+	{"hebrew_aleph", Keyhebrew_aleph},
+	{"hebrew_bet", Keyhebrew_bet},
+	{"hebrew_gimel", Keyhebrew_gimel},
+	{"hebrew_dalet", Keyhebrew_dalet},
+	{"hebrew_he", Keyhebrew_he},
+	{"hebrew_waw", Keyhebrew_waw},
+	{"hebrew_zain", Keyhebrew_zain},
+	{"hebrew_chet", Keyhebrew_chet},
+	{"hebrew_tet", Keyhebrew_tet},
+	{"hebrew_yod", Keyhebrew_yod},
+	{"hebrew_finalkaph", Keyhebrew_finalkaph},
+	{"hebrew_kaph", Keyhebrew_kaph},
+	{"hebrew_lamed", Keyhebrew_lamed},
+	{"hebrew_finalmem", Keyhebrew_finalmem},
+	{"hebrew_mem", Keyhebrew_mem},
+	{"hebrew_finalnun", Keyhebrew_finalnun},
+	{"hebrew_nun", Keyhebrew_nun},
+	{"hebrew_samech", Keyhebrew_samech},
+	{"hebrew_ayin", Keyhebrew_ayin},
+	{"hebrew_finalpe", Keyhebrew_finalpe},
+	{"hebrew_pe", Keyhebrew_pe},
+	{"hebrew_finalzade", Keyhebrew_finalzade},
+	{"hebrew_zade", Keyhebrew_zade},
+	{"hebrew_qoph", Keyhebrew_qoph},
+	{"hebrew_resh", Keyhebrew_resh},
+	{"hebrew_shin", Keyhebrew_shin},
+	{"hebrew_taw", Keyhebrew_taw},
+	{"Thai_kokai", KeyThai_kokai},
+	{"Thai_khokhai", KeyThai_khokhai},
+	{"Thai_khokhuat", KeyThai_khokhuat},
+	{"Thai_khokhwai", KeyThai_khokhwai},
+	{"Thai_khokhon", KeyThai_khokhon},
+	{"Thai_khorakhang", KeyThai_khorakhang},
+	{"Thai_ngongu", KeyThai_ngongu},
+	{"Thai_chochan", KeyThai_chochan},
+	{"Thai_choching", KeyThai_choching},
+	{"Thai_chochang", KeyThai_chochang},
+	{"Thai_soso", KeyThai_soso},
+	{"Thai_chochoe", KeyThai_chochoe},
+	{"Thai_yoying", KeyThai_yoying},
+	{"Thai_dochada", KeyThai_dochada},
+	{"Thai_topatak", KeyThai_topatak},
+	{"Thai_thothan", KeyThai_thothan},
+	{"Thai_thonangmontho", KeyThai_thonangmontho},
+	{"Thai_thophuthao", KeyThai_thophuthao},
+	{"Thai_nonen", KeyThai_nonen},
+	{"Thai_dodek", KeyThai_dodek},
+	{"Thai_totao", KeyThai_totao},
+	{"Thai_thothung", KeyThai_thothung},
+	{"Thai_thothahan", KeyThai_thothahan},
+	{"Thai_thothong", KeyThai_thothong},
+	{"Thai_nonu", KeyThai_nonu},
+	{"Thai_bobaimai", KeyThai_bobaimai},
+	{"Thai_popla", KeyThai_popla},
+	{"Thai_phophung", KeyThai_phophung},
+	{"Thai_fofa", KeyThai_fofa},
+	{"Thai_phophan", KeyThai_phophan},
+	{"Thai_fofan", KeyThai_fofan},
+	{"Thai_phosamphao", KeyThai_phosamphao},
+	{"Thai_moma", KeyThai_moma},
+	{"Thai_yoyak", KeyThai_yoyak},
+	{"Thai_rorua", KeyThai_rorua},
+	{"Thai_ru", KeyThai_ru},
+	{"Thai_loling", KeyThai_loling},
+	{"Thai_lu", KeyThai_lu},
+	{"Thai_wowaen", KeyThai_wowaen},
+	{"Thai_sosala", KeyThai_sosala},
+	{"Thai_sorusi", KeyThai_sorusi},
+	{"Thai_sosua", KeyThai_sosua},
+	{"Thai_hohip", KeyThai_hohip},
+	{"Thai_lochula", KeyThai_lochula},
+	{"Thai_oang", KeyThai_oang},
+	{"Thai_honokhuk", KeyThai_honokhuk},
+	{"Thai_paiyannoi", KeyThai_paiyannoi},
+	{"Thai_saraa", KeyThai_saraa},
+	{"Thai_maihanakat", KeyThai_maihanakat},
+	{"Thai_saraaa", KeyThai_saraaa},
+	{"Thai_saraam", KeyThai_saraam},
+	{"Thai_sarai", KeyThai_sarai},
+	{"Thai_saraii", KeyThai_saraii},
+	{"Thai_saraue", KeyThai_saraue},
+	{"Thai_sarauee", KeyThai_sarauee},
+	{"Thai_sarau", KeyThai_sarau},
+	{"Thai_sarauu", KeyThai_sarauu},
+	{"Thai_phinthu", KeyThai_phinthu},
+	{"Thai_maihanakat_maitho", KeyThai_maihanakat_maitho},
+	{"Thai_baht", KeyThai_baht},
+	{"Thai_sarae", KeyThai_sarae},
+	{"Thai_saraae", KeyThai_saraae},
+	{"Thai_sarao", KeyThai_sarao},
+	{"Thai_saraaimaimuan", KeyThai_saraaimaimuan},
+	{"Thai_saraaimaimalai", KeyThai_saraaimaimalai},
+	{"Thai_lakkhangyao", KeyThai_lakkhangyao},
+	{"Thai_maiyamok", KeyThai_maiyamok},
+	{"Thai_maitaikhu", KeyThai_maitaikhu},
+	{"Thai_maiek", KeyThai_maiek},
+	{"Thai_maitho", KeyThai_maitho},
+	{"Thai_maitri", KeyThai_maitri},
+	{"Thai_maichattawa", KeyThai_maichattawa},
+	{"Thai_thanthakhat", KeyThai_thanthakhat},
+	{"Thai_nikhahit", KeyThai_nikhahit},
+	{"Thai_leksun", KeyThai_leksun},
+	{"Thai_leknung", KeyThai_leknung},
+	{"Thai_leksong", KeyThai_leksong},
+	{"Thai_leksam", KeyThai_leksam},
+	{"Thai_leksi", KeyThai_leksi},
+	{"Thai_lekha", KeyThai_lekha},
+	{"Thai_lekhok", KeyThai_lekhok},
+	{"Thai_lekchet", KeyThai_lekchet},
+	{"Thai_lekpaet", KeyThai_lekpaet},
+	{"Thai_lekkao", KeyThai_lekkao},
+	{"Hangul_Kiyeog", KeyHangul_Kiyeog},
+	{"Hangul_SsangKiyeog", KeyHangul_SsangKiyeog},
+	{"Hangul_KiyeogSios", KeyHangul_KiyeogSios},
+	{"Hangul_Nieun", KeyHangul_Nieun},
+	{"Hangul_NieunJieuj", KeyHangul_NieunJieuj},
+	{"Hangul_NieunHieuh", KeyHangul_NieunHieuh},
+	{"Hangul_Dikeud", KeyHangul_Dikeud},
+	{"Hangul_SsangDikeud", KeyHangul_SsangDikeud},
+	{"Hangul_Rieul", KeyHangul_Rieul},
+	{"Hangul_RieulKiyeog", KeyHangul_RieulKiyeog},
+	{"Hangul_RieulMieum", KeyHangul_RieulMieum},
+	{"Hangul_RieulPieub", KeyHangul_RieulPieub},
+	{"Hangul_RieulSios", KeyHangul_RieulSios},
+	{"Hangul_RieulTieut", KeyHangul_RieulTieut},
+	{"Hangul_RieulPhieuf", KeyHangul_RieulPhieuf},
+	{"Hangul_RieulHieuh", KeyHangul_RieulHieuh},
+	{"Hangul_Mieum", KeyHangul_Mieum},
+	{"Hangul_Pieub", KeyHangul_Pieub},
+	{"Hangul_SsangPieub", KeyHangul_SsangPieub},
+	{"Hangul_PieubSios", KeyHangul_PieubSios},
+	{"Hangul_Sios", KeyHangul_Sios},
+	{"Hangul_SsangSios", KeyHangul_SsangSios},
+	{"Hangul_Ieung", KeyHangul_Ieung},
+	{"Hangul_Jieuj", KeyHangul_Jieuj},
+	{"Hangul_SsangJieuj", KeyHangul_SsangJieuj},
+	{"Hangul_Cieuc", KeyHangul_Cieuc},
+	{"Hangul_Khieuq", KeyHangul_Khieuq},
+	{"Hangul_Tieut", KeyHangul_Tieut},
+	{"Hangul_Phieuf", KeyHangul_Phieuf},
+	{"Hangul_Hieuh", KeyHangul_Hieuh},
+	{"Hangul_A", KeyHangul_A},
+	{"Hangul_AE", KeyHangul_AE},
+	{"Hangul_YA", KeyHangul_YA},
+	{"Hangul_YAE", KeyHangul_YAE},
+	{"Hangul_EO", KeyHangul_EO},
+	{"Hangul_E", KeyHangul_E},
+	{"Hangul_YEO", KeyHangul_YEO},
+	{"Hangul_YE", KeyHangul_YE},
+	{"Hangul_O", KeyHangul_O},
+	{"Hangul_WA", KeyHangul_WA},
+	{"Hangul_WAE", KeyHangul_WAE},
+	{"Hangul_OE", KeyHangul_OE},
+	{"Hangul_YO", KeyHangul_YO},
+	{"Hangul_U", KeyHangul_U},
+	{"Hangul_WEO", KeyHangul_WEO},
+	{"Hangul_WE", KeyHangul_WE},
+	{"Hangul_WI", KeyHangul_WI},
+	{"Hangul_YU", KeyHangul_YU},
+	{"Hangul_EU", KeyHangul_EU},
+	{"Hangul_YI", KeyHangul_YI},
+	{"Hangul_I", KeyHangul_I},
+	{"Hangul_J_Kiyeog", KeyHangul_J_Kiyeog},
+	{"Hangul_J_SsangKiyeog", KeyHangul_J_SsangKiyeog},
+	{"Hangul_J_KiyeogSios", KeyHangul_J_KiyeogSios},
+	{"Hangul_J_Nieun", KeyHangul_J_Nieun},
+	{"Hangul_J_NieunJieuj", KeyHangul_J_NieunJieuj},
+	{"Hangul_J_NieunHieuh", KeyHangul_J_NieunHieuh},
+	{"Hangul_J_Dikeud", KeyHangul_J_Dikeud},
+	{"Hangul_J_Rieul", KeyHangul_J_Rieul},
+	{"Hangul_J_RieulKiyeog", KeyHangul_J_RieulKiyeog},
+	{"Hangul_J_RieulMieum", KeyHangul_J_RieulMieum},
+	{"Hangul_J_RieulPieub", KeyHangul_J_RieulPieub},
+	{"Hangul_J_RieulSios", KeyHangul_J_RieulSios},
+	{"Hangul_J_RieulTieut", KeyHangul_J_RieulTieut},
+	{"Hangul_J_RieulPhieuf", KeyHangul_J_RieulPhieuf},
+	{"Hangul_J_RieulHieuh", KeyHangul_J_RieulHieuh},
+	{"Hangul_J_Mieum", KeyHangul_J_Mieum},
+	{"Hangul_J_Pieub", KeyHangul_J_Pieub},
+	{"Hangul_J_PieubSios", KeyHangul_J_PieubSios},
+	{"Hangul_J_Sios", KeyHangul_J_Sios},
+	{"Hangul_J_SsangSios", KeyHangul_J_SsangSios},
+	{"Hangul_J_Ieung", KeyHangul_J_Ieung},
+	{"Hangul_J_Jieuj", KeyHangul_J_Jieuj},
+	{"Hangul_J_Cieuc", KeyHangul_J_Cieuc},
+	{"Hangul_J_Khieuq", KeyHangul_J_Khieuq},
+	{"Hangul_J_Tieut", KeyHangul_J_Tieut},
+	{"Hangul_J_Phieuf", KeyHangul_J_Phieuf},
+	{"Hangul_J_Hieuh", KeyHangul_J_Hieuh},
+	{"Hangul_RieulYeorinHieuh", KeyHangul_RieulYeorinHieuh},
+	{"Hangul_SunkyeongeumMieum", KeyHangul_SunkyeongeumMieum},
+	{"Hangul_SunkyeongeumPieub", KeyHangul_SunkyeongeumPieub},
+	{"Hangul_PanSios", KeyHangul_PanSios},
+	{"Hangul_KkogjiDalrinIeung", KeyHangul_KkogjiDalrinIeung},
+	{"Hangul_SunkyeongeumPhieuf", KeyHangul_SunkyeongeumPhieuf},
+	{"Hangul_YeorinHieuh", KeyHangul_YeorinHieuh},
+	{"Hangul_AraeA", KeyHangul_AraeA},
+	{"Hangul_AraeAE", KeyHangul_AraeAE},
+	{"Hangul_J_PanSios", KeyHangul_J_PanSios},
+	{"Hangul_J_KkogjiDalrinIeung", KeyHangul_J_KkogjiDalrinIeung},
+	{"Hangul_J_YeorinHieuh", KeyHangul_J_YeorinHieuh},
+	{"Korean_Won", KeyKorean_Won},
+	{"OE", KeyOE},
+	{"oe", Keyoe},
+	{"Ydiaeresis", KeyYdiaeresis},
+	{"EuroSign", KeyEuroSign},
+	{"3270_Duplicate", Key3270_Duplicate},
+	{"3270_FieldMark", Key3270_FieldMark},
+	{"3270_Right2", Key3270_Right2},
+	{"3270_Left2", Key3270_Left2},
+	{"3270_BackTab", Key3270_BackTab},
+	{"3270_EraseEOF", Key3270_EraseEOF},
+	{"3270_EraseInput", Key3270_EraseInput},
+	{"3270_Reset", Key3270_Reset},
+	{"3270_Quit", Key3270_Quit},
+	{"3270_PA1", Key3270_PA1},
+	{"3270_PA2", Key3270_PA2},
+	{"3270_PA3", Key3270_PA3},
+	{"3270_Test", Key3270_Test},
+	{"3270_Attn", Key3270_Attn},
+	{"3270_CursorBlink", Key3270_CursorBlink},
+	{"3270_AltCursor", Key3270_AltCursor},
+	{"3270_KeyClick", Key3270_KeyClick},
+	{"3270_Jump", Key3270_Jump},
+	{"3270_Ident", Key3270_Ident},
+	{"3270_Rule", Key3270_Rule},
+	{"3270_Copy", Key3270_Copy},
+	{"3270_Play", Key3270_Play},
+	{"3270_Setup", Key3270_Setup},
+	{"3270_Record", Key3270_Record},
+	{"3270_ChangeScreen", Key3270_ChangeScreen},
+	{"3270_DeleteWord", Key3270_DeleteWord},
+	{"3270_ExSelect", Key3270_ExSelect},
+	{"3270_CursorSelect", Key3270_CursorSelect},
+	{"3270_PrintScreen", Key3270_PrintScreen},
+	{"3270_Enter", Key3270_Enter},
+	{"ISO_Lock", KeyISO_Lock},
+	{"ISO_Level2_Latch", KeyISO_Level2_Latch},
+	{"ISO_Level3_Shift", KeyISO_Level3_Shift},
+	{"ISO_Level3_Latch", KeyISO_Level3_Latch},
+	{"ISO_Level3_Lock", KeyISO_Level3_Lock},
+	{"ISO_Group_Latch", KeyISO_Group_Latch},
+	{"ISO_Group_Lock", KeyISO_Group_Lock},
+	{"ISO_Next_Group", KeyISO_Next_Group},
+	{"ISO_Next_Group_Lock", KeyISO_Next_Group_Lock},
+	{"ISO_Prev_Group", KeyISO_Prev_Group},
+	{"ISO_Prev_Group_Lock", KeyISO_Prev_Group_Lock},
+	{"ISO_First_Group", KeyISO_First_Group},
+	{"ISO_First_Group_Lock", KeyISO_First_Group_Lock},
+	{"ISO_Last_Group", KeyISO_Last_Group},
+	{"ISO_Last_Group_Lock", KeyISO_Last_Group_Lock},
+	{"ISO_Level5_Shift", KeyISO_Level5_Shift},
+	{"ISO_Level5_Latch", KeyISO_Level5_Latch},
+	{"ISO_Level5_Lock", KeyISO_Level5_Lock},
+	{"ISO_Left_Tab", KeyISO_Left_Tab},
+	{"ISO_Move_Line_Up", KeyISO_Move_Line_Up},
+	{"ISO_Move_Line_Down", KeyISO_Move_Line_Down},
+	{"ISO_Partial_Line_Up", KeyISO_Partial_Line_Up},
+	{"ISO_Partial_Line_Down", KeyISO_Partial_Line_Down},
+	{"ISO_Partial_Space_Left", KeyISO_Partial_Space_Left},
+	{"ISO_Partial_Space_Right", KeyISO_Partial_Space_Right},
+	{"ISO_Set_Margin_Left", KeyISO_Set_Margin_Left},
+	{"ISO_Set_Margin_Right", KeyISO_Set_Margin_Right},
+	{"ISO_Release_Margin_Left", KeyISO_Release_Margin_Left},
+	{"ISO_Release_Margin_Right", KeyISO_Release_Margin_Right},
+	{"ISO_Release_Both_Margins", KeyISO_Release_Both_Margins},
+	{"ISO_Fast_Cursor_Left", KeyISO_Fast_Cursor_Left},
+	{"ISO_Fast_Cursor_Right", KeyISO_Fast_Cursor_Right},
+	{"ISO_Fast_Cursor_Up", KeyISO_Fast_Cursor_Up},
+	{"ISO_Fast_Cursor_Down", KeyISO_Fast_Cursor_Down},
+	{"ISO_Continuous_Underline", KeyISO_Continuous_Underline},
+	{"ISO_Discontinuous_Underline", KeyISO_Discontinuous_Underline},
+	{"ISO_Emphasize", KeyISO_Emphasize},
+	{"ISO_Center_Object", KeyISO_Center_Object},
+	{"ISO_Enter", KeyISO_Enter},
+	{"dead_grave", Keydead_grave},
+	{"dead_acute", Keydead_acute},
+	{"dead_circumflex", Keydead_circumflex},
+	{"dead_tilde", Keydead_tilde},
+	{"dead_macron", Keydead_macron},
+	{"dead_breve", Keydead_breve},
+	{"dead_abovedot", Keydead_abovedot},
+	{"dead_diaeresis", Keydead_diaeresis},
+	{"dead_abovering", Keydead_abovering},
+	{"dead_doubleacute", Keydead_doubleacute},
+	{"dead_caron", Keydead_caron},
+	{"dead_cedilla", Keydead_cedilla},
+	{"dead_ogonek", Keydead_ogonek},
+	{"dead_iota", Keydead_iota},
+	{"dead_voiced_sound", Keydead_voiced_sound},
+	{"dead_semivoiced_sound", Keydead_semivoiced_sound},
+	{"dead_belowdot", Keydead_belowdot},
+	{"dead_hook", Keydead_hook},
+	{"dead_horn", Keydead_horn},
+	{"dead_stroke", Keydead_stroke},
+	{"dead_abovecomma", Keydead_abovecomma},
+	{"dead_abovereversedcomma", Keydead_abovereversedcomma},
+	{"dead_doublegrave", Keydead_doublegrave},
+	{"dead_belowring", Keydead_belowring},
+	{"dead_belowmacron", Keydead_belowmacron},
+	{"dead_belowcircumflex", Keydead_belowcircumflex},
+	{"dead_belowtilde", Keydead_belowtilde},
+	{"dead_belowbreve", Keydead_belowbreve},
+	{"dead_belowdiaeresis", Keydead_belowdiaeresis},
+	{"dead_invertedbreve", Keydead_invertedbreve},
+	{"dead_belowcomma", Keydead_belowcomma},
+	{"dead_currency", Keydead_currency},
+	{"AccessX_Enable", KeyAccessX_Enable},
+	{"AccessX_Feedback_Enable", KeyAccessX_Feedback_Enable},
+	{"RepeatKeys_Enable", KeyRepeatKeys_Enable},
+	{"SlowKeys_Enable", KeySlowKeys_Enable},
+	{"BounceKeys_Enable", KeyBounceKeys_Enable},
+	{"StickyKeys_Enable", KeyStickyKeys_Enable},
+	{"MouseKeys_Enable", KeyMouseKeys_Enable},
+	{"MouseKeys_Accel_Enable", KeyMouseKeys_Accel_Enable},
+	{"Overlay1_Enable", KeyOverlay1_Enable},
+	{"Overlay2_Enable", KeyOverlay2_Enable},
+	{"AudibleBell_Enable", KeyAudibleBell_Enable},
+	{"dead_a", Keydead_a},
+	{"dead_A", Keydead_A},
+	{"dead_e", Keydead_e},
+	{"dead_E", Keydead_E},
+	{"dead_i", Keydead_i},
+	{"dead_I", Keydead_I},
+	{"dead_o", Keydead_o},
+	{"dead_O", Keydead_O},
+	{"dead_u", Keydead_u},
+	{"dead_U", Keydead_U},
+	{"dead_small_schwa", Keydead_small_schwa},
+	{"dead_capital_schwa", Keydead_capital_schwa},
+	{"dead_greek", Keydead_greek},
+	{"dead_lowline", Keydead_lowline},
+	{"dead_aboveverticalline", Keydead_aboveverticalline},
+	{"dead_belowverticalline", Keydead_belowverticalline},
+	{"dead_longsolidusoverlay", Keydead_longsolidusoverlay},
+	{"ch", Keych},
+	{"Ch", KeyCh},
+	{"CH", KeyCH},
+	{"c_h", Keyc_h},
+	{"C_h", KeyC_h},
+	{"C_H", KeyC_H},
+	{"First_Virtual_Screen", KeyFirst_Virtual_Screen},
+	{"Prev_Virtual_Screen", KeyPrev_Virtual_Screen},
+	{"Next_Virtual_Screen", KeyNext_Virtual_Screen},
+	{"Last_Virtual_Screen", KeyLast_Virtual_Screen},
+	{"Terminate_Server", KeyTerminate_Server},
+	{"Pointer_Left", KeyPointer_Left},
+	{"Pointer_Right", KeyPointer_Right},
+	{"Pointer_Up", KeyPointer_Up},
+	{"Pointer_Down", KeyPointer_Down},
+	{"Pointer_UpLeft", KeyPointer_UpLeft},
+	{"Pointer_UpRight", KeyPointer_UpRight},
+	{"Pointer_DownLeft", KeyPointer_DownLeft},
+	{"Pointer_DownRight", KeyPointer_DownRight},
+	{"Pointer_Button_Dflt", KeyPointer_Button_Dflt},
+	{"Pointer_Button1", KeyPointer_Button1},
+	{"Pointer_Button2", KeyPointer_Button2},
+	{"Pointer_Button3", KeyPointer_Button3},
+	{"Pointer_Button4", KeyPointer_Button4},
+	{"Pointer_Button5", KeyPointer_Button5},
+	{"Pointer_DblClick_Dflt", KeyPointer_DblClick_Dflt},
+	{"Pointer_DblClick1", KeyPointer_DblClick1},
+	{"Pointer_DblClick2", KeyPointer_DblClick2},
+	{"Pointer_DblClick3", KeyPointer_DblClick3},
+	{"Pointer_DblClick4", KeyPointer_DblClick4},
+	{"Pointer_DblClick5", KeyPointer_DblClick5},
+	{"Pointer_Drag_Dflt", KeyPointer_Drag_Dflt},
+	{"Pointer_Drag1", KeyPointer_Drag1},
+	{"Pointer_Drag2", KeyPointer_Drag2},
+	{"Pointer_Drag3", KeyPointer_Drag3},
+	{"Pointer_Drag4", KeyPointer_Drag4},
+	{"Pointer_EnableKeys", KeyPointer_EnableKeys},
+	{"Pointer_Accelerate", KeyPointer_Accelerate},
+	{"Pointer_DfltBtnNext", KeyPointer_DfltBtnNext},
+	{"Pointer_DfltBtnPrev", KeyPointer_DfltBtnPrev},
+	{"Pointer_Drag5", KeyPointer_Drag5},
+	{"BackSpace", KeyBackSpace},
+	{"Tab", KeyTab},
+	{"Linefeed", KeyLinefeed},
+	{"Clear", KeyClear},
+	{"Return", KeyReturn},
+	{"Pause", KeyPause},
+	{"Scroll_Lock", KeyScroll_Lock},
+	{"Sys_Req", KeySys_Req},
+	{"Escape", KeyEscape},
+	{"Multi_key", KeyMulti_key},
+	{"Kanji", KeyKanji},
+	{"Muhenkan", KeyMuhenkan},
+	{"Henkan_Mode", KeyHenkan_Mode},
+	{"Romaji", KeyRomaji},
+	{"Hiragana", KeyHiragana},
+	{"Katakana", KeyKatakana},
+	{"Hiragana_Katakana", KeyHiragana_Katakana},
+	{"Zenkaku", KeyZenkaku},
+	{"Hankaku", KeyHankaku},
+	{"Zenkaku_Hankaku", KeyZenkaku_Hankaku},
+	{"Touroku", KeyTouroku},
+	{"Massyo", KeyMassyo},
+	{"Kana_Lock", KeyKana_Lock},
+	{"Kana_Shift", KeyKana_Shift},
+	{"Eisu_Shift", KeyEisu_Shift},
+	{"Eisu_toggle", KeyEisu_toggle},
+	{"Hangul", KeyHangul},
+	{"Hangul_Start", KeyHangul_Start},
+	{"Hangul_End", KeyHangul_End},
+	{"Hangul_Hanja", KeyHangul_Hanja},
+	{"Hangul_Jamo", KeyHangul_Jamo},
+	{"Hangul_Romaja", KeyHangul_Romaja},
+	{"Codeinput", KeyCodeinput},
+	{"Hangul_Jeonja", KeyHangul_Jeonja},
+	{"Hangul_Banja", KeyHangul_Banja},
+	{"Hangul_PreHanja", KeyHangul_PreHanja},
+	{"Hangul_PostHanja", KeyHangul_PostHanja},
+	{"SingleCandidate", KeySingleCandidate},
+	{"MultipleCandidate", KeyMultipleCandidate},
+	{"PreviousCandidate", KeyPreviousCandidate},
+	{"Hangul_Special", KeyHangul_Special},
+	{"Home", KeyHome},
+	{"Left", KeyLeft},
+	{"Up", KeyUp},
+	{"Right", KeyRight},
+	{"Down", KeyDown},
+	{"Prior", KeyPrior},
+	{"Next", KeyNext},
+	{"End", KeyEnd},
+	{"Begin", KeyBegin},
+	{"Select", KeySelect},
+	{"Print", KeyPrint},
+	{"Execute", KeyExecute},
+	{"Insert", KeyInsert},
+	{"Undo", KeyUndo},
+	{"Redo", KeyRedo},
+	{"Menu", KeyMenu},
+	{"Find", KeyFind},
+	{"Cancel", KeyCancel},
+	{"Help", KeyHelp},
+	{"Break", KeyBreak},
+	{"Mode_switch", KeyMode_switch},
+	{"Num_Lock", KeyNum_Lock},
+	{"KP_Space", KeyKP_Space},
+	{"KP_Tab", KeyKP_Tab},
+	{"KP_Enter", KeyKP_Enter},
+	{"KP_F1", KeyKP_F1},
+	{"KP_F2", KeyKP_F2},
+	{"KP_F3", KeyKP_F3},
+	{"KP_F4", KeyKP_F4},
+	{"KP_Home", KeyKP_Home},
+	{"KP_Left", KeyKP_Left},
+	{"KP_Up", KeyKP_Up},
+	{"KP_Right", KeyKP_Right},
+	{"KP_Down", KeyKP_Down},
+	{"KP_Prior", KeyKP_Prior},
+	{"KP_Next", KeyKP_Next},
+	{"KP_End", KeyKP_End},
+	{"KP_Begin", KeyKP_Begin},
+	{"KP_Insert", KeyKP_Insert},
+	{"KP_Delete", KeyKP_Delete},
+	{"KP_Multiply", KeyKP_Multiply},
+	{"KP_Add", KeyKP_Add},
+	{"KP_Separator", KeyKP_Separator},
+	{"KP_Subtract", KeyKP_Subtract},
+	{"KP_Decimal", KeyKP_Decimal},
+	{"KP_Divide", KeyKP_Divide},
+	{"KP_0", KeyKP_0},
+	{"KP_1", KeyKP_1},
+	{"KP_2", KeyKP_2},
+	{"KP_3", KeyKP_3},
+	{"KP_4", KeyKP_4},
+	{"KP_5", KeyKP_5},
+	{"KP_6", KeyKP_6},
+	{"KP_7", KeyKP_7},
+	{"KP_8", KeyKP_8},
+	{"KP_9", KeyKP_9},
+	{"KP_Equal", KeyKP_Equal},
+	{"F1", KeyF1},
+	{"F2", KeyF2},
+	{"F3", KeyF3},
+	{"F4", KeyF4},
+	{"F5", KeyF5},
+	{"F6", KeyF6},
+	{"F7", KeyF7},
+	{"F8", KeyF8},
+	{"F9", KeyF9},
+	{"F10", KeyF10},
+	{"F11", KeyF11},
+	{"F12", KeyF12},
+	{"F13", KeyF13},
+	{"F14", KeyF14},
+	{"F15", KeyF15},
+	{"F16", KeyF16},
+	{"F17", KeyF17},
+	{"F18", KeyF18},
+	{"F19", KeyF19},
+	{"F20", KeyF20},
+	{"F21", KeyF21},
+	{"F22", KeyF22},
+	{"F23", KeyF23},
+	{"F24", KeyF24},
+	{"F25", KeyF25},
+	{"F26", KeyF26},
+	{"F27", KeyF27},
+	{"F28", KeyF28},
+	{"F29", KeyF29},
+	{"F30", KeyF30},
+	{"F31", KeyF31},
+	{"F32", KeyF32},
+	{"F33", KeyF33},
+	{"F34", KeyF34},
+	{"F35", KeyF35},
+	{"Shift_L", KeyShift_L},
+	{"Shift_R", KeyShift_R},
+	{"Control_L", KeyControl_L},
+	{"Control_R", KeyControl_R},
+	{"Caps_Lock", KeyCaps_Lock},
+	{"Shift_Lock", KeyShift_Lock},
+	{"Meta_L", KeyMeta_L},
+	{"Meta_R", KeyMeta_R},
+	{"Alt_L", KeyAlt_L},
+	{"Alt_R", KeyAlt_R},
+	{"Super_L", KeySuper_L},
+	{"Super_R", KeySuper_R},
+	{"Hyper_L", KeyHyper_L},
+	{"Hyper_R", KeyHyper_R},
+	{"braille_dot_1", Keybraille_dot_1},
+	{"braille_dot_2", Keybraille_dot_2},
+	{"braille_dot_3", Keybraille_dot_3},
+	{"braille_dot_4", Keybraille_dot_4},
+	{"braille_dot_5", Keybraille_dot_5},
+	{"braille_dot_6", Keybraille_dot_6},
+	{"braille_dot_7", Keybraille_dot_7},
+	{"braille_dot_8", Keybraille_dot_8},
+	{"braille_dot_9", Keybraille_dot_9},
+	{"braille_dot_10", Keybraille_dot_10},
+	{"Delete", KeyDelete},
+	{"VoidSymbol", KeyVoidSymbol},
+	{"Ibreve", KeyIbreve},
+	{"ibreve", Keyibreve},
+	{"Wcircumflex", KeyWcircumflex},
+	{"wcircumflex", Keywcircumflex},
+	{"Ycircumflex", KeyYcircumflex},
+	{"ycircumflex", Keyycircumflex},
+	{"SCHWA", KeySCHWA},
+	{"Obarred", KeyObarred},
+	{"Ohorn", KeyOhorn},
+	{"ohorn", Keyohorn},
+	{"Uhorn", KeyUhorn},
+	{"uhorn", Keyuhorn},
+	{"Zstroke", KeyZstroke},
+	{"zstroke", Keyzstroke},
+	{"EZH", KeyEZH},
+	{"Ocaron", KeyOcaron},
+	{"ocaron", Keyocaron},
+	{"Gcaron", KeyGcaron},
+	{"gcaron", Keygcaron},
+	{"schwa", Keyschwa},
+	{"obarred", Keyobarred},
+	{"ezh", Keyezh},
+	{"combining_grave", Keycombining_grave},
+	{"combining_acute", Keycombining_acute},
+	{"combining_tilde", Keycombining_tilde},
+	{"combining_hook", Keycombining_hook},
+	{"combining_belowdot", Keycombining_belowdot},
+	{"Cyrillic_GHE_bar", KeyCyrillic_GHE_bar},
+	{"Cyrillic_ghe_bar", KeyCyrillic_ghe_bar},
+	{"Cyrillic_ZHE_descender", KeyCyrillic_ZHE_descender},
+	{"Cyrillic_zhe_descender", KeyCyrillic_zhe_descender},
+	{"Cyrillic_KA_descender", KeyCyrillic_KA_descender},
+	{"Cyrillic_ka_descender", KeyCyrillic_ka_descender},
+	{"Cyrillic_KA_vertstroke", KeyCyrillic_KA_vertstroke},
+	{"Cyrillic_ka_vertstroke", KeyCyrillic_ka_vertstroke},
+	{"Cyrillic_EN_descender", KeyCyrillic_EN_descender},
+	{"Cyrillic_en_descender", KeyCyrillic_en_descender},
+	{"Cyrillic_U_straight", KeyCyrillic_U_straight},
+	{"Cyrillic_u_straight", KeyCyrillic_u_straight},
+	{"Cyrillic_U_straight_bar", KeyCyrillic_U_straight_bar},
+	{"Cyrillic_u_straight_bar", KeyCyrillic_u_straight_bar},
+	{"Cyrillic_HA_descender", KeyCyrillic_HA_descender},
+	{"Cyrillic_ha_descender", KeyCyrillic_ha_descender},
+	{"Cyrillic_CHE_descender", KeyCyrillic_CHE_descender},
+	{"Cyrillic_che_descender", KeyCyrillic_che_descender},
+	{"Cyrillic_CHE_vertstroke", KeyCyrillic_CHE_vertstroke},
+	{"Cyrillic_che_vertstroke", KeyCyrillic_che_vertstroke},
+	{"Cyrillic_SHHA", KeyCyrillic_SHHA},
+	{"Cyrillic_shha", KeyCyrillic_shha},
+	{"Cyrillic_SCHWA", KeyCyrillic_SCHWA},
+	{"Cyrillic_schwa", KeyCyrillic_schwa},
+	{"Cyrillic_I_macron", KeyCyrillic_I_macron},
+	{"Cyrillic_i_macron", KeyCyrillic_i_macron},
+	{"Cyrillic_O_bar", KeyCyrillic_O_bar},
+	{"Cyrillic_o_bar", KeyCyrillic_o_bar},
+	{"Cyrillic_U_macron", KeyCyrillic_U_macron},
+	{"Cyrillic_u_macron", KeyCyrillic_u_macron},
+	{"Armenian_AYB", KeyArmenian_AYB},
+	{"Armenian_BEN", KeyArmenian_BEN},
+	{"Armenian_GIM", KeyArmenian_GIM},
+	{"Armenian_DA", KeyArmenian_DA},
+	{"Armenian_YECH", KeyArmenian_YECH},
+	{"Armenian_ZA", KeyArmenian_ZA},
+	{"Armenian_E", KeyArmenian_E},
+	{"Armenian_AT", KeyArmenian_AT},
+	{"Armenian_TO", KeyArmenian_TO},
+	{"Armenian_ZHE", KeyArmenian_ZHE},
+	{"Armenian_INI", KeyArmenian_INI},
+	{"Armenian_LYUN", KeyArmenian_LYUN},
+	{"Armenian_KHE", KeyArmenian_KHE},
+	{"Armenian_TSA", KeyArmenian_TSA},
+	{"Armenian_KEN", KeyArmenian_KEN},
+	{"Armenian_HO", KeyArmenian_HO},
+	{"Armenian_DZA", KeyArmenian_DZA},
+	{"Armenian_GHAT", KeyArmenian_GHAT},
+	{"Armenian_TCHE", KeyArmenian_TCHE},
+	{"Armenian_MEN", KeyArmenian_MEN},
+	{"Armenian_HI", KeyArmenian_HI},
+	{"Armenian_NU", KeyArmenian_NU},
+	{"Armenian_SHA", KeyArmenian_SHA},
+	{"Armenian_VO", KeyArmenian_VO},
+	{"Armenian_CHA", KeyArmenian_CHA},
+	{"Armenian_PE", KeyArmenian_PE},
+	{"Armenian_JE", KeyArmenian_JE},
+	{"Armenian_RA", KeyArmenian_RA},
+	{"Armenian_SE", KeyArmenian_SE},
+	{"Armenian_VEV", KeyArmenian_VEV},
+	{"Armenian_TYUN", KeyArmenian_TYUN},
+	{"Armenian_RE", KeyArmenian_RE},
+	{"Armenian_TSO", KeyArmenian_TSO},
+	{"Armenian_VYUN", KeyArmenian_VYUN},
+	{"Armenian_PYUR", KeyArmenian_PYUR},
+	{"Armenian_KE", KeyArmenian_KE},
+	{"Armenian_O", KeyArmenian_O},
+	{"Armenian_FE", KeyArmenian_FE},
+	{"Armenian_apostrophe", KeyArmenian_apostrophe},
+	{"Armenian_accent", KeyArmenian_accent},
+	{"Armenian_exclam", KeyArmenian_exclam},
+	{"Armenian_separation_mark", KeyArmenian_separation_mark},
+	{"Armenian_question", KeyArmenian_question},
+	{"Armenian_ayb", KeyArmenian_ayb},
+	{"Armenian_ben", KeyArmenian_ben},
+	{"Armenian_gim", KeyArmenian_gim},
+	{"Armenian_da", KeyArmenian_da},
+	{"Armenian_yech", KeyArmenian_yech},
+	{"Armenian_za", KeyArmenian_za},
+	{"Armenian_e", KeyArmenian_e},
+	{"Armenian_at", KeyArmenian_at},
+	{"Armenian_to", KeyArmenian_to},
+	{"Armenian_zhe", KeyArmenian_zhe},
+	{"Armenian_ini", KeyArmenian_ini},
+	{"Armenian_lyun", KeyArmenian_lyun},
+	{"Armenian_khe", KeyArmenian_khe},
+	{"Armenian_tsa", KeyArmenian_tsa},
+	{"Armenian_ken", KeyArmenian_ken},
+	{"Armenian_ho", KeyArmenian_ho},
+	{"Armenian_dza", KeyArmenian_dza},
+	{"Armenian_ghat", KeyArmenian_ghat},
+	{"Armenian_tche", KeyArmenian_tche},
+	{"Armenian_men", KeyArmenian_men},
+	{"Armenian_hi", KeyArmenian_hi},
+	{"Armenian_nu", KeyArmenian_nu},
+	{"Armenian_sha", KeyArmenian_sha},
+	{"Armenian_vo", KeyArmenian_vo},
+	{"Armenian_cha", KeyArmenian_cha},
+	{"Armenian_pe", KeyArmenian_pe},
+	{"Armenian_je", KeyArmenian_je},
+	{"Armenian_ra", KeyArmenian_ra},
+	{"Armenian_se", KeyArmenian_se},
+	{"Armenian_vev", KeyArmenian_vev},
+	{"Armenian_tyun", KeyArmenian_tyun},
+	{"Armenian_re", KeyArmenian_re},
+	{"Armenian_tso", KeyArmenian_tso},
+	{"Armenian_vyun", KeyArmenian_vyun},
+	{"Armenian_pyur", KeyArmenian_pyur},
+	{"Armenian_ke", KeyArmenian_ke},
+	{"Armenian_o", KeyArmenian_o},
+	{"Armenian_fe", KeyArmenian_fe},
+	{"Armenian_ligature_ew", KeyArmenian_ligature_ew},
+	{"Armenian_full_stop", KeyArmenian_full_stop},
+	{"Armenian_hyphen", KeyArmenian_hyphen},
+	{"Arabic_madda_above", KeyArabic_madda_above},
+	{"Arabic_hamza_above", KeyArabic_hamza_above},
+	{"Arabic_hamza_below", KeyArabic_hamza_below},
+	{"Arabic_0", KeyArabic_0},
+	{"Arabic_1", KeyArabic_1},
+	{"Arabic_2", KeyArabic_2},
+	{"Arabic_3", KeyArabic_3},
+	{"Arabic_4", KeyArabic_4},
+	{"Arabic_5", KeyArabic_5},
+	{"Arabic_6", KeyArabic_6},
+	{"Arabic_7", KeyArabic_7},
+	{"Arabic_8", KeyArabic_8},
+	{"Arabic_9", KeyArabic_9},
+	{"Arabic_percent", KeyArabic_percent},
+	{"Arabic_superscript_alef", KeyArabic_superscript_alef},
+	{"Arabic_tteh", KeyArabic_tteh},
+	{"Arabic_peh", KeyArabic_peh},
+	{"Arabic_tcheh", KeyArabic_tcheh},
+	{"Arabic_ddal", KeyArabic_ddal},
+	{"Arabic_rreh", KeyArabic_rreh},
+	{"Arabic_jeh", KeyArabic_jeh},
+	{"Arabic_veh", KeyArabic_veh},
+	{"Arabic_keheh", KeyArabic_keheh},
+	{"Arabic_gaf", KeyArabic_gaf},
+	{"Arabic_noon_ghunna", KeyArabic_noon_ghunna},
+	{"Arabic_heh_doachashmee", KeyArabic_heh_doachashmee},
+	{"Arabic_heh_goal", KeyArabic_heh_goal},
+	{"Farsi_yeh", KeyFarsi_yeh},
+	{"Arabic_yeh_baree", KeyArabic_yeh_baree},
+	{"Arabic_fullstop", KeyArabic_fullstop},
+	{"Farsi_0", KeyFarsi_0},
+	{"Farsi_1", KeyFarsi_1},
+	{"Farsi_2", KeyFarsi_2},
+	{"Farsi_3", KeyFarsi_3},
+	{"Farsi_4", KeyFarsi_4},
+	{"Farsi_5", KeyFarsi_5},
+	{"Farsi_6", KeyFarsi_6},
+	{"Farsi_7", KeyFarsi_7},
+	{"Farsi_8", KeyFarsi_8},
+	{"Farsi_9", KeyFarsi_9},
+	{"Sinh_ng", KeySinh_ng},
+	{"Sinh_h2", KeySinh_h2},
+	{"Sinh_a", KeySinh_a},
+	{"Sinh_aa", KeySinh_aa},
+	{"Sinh_ae", KeySinh_ae},
+	{"Sinh_aee", KeySinh_aee},
+	{"Sinh_i", KeySinh_i},
+	{"Sinh_ii", KeySinh_ii},
+	{"Sinh_u", KeySinh_u},
+	{"Sinh_uu", KeySinh_uu},
+	{"Sinh_ri", KeySinh_ri},
+	{"Sinh_rii", KeySinh_rii},
+	{"Sinh_lu", KeySinh_lu},
+	{"Sinh_luu", KeySinh_luu},
+	{"Sinh_e", KeySinh_e},
+	{"Sinh_ee", KeySinh_ee},
+	{"Sinh_ai", KeySinh_ai},
+	{"Sinh_o", KeySinh_o},
+	{"Sinh_oo", KeySinh_oo},
+	{"Sinh_au", KeySinh_au},
+	{"Sinh_ka", KeySinh_ka},
+	{"Sinh_kha", KeySinh_kha},
+	{"Sinh_ga", KeySinh_ga},
+	{"Sinh_gha", KeySinh_gha},
+	{"Sinh_ng2", KeySinh_ng2},
+	{"Sinh_nga", KeySinh_nga},
+	{"Sinh_ca", KeySinh_ca},
+	{"Sinh_cha", KeySinh_cha},
+	{"Sinh_ja", KeySinh_ja},
+	{"Sinh_jha", KeySinh_jha},
+	{"Sinh_nya", KeySinh_nya},
+	{"Sinh_jnya", KeySinh_jnya},
+	{"Sinh_nja", KeySinh_nja},
+	{"Sinh_tta", KeySinh_tta},
+	{"Sinh_ttha", KeySinh_ttha},
+	{"Sinh_dda", KeySinh_dda},
+	{"Sinh_ddha", KeySinh_ddha},
+	{"Sinh_nna", KeySinh_nna},
+	{"Sinh_ndda", KeySinh_ndda},
+	{"Sinh_tha", KeySinh_tha},
+	{"Sinh_thha", KeySinh_thha},
+	{"Sinh_dha", KeySinh_dha},
+	{"Sinh_dhha", KeySinh_dhha},
+	{"Sinh_na", KeySinh_na},
+	{"Sinh_ndha", KeySinh_ndha},
+	{"Sinh_pa", KeySinh_pa},
+	{"Sinh_pha", KeySinh_pha},
+	{"Sinh_ba", KeySinh_ba},
+	{"Sinh_bha", KeySinh_bha},
+	{"Sinh_ma", KeySinh_ma},
+	{"Sinh_mba", KeySinh_mba},
+	{"Sinh_ya", KeySinh_ya},
+	{"Sinh_ra", KeySinh_ra},
+	{"Sinh_la", KeySinh_la},
+	{"Sinh_va", KeySinh_va},
+	{"Sinh_sha", KeySinh_sha},
+	{"Sinh_ssha", KeySinh_ssha},
+	{"Sinh_sa", KeySinh_sa},
+	{"Sinh_ha", KeySinh_ha},
+	{"Sinh_lla", KeySinh_lla},
+	{"Sinh_fa", KeySinh_fa},
+	{"Sinh_al", KeySinh_al},
+	{"Sinh_aa2", KeySinh_aa2},
+	{"Sinh_ae2", KeySinh_ae2},
+	{"Sinh_aee2", KeySinh_aee2},
+	{"Sinh_i2", KeySinh_i2},
+	{"Sinh_ii2", KeySinh_ii2},
+	{"Sinh_u2", KeySinh_u2},
+	{"Sinh_uu2", KeySinh_uu2},
+	{"Sinh_ru2", KeySinh_ru2},
+	{"Sinh_e2", KeySinh_e2},
+	{"Sinh_ee2", KeySinh_ee2},
+	{"Sinh_ai2", KeySinh_ai2},
+	{"Sinh_o2", KeySinh_o2},
+	{"Sinh_oo2", KeySinh_oo2},
+	{"Sinh_au2", KeySinh_au2},
+	{"Sinh_lu2", KeySinh_lu2},
+	{"Sinh_ruu2", KeySinh_ruu2},
+	{"Sinh_luu2", KeySinh_luu2},
+	{"Sinh_kunddaliya", KeySinh_kunddaliya},
+	{"Georgian_an", KeyGeorgian_an},
+	{"Georgian_ban", KeyGeorgian_ban},
+	{"Georgian_gan", KeyGeorgian_gan},
+	{"Georgian_don", KeyGeorgian_don},
+	{"Georgian_en", KeyGeorgian_en},
+	{"Georgian_vin", KeyGeorgian_vin},
+	{"Georgian_zen", KeyGeorgian_zen},
+	{"Georgian_tan", KeyGeorgian_tan},
+	{"Georgian_in", KeyGeorgian_in},
+	{"Georgian_kan", KeyGeorgian_kan},
+	{"Georgian_las", KeyGeorgian_las},
+	{"Georgian_man", KeyGeorgian_man},
+	{"Georgian_nar", KeyGeorgian_nar},
+	{"Georgian_on", KeyGeorgian_on},
+	{"Georgian_par", KeyGeorgian_par},
+	{"Georgian_zhar", KeyGeorgian_zhar},
+	{"Georgian_rae", KeyGeorgian_rae},
+	{"Georgian_san", KeyGeorgian_san},
+	{"Georgian_tar", KeyGeorgian_tar},
+	{"Georgian_un", KeyGeorgian_un},
+	{"Georgian_phar", KeyGeorgian_phar},
+	{"Georgian_khar", KeyGeorgian_khar},
+	{"Georgian_ghan", KeyGeorgian_ghan},
+	{"Georgian_qar", KeyGeorgian_qar},
+	{"Georgian_shin", KeyGeorgian_shin},
+	{"Georgian_chin", KeyGeorgian_chin},
+	{"Georgian_can", KeyGeorgian_can},
+	{"Georgian_jil", KeyGeorgian_jil},
+	{"Georgian_cil", KeyGeorgian_cil},
+	{"Georgian_char", KeyGeorgian_char},
+	{"Georgian_xan", KeyGeorgian_xan},
+	{"Georgian_jhan", KeyGeorgian_jhan},
+	{"Georgian_hae", KeyGeorgian_hae},
+	{"Georgian_he", KeyGeorgian_he},
+	{"Georgian_hie", KeyGeorgian_hie},
+	{"Georgian_we", KeyGeorgian_we},
+	{"Georgian_har", KeyGeorgian_har},
+	{"Georgian_hoe", KeyGeorgian_hoe},
+	{"Georgian_fi", KeyGeorgian_fi},
+	{"Babovedot", KeyBabovedot},
+	{"babovedot", Keybabovedot},
+	{"Dabovedot", KeyDabovedot},
+	{"dabovedot", Keydabovedot},
+	{"Fabovedot", KeyFabovedot},
+	{"fabovedot", Keyfabovedot},
+	{"Lbelowdot", KeyLbelowdot},
+	{"lbelowdot", Keylbelowdot},
+	{"Mabovedot", KeyMabovedot},
+	{"mabovedot", Keymabovedot},
+	{"Pabovedot", KeyPabovedot},
+	{"pabovedot", Keypabovedot},
+	{"Sabovedot", KeySabovedot},
+	{"sabovedot", Keysabovedot},
+	{"Tabovedot", KeyTabovedot},
+	{"tabovedot", Keytabovedot},
+	{"Wgrave", KeyWgrave},
+	{"wgrave", Keywgrave},
+	{"Wacute", KeyWacute},
+	{"wacute", Keywacute},
+	{"Wdiaeresis", KeyWdiaeresis},
+	{"wdiaeresis", Keywdiaeresis},
+	{"Xabovedot", KeyXabovedot},
+	{"xabovedot", Keyxabovedot},
+	{"Abelowdot", KeyAbelowdot},
+	{"abelowdot", Keyabelowdot},
+	{"Ahook", KeyAhook},
+	{"ahook", Keyahook},
+	{"Acircumflexacute", KeyAcircumflexacute},
+	{"acircumflexacute", Keyacircumflexacute},
+	{"Acircumflexgrave", KeyAcircumflexgrave},
+	{"acircumflexgrave", Keyacircumflexgrave},
+	{"Acircumflexhook", KeyAcircumflexhook},
+	{"acircumflexhook", Keyacircumflexhook},
+	{"Acircumflextilde", KeyAcircumflextilde},
+	{"acircumflextilde", Keyacircumflextilde},
+	{"Acircumflexbelowdot", KeyAcircumflexbelowdot},
+	{"acircumflexbelowdot", Keyacircumflexbelowdot},
+	{"Abreveacute", KeyAbreveacute},
+	{"abreveacute", Keyabreveacute},
+	{"Abrevegrave", KeyAbrevegrave},
+	{"abrevegrave", Keyabrevegrave},
+	{"Abrevehook", KeyAbrevehook},
+	{"abrevehook", Keyabrevehook},
+	{"Abrevetilde", KeyAbrevetilde},
+	{"abrevetilde", Keyabrevetilde},
+	{"Abrevebelowdot", KeyAbrevebelowdot},
+	{"abrevebelowdot", Keyabrevebelowdot},
+	{"Ebelowdot", KeyEbelowdot},
+	{"ebelowdot", Keyebelowdot},
+	{"Ehook", KeyEhook},
+	{"ehook", Keyehook},
+	{"Etilde", KeyEtilde},
+	{"etilde", Keyetilde},
+	{"Ecircumflexacute", KeyEcircumflexacute},
+	{"ecircumflexacute", Keyecircumflexacute},
+	{"Ecircumflexgrave", KeyEcircumflexgrave},
+	{"ecircumflexgrave", Keyecircumflexgrave},
+	{"Ecircumflexhook", KeyEcircumflexhook},
+	{"ecircumflexhook", Keyecircumflexhook},
+	{"Ecircumflextilde", KeyEcircumflextilde},
+	{"ecircumflextilde", Keyecircumflextilde},
+	{"Ecircumflexbelowdot", KeyEcircumflexbelowdot},
+	{"ecircumflexbelowdot", Keyecircumflexbelowdot},
+	{"Ihook", KeyIhook},
+	{"ihook", Keyihook},
+	{"Ibelowdot", KeyIbelowdot},
+	{"ibelowdot", Keyibelowdot},
+	{"Obelowdot", KeyObelowdot},
+	{"obelowdot", Keyobelowdot},
+	{"Ohook", KeyOhook},
+	{"ohook", Keyohook},
+	{"Ocircumflexacute", KeyOcircumflexacute},
+	{"ocircumflexacute", Keyocircumflexacute},
+	{"Ocircumflexgrave", KeyOcircumflexgrave},
+	{"ocircumflexgrave", Keyocircumflexgrave},
+	{"Ocircumflexhook", KeyOcircumflexhook},
+	{"ocircumflexhook", Keyocircumflexhook},
+	{"Ocircumflextilde", KeyOcircumflextilde},
+	{"ocircumflextilde", Keyocircumflextilde},
+	{"Ocircumflexbelowdot", KeyOcircumflexbelowdot},
+	{"ocircumflexbelowdot", Keyocircumflexbelowdot},
+	{"Ohornacute", KeyOhornacute},
+	{"ohornacute", Keyohornacute},
+	{"Ohorngrave", KeyOhorngrave},
+	{"ohorngrave", Keyohorngrave},
+	{"Ohornhook", KeyOhornhook},
+	{"ohornhook", Keyohornhook},
+	{"Ohorntilde", KeyOhorntilde},
+	{"ohorntilde", Keyohorntilde},
+	{"Ohornbelowdot", KeyOhornbelowdot},
+	{"ohornbelowdot", Keyohornbelowdot},
+	{"Ubelowdot", KeyUbelowdot},
+	{"ubelowdot", Keyubelowdot},
+	{"Uhook", KeyUhook},
+	{"uhook", Keyuhook},
+	{"Uhornacute", KeyUhornacute},
+	{"uhornacute", Keyuhornacute},
+	{"Uhorngrave", KeyUhorngrave},
+	{"uhorngrave", Keyuhorngrave},
+	{"Uhornhook", KeyUhornhook},
+	{"uhornhook", Keyuhornhook},
+	{"Uhorntilde", KeyUhorntilde},
+	{"uhorntilde", Keyuhorntilde},
+	{"Uhornbelowdot", KeyUhornbelowdot},
+	{"uhornbelowdot", Keyuhornbelowdot},
+	{"Ygrave", KeyYgrave},
+	{"ygrave", Keyygrave},
+	{"Ybelowdot", KeyYbelowdot},
+	{"ybelowdot", Keyybelowdot},
+	{"Yhook", KeyYhook},
+	{"yhook", Keyyhook},
+	{"Ytilde", KeyYtilde},
+	{"ytilde", Keyytilde},
+	{"zerosuperior", Keyzerosuperior},
+	{"foursuperior", Keyfoursuperior},
+	{"fivesuperior", Keyfivesuperior},
+	{"sixsuperior", Keysixsuperior},
+	{"sevensuperior", Keysevensuperior},
+	{"eightsuperior", Keyeightsuperior},
+	{"ninesuperior", Keyninesuperior},
+	{"zerosubscript", Keyzerosubscript},
+	{"onesubscript", Keyonesubscript},
+	{"twosubscript", Keytwosubscript},
+	{"threesubscript", Keythreesubscript},
+	{"foursubscript", Keyfoursubscript},
+	{"fivesubscript", Keyfivesubscript},
+	{"sixsubscript", Keysixsubscript},
+	{"sevensubscript", Keysevensubscript},
+	{"eightsubscript", Keyeightsubscript},
+	{"ninesubscript", Keyninesubscript},
+	{"EcuSign", KeyEcuSign},
+	{"ColonSign", KeyColonSign},
+	{"CruzeiroSign", KeyCruzeiroSign},
+	{"FFrancSign", KeyFFrancSign},
+	{"LiraSign", KeyLiraSign},
+	{"MillSign", KeyMillSign},
+	{"NairaSign", KeyNairaSign},
+	{"PesetaSign", KeyPesetaSign},
+	{"RupeeSign", KeyRupeeSign},
+	{"WonSign", KeyWonSign},
+	{"NewSheqelSign", KeyNewSheqelSign},
+	{"DongSign", KeyDongSign},
+	{"partdifferential", Keypartdifferential},
+	{"emptyset", Keyemptyset},
+	{"elementof", Keyelementof},
+	{"notelementof", Keynotelementof},
+	{"containsas", Keycontainsas},
+	{"squareroot", Keysquareroot},
+	{"cuberoot", Keycuberoot},
+	{"fourthroot", Keyfourthroot},
+	{"dintegral", Keydintegral},
+	{"tintegral", Keytintegral},
+	{"because", Keybecause},
+	{"notapproxeq", Keynotapproxeq},
+	{"approxeq", Keyapproxeq},
+	{"notidentical", Keynotidentical},
+	{"stricteq", Keystricteq},
+	{"braille_blank", Keybraille_blank},
+	{"braille_dots_1", Keybraille_dots_1},
+	{"braille_dots_2", Keybraille_dots_2},
+	{"braille_dots_12", Keybraille_dots_12},
+	{"braille_dots_3", Keybraille_dots_3},
+	{"braille_dots_13", Keybraille_dots_13},
+	{"braille_dots_23", Keybraille_dots_23},
+	{"braille_dots_123", Keybraille_dots_123},
+	{"braille_dots_4", Keybraille_dots_4},
+	{"braille_dots_14", Keybraille_dots_14},
+	{"braille_dots_24", Keybraille_dots_24},
+	{"braille_dots_124", Keybraille_dots_124},
+	{"braille_dots_34", Keybraille_dots_34},
+	{"braille_dots_134", Keybraille_dots_134},
+	{"braille_dots_234", Keybraille_dots_234},
+	{"braille_dots_1234", Keybraille_dots_1234},
+	{"braille_dots_5", Keybraille_dots_5},
+	{"braille_dots_15", Keybraille_dots_15},
+	{"braille_dots_25", Keybraille_dots_25},
+	{"braille_dots_125", Keybraille_dots_125},
+	{"braille_dots_35", Keybraille_dots_35},
+	{"braille_dots_135", Keybraille_dots_135},
+	{"braille_dots_235", Keybraille_dots_235},
+	{"braille_dots_1235", Keybraille_dots_1235},
+	{"braille_dots_45", Keybraille_dots_45},
+	{"braille_dots_145", Keybraille_dots_145},
+	{"braille_dots_245", Keybraille_dots_245},
+	{"braille_dots_1245", Keybraille_dots_1245},
+	{"braille_dots_345", Keybraille_dots_345},
+	{"braille_dots_1345", Keybraille_dots_1345},
+	{"braille_dots_2345", Keybraille_dots_2345},
+	{"braille_dots_12345", Keybraille_dots_12345},
+	{"braille_dots_6", Keybraille_dots_6},
+	{"braille_dots_16", Keybraille_dots_16},
+	{"braille_dots_26", Keybraille_dots_26},
+	{"braille_dots_126", Keybraille_dots_126},
+	{"braille_dots_36", Keybraille_dots_36},
+	{"braille_dots_136", Keybraille_dots_136},
+	{"braille_dots_236", Keybraille_dots_236},
+	{"braille_dots_1236", Keybraille_dots_1236},
+	{"braille_dots_46", Keybraille_dots_46},
+	{"braille_dots_146", Keybraille_dots_146},
+	{"braille_dots_246", Keybraille_dots_246},
+	{"braille_dots_1246", Keybraille_dots_1246},
+	{"braille_dots_346", Keybraille_dots_346},
+	{"braille_dots_1346", Keybraille_dots_1346},
+	{"braille_dots_2346", Keybraille_dots_2346},
+	{"braille_dots_12346", Keybraille_dots_12346},
+	{"braille_dots_56", Keybraille_dots_56},
+	{"braille_dots_156", Keybraille_dots_156},
+	{"braille_dots_256", Keybraille_dots_256},
+	{"braille_dots_1256", Keybraille_dots_1256},
+	{"braille_dots_356", Keybraille_dots_356},
+	{"braille_dots_1356", Keybraille_dots_1356},
+	{"braille_dots_2356", Keybraille_dots_2356},
+	{"braille_dots_12356", Keybraille_dots_12356},
+	{"braille_dots_456", Keybraille_dots_456},
+	{"braille_dots_1456", Keybraille_dots_1456},
+	{"braille_dots_2456", Keybraille_dots_2456},
+	{"braille_dots_12456", Keybraille_dots_12456},
+	{"braille_dots_3456", Keybraille_dots_3456},
+	{"braille_dots_13456", Keybraille_dots_13456},
+	{"braille_dots_23456", Keybraille_dots_23456},
+	{"braille_dots_123456", Keybraille_dots_123456},
+	{"braille_dots_7", Keybraille_dots_7},
+	{"braille_dots_17", Keybraille_dots_17},
+	{"braille_dots_27", Keybraille_dots_27},
+	{"braille_dots_127", Keybraille_dots_127},
+	{"braille_dots_37", Keybraille_dots_37},
+	{"braille_dots_137", Keybraille_dots_137},
+	{"braille_dots_237", Keybraille_dots_237},
+	{"braille_dots_1237", Keybraille_dots_1237},
+	{"braille_dots_47", Keybraille_dots_47},
+	{"braille_dots_147", Keybraille_dots_147},
+	{"braille_dots_247", Keybraille_dots_247},
+	{"braille_dots_1247", Keybraille_dots_1247},
+	{"braille_dots_347", Keybraille_dots_347},
+	{"braille_dots_1347", Keybraille_dots_1347},
+	{"braille_dots_2347", Keybraille_dots_2347},
+	{"braille_dots_12347", Keybraille_dots_12347},
+	{"braille_dots_57", Keybraille_dots_57},
+	{"braille_dots_157", Keybraille_dots_157},
+	{"braille_dots_257", Keybraille_dots_257},
+	{"braille_dots_1257", Keybraille_dots_1257},
+	{"braille_dots_357", Keybraille_dots_357},
+	{"braille_dots_1357", Keybraille_dots_1357},
+	{"braille_dots_2357", Keybraille_dots_2357},
+	{"braille_dots_12357", Keybraille_dots_12357},
+	{"braille_dots_457", Keybraille_dots_457},
+	{"braille_dots_1457", Keybraille_dots_1457},
+	{"braille_dots_2457", Keybraille_dots_2457},
+	{"braille_dots_12457", Keybraille_dots_12457},
+	{"braille_dots_3457", Keybraille_dots_3457},
+	{"braille_dots_13457", Keybraille_dots_13457},
+	{"braille_dots_23457", Keybraille_dots_23457},
+	{"braille_dots_123457", Keybraille_dots_123457},
+	{"braille_dots_67", Keybraille_dots_67},
+	{"braille_dots_167", Keybraille_dots_167},
+	{"braille_dots_267", Keybraille_dots_267},
+	{"braille_dots_1267", Keybraille_dots_1267},
+	{"braille_dots_367", Keybraille_dots_367},
+	{"braille_dots_1367", Keybraille_dots_1367},
+	{"braille_dots_2367", Keybraille_dots_2367},
+	{"braille_dots_12367", Keybraille_dots_12367},
+	{"braille_dots_467", Keybraille_dots_467},
+	{"braille_dots_1467", Keybraille_dots_1467},
+	{"braille_dots_2467", Keybraille_dots_2467},
+	{"braille_dots_12467", Keybraille_dots_12467},
+	{"braille_dots_3467", Keybraille_dots_3467},
+	{"braille_dots_13467", Keybraille_dots_13467},
+	{"braille_dots_23467", Keybraille_dots_23467},
+	{"braille_dots_123467", Keybraille_dots_123467},
+	{"braille_dots_567", Keybraille_dots_567},
+	{"braille_dots_1567", Keybraille_dots_1567},
+	{"braille_dots_2567", Keybraille_dots_2567},
+	{"braille_dots_12567", Keybraille_dots_12567},
+	{"braille_dots_3567", Keybraille_dots_3567},
+	{"braille_dots_13567", Keybraille_dots_13567},
+	{"braille_dots_23567", Keybraille_dots_23567},
+	{"braille_dots_123567", Keybraille_dots_123567},
+	{"braille_dots_4567", Keybraille_dots_4567},
+	{"braille_dots_14567", Keybraille_dots_14567},
+	{"braille_dots_24567", Keybraille_dots_24567},
+	{"braille_dots_124567", Keybraille_dots_124567},
+	{"braille_dots_34567", Keybraille_dots_34567},
+	{"braille_dots_134567", Keybraille_dots_134567},
+	{"braille_dots_234567", Keybraille_dots_234567},
+	{"braille_dots_1234567", Keybraille_dots_1234567},
+	{"braille_dots_8", Keybraille_dots_8},
+	{"braille_dots_18", Keybraille_dots_18},
+	{"braille_dots_28", Keybraille_dots_28},
+	{"braille_dots_128", Keybraille_dots_128},
+	{"braille_dots_38", Keybraille_dots_38},
+	{"braille_dots_138", Keybraille_dots_138},
+	{"braille_dots_238", Keybraille_dots_238},
+	{"braille_dots_1238", Keybraille_dots_1238},
+	{"braille_dots_48", Keybraille_dots_48},
+	{"braille_dots_148", Keybraille_dots_148},
+	{"braille_dots_248", Keybraille_dots_248},
+	{"braille_dots_1248", Keybraille_dots_1248},
+	{"braille_dots_348", Keybraille_dots_348},
+	{"braille_dots_1348", Keybraille_dots_1348},
+	{"braille_dots_2348", Keybraille_dots_2348},
+	{"braille_dots_12348", Keybraille_dots_12348},
+	{"braille_dots_58", Keybraille_dots_58},
+	{"braille_dots_158", Keybraille_dots_158},
+	{"braille_dots_258", Keybraille_dots_258},
+	{"braille_dots_1258", Keybraille_dots_1258},
+	{"braille_dots_358", Keybraille_dots_358},
+	{"braille_dots_1358", Keybraille_dots_1358},
+	{"braille_dots_2358", Keybraille_dots_2358},
+	{"braille_dots_12358", Keybraille_dots_12358},
+	{"braille_dots_458", Keybraille_dots_458},
+	{"braille_dots_1458", Keybraille_dots_1458},
+	{"braille_dots_2458", Keybraille_dots_2458},
+	{"braille_dots_12458", Keybraille_dots_12458},
+	{"braille_dots_3458", Keybraille_dots_3458},
+	{"braille_dots_13458", Keybraille_dots_13458},
+	{"braille_dots_23458", Keybraille_dots_23458},
+	{"braille_dots_123458", Keybraille_dots_123458},
+	{"braille_dots_68", Keybraille_dots_68},
+	{"braille_dots_168", Keybraille_dots_168},
+	{"braille_dots_268", Keybraille_dots_268},
+	{"braille_dots_1268", Keybraille_dots_1268},
+	{"braille_dots_368", Keybraille_dots_368},
+	{"braille_dots_1368", Keybraille_dots_1368},
+	{"braille_dots_2368", Keybraille_dots_2368},
+	{"braille_dots_12368", Keybraille_dots_12368},
+	{"braille_dots_468", Keybraille_dots_468},
+	{"braille_dots_1468", Keybraille_dots_1468},
+	{"braille_dots_2468", Keybraille_dots_2468},
+	{"braille_dots_12468", Keybraille_dots_12468},
+	{"braille_dots_3468", Keybraille_dots_3468},
+	{"braille_dots_13468", Keybraille_dots_13468},
+	{"braille_dots_23468", Keybraille_dots_23468},
+	{"braille_dots_123468", Keybraille_dots_123468},
+	{"braille_dots_568", Keybraille_dots_568},
+	{"braille_dots_1568", Keybraille_dots_1568},
+	{"braille_dots_2568", Keybraille_dots_2568},
+	{"braille_dots_12568", Keybraille_dots_12568},
+	{"braille_dots_3568", Keybraille_dots_3568},
+	{"braille_dots_13568", Keybraille_dots_13568},
+	{"braille_dots_23568", Keybraille_dots_23568},
+	{"braille_dots_123568", Keybraille_dots_123568},
+	{"braille_dots_4568", Keybraille_dots_4568},
+	{"braille_dots_14568", Keybraille_dots_14568},
+	{"braille_dots_24568", Keybraille_dots_24568},
+	{"braille_dots_124568", Keybraille_dots_124568},
+	{"braille_dots_34568", Keybraille_dots_34568},
+	{"braille_dots_134568", Keybraille_dots_134568},
+	{"braille_dots_234568", Keybraille_dots_234568},
+	{"braille_dots_1234568", Keybraille_dots_1234568},
+	{"braille_dots_78", Keybraille_dots_78},
+	{"braille_dots_178", Keybraille_dots_178},
+	{"braille_dots_278", Keybraille_dots_278},
+	{"braille_dots_1278", Keybraille_dots_1278},
+	{"braille_dots_378", Keybraille_dots_378},
+	{"braille_dots_1378", Keybraille_dots_1378},
+	{"braille_dots_2378", Keybraille_dots_2378},
+	{"braille_dots_12378", Keybraille_dots_12378},
+	{"braille_dots_478", Keybraille_dots_478},
+	{"braille_dots_1478", Keybraille_dots_1478},
+	{"braille_dots_2478", Keybraille_dots_2478},
+	{"braille_dots_12478", Keybraille_dots_12478},
+	{"braille_dots_3478", Keybraille_dots_3478},
+	{"braille_dots_13478", Keybraille_dots_13478},
+	{"braille_dots_23478", Keybraille_dots_23478},
+	{"braille_dots_123478", Keybraille_dots_123478},
+	{"braille_dots_578", Keybraille_dots_578},
+	{"braille_dots_1578", Keybraille_dots_1578},
+	{"braille_dots_2578", Keybraille_dots_2578},
+	{"braille_dots_12578", Keybraille_dots_12578},
+	{"braille_dots_3578", Keybraille_dots_3578},
+	{"braille_dots_13578", Keybraille_dots_13578},
+	{"braille_dots_23578", Keybraille_dots_23578},
+	{"braille_dots_123578", Keybraille_dots_123578},
+	{"braille_dots_4578", Keybraille_dots_4578},
+	{"braille_dots_14578", Keybraille_dots_14578},
+	{"braille_dots_24578", Keybraille_dots_24578},
+	{"braille_dots_124578", Keybraille_dots_124578},
+	{"braille_dots_34578", Keybraille_dots_34578},
+	{"braille_dots_134578", Keybraille_dots_134578},
+	{"braille_dots_234578", Keybraille_dots_234578},
+	{"braille_dots_1234578", Keybraille_dots_1234578},
+	{"braille_dots_678", Keybraille_dots_678},
+	{"braille_dots_1678", Keybraille_dots_1678},
+	{"braille_dots_2678", Keybraille_dots_2678},
+	{"braille_dots_12678", Keybraille_dots_12678},
+	{"braille_dots_3678", Keybraille_dots_3678},
+	{"braille_dots_13678", Keybraille_dots_13678},
+	{"braille_dots_23678", Keybraille_dots_23678},
+	{"braille_dots_123678", Keybraille_dots_123678},
+	{"braille_dots_4678", Keybraille_dots_4678},
+	{"braille_dots_14678", Keybraille_dots_14678},
+	{"braille_dots_24678", Keybraille_dots_24678},
+	{"braille_dots_124678", Keybraille_dots_124678},
+	{"braille_dots_34678", Keybraille_dots_34678},
+	{"braille_dots_134678", Keybraille_dots_134678},
+	{"braille_dots_234678", Keybraille_dots_234678},
+	{"braille_dots_1234678", Keybraille_dots_1234678},
+	{"braille_dots_5678", Keybraille_dots_5678},
+	{"braille_dots_15678", Keybraille_dots_15678},
+	{"braille_dots_25678", Keybraille_dots_25678},
+	{"braille_dots_125678", Keybraille_dots_125678},
+	{"braille_dots_35678", Keybraille_dots_35678},
+	{"braille_dots_135678", Keybraille_dots_135678},
+	{"braille_dots_235678", Keybraille_dots_235678},
+	{"braille_dots_1235678", Keybraille_dots_1235678},
+	{"braille_dots_45678", Keybraille_dots_45678},
+	{"braille_dots_145678", Keybraille_dots_145678},
+	{"braille_dots_245678", Keybraille_dots_245678},
+	{"braille_dots_1245678", Keybraille_dots_1245678},
+	{"braille_dots_345678", Keybraille_dots_345678},
+	{"braille_dots_1345678", Keybraille_dots_1345678},
+	{"braille_dots_2345678", Keybraille_dots_2345678},
+	{"braille_dots_12345678", Keybraille_dots_12345678},
+	{"hpmute_acute", Keyhpmute_acute},
+	{"hpmute_grave", Keyhpmute_grave},
+	{"hpmute_asciicircum", Keyhpmute_asciicircum},
+	{"hpmute_diaeresis", Keyhpmute_diaeresis},
+	{"hpmute_asciitilde", Keyhpmute_asciitilde},
+	{"hplira", Keyhplira},
+	{"hpguilder", Keyhpguilder},
+	{"hpYdiaeresis", KeyhpYdiaeresis},
+	{"hplongminus", Keyhplongminus},
+	{"hpblock", Keyhpblock},
+	{"Ddiaeresis", KeyDdiaeresis},
+	{"Dacute_accent", KeyDacute_accent},
+	{"Dcedilla_accent", KeyDcedilla_accent},
+	{"Dcircumflex_accent", KeyDcircumflex_accent},
+	{"Dgrave_accent", KeyDgrave_accent},
+	{"Dtilde", KeyDtilde},
+	{"Dring_accent", KeyDring_accent},
+	{"DRemove", KeyDRemove},
+	{"hpModelock1", KeyhpModelock1},
+	{"hpModelock2", KeyhpModelock2},
+	{"hpReset", KeyhpReset},
+	{"hpSystem", KeyhpSystem},
+	{"hpUser", KeyhpUser},
+	{"hpClearLine", KeyhpClearLine},
+	{"hpInsertLine", KeyhpInsertLine},
+	{"hpDeleteLine", KeyhpDeleteLine},
+	{"hpInsertChar", KeyhpInsertChar},
+	{"hpDeleteChar", KeyhpDeleteChar},
+	{"hpBackTab", KeyhpBackTab},
+	{"hpKP_BackTab", KeyhpKP_BackTab},
+	{"Ext16bit_L", KeyExt16bit_L},
+	{"Ext16bit_R", KeyExt16bit_R},
+	{"osfCopy", KeyosfCopy},
+	{"osfCut", KeyosfCut},
+	{"osfPaste", KeyosfPaste},
+	{"osfBackTab", KeyosfBackTab},
+	{"osfBackSpace", KeyosfBackSpace},
+	{"osfClear", KeyosfClear},
+	{"osfEscape", KeyosfEscape},
+	{"osfAddMode", KeyosfAddMode},
+	{"osfPrimaryPaste", KeyosfPrimaryPaste},
+	{"osfQuickPaste", KeyosfQuickPaste},
+	{"osfPageLeft", KeyosfPageLeft},
+	{"osfPageUp", KeyosfPageUp},
+	{"osfPageDown", KeyosfPageDown},
+	{"osfPageRight", KeyosfPageRight},
+	{"osfActivate", KeyosfActivate},
+	{"osfMenuBar", KeyosfMenuBar},
+	{"osfLeft", KeyosfLeft},
+	{"osfUp", KeyosfUp},
+	{"osfRight", KeyosfRight},
+	{"osfDown", KeyosfDown},
+	{"osfEndLine", KeyosfEndLine},
+	{"osfBeginLine", KeyosfBeginLine},
+	{"osfEndData", KeyosfEndData},
+	{"osfBeginData", KeyosfBeginData},
+	{"osfPrevMenu", KeyosfPrevMenu},
+	{"osfNextMenu", KeyosfNextMenu},
+	{"osfPrevField", KeyosfPrevField},
+	{"osfNextField", KeyosfNextField},
+	{"osfSelect", KeyosfSelect},
+	{"osfInsert", KeyosfInsert},
+	{"osfUndo", KeyosfUndo},
+	{"osfMenu", KeyosfMenu},
+	{"osfCancel", KeyosfCancel},
+	{"osfHelp", KeyosfHelp},
+	{"osfSelectAll", KeyosfSelectAll},
+	{"osfDeselectAll", KeyosfDeselectAll},
+	{"osfReselect", KeyosfReselect},
+	{"osfExtend", KeyosfExtend},
+	{"osfRestore", KeyosfRestore},
+	{"osfDelete", KeyosfDelete},
+	{"SunFA_Grave", KeySunFA_Grave},
+	{"SunFA_Circum", KeySunFA_Circum},
+	{"SunFA_Tilde", KeySunFA_Tilde},
+	{"SunFA_Acute", KeySunFA_Acute},
+	{"SunFA_Diaeresis", KeySunFA_Diaeresis},
+	{"SunFA_Cedilla", KeySunFA_Cedilla},
+	{"SunF36", KeySunF36},
+	{"SunF37", KeySunF37},
+	{"SunSys_Req", KeySunSys_Req},
+	{"SunProps", KeySunProps},
+	{"SunFront", KeySunFront},
+	{"SunCopy", KeySunCopy},
+	{"SunOpen", KeySunOpen},
+	{"SunPaste", KeySunPaste},
+	{"SunCut", KeySunCut},
+	{"SunPowerSwitch", KeySunPowerSwitch},
+	{"SunAudioLowerVolume", KeySunAudioLowerVolume},
+	{"SunAudioMute", KeySunAudioMute},
+	{"SunAudioRaiseVolume", KeySunAudioRaiseVolume},
+	{"SunVideoDegauss", KeySunVideoDegauss},
+	{"SunVideoLowerBrightness", KeySunVideoLowerBrightness},
+	{"SunVideoRaiseBrightness", KeySunVideoRaiseBrightness},
+	{"SunPowerSwitchShift", KeySunPowerSwitchShift},
+	{"XF86BrightnessAuto", KeyXF86BrightnessAuto},
+	{"XF86DisplayOff", KeyXF86DisplayOff},
+	{"XF86Info", KeyXF86Info},
+	{"XF86AspectRatio", KeyXF86AspectRatio},
+	{"XF86DVD", KeyXF86DVD},
+	{"XF86Audio", KeyXF86Audio},
+	{"XF86ChannelUp", KeyXF86ChannelUp},
+	{"XF86ChannelDown", KeyXF86ChannelDown},
+	{"XF86Break", KeyXF86Break},
+	{"XF86VideoPhone", KeyXF86VideoPhone},
+	{"XF86ZoomReset", KeyXF86ZoomReset},
+	{"XF86Editor", KeyXF86Editor},
+	{"XF86GraphicsEditor", KeyXF86GraphicsEditor},
+	{"XF86Presentation", KeyXF86Presentation},
+	{"XF86Database", KeyXF86Database},
+	{"XF86Voicemail", KeyXF86Voicemail},
+	{"XF86Addressbook", KeyXF86Addressbook},
+	{"XF86DisplayToggle", KeyXF86DisplayToggle},
+	{"XF86SpellCheck", KeyXF86SpellCheck},
+	{"XF86ContextMenu", KeyXF86ContextMenu},
+	{"XF86MediaRepeat", KeyXF86MediaRepeat},
+	{"XF8610ChannelsUp", KeyXF8610ChannelsUp},
+	{"XF8610ChannelsDown", KeyXF8610ChannelsDown},
+	{"XF86Images", KeyXF86Images},
+	{"XF86NotificationCenter", KeyXF86NotificationCenter},
+	{"XF86PickupPhone", KeyXF86PickupPhone},
+	{"XF86HangupPhone", KeyXF86HangupPhone},
+	{"XF86Fn", KeyXF86Fn},
+	{"XF86Fn_Esc", KeyXF86Fn_Esc},
+	{"XF86FnRightShift", KeyXF86FnRightShift},
+	{"XF86Numeric0", KeyXF86Numeric0},
+	{"XF86Numeric1", KeyXF86Numeric1},
+	{"XF86Numeric2", KeyXF86Numeric2},
+	{"XF86Numeric3", KeyXF86Numeric3},
+	{"XF86Numeric4", KeyXF86Numeric4},
+	{"XF86Numeric5", KeyXF86Numeric5},
+	{"XF86Numeric6", KeyXF86Numeric6},
+	{"XF86Numeric7", KeyXF86Numeric7},
+	{"XF86Numeric8", KeyXF86Numeric8},
+	{"XF86Numeric9", KeyXF86Numeric9},
+	{"XF86NumericStar", KeyXF86NumericStar},
+	{"XF86NumericPound", KeyXF86NumericPound},
+	{"XF86NumericA", KeyXF86NumericA},
+	{"XF86NumericB", KeyXF86NumericB},
+	{"XF86NumericC", KeyXF86NumericC},
+	{"XF86NumericD", KeyXF86NumericD},
+	{"XF86CameraFocus", KeyXF86CameraFocus},
+	{"XF86WPSButton", KeyXF86WPSButton},
+	{"XF86CameraZoomIn", KeyXF86CameraZoomIn},
+	{"XF86CameraZoomOut", KeyXF86CameraZoomOut},
+	{"XF86CameraUp", KeyXF86CameraUp},
+	{"XF86CameraDown", KeyXF86CameraDown},
+	{"XF86CameraLeft", KeyXF86CameraLeft},
+	{"XF86CameraRight", KeyXF86CameraRight},
+	{"XF86AttendantOn", KeyXF86AttendantOn},
+	{"XF86AttendantOff", KeyXF86AttendantOff},
+	{"XF86AttendantToggle", KeyXF86AttendantToggle},
+	{"XF86LightsToggle", KeyXF86LightsToggle},
+	{"XF86ALSToggle", KeyXF86ALSToggle},
+	{"XF86Buttonconfig", KeyXF86Buttonconfig},
+	{"XF86Taskmanager", KeyXF86Taskmanager},
+	{"XF86Journal", KeyXF86Journal},
+	{"XF86ControlPanel", KeyXF86ControlPanel},
+	{"XF86AppSelect", KeyXF86AppSelect},
+	{"XF86Screensaver", KeyXF86Screensaver},
+	{"XF86VoiceCommand", KeyXF86VoiceCommand},
+	{"XF86Assistant", KeyXF86Assistant},
+	{"XF86EmojiPicker", KeyXF86EmojiPicker},
+	{"XF86Dictate", KeyXF86Dictate},
+	{"XF86BrightnessMin", KeyXF86BrightnessMin},
+	{"XF86BrightnessMax", KeyXF86BrightnessMax},
+	{"XF86KbdInputAssistPrev", KeyXF86KbdInputAssistPrev},
+	{"XF86KbdInputAssistNext", KeyXF86KbdInputAssistNext},
+	{"XF86KbdInputAssistPrevgroup", KeyXF86KbdInputAssistPrevgroup},
+	{"XF86KbdInputAssistNextgroup", KeyXF86KbdInputAssistNextgroup},
+	{"XF86KbdInputAssistAccept", KeyXF86KbdInputAssistAccept},
+	{"XF86KbdInputAssistCancel", KeyXF86KbdInputAssistCancel},
+	{"XF86RightUp", KeyXF86RightUp},
+	{"XF86RightDown", KeyXF86RightDown},
+	{"XF86LeftUp", KeyXF86LeftUp},
+	{"XF86LeftDown", KeyXF86LeftDown},
+	{"XF86RootMenu", KeyXF86RootMenu},
+	{"XF86MediaTopMenu", KeyXF86MediaTopMenu},
+	{"XF86Numeric11", KeyXF86Numeric11},
+	{"XF86Numeric12", KeyXF86Numeric12},
+	{"XF86AudioDesc", KeyXF86AudioDesc},
+	{"XF863DMode", KeyXF863DMode},
+	{"XF86NextFavorite", KeyXF86NextFavorite},
+	{"XF86StopRecord", KeyXF86StopRecord},
+	{"XF86PauseRecord", KeyXF86PauseRecord},
+	{"XF86VOD", KeyXF86VOD},
+	{"XF86Unmute", KeyXF86Unmute},
+	{"XF86FastReverse", KeyXF86FastReverse},
+	{"XF86SlowReverse", KeyXF86SlowReverse},
+	{"XF86Data", KeyXF86Data},
+	{"XF86OnScreenKeyboard", KeyXF86OnScreenKeyboard},
+	{"XF86PrivacyScreenToggle", KeyXF86PrivacyScreenToggle},
+	{"XF86SelectiveScreenshot", KeyXF86SelectiveScreenshot},
+	{"XF86Macro1", KeyXF86Macro1},
+	{"XF86Macro2", KeyXF86Macro2},
+	{"XF86Macro3", KeyXF86Macro3},
+	{"XF86Macro4", KeyXF86Macro4},
+	{"XF86Macro5", KeyXF86Macro5},
+	{"XF86Macro6", KeyXF86Macro6},
+	{"XF86Macro7", KeyXF86Macro7},
+	{"XF86Macro8", KeyXF86Macro8},
+	{"XF86Macro9", KeyXF86Macro9},
+	{"XF86Macro10", KeyXF86Macro10},
+	{"XF86Macro11", KeyXF86Macro11},
+	{"XF86Macro12", KeyXF86Macro12},
+	{"XF86Macro13", KeyXF86Macro13},
+	{"XF86Macro14", KeyXF86Macro14},
+	{"XF86Macro15", KeyXF86Macro15},
+	{"XF86Macro16", KeyXF86Macro16},
+	{"XF86Macro17", KeyXF86Macro17},
+	{"XF86Macro18", KeyXF86Macro18},
+	{"XF86Macro19", KeyXF86Macro19},
+	{"XF86Macro20", KeyXF86Macro20},
+	{"XF86Macro21", KeyXF86Macro21},
+	{"XF86Macro22", KeyXF86Macro22},
+	{"XF86Macro23", KeyXF86Macro23},
+	{"XF86Macro24", KeyXF86Macro24},
+	{"XF86Macro25", KeyXF86Macro25},
+	{"XF86Macro26", KeyXF86Macro26},
+	{"XF86Macro27", KeyXF86Macro27},
+	{"XF86Macro28", KeyXF86Macro28},
+	{"XF86Macro29", KeyXF86Macro29},
+	{"XF86Macro30", KeyXF86Macro30},
+	{"XF86MacroRecordStart", KeyXF86MacroRecordStart},
+	{"XF86MacroRecordStop", KeyXF86MacroRecordStop},
+	{"XF86MacroPresetCycle", KeyXF86MacroPresetCycle},
+	{"XF86MacroPreset1", KeyXF86MacroPreset1},
+	{"XF86MacroPreset2", KeyXF86MacroPreset2},
+	{"XF86MacroPreset3", KeyXF86MacroPreset3},
+	{"XF86KbdLcdMenu1", KeyXF86KbdLcdMenu1},
+	{"XF86KbdLcdMenu2", KeyXF86KbdLcdMenu2},
+	{"XF86KbdLcdMenu3", KeyXF86KbdLcdMenu3},
+	{"XF86KbdLcdMenu4", KeyXF86KbdLcdMenu4},
+	{"XF86KbdLcdMenu5", KeyXF86KbdLcdMenu5},
+	{"XF86Switch_VT_1", KeyXF86Switch_VT_1},
+	{"XF86Switch_VT_2", KeyXF86Switch_VT_2},
+	{"XF86Switch_VT_3", KeyXF86Switch_VT_3},
+	{"XF86Switch_VT_4", KeyXF86Switch_VT_4},
+	{"XF86Switch_VT_5", KeyXF86Switch_VT_5},
+	{"XF86Switch_VT_6", KeyXF86Switch_VT_6},
+	{"XF86Switch_VT_7", KeyXF86Switch_VT_7},
+	{"XF86Switch_VT_8", KeyXF86Switch_VT_8},
+	{"XF86Switch_VT_9", KeyXF86Switch_VT_9},
+	{"XF86Switch_VT_10", KeyXF86Switch_VT_10},
+	{"XF86Switch_VT_11", KeyXF86Switch_VT_11},
+	{"XF86Switch_VT_12", KeyXF86Switch_VT_12},
+	{"XF86Ungrab", KeyXF86Ungrab},
+	{"XF86ClearGrab", KeyXF86ClearGrab},
+	{"XF86Next_VMode", KeyXF86Next_VMode},
+	{"XF86Prev_VMode", KeyXF86Prev_VMode},
+	{"XF86LogWindowTree", KeyXF86LogWindowTree},
+	{"XF86LogGrabInfo", KeyXF86LogGrabInfo},
+	{"XF86ModeLock", KeyXF86ModeLock},
+	{"XF86MonBrightnessUp", KeyXF86MonBrightnessUp},
+	{"XF86MonBrightnessDown", KeyXF86MonBrightnessDown},
+	{"XF86KbdLightOnOff", KeyXF86KbdLightOnOff},
+	{"XF86KbdBrightnessUp", KeyXF86KbdBrightnessUp},
+	{"XF86KbdBrightnessDown", KeyXF86KbdBrightnessDown},
+	{"XF86MonBrightnessCycle", KeyXF86MonBrightnessCycle},
+	{"XF86Standby", KeyXF86Standby},
+	{"XF86AudioLowerVolume", KeyXF86AudioLowerVolume},
+	{"XF86AudioMute", KeyXF86AudioMute},
+	{"XF86AudioRaiseVolume", KeyXF86AudioRaiseVolume},
+	{"XF86AudioPlay", KeyXF86AudioPlay},
+	{"XF86AudioStop", KeyXF86AudioStop},
+	{"XF86AudioPrev", KeyXF86AudioPrev},
+	{"XF86AudioNext", KeyXF86AudioNext},
+	{"XF86HomePage", KeyXF86HomePage},
+	{"XF86Mail", KeyXF86Mail},
+	{"XF86Start", KeyXF86Start},
+	{"XF86Search", KeyXF86Search},
+	{"XF86AudioRecord", KeyXF86AudioRecord},
+	{"XF86Calculator", KeyXF86Calculator},
+	{"XF86Memo", KeyXF86Memo},
+	{"XF86ToDoList", KeyXF86ToDoList},
+	{"XF86Calendar", KeyXF86Calendar},
+	{"XF86PowerDown", KeyXF86PowerDown},
+	{"XF86ContrastAdjust", KeyXF86ContrastAdjust},
+	{"XF86RockerUp", KeyXF86RockerUp},
+	{"XF86RockerDown", KeyXF86RockerDown},
+	{"XF86RockerEnter", KeyXF86RockerEnter},
+	{"XF86Back", KeyXF86Back},
+	{"XF86Forward", KeyXF86Forward},
+	{"XF86Stop", KeyXF86Stop},
+	{"XF86Refresh", KeyXF86Refresh},
+	{"XF86PowerOff", KeyXF86PowerOff},
+	{"XF86WakeUp", KeyXF86WakeUp},
+	{"XF86Eject", KeyXF86Eject},
+	{"XF86ScreenSaver", KeyXF86ScreenSaver},
+	{"XF86WWW", KeyXF86WWW},
+	{"XF86Sleep", KeyXF86Sleep},
+	{"XF86Favorites", KeyXF86Favorites},
+	{"XF86AudioPause", KeyXF86AudioPause},
+	{"XF86AudioMedia", KeyXF86AudioMedia},
+	{"XF86MyComputer", KeyXF86MyComputer},
+	{"XF86VendorHome", KeyXF86VendorHome},
+	{"XF86LightBulb", KeyXF86LightBulb},
+	{"XF86Shop", KeyXF86Shop},
+	{"XF86History", KeyXF86History},
+	{"XF86OpenURL", KeyXF86OpenURL},
+	{"XF86AddFavorite", KeyXF86AddFavorite},
+	{"XF86HotLinks", KeyXF86HotLinks},
+	{"XF86BrightnessAdjust", KeyXF86BrightnessAdjust},
+	{"XF86Finance", KeyXF86Finance},
+	{"XF86Community", KeyXF86Community},
+	{"XF86AudioRewind", KeyXF86AudioRewind},
+	{"XF86BackForward", KeyXF86BackForward},
+	{"XF86Launch0", KeyXF86Launch0},
+	{"XF86Launch1", KeyXF86Launch1},
+	{"XF86Launch2", KeyXF86Launch2},
+	{"XF86Launch3", KeyXF86Launch3},
+	{"XF86Launch4", KeyXF86Launch4},
+	{"XF86Launch5", KeyXF86Launch5},
+	{"XF86Launch6", KeyXF86Launch6},
+	{"XF86Launch7", KeyXF86Launch7},
+	{"XF86Launch8", KeyXF86Launch8},
+	{"XF86Launch9", KeyXF86Launch9},
+	{"XF86LaunchA", KeyXF86LaunchA},
+	{"XF86LaunchB", KeyXF86LaunchB},
+	{"XF86LaunchC", KeyXF86LaunchC},
+	{"XF86LaunchD", KeyXF86LaunchD},
+	{"XF86LaunchE", KeyXF86LaunchE},
+	{"XF86LaunchF", KeyXF86LaunchF},
+	{"XF86ApplicationLeft", KeyXF86ApplicationLeft},
+	{"XF86ApplicationRight", KeyXF86ApplicationRight},
+	{"XF86Book", KeyXF86Book},
+	{"XF86CD", KeyXF86CD},
+	{"XF86Calculater", KeyXF86Calculater},
+	{"XF86Clear", KeyXF86Clear},
+	{"XF86Close", KeyXF86Close},
+	{"XF86Copy", KeyXF86Copy},
+	{"XF86Cut", KeyXF86Cut},
+	{"XF86Display", KeyXF86Display},
+	{"XF86DOS", KeyXF86DOS},
+	{"XF86Documents", KeyXF86Documents},
+	{"XF86Excel", KeyXF86Excel},
+	{"XF86Explorer", KeyXF86Explorer},
+	{"XF86Game", KeyXF86Game},
+	{"XF86Go", KeyXF86Go},
+	{"XF86iTouch", KeyXF86iTouch},
+	{"XF86LogOff", KeyXF86LogOff},
+	{"XF86Market", KeyXF86Market},
+	{"XF86Meeting", KeyXF86Meeting},
+	{"XF86MenuKB", KeyXF86MenuKB},
+	{"XF86MenuPB", KeyXF86MenuPB},
+	{"XF86MySites", KeyXF86MySites},
+	{"XF86New", KeyXF86New},
+	{"XF86News", KeyXF86News},
+	{"XF86OfficeHome", KeyXF86OfficeHome},
+	{"XF86Open", KeyXF86Open},
+	{"XF86Option", KeyXF86Option},
+	{"XF86Paste", KeyXF86Paste},
+	{"XF86Phone", KeyXF86Phone},
+	{"XF86Q", KeyXF86Q},
+	{"XF86Reply", KeyXF86Reply},
+	{"XF86Reload", KeyXF86Reload},
+	{"XF86RotateWindows", KeyXF86RotateWindows},
+	{"XF86RotationPB", KeyXF86RotationPB},
+	{"XF86RotationKB", KeyXF86RotationKB},
+	{"XF86Save", KeyXF86Save},
+	{"XF86ScrollUp", KeyXF86ScrollUp},
+	{"XF86ScrollDown", KeyXF86ScrollDown},
+	{"XF86ScrollClick", KeyXF86ScrollClick},
+	{"XF86Send", KeyXF86Send},
+	{"XF86Spell", KeyXF86Spell},
+	{"XF86SplitScreen", KeyXF86SplitScreen},
+	{"XF86Support", KeyXF86Support},
+	{"XF86TaskPane", KeyXF86TaskPane},
+	{"XF86Terminal", KeyXF86Terminal},
+	{"XF86Tools", KeyXF86Tools},
+	{"XF86Travel", KeyXF86Travel},
+	{"XF86UserPB", KeyXF86UserPB},
+	{"XF86User1KB", KeyXF86User1KB},
+	{"XF86User2KB", KeyXF86User2KB},
+	{"XF86Video", KeyXF86Video},
+	{"XF86WheelButton", KeyXF86WheelButton},
+	{"XF86Word", KeyXF86Word},
+	{"XF86Xfer", KeyXF86Xfer},
+	{"XF86ZoomIn", KeyXF86ZoomIn},
+	{"XF86ZoomOut", KeyXF86ZoomOut},
+	{"XF86Away", KeyXF86Away},
+	{"XF86Messenger", KeyXF86Messenger},
+	{"XF86WebCam", KeyXF86WebCam},
+	{"XF86MailForward", KeyXF86MailForward},
+	{"XF86Pictures", KeyXF86Pictures},
+	{"XF86Music", KeyXF86Music},
+	{"XF86Battery", KeyXF86Battery},
+	{"XF86Bluetooth", KeyXF86Bluetooth},
+	{"XF86WLAN", KeyXF86WLAN},
+	{"XF86UWB", KeyXF86UWB},
+	{"XF86AudioForward", KeyXF86AudioForward},
+	{"XF86AudioRepeat", KeyXF86AudioRepeat},
+	{"XF86AudioRandomPlay", KeyXF86AudioRandomPlay},
+	{"XF86Subtitle", KeyXF86Subtitle},
+	{"XF86AudioCycleTrack", KeyXF86AudioCycleTrack},
+	{"XF86CycleAngle", KeyXF86CycleAngle},
+	{"XF86FrameBack", KeyXF86FrameBack},
+	{"XF86FrameForward", KeyXF86FrameForward},
+	{"XF86Time", KeyXF86Time},
+	{"XF86Select", KeyXF86Select},
+	{"XF86View", KeyXF86View},
+	{"XF86TopMenu", KeyXF86TopMenu},
+	{"XF86Red", KeyXF86Red},
+	{"XF86Green", KeyXF86Green},
+	{"XF86Yellow", KeyXF86Yellow},
+	{"XF86Blue", KeyXF86Blue},
+	{"XF86Suspend", KeyXF86Suspend},
+	{"XF86Hibernate", KeyXF86Hibernate},
+	{"XF86TouchpadToggle", KeyXF86TouchpadToggle},
+	{"XF86TouchpadOn", KeyXF86TouchpadOn},
+	{"XF86TouchpadOff", KeyXF86TouchpadOff},
+	{"XF86AudioMicMute", KeyXF86AudioMicMute},
+	{"XF86Keyboard", KeyXF86Keyboard},
+	{"XF86WWAN", KeyXF86WWAN},
+	{"XF86RFKill", KeyXF86RFKill},
+	{"XF86AudioPreset", KeyXF86AudioPreset},
+	{"XF86RotationLockToggle", KeyXF86RotationLockToggle},
+	{"XF86FullScreen", KeyXF86FullScreen},
+}
+
+// keysymRunes maps keysyms to characters, sorted by keysym. Legacy
+// mappings are only used to convert keysyms to characters.
+var keysymRunes = [...]keysymRune{
+	{0x1a1, 0x104, false},
+	{0x1a2, 0x2d8, false},
+	{0x1a3, 0x141, false},
+	{0x1a5, 0x13d, false},
+	{0x1a6, 0x15a, false},
+	{0x1a9, 0x160, false},
+	{0x1aa, 0x15e, false},
+	{0x1ab, 0x164, false},
+	{0x1ac, 0x179, false},
+	{0x1ae, 0x17d, false},
+	{0x1af, 0x17b, false},
+	{0x1b1, 0x105, false},
+	{0x1b2, 0x2db, false},
+	{0x1b3, 0x142, false},
+	{0x1b5, 0x13e, false},
+	{0x1b6, 0x15b, false},
+	{0x1b7, 0x2c7, false},
+	{0x1b9, 0x161, false},
+	{0x1ba, 0x15f, false},
+	{0x1bb, 0x165, false},
+	{0x1bc, 0x17a, false},
+	{0x1bd, 0x2dd, false},
+	{0x1be, 0x17e, false},
+	{0x1bf, 0x17c, false},
+	{0x1c0, 0x154, false},
+	{0x1c3, 0x102, false},
+	{0x1c5, 0x139, false},
+	{0x1c6, 0x106, false},
+	{0x1c8, 0x10c, false},
+	{0x1ca, 0x118, false},
+	{0x1cc, 0x11a, false},
+	{0x1cf, 0x10e, false},
+	{0x1d0, 0x110, false},
+	{0x1d1, 0x143, false},
+	{0x1d2, 0x147, false},
+	{0x1d5, 0x150, false},
+	{0x1d8, 0x158, false},
+	{0x1d9, 0x16e, false},
+	{0x1db, 0x170, false},
+	{0x1de, 0x162, false},
+	{0x1e0, 0x155, false},
+	{0x1e3, 0x103, false},
+	{0x1e5, 0x13a, false},
+	{0x1e6, 0x107, false},
+	{0x1e8, 0x10d, false},
+	{0x1ea, 0x119, false},
+	{0x1ec, 0x11b, false},
+	{0x1ef, 0x10f, false},
+	{0x1f0, 0x111, false},
+	{0x1f1, 0x144, false},
+	{0x1f2, 0x148, false},
+	{0x1f5, 0x151, false},
+	{0x1f8, 0x159, false},
+	{0x1f9, 0x16f, false},
+	{0x1fb, 0x171, false},
+	{0x1fe, 0x163, false},
+	{0x1ff, 0x2d9, false},
+	{0x2a1, 0x126, false},
+	{0x2a6, 0x124, false},
+	{0x2a9, 0x130, false},
+	{0x2ab, 0x11e, false},
+	{0x2ac, 0x134, false},
+	{0x2b1, 0x127, false},
+	{0x2b6, 0x125, false},
+	{0x2b9, 0x131, false},
+	{0x2bb, 0x11f, false},
+	{0x2bc, 0x135, false},
+	{0x2c5, 0x10a, false},
+	{0x2c6, 0x108, false},
+	{0x2d5, 0x120, false},
+	{0x2d8, 0x11c, false},
+	{0x2dd, 0x16c, false},
+	{0x2de, 0x15c, false},
+	{0x2e5, 0x10b, false},
+	{0x2e6, 0x109, false},
+	{0x2f5, 0x121, false},
+	{0x2f8, 0x11d, false},
+	{0x2fd, 0x16d, false},
+	{0x2fe, 0x15d, false},
+	{0x3a2, 0x138, false},
+	{0x3a3, 0x156, false},
+	{0x3a5, 0x128, false},
+	{0x3a6, 0x13b, false},
+	{0x3aa, 0x112, false},
+	{0x3ab, 0x122, false},
+	{0x3ac, 0x166, false},
+	{0x3b3, 0x157, false},
+	{0x3b5, 0x129, false},
+	{0x3b6, 0x13c, false},
+	{0x3ba, 0x113, false},
+	{0x3bb, 0x123, false},
+	{0x3bc, 0x167, false},
+	{0x3bd, 0x14a, false},
+	{0x3bf, 0x14b, false},
+	{0x3c0, 0x100, false},
+	{0x3c7, 0x12e, false},
+	{0x3cc, 0x116, false},
+	{0x3cf, 0x12a, false},
+	{0x3d1, 0x145, false},
+	{0x3d2, 0x14c, false},
+	{0x3d3, 0x136, false},
+	{0x3d9, 0x172, false},
+	{0x3dd, 0x168, false},
+	{0x3de, 0x16a, false},
+	{0x3e0, 0x101, false},
+	{0x3e7, 0x12f, false},
+	{0x3ec, 0x117, false},
+	{0x3ef, 0x12b, false},
+	{0x3f1, 0x146, false},
+	{0x3f2, 0x14d, false},
+	{0x3f3, 0x137, false},
+	{0x3f9, 0x173, false},
+	{0x3fd, 0x169, false},
+	{0x3fe, 0x16b, false},
+	{0x47e, 0x203e, false},
+	{0x4a1, 0x3002, false},
+	{0x4a2, 0x300c, false},
+	{0x4a3, 0x300d, false},
+	{0x4a4, 0x3001, false},
+	{0x4a5, 0x30fb, false},
+	{0x4a6, 0x30f2, false},
+	{0x4a7, 0x30a1, false},
+	{0x4a8, 0x30a3, false},
+	{0x4a9, 0x30a5, false},
+	{0x4aa, 0x30a7, false},
+	{0x4ab, 0x30a9, false},
+	{0x4ac, 0x30e3, false},
+	{0x4ad, 0x30e5, false},
+	{0x4ae, 0x30e7, false},
+	{0x4af, 0x30c3, false},
+	{0x4b0, 0x30fc, false},
+	{0x4b1, 0x30a2, false},
+	{0x4b2, 0x30a4, false},
+	{0x4b3, 0x30a6, false},
+	{0x4b4, 0x30a8, false},
+	{0x4b5, 0x30aa, false},
+	{0x4b6, 0x30ab, false},
+	{0x4b7, 0x30ad, false},
+	{0x4b8, 0x30af, false},
+	{0x4b9, 0x30b1, false},
+	{0x4ba, 0x30b3, false},
+	{0x4bb, 0x30b5, false},
+	{0x4bc, 0x30b7, false},
+	{0x4bd, 0x30b9, false},
+	{0x4be, 0x30bb, false},
+	{0x4bf, 0x30bd, false},
+	{0x4c0, 0x30bf, false},
+	{0x4c1, 0x30c1, false},
+	{0x4c2, 0x30c4, false},
+	{0x4c3, 0x30c6, false},
+	{0x4c4, 0x30c8, false},
+	{0x4c5, 0x30ca, false},
+	{0x4c6, 0x30cb, false},
+	{0x4c7, 0x30cc, false},
+	{0x4c8, 0x30cd, false},
+	{0x4c9, 0x30ce, false},
+	{0x4ca, 0x30cf, false},
+	{0x4cb, 0x30d2, false},
+	{0x4cc, 0x30d5, false},
+	{0x4cd, 0x30d8, false},
+	{0x4ce, 0x30db, false},
+	{0x4cf, 0x30de, false},
+	{0x4d0, 0x30df, false},
+	{0x4d1, 0x30e0, false},
+	{0x4d2, 0x30e1, false},
+	{0x4d3, 0x30e2, false},
+	{0x4d4, 0x30e4, false},
+	{0x4d5, 0x30e6, false},
+	{0x4d6, 0x30e8, false},
+	{0x4d7, 0x30e9, false},
+	{0x4d8, 0x30ea, false},
+	{0x4d9, 0x30eb, false},
+	{0x4da, 0x30ec, false},
+	{0x4db, 0x30ed, false},
+	{0x4dc, 0x30ef, false},
+	{0x4dd, 0x30f3, false},
+	{0x4de, 0x309b, false},
+	{0x4df, 0x309c, false},
+	{0x5ac, 0x60c, false},
+	{0x5bb, 0x61b, false},
+	{0x5bf, 0x61f, false},
+	{0x5c1, 0x621, false},
+	{0x5c2, 0x622, false},
+	{0x5c3, 0x623, false},
+	{0x5c4, 0x624, false},
+	{0x5c5, 0x625, false},
+	{0x5c6, 0x626, false},
+	{0x5c7, 0x627, false},
+	{0x5c8, 0x628, false},
+	{0x5c9, 0x629, false},
+	{0x5ca, 0x62a, false},
+	{0x5cb, 0x62b, false},
+	{0x5cc, 0x62c, false},
+	{0x5cd, 0x62d, false},
+	{0x5ce, 0x62e, false},
+	{0x5cf, 0x62f, false},
+	{0x5d0, 0x630, false},
+	{0x5d1, 0x631, false},
+	{0x5d2, 0x632, false},
+	{0x5d3, 0x633, false},
+	{0x5d4, 0x634, false},
+	{0x5d5, 0x635, false},
+	{0x5d6, 0x636, false},
+	{0x5d7, 0x637, false},
+	{0x5d8, 0x638, false},
+	{0x5d9, 0x639, false},
+	{0x5da, 0x63a, false},
+	{0x5e0, 0x640, false},
+	{0x5e1, 0x641, false},
+	{0x5e2, 0x642, false},
+	{0x5e3, 0x643, false},
+	{0x5e4, 0x644, false},
+	{0x5e5, 0x645, false},
+	{0x5e6, 0x646, false},
+	{0x5e7, 0x647, false},
+	{0x5e8, 0x648, false},
+	{0x5e9, 0x649, false},
+	{0x5ea, 0x64a, false},
+	{0x5eb, 0x64b, false},
+	{0x5ec, 0x64c, false},
+	{0x5ed, 0x64d, false},
+	{0x5ee, 0x64e, false},
+	{0x5ef, 0x64f, false},
+	{0x5f0, 0x650, false},
+	{0x5f1, 0x651, false},
+	{0x5f2, 0x652, false},
+	{0x6a1, 0x452, false},
+	{0x6a2, 0x453, false},
+	{0x6a3, 0x451, false},
+	{0x6a4, 0x454, false},
+	{0x6a5, 0x455, false},
+	{0x6a6, 0x456, false},
+	{0x6a7, 0x457, false},
+	{0x6a8, 0x458, false},
+	{0x6a9, 0x459, false},
+	{0x6aa, 0x45a, false},
+	{0x6ab, 0x45b, false},
+	{0x6ac, 0x45c, false},
+	{0x6ad, 0x491, false},
+	{0x6ae, 0x45e, false},
+	{0x6af, 0x45f, false},
+	{0x6b0, 0x2116, false},
+	{0x6b1, 0x402, false},
+	{0x6b2, 0x403, false},
+	{0x6b3, 0x401, false},
+	{0x6b4, 0x404, false},
+	{0x6b5, 0x405, false},
+	{0x6b6, 0x406, false},
+	{0x6b7, 0x407, false},
+	{0x6b8, 0x408, false},
+	{0x6b9, 0x409, false},
+	{0x6ba, 0x40a, false},
+	{0x6bb, 0x40b, false},
+	{0x6bc, 0x40c, false},
+	{0x6bd, 0x490, false},
+	{0x6be, 0x40e, false},
+	{0x6bf, 0x40f, false},
+	{0x6c0, 0x44e, false},
+	{0x6c1, 0x430, false},
+	{0x6c2, 0x431, false},
+	{0x6c3, 0x446, false},
+	{0x6c4, 0x434, false},
+	{0x6c5, 0x435, false},
+	{0x6c6, 0x444, false},
+	{0x6c7, 0x433, false},
+	{0x6c8, 0x445, false},
+	{0x6c9, 0x438, false},
+	{0x6ca, 0x439, false},
+	{0x6cb, 0x43a, false},
+	{0x6cc, 0x43b, false},
+	{0x6cd, 0x43c, false},
+	{0x6ce, 0x43d, false},
+	{0x6cf, 0x43e, false},
+	{0x6d0, 0x43f, false},
+	{0x6d1, 0x44f, false},
+	{0x6d2, 0x440, false},
+	{0x6d3, 0x441, false},
+	{0x6d4, 0x442, false},
+	{0x6d5, 0x443, false},
+	{0x6d6, 0x436, false},
+	{0x6d7, 0x432, false},
+	{0x6d8, 0x44c, false},
+	{0x6d9, 0x44b, false},
+	{0x6da, 0x437, false},
+	{0x6db, 0x448, false},
+	{0x6dc, 0x44d, false},
+	{0x6dd, 0x449, false},
+	{0x6de, 0x447, false},
+	{0x6df, 0x44a, false},
+	{0x6e0, 0x42e, false},
+	{0x6e1, 0x410, false},
+	{0x6e2, 0x411, false},
+	{0x6e3, 0x426, false},
+	{0x6e4, 0x414, false},
+	{0x6e5, 0x415, false},
+	{0x6e6, 0x424, false},
+	{0x6e7, 0x413, false},
+	{0x6e8, 0x425, false},
+	{0x6e9, 0x418, false},
+	{0x6ea, 0x419, false},
+	{0x6eb, 0x41a, false},
+	{0x6ec, 0x41b, false},
+	{0x6ed, 0x41c, false},
+	{0x6ee, 0x41d, false},
+	{0x6ef, 0x41e, false},
+	{0x6f0, 0x41f, false},
+	{0x6f1, 0x42f, false},
+	{0x6f2, 0x420, false},
+	{0x6f3, 0x421, false},
+	{0x6f4, 0x422, false},
+	{0x6f5, 0x423, false},
+	{0x6f6, 0x416, false},
+	{0x6f7, 0x412, false},
+	{0x6f8, 0x42c, false},
+	{0x6f9, 0x42b, false},
+	{0x6fa, 0x417, false},
+	{0x6fb, 0x428, false},
+	{0x6fc, 0x42d, false},
+	{0x6fd, 0x429, false},
+	{0x6fe, 0x427, false},
+	{0x6ff, 0x42a, false},
+	{0x7a1, 0x386, false},
+	{0x7a2, 0x388, false},
+	{0x7a3, 0x389, false},
+	{0x7a4, 0x38a, false},
+	{0x7a5, 0x3aa, false},
+	{0x7a7, 0x38c, false},
+	{0x7a8, 0x38e, false},
+	{0x7a9, 0x3ab, false},
+	{0x7ab, 0x38f, false},
+	{0x7ae, 0x385, false},
+	{0x7af, 0x2015, false},
+	{0x7b1, 0x3ac, false},
+	{0x7b2, 0x3ad, false},
+	{0x7b3, 0x3ae, false},
+	{0x7b4, 0x3af, false},
+	{0x7b5, 0x3ca, false},
+	{0x7b6, 0x390, false},
+	{0x7b7, 0x3cc, false},
+	{0x7b8, 0x3cd, false},
+	{0x7b9, 0x3cb, false},
+	{0x7ba, 0x3b0, false},
+	{0x7bb, 0x3ce, false},
+	{0x7c1, 0x391, false},
+	{0x7c2, 0x392, false},
+	{0x7c3, 0x393, false},
+	{0x7c4, 0x394, false},
+	{0x7c5, 0x395, false},
+	{0x7c6, 0x396, false},
+	{0x7c7, 0x397, false},
+	{0x7c8, 0x398, false},
+	{0x7c9, 0x399, false},
+	{0x7ca, 0x39a, false},
+	{0x7cb, 0x39b, false},
+	{0x7cc, 0x39c, false},
+	{0x7cd, 0x39d, false},
+	{0x7ce, 0x39e, false},
+	{0x7cf, 0x39f, false},
+	{0x7d0, 0x3a0, false},
+	{0x7d1, 0x3a1, false},
+	{0x7d2, 0x3a3, false},
+	{0x7d4, 0x3a4, false},
+	{0x7d5, 0x3a5, false},
+	{0x7d6, 0x3a6, false},
+	{0x7d7, 0x3a7, false},
+	{0x7d8, 0x3a8, false},
+	{0x7d9, 0x3a9, false},
+	{0x7e1, 0x3b1, false},
+	{0x7e2, 0x3b2, false},
+	{0x7e3, 0x3b3, false},
+	{0x7e4, 0x3b4, false},
+	{0x7e5, 0x3b5, false},
+	{0x7e6, 0x3b6, false},
+	{0x7e7, 0x3b7, false},
+	{0x7e8, 0x3b8, false},
+	{0x7e9, 0x3b9, false},
+	{0x7ea, 0x3ba, false},
+	{0x7eb, 0x3bb, false},
+	{0x7ec, 0x3bc, false},
+	{0x7ed, 0x3bd, false},
+	{0x7ee, 0x3be, false},
+	{0x7ef, 0x3bf, false},
+	{0x7f0, 0x3c0, false},
+	{0x7f1, 0x3c1, false},
+	{0x7f2, 0x3c3, false},
+	{0x7f3, 0x3c2, false},
+	{0x7f4, 0x3c4, false},
+	{0x7f5, 0x3c5, false},
+	{0x7f6, 0x3c6, false},
+	{0x7f7, 0x3c7, false},
+	{0x7f8, 0x3c8, false},
+	{0x7f9, 0x3c9, false},
+	{0x8a1, 0x23b7, false},
+	{0x8a2, 0x250c, true},
+	{0x8a3, 0x2500, true},
+	{0x8a4, 0x2320, false},
+	{0x8a5, 0x2321, false},
+	{0x8a6, 0x2502, true},
+	{0x8a7, 0x23a1, false},
+	{0x8a8, 0x23a3, false},
+	{0x8a9, 0x23a4, false},
+	{0x8aa, 0x23a6, false},
+	{0x8ab, 0x239b, false},
+	{0x8ac, 0x239d, false},
+	{0x8ad, 0x239e, false},
+	{0x8ae, 0x23a0, false},
+	{0x8af, 0x23a8, false},
+	{0x8b0, 0x23ac, false},
+	{0x8bc, 0x2264, false},
+	{0x8bd, 0x2260, false},
+	{0x8be, 0x2265, false},
+	{0x8bf, 0x222b, false},
+	{0x8c0, 0x2234, false},
+	{0x8c1, 0x221d, false},
+	{0x8c2, 0x221e, false},
+	{0x8c5, 0x2207, false},
+	{0x8c8, 0x223c, false},
+	{0x8c9, 0x2243, false},
+	{0x8cd, 0x21d4, false},
+	{0x8ce, 0x21d2, false},
+	{0x8cf, 0x2261, false},
+	{0x8d6, 0x221a, false},
+	{0x8da, 0x2282, false},
+	{0x8db, 0x2283, false},
+	{0x8dc, 0x2229, false},
+	{0x8dd, 0x222a, false},
+	{0x8de, 0x2227, false},
+	{0x8df, 0x2228, false},
+	{0x8ef, 0x2202, false},
+	{0x8f6, 0x192, false},
+	{0x8fb, 0x2190, false},
+	{0x8fc, 0x2191, false},
+	{0x8fd, 0x2192, false},
+	{0x8fe, 0x2193, false},
+	{0x9e0, 0x25c6, false},
+	{0x9e1, 0x2592, false},
+	{0x9e2, 0x2409, false},
+	{0x9e3, 0x240c, false},
+	{0x9e4, 0x240d, false},
+	{0x9e5, 0x240a, false},
+	{0x9e8, 0x2424, false},
+	{0x9e9, 0x240b, false},
+	{0x9ea, 0x2518, false},
+	{0x9eb, 0x2510, false},
+	{0x9ec, 0x250c, false},
+	{0x9ed, 0x2514, false},
+	{0x9ee, 0x253c, false},
+	{0x9ef, 0x23ba, false},
+	{0x9f0, 0x23bb, false},
+	{0x9f1, 0x2500, false},
+	{0x9f2, 0x23bc, false},
+	{0x9f3, 0x23bd, false},
+	{0x9f4, 0x251c, false},
+	{0x9f5, 0x2524, false},
+	{0x9f6, 0x2534, false},
+	{0x9f7, 0x252c, false},
+	{0x9f8, 0x2502, false},
+	{0xaa1, 0x2003, false},
+	{0xaa2, 0x2002, false},
+	{0xaa3, 0x2004, false},
+	{0xaa4, 0x2005, false},
+	{0xaa5, 0x2007, false},
+	{0xaa6, 0x2008, false},
+	{0xaa7, 0x2009, false},
+	{0xaa8, 0x200a, false},
+	{0xaa9, 0x2014, false},
+	{0xaaa, 0x2013, false},
+	{0xaac, 0x2423, true},
+	{0xaae, 0x2026, false},
+	{0xaaf, 0x2025, false},
+	{0xab0, 0x2153, false},
+	{0xab1, 0x2154, false},
+	{0xab2, 0x2155, false},
+	{0xab3, 0x2156, false},
+	{0xab4, 0x2157, false},
+	{0xab5, 0x2158, false},
+	{0xab6, 0x2159, false},
+	{0xab7, 0x215a, false},
+	{0xab8, 0x2105, false},
+	{0xabb, 0x2012, false},
+	{0xabc, 0x2329, true},
+	{0xabd, 0x2e, true},
+	{0xabe, 0x232a, true},
+	{0xac3, 0x215b, false},
+	{0xac4, 0x215c, false},
+	{0xac5, 0x215d, false},
+	{0xac6, 0x215e, false},
+	{0xac9, 0x2122, false},
+	{0xaca, 0x2613, true},
+	{0xacc, 0x25c1, true},
+	{0xacd, 0x25b7, true},
+	{0xace, 0x25cb, true},
+	{0xacf, 0x25af, true},
+	{0xad0, 0x2018, false},
+	{0xad1, 0x2019, false},
+	{0xad2, 0x201c, false},
+	{0xad3, 0x201d, false},
+	{0xad4, 0x211e, false},
+	{0xad5, 0x2030, false},
+	{0xad6, 0x2032, false},
+	{0xad7, 0x2033, false},
+	{0xad9, 0x271d, false},
+	{0xadb, 0x25ac, true},
+	{0xadc, 0x25c0, true},
+	{0xadd, 0x25b6, true},
+	{0xade, 0x25cf, true},
+	{0xadf, 0x25ae, true},
+	{0xae0, 0x25e6, true},
+	{0xae1, 0x25ab, true},
+	{0xae2, 0x25ad, true},
+	{0xae3, 0x25b3, true},
+	{0xae4, 0x25bd, true},
+	{0xae5, 0x2606, true},
+	{0xae6, 0x2022, true},
+	{0xae7, 0x25aa, true},
+	{0xae8, 0x25b2, true},
+	{0xae9, 0x25bc, true},
+	{0xaea, 0x261c, true},
+	{0xaeb, 0x261e, true},
+	{0xaec, 0x2663, false},
+	{0xaed, 0x2666, false},
+	{0xaee, 0x2665, false},
+	{0xaf0, 0x2720, false},
+	{0xaf1, 0x2020, false},
+	{0xaf2, 0x2021, false},
+	{0xaf3, 0x2713, false},
+	{0xaf4, 0x2717, false},
+	{0xaf5, 0x266f, false},
+	{0xaf6, 0x266d, false},
+	{0xaf7, 0x2642, false},
+	{0xaf8, 0x2640, false},
+	{0xaf9, 0x260e, false},
+	{0xafa, 0x2315, false},
+	{0xafb, 0x2117, false},
+	{0xafc, 0x2038, false},
+	{0xafd, 0x201a, false},
+	{0xafe, 0x201e, false},
+	{0xba3, 0x3c, true},
+	{0xba6, 0x3e, true},
+	{0xba8, 0x2228, true},
+	{0xba9, 0x2227, true},
+	{0xbc0, 0xaf, true},
+	{0xbc2, 0x22a4, false},
+	{0xbc3, 0x2229, true},
+	{0xbc4, 0x230a, false},
+	{0xbc6, 0x5f, true},
+	{0xbca, 0x2218, false},
+	{0xbcc, 0x2395, false},
+	{0xbce, 0x22a5, false},
+	{0xbcf, 0x25cb, false},
+	{0xbd3, 0x2308, false},
+	{0xbd6, 0x222a, true},
+	{0xbd8, 0x2283, true},
+	{0xbda, 0x2282, true},
+	{0xbdc, 0x22a3, false},
+	{0xbfc, 0x22a2, false},
+	{0xcdf, 0x2017, false},
+	{0xce0, 0x5d0, false},
+	{0xce1, 0x5d1, false},
+	{0xce2, 0x5d2, false},
+	{0xce3, 0x5d3, false},
+	{0xce4, 0x5d4, false},
+	{0xce5, 0x5d5, false},
+	{0xce6, 0x5d6, false},
+	{0xce7, 0x5d7, false},
+	{0xce8, 0x5d8, false},
+	{0xce9, 0x5d9, false},
+	{0xcea, 0x5da, false},
+	{0xceb, 0x5db, false},
+	{0xcec, 0x5dc, false},
+	{0xced, 0x5dd, false},
+	{0xcee, 0x5de, false},
+	{0xcef, 0x5df, false},
+	{0xcf0, 0x5e0, false},
+	{0xcf1, 0x5e1, false},
+	{0xcf2, 0x5e2, false},
+	{0xcf3, 0x5e3, false},
+	{0xcf4, 0x5e4, false},
+	{0xcf5, 0x5e5, false},
+	{0xcf6, 0x5e6, false},
+	{0xcf7, 0x5e7, false},
+	{0xcf8, 0x5e8, false},
+	{0xcf9, 0x5e9, false},
+	{0xcfa, 0x5ea, false},
+	{0xda1, 0xe01, false},
+	{0xda2, 0xe02, false},
+	{0xda3, 0xe03, false},
+	{0xda4, 0xe04, false},
+	{0xda5, 0xe05, false},
+	{0xda6, 0xe06, false},
+	{0xda7, 0xe07, false},
+	{0xda8, 0xe08, false},
+	{0xda9, 0xe09, false},
+	{0xdaa, 0xe0a, false},
+	{0xdab, 0xe0b, false},
+	{0xdac, 0xe0c, false},
+	{0xdad, 0xe0d, false},
+	{0xdae, 0xe0e, false},
+	{0xdaf, 0xe0f, false},
+	{0xdb0, 0xe10, false},
+	{0xdb1, 0xe11, false},
+	{0xdb2, 0xe12, false},
+	{0xdb3, 0xe13, false},
+	{0xdb4, 0xe14, false},
+	{0xdb5, 0xe15, false},
+	{0xdb6, 0xe16, false},
+	{0xdb7, 0xe17, false},
+	{0xdb8, 0xe18, false},
+	{0xdb9, 0xe19, false},
+	{0xdba, 0xe1a, false},
+	{0xdbb, 0xe1b, false},
+	{0xdbc, 0xe1c, false},
+	{0xdbd, 0xe1d, false},
+	{0xdbe, 0xe1e, false},
+	{0xdbf, 0xe1f, false},
+	{0xdc0, 0xe20, false},
+	{0xdc1, 0xe21, false},
+	{0xdc2, 0xe22, false},
+	{0xdc3, 0xe23, false},
+	{0xdc4, 0xe24, false},
+	{0xdc5, 0xe25, false},
+	{0xdc6, 0xe26, false},
+	{0xdc7, 0xe27, false},
+	{0xdc8, 0xe28, false},
+	{0xdc9, 0xe29, false},
+	{0xdca, 0xe2a, false},
+	{0xdcb, 0xe2b, false},
+	{0xdcc, 0xe2c, false},
+	{0xdcd, 0xe2d, false},
+	{0xdce, 0xe2e, false},
+	{0xdcf, 0xe2f, false},
+	{0xdd0, 0xe30, false},
+	{0xdd1, 0xe31, false},
+	{0xdd2, 0xe32, false},
+	{0xdd3, 0xe33, false},
+	{0xdd4, 0xe34, false},
+	{0xdd5, 0xe35, false},
+	{0xdd6, 0xe36, false},
+	{0xdd7, 0xe37, false},
+	{0xdd8, 0xe38, false},
+	{0xdd9, 0xe39, false},
+	{0xdda, 0xe3a, false},
+	{0xddf, 0xe3f, false},
+	{0xde0, 0xe40, false},
+	{0xde1, 0xe41, false},
+	{0xde2, 0xe42, false},
+	{0xde3, 0xe43, false},
+	{0xde4, 0xe44, false},
+	{0xde5, 0xe45, false},
+	{0xde6, 0xe46, false},
+	{0xde7, 0xe47, false},
+	{0xde8, 0xe48, false},
+	{0xde9, 0xe49, false},
+	{0xdea, 0xe4a, false},
+	{0xdeb, 0xe4b, false},
+	{0xdec, 0xe4c, false},
+	{0xded, 0xe4d, false},
+	{0xdf0, 0xe50, false},
+	{0xdf1, 0xe51, false},
+	{0xdf2, 0xe52, false},
+	{0xdf3, 0xe53, false},
+	{0xdf4, 0xe54, false},
+	{0xdf5, 0xe55, false},
+	{0xdf6, 0xe56, false},
+	{0xdf7, 0xe57, false},
+	{0xdf8, 0xe58, false},
+	{0xdf9, 0xe59, false},
+	{0xea1, 0x3131, false},
+	{0xea2, 0x3132, false},
+	{0xea3, 0x3133, false},
+	{0xea4, 0x3134, false},
+	{0xea5, 0x3135, false},
+	{0xea6, 0x3136, false},
+	{0xea7, 0x3137, false},
+	{0xea8, 0x3138, false},
+	{0xea9, 0x3139, false},
+	{0xeaa, 0x313a, false},
+	{0xeab, 0x313b, false},
+	{0xeac, 0x313c, false},
+	{0xead, 0x313d, false},
+	{0xeae, 0x313e, false},
+	{0xeaf, 0x313f, false},
+	{0xeb0, 0x3140, false},
+	{0xeb1, 0x3141, false},
+	{0xeb2, 0x3142, false},
+	{0xeb3, 0x3143, false},
+	{0xeb4, 0x3144, false},
+	{0xeb5, 0x3145, false},
+	{0xeb6, 0x3146, false},
+	{0xeb7, 0x3147, false},
+	{0xeb8, 0x3148, false},
+	{0xeb9, 0x3149, false},
+	{0xeba, 0x314a, false},
+	{0xebb, 0x314b, false},
+	{0xebc, 0x314c, false},
+	{0xebd, 0x314d, false},
+	{0xebe, 0x314e, false},
+	{0xebf, 0x314f, false},
+	{0xec0, 0x3150, false},
+	{0xec1, 0x3151, false},
+	{0xec2, 0x3152, false},
+	{0xec3, 0x3153, false},
+	{0xec4, 0x3154, false},
+	{0xec5, 0x3155, false},
+	{0xec6, 0x3156, false},
+	{0xec7, 0x3157, false},
+	{0xec8, 0x3158, false},
+	{0xec9, 0x3159, false},
+	{0xeca, 0x315a, false},
+	{0xecb, 0x315b, false},
+	{0xecc, 0x315c, false},
+	{0xecd, 0x315d, false},
+	{0xece, 0x315e, false},
+	{0xecf, 0x315f, false},
+	{0xed0, 0x3160, false},
+	{0xed1, 0x3161, false},
+	{0xed2, 0x3162, false},
+	{0xed3, 0x3163, false},
+	{0xed4, 0x11a8, false},
+	{0xed5, 0x11a9, false},
+	{0xed6, 0x11aa, false},
+	{0xed7, 0x11ab, false},
+	{0xed8, 0x11ac, false},
+	{0xed9, 0x11ad, false},
+	{0xeda, 0x11ae, false},
+	{0xedb, 0x11af, false},
+	{0xedc, 0x11b0, false},
+	{0xedd, 0x11b1, false},
+	{0xede, 0x11b2, false},
+	{0xedf, 0x11b3, false},
+	{0xee0, 0x11b4, false},
+	{0xee1, 0x11b5, false},
+	{0xee2, 0x11b6, false},
+	{0xee3, 0x11b7, false},
+	{0xee4, 0x11b8, false},
+	{0xee5, 0x11b9, false},
+	{0xee6, 0x11ba, false},
+	{0xee7, 0x11bb, false},
+	{0xee8, 0x11bc, false},
+	{0xee9, 0x11bd, false},
+	{0xeea, 0x11be, false},
+	{0xeeb, 0x11bf, false},
+	{0xeec, 0x11c0, false},
+	{0xeed, 0x11c1, false},
+	{0xeee, 0x11c2, false},
+	{0xeef, 0x316d, false},
+	{0xef0, 0x3171, false},
+	{0xef1, 0x3178, false},
+	{0xef2, 0x317f, false},
+	{0xef3, 0x3181, false},
+	{0xef4, 0x3184, false},
+	{0xef5, 0x3186, false},
+	{0xef6, 0x318d, false},
+	{0xef7, 0x318e, false},
+	{0xef8, 0x11eb, false},
+	{0xef9, 0x11f0, false},
+	{0xefa, 0x11f9, false},
+	{0xeff, 0x20a9, true},
+	{0x13bc, 0x152, false},
+	{0x13bd, 0x153, false},
+	{0x13be, 0x178, false},
+	{0x20ac, 0x20ac, false},
+}
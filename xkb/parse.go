@@ -0,0 +1,1029 @@
+package xkb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The keymap text format is a sequence of sections, each a list of statements
+// terminated by semicolons. Statements that don't affect how keys are
+// translated, such as actions and indicators, are skipped.
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokKeyName
+	tokPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	num  uint32
+	line int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of file"
+	case tokString:
+		return strconv.Quote(t.text)
+	case tokKeyName:
+		return "<" + t.text + ">"
+	}
+	return t.text
+}
+
+// lex splits a keymap into tokens.
+func lex(src string) ([]token, error) {
+	var toks []token
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case c == '#' || c == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(src) && src[i+1] == '*':
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(src) && src[j] != '"'; j++ {
+				if src[j] == '\n' {
+					line++
+				}
+				if src[j] != '\\' || j+1 >= len(src) {
+					b.WriteByte(src[j])
+					continue
+				}
+				j++
+				switch e := src[j]; e {
+				case 'n':
+					b.WriteByte('\n')
+				case 't':
+					b.WriteByte('\t')
+				case 'r':
+					b.WriteByte('\r')
+				case 'b':
+					b.WriteByte('\b')
+				case 'f':
+					b.WriteByte('\f')
+				case 'v':
+					b.WriteByte('\v')
+				case 'e':
+					b.WriteByte(0x1b)
+				case '0', '1', '2', '3', '4', '5', '6', '7':
+					v := 0
+					k := 0
+					for ; k < 3 && j+k < len(src) && src[j+k] >= '0' && src[j+k] <= '7'; k++ {
+						v = v*8 + int(src[j+k]-'0')
+					}
+					b.WriteByte(byte(v))
+					j += k - 1
+				default:
+					b.WriteByte(e)
+				}
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			toks = append(toks, token{kind: tokString, text: b.String(), line: line})
+			i = j + 1
+		case c == '<':
+			end := strings.IndexAny(src[i+1:], ">\n")
+			if end < 0 || src[i+1+end] != '>' {
+				return nil, fmt.Errorf("line %d: unterminated key name", line)
+			}
+			toks = append(toks, token{kind: tokKeyName, text: src[i+1 : i+1+end], line: line})
+			i += end + 2
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(src) && isIdentChar(src[j]) {
+				j++
+			}
+			// Some keysym names, such as 3270_Enter, start with a digit.
+			text := src[i:j]
+			if v, err := strconv.ParseUint(text, 0, 32); err == nil {
+				toks = append(toks, token{kind: tokNumber, text: text, num: uint32(v), line: line})
+			} else {
+				toks = append(toks, token{kind: tokIdent, text: text, line: line})
+			}
+			i = j
+		case isIdentChar(c):
+			j := i
+			for j < len(src) && isIdentChar(src[j]) {
+				j++
+			}
+			toks = append(toks, token{kind: tokIdent, text: src[i:j], line: line})
+			i = j
+		case strings.IndexByte("{}[]();,=+-!~.", c) >= 0:
+			toks = append(toks, token{kind: tokPunct, text: string(c), line: line})
+			i++
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+	}
+	return append(toks, token{kind: tokEOF, line: line}), nil
+}
+
+func isIdentChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_'
+}
+
+// parser reads keymap statements into the definitions a keymap is compiled
+// from. Modifier names are resolved as they are read, so that virtual
+// modifiers are numbered in order of declaration.
+type parser struct {
+	toks []token
+	pos  int
+
+	mods       []string
+	vmodValues map[int]uint32
+
+	keycodes  map[string]uint32
+	aliases   map[string]string
+	types     []*typeDef
+	interpret interpretDef
+	interps   []*interpretDef
+	keys      []*keyDef
+	modmaps   []modmapDef
+	groups    []string
+}
+
+type typeDef struct {
+	name     string
+	mods     uint32
+	entries  []entryDef
+	numLevel int
+}
+
+type entryDef struct {
+	mods, preserve uint32
+	level          int
+}
+
+// Interpret predicates, in order of precedence.
+const (
+	matchExactly = iota
+	matchAllOf
+	matchNoneOf
+	matchAnyOf
+	matchAnyOfOrNone
+)
+
+type interpretDef struct {
+	sym          Keysym
+	pred         int
+	mods         uint32
+	vmod         int
+	levelOneOnly bool
+	repeat       bool
+}
+
+type keyDef struct {
+	name        string
+	groups      []groupDef
+	repeat      int
+	vmods       uint32
+	hasVmods    bool
+	outOfRange  int
+	redirect    int
+	defaultType string
+}
+
+type groupDef struct {
+	levels   [][]Keysym
+	typeName string
+}
+
+// Key repeat settings of keyDef.
+const (
+	repeatUndefined = iota
+	repeatYes
+	repeatNo
+)
+
+type modmapDef struct {
+	mod  int
+	keys []string
+	syms []Keysym
+}
+
+// realMods are the names of the real modifiers, by index.
+var realMods = [...]string{"Shift", "Lock", "Control", "Mod1", "Mod2", "Mod3", "Mod4", "Mod5"}
+
+func newParser(toks []token) *parser {
+	p := &parser{
+		toks:       toks,
+		vmodValues: make(map[int]uint32),
+		keycodes:   make(map[string]uint32),
+		aliases:    make(map[string]string),
+		interpret:  interpretDef{vmod: -1},
+	}
+	p.mods = append(p.mods, realMods[:]...)
+	return p
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// back returns t, the last token read, to the stream.
+func (p *parser) back(t token) {
+	if t.kind != tokEOF {
+		p.pos--
+	}
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", p.peek().line, fmt.Sprintf(format, args...))
+}
+
+// is returns whether the next token is the punctuation or identifier s.
+func (p *parser) is(s string) bool {
+	t := p.peek()
+	return (t.kind == tokPunct || t.kind == tokIdent) && t.text == s
+}
+
+// accept consumes the next token if it is s.
+func (p *parser) accept(s string) bool {
+	if p.is(s) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(s string) error {
+	if !p.accept(s) {
+		return p.errorf("expected %q, found %v", s, p.peek())
+	}
+	return nil
+}
+
+func (p *parser) expectKind(kind tokenKind, what string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		p.back(t)
+		return t, p.errorf("expected %s, found %v", what, t)
+	}
+	return t, nil
+}
+
+// skip skips to the end of the current statement, or to stop if it is found
+// outside of any brackets first. The terminator itself is not consumed.
+func (p *parser) skip(stop string) error {
+	depth := 0
+	for {
+		t := p.peek()
+		if t.kind == tokEOF {
+			return p.errorf("unexpected end of file")
+		}
+		if t.kind == tokPunct {
+			switch t.text {
+			case "{", "[", "(":
+				depth++
+			case "}", "]", ")":
+				if depth == 0 {
+					return nil
+				}
+				depth--
+			case ";":
+				if depth == 0 {
+					return nil
+				}
+			}
+			if depth == 0 && t.text == stop {
+				return nil
+			}
+		}
+		p.next()
+	}
+}
+
+// skipStatement skips a statement, including its semicolon.
+func (p *parser) skipStatement() error {
+	if err := p.skip(";"); err != nil {
+		return err
+	}
+	return p.expect(";")
+}
+
+// parseKeymap parses a complete keymap.
+func (p *parser) parseKeymap() error {
+	// Flags such as "default" may precede the keymap.
+	for p.peek().kind == tokIdent && p.peek().text != "xkb_keymap" {
+		p.next()
+	}
+	if err := p.expect("xkb_keymap"); err != nil {
+		return err
+	}
+	if p.peek().kind == tokString {
+		p.next()
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	for !p.accept("}") {
+		for p.peek().kind == tokIdent && !strings.HasPrefix(p.peek().text, "xkb_") {
+			p.next()
+		}
+		t, err := p.expectKind(tokIdent, "section")
+		if err != nil {
+			return err
+		}
+		if p.peek().kind == tokString {
+			p.next()
+		}
+		if err := p.expect("{"); err != nil {
+			return err
+		}
+
+		var parse func() error
+		switch t.text {
+		case "xkb_keycodes":
+			parse = p.parseKeycodes
+		case "xkb_types":
+			parse = p.parseTypes
+		case "xkb_compatibility", "xkb_compatibility_map", "xkb_compat", "xkb_compat_map":
+			parse = p.parseCompat
+		case "xkb_symbols":
+			parse = p.parseSymbols
+		default:
+			parse = p.skipStatement
+		}
+		for !p.accept("}") {
+			if p.accept(";") {
+				continue
+			}
+			if err := parse(); err != nil {
+				return err
+			}
+		}
+		p.accept(";")
+	}
+	p.accept(";")
+	return nil
+}
+
+func (p *parser) parseKeycodes() error {
+	t := p.peek()
+	switch {
+	case t.kind == tokKeyName:
+		p.next()
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		code, err := p.expectKind(tokNumber, "keycode")
+		if err != nil {
+			return err
+		}
+		p.keycodes[t.text] = code.num
+	case p.accept("alias"):
+		alias, err := p.expectKind(tokKeyName, "key name")
+		if err != nil {
+			return err
+		}
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		real, err := p.expectKind(tokKeyName, "key name")
+		if err != nil {
+			return err
+		}
+		p.aliases[alias.text] = real.text
+	default:
+		return p.skipStatement()
+	}
+	return p.expect(";")
+}
+
+func (p *parser) parseTypes() error {
+	switch {
+	case p.accept("virtual_modifiers"):
+		return p.parseVirtualMods()
+	case p.accept("type"):
+		name, err := p.expectKind(tokString, "type name")
+		if err != nil {
+			return err
+		}
+		def := &typeDef{name: name.text, numLevel: 1}
+		if err := p.expect("{"); err != nil {
+			return err
+		}
+		for !p.accept("}") {
+			if err := p.parseTypeStatement(def); err != nil {
+				return err
+			}
+		}
+		p.types = append(p.types, def)
+		return p.expect(";")
+	}
+	return p.skipStatement()
+}
+
+func (p *parser) parseTypeStatement(def *typeDef) error {
+	field, err := p.expectKind(tokIdent, "type field")
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(field.text) {
+	case "modifiers":
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		if def.mods, err = p.parseMods(); err != nil {
+			return err
+		}
+	case "map":
+		if err := p.expect("["); err != nil {
+			return err
+		}
+		mods, err := p.parseMods()
+		if err != nil {
+			return err
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		level, err := p.parseLevel()
+		if err != nil {
+			return err
+		}
+		// A later entry for the same modifiers replaces an earlier one.
+		i := 0
+		for ; i < len(def.entries) && def.entries[i].mods != mods; i++ {
+		}
+		if i == len(def.entries) {
+			def.entries = append(def.entries, entryDef{mods: mods})
+		}
+		def.entries[i].level = level
+		if level+1 > def.numLevel {
+			def.numLevel = level + 1
+		}
+	case "preserve":
+		if err := p.expect("["); err != nil {
+			return err
+		}
+		mods, err := p.parseMods()
+		if err != nil {
+			return err
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		preserve, err := p.parseMods()
+		if err != nil {
+			return err
+		}
+		i := 0
+		for ; i < len(def.entries) && def.entries[i].mods != mods; i++ {
+		}
+		if i == len(def.entries) {
+			def.entries = append(def.entries, entryDef{mods: mods})
+		}
+		def.entries[i].preserve = preserve
+	case "level_name", "levelname":
+		if err := p.expect("["); err != nil {
+			return err
+		}
+		if _, err := p.parseLevel(); err != nil {
+			return err
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+		return p.skipStatement()
+	default:
+		return p.skipStatement()
+	}
+	return p.expect(";")
+}
+
+// parseVirtualMods parses the list of a virtual_modifiers statement, where
+// each modifier may be given a mapping.
+func (p *parser) parseVirtualMods() error {
+	for {
+		name, err := p.expectKind(tokIdent, "modifier name")
+		if err != nil {
+			return err
+		}
+		index := p.mod(name.text)
+		if index < 0 {
+			index = len(p.mods)
+			p.mods = append(p.mods, name.text)
+		}
+		if p.accept("=") {
+			mods, err := p.parseMods()
+			if err != nil {
+				return err
+			}
+			p.vmodValues[index] = mods
+		}
+		if !p.accept(",") {
+			break
+		}
+	}
+	return p.expect(";")
+}
+
+// mod returns the index of a modifier, or -1 if there is none with that name.
+// Real modifier names are not case sensitive.
+func (p *parser) mod(name string) int {
+	for i, real := range realMods {
+		if strings.EqualFold(name, real) {
+			return i
+		}
+	}
+	for i := len(realMods); i < len(p.mods); i++ {
+		if p.mods[i] == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseMods parses a modifier mask, such as Shift+LevelThree.
+func (p *parser) parseMods() (uint32, error) {
+	var mask uint32
+	for {
+		t := p.next()
+		switch {
+		case t.kind == tokNumber:
+			mask |= t.num
+		case t.kind == tokIdent && strings.EqualFold(t.text, "none"):
+		case t.kind == tokIdent && strings.EqualFold(t.text, "all"):
+			mask |= 0xffffffff
+		case t.kind == tokIdent:
+			index := p.mod(t.text)
+			if index < 0 {
+				return 0, p.errorf("unknown modifier %q", t.text)
+			}
+			if index < 32 {
+				mask |= 1 << index
+			}
+		default:
+			p.back(t)
+			return 0, p.errorf("expected modifiers, found %v", t)
+		}
+		if !p.accept("+") && !p.accept("|") {
+			return mask, nil
+		}
+	}
+}
+
+// parseLevel parses a shift level, such as Level2 or 2, returning it counting
+// from zero.
+func (p *parser) parseLevel() (int, error) {
+	t := p.next()
+	var n uint64
+	var err error
+	switch {
+	case t.kind == tokNumber:
+		n = uint64(t.num)
+	case t.kind == tokIdent && len(t.text) > 5 && strings.EqualFold(t.text[:5], "level"):
+		n, err = strconv.ParseUint(t.text[5:], 10, 8)
+	default:
+		err = fmt.Errorf("invalid")
+	}
+	if err != nil || n < 1 {
+		p.back(t)
+		return 0, p.errorf("expected level, found %v", t)
+	}
+	return int(n - 1), nil
+}
+
+// parseGroup parses a group index, such as Group2 or 2, returning it counting
+// from zero.
+func (p *parser) parseGroup() (int, error) {
+	t := p.next()
+	var n uint64
+	var err error
+	switch {
+	case t.kind == tokNumber:
+		n = uint64(t.num)
+	case t.kind == tokIdent && len(t.text) > 5 && strings.EqualFold(t.text[:5], "group"):
+		n, err = strconv.ParseUint(t.text[5:], 10, 8)
+	default:
+		err = fmt.Errorf("invalid")
+	}
+	if err != nil || n < 1 {
+		p.back(t)
+		return 0, p.errorf("expected group, found %v", t)
+	}
+	return int(n - 1), nil
+}
+
+// parseBool parses a boolean value.
+func (p *parser) parseBool() (bool, error) {
+	t := p.next()
+	switch strings.ToLower(t.text) {
+	case "true", "yes", "on":
+		return true, nil
+	case "false", "no", "off":
+		return false, nil
+	}
+	p.back(t)
+	return false, p.errorf("expected boolean, found %v", t)
+}
+
+// parseKeysym parses a keysym: a name, a number, or a digit.
+func (p *parser) parseKeysym() (Keysym, error) {
+	t := p.next()
+	switch t.kind {
+	case tokIdent:
+		if strings.EqualFold(t.text, "any") || strings.EqualFold(t.text, "nosymbol") {
+			return NoSymbol, nil
+		}
+		if strings.EqualFold(t.text, "voidsymbol") {
+			return KeyVoidSymbol, nil
+		}
+		return KeysymFromName(t.text), nil
+	case tokNumber:
+		if len(t.text) == 1 {
+			return Keysym('0' + t.num), nil
+		}
+		return Keysym(t.num), nil
+	}
+	p.back(t)
+	return NoSymbol, p.errorf("expected keysym, found %v", t)
+}
+
+func (p *parser) parseCompat() error {
+	switch {
+	case p.accept("virtual_modifiers"):
+		return p.parseVirtualMods()
+	case p.accept("interpret"):
+		if p.accept(".") {
+			return p.parseInterpretField(&p.interpret)
+		}
+		return p.parseInterpret()
+	}
+	return p.skipStatement()
+}
+
+// parseInterpret parses an interpret statement, such as
+//
+//	interpret Num_Lock+AnyOf(all) { virtualModifier= NumLock; };
+func (p *parser) parseInterpret() error {
+	def := p.interpret
+	def.pred = matchAnyOfOrNone
+	def.mods = 0xffffffff
+
+	sym, err := p.parseKeysym()
+	if err != nil {
+		return err
+	}
+	def.sym = sym
+
+	if p.accept("+") {
+		if p.peek().kind == tokIdent && p.toks[p.pos+1].text == "(" {
+			pred := p.next()
+			switch strings.ToLower(pred.text) {
+			case "noneof":
+				def.pred = matchNoneOf
+			case "anyofornone":
+				def.pred = matchAnyOfOrNone
+			case "anyof":
+				def.pred = matchAnyOf
+			case "allof":
+				def.pred = matchAllOf
+			case "exactly":
+				def.pred = matchExactly
+			default:
+				p.back(pred)
+				return p.errorf("unknown predicate %v", pred)
+			}
+			p.next()
+			if def.mods, err = p.parseMods(); err != nil {
+				return err
+			}
+			if err := p.expect(")"); err != nil {
+				return err
+			}
+		} else {
+			def.pred = matchExactly
+			if def.mods, err = p.parseMods(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.accept("}") {
+		if err := p.parseInterpretField(&def); err != nil {
+			return err
+		}
+	}
+	p.interps = append(p.interps, &def)
+	return p.expect(";")
+}
+
+// parseInterpretField parses a field of an interpret statement.
+func (p *parser) parseInterpretField(def *interpretDef) error {
+	field, err := p.expectKind(tokIdent, "interpret field")
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(field.text) {
+	case "virtualmodifier", "virtualmod":
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		name, err := p.expectKind(tokIdent, "virtual modifier")
+		if err != nil {
+			return err
+		}
+		if def.vmod = p.mod(name.text); def.vmod < 0 {
+			return p.errorf("unknown modifier %q", name.text)
+		}
+	case "usemodmapmods", "usemodmap":
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		t := p.next()
+		switch strings.ToLower(t.text) {
+		case "level1", "levelone":
+			def.levelOneOnly = true
+		case "anylevel", "any":
+			def.levelOneOnly = false
+		default:
+			p.back(t)
+			return p.errorf("expected level, found %v", t)
+		}
+	case "repeat":
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		if def.repeat, err = p.parseBool(); err != nil {
+			return err
+		}
+	default:
+		return p.skipStatement()
+	}
+	return p.expect(";")
+}
+
+func (p *parser) parseSymbols() error {
+	switch {
+	case p.accept("virtual_modifiers"):
+		return p.parseVirtualMods()
+	case p.accept("key"):
+		if p.is(".") {
+			return p.skipStatement()
+		}
+		return p.parseKey()
+	case p.accept("modifier_map") || p.accept("modmap") || p.accept("mod_map"):
+		return p.parseModmap()
+	case p.accept("name"):
+		if err := p.expect("["); err != nil {
+			return err
+		}
+		group, err := p.parseGroup()
+		if err != nil {
+			return err
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		name, err := p.expectKind(tokString, "group name")
+		if err != nil {
+			return err
+		}
+		for len(p.groups) <= group {
+			p.groups = append(p.groups, "")
+		}
+		p.groups[group] = name.text
+		return p.expect(";")
+	}
+	return p.skipStatement()
+}
+
+// parseKey parses a key statement, such as
+//
+//	key <AD01> { type= "ALPHABETIC", symbols[Group1]= [ q, Q ] };
+func (p *parser) parseKey() error {
+	name, err := p.expectKind(tokKeyName, "key name")
+	if err != nil {
+		return err
+	}
+	def := &keyDef{name: name.text}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	// Bare symbol lists fill groups in order.
+	nextGroup := 0
+	for !p.accept("}") {
+		if p.is("[") {
+			levels, err := p.parseLevels()
+			if err != nil {
+				return err
+			}
+			def.group(nextGroup).levels = levels
+			nextGroup++
+		} else if err := p.parseKeyField(def); err != nil {
+			return err
+		}
+		if !p.accept(",") && !p.is("}") {
+			return p.errorf("expected \",\" or \"}\", found %v", p.peek())
+		}
+	}
+	p.keys = append(p.keys, def)
+	return p.expect(";")
+}
+
+func (def *keyDef) group(index int) *groupDef {
+	for len(def.groups) <= index {
+		def.groups = append(def.groups, groupDef{})
+	}
+	return &def.groups[index]
+}
+
+func (p *parser) parseKeyField(def *keyDef) error {
+	field, err := p.expectKind(tokIdent, "key field")
+	if err != nil {
+		return err
+	}
+
+	// An optional group index, as in symbols[Group1].
+	group := -1
+	if p.accept("[") {
+		if group, err = p.parseGroup(); err != nil {
+			return err
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+	}
+	if err := p.expect("="); err != nil {
+		return err
+	}
+
+	switch strings.ToLower(field.text) {
+	case "symbols":
+		if group < 0 {
+			group = 0
+		}
+		levels, err := p.parseLevels()
+		if err != nil {
+			return err
+		}
+		def.group(group).levels = levels
+	case "type":
+		name, err := p.expectKind(tokString, "type name")
+		if err != nil {
+			return err
+		}
+		if group < 0 {
+			def.defaultType = name.text
+		} else {
+			def.group(group).typeName = name.text
+		}
+	case "vmods", "virtualmods", "virtualmodifiers":
+		if def.vmods, err = p.parseMods(); err != nil {
+			return err
+		}
+		def.hasVmods = true
+	case "repeat", "repeats":
+		if p.accept("default") {
+			def.repeat = repeatUndefined
+		} else if repeat, err := p.parseBool(); err != nil {
+			return err
+		} else if repeat {
+			def.repeat = repeatYes
+		} else {
+			def.repeat = repeatNo
+		}
+	case "groupswrap", "wrapgroups":
+		if wrap, err := p.parseBool(); err != nil {
+			return err
+		} else if wrap {
+			def.outOfRange = rangeWrap
+		} else {
+			def.outOfRange = rangeSaturate
+		}
+	case "groupsclamp", "clampgroups":
+		if clamp, err := p.parseBool(); err != nil {
+			return err
+		} else if clamp {
+			def.outOfRange = rangeSaturate
+		} else {
+			def.outOfRange = rangeWrap
+		}
+	case "groupsredirect", "redirectgroups":
+		if def.redirect, err = p.parseGroup(); err != nil {
+			return err
+		}
+		def.outOfRange = rangeRedirect
+	default:
+		return p.skip(",")
+	}
+	return nil
+}
+
+// parseLevels parses the symbols of a group, such as [ a, A, { x, y } ],
+// where braces hold several keysyms for one level.
+func (p *parser) parseLevels() ([][]Keysym, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+	var levels [][]Keysym
+	for !p.accept("]") {
+		var syms []Keysym
+		if p.accept("{") {
+			for !p.accept("}") {
+				sym, err := p.parseKeysym()
+				if err != nil {
+					return nil, err
+				}
+				if sym != NoSymbol {
+					syms = append(syms, sym)
+				}
+				p.accept(",")
+			}
+		} else {
+			sym, err := p.parseKeysym()
+			if err != nil {
+				return nil, err
+			}
+			if sym != NoSymbol {
+				syms = append(syms, sym)
+			}
+		}
+		levels = append(levels, syms)
+		if !p.accept(",") && !p.is("]") {
+			return nil, p.errorf("expected \",\" or \"]\", found %v", p.peek())
+		}
+	}
+	return levels, nil
+}
+
+// parseModmap parses a modifier_map statement, which lists the keys bound to
+// a real modifier, by name or by keysym.
+func (p *parser) parseModmap() error {
+	name, err := p.expectKind(tokIdent, "modifier name")
+	if err != nil {
+		return err
+	}
+	def := modmapDef{mod: p.mod(name.text)}
+	if def.mod < 0 || def.mod >= len(realMods) {
+		return p.errorf("%q is not a real modifier", name.text)
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.accept("}") {
+		if t := p.peek(); t.kind == tokKeyName {
+			p.next()
+			def.keys = append(def.keys, t.text)
+		} else {
+			sym, err := p.parseKeysym()
+			if err != nil {
+				return err
+			}
+			def.syms = append(def.syms, sym)
+		}
+		p.accept(",")
+	}
+	p.modmaps = append(p.modmaps, def)
+	return p.expect(";")
+}
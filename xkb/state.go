@@ -0,0 +1,244 @@
+package xkb
+
+import "unicode/utf8"
+
+// State is the state of a keyboard: the active modifiers and group. Wayland
+// compositors track the state themselves and send it to clients, which set
+// it with UpdateMask.
+type State struct {
+	km *Keymap
+
+	depressed, latched, locked uint32
+	group                      int32
+
+	// mods is the effective mask of real modifiers.
+	mods uint32
+}
+
+// NewState returns the state of a keyboard with a keymap, with no modifiers
+// active and the first group.
+func NewState(km *Keymap) *State {
+	return &State{km: km}
+}
+
+// Keymap returns the keymap of the state.
+func (s *State) Keymap() *Keymap {
+	return s.km
+}
+
+// UpdateMask sets the modifiers and group, as sent by wl_keyboard.modifiers.
+// Modifier masks may include virtual modifiers, by index.
+func (s *State) UpdateMask(depressed, latched, locked, group uint32) {
+	s.depressed = s.km.resolve(depressed)
+	s.latched = s.km.resolve(latched)
+	s.locked = s.km.resolve(locked)
+	s.mods = s.depressed | s.latched | s.locked
+	s.group = int32(group)
+}
+
+// Group returns the effective group.
+func (s *State) Group() int {
+	n := s.km.Groups()
+	if n == 0 {
+		return 0
+	}
+	return wrapGroup(int(s.group), n)
+}
+
+// ModActive returns whether a modifier, such as "Shift" or "NumLock", is
+// active.
+func (s *State) ModActive(name string) bool {
+	mask := s.km.ModMask(name)
+	return mask != 0 && s.mods&mask == mask
+}
+
+// Keysyms returns the keysyms a key produces in the current state, without
+// the transformations that Lock and Control apply. Most keys produce at most
+// one keysym.
+func (s *State) Keysyms(keycode uint32) []Keysym {
+	k := s.km.keys[keycode]
+	if k == nil {
+		return nil
+	}
+	group, ok := s.keyGroup(k)
+	if !ok {
+		return nil
+	}
+	entry := s.entry(k.groups[group].typ)
+	level := 0
+	if entry != nil {
+		level = entry.level
+	}
+	if level >= len(k.groups[group].levels) {
+		return nil
+	}
+	return k.groups[group].levels[level]
+}
+
+// Keysym returns the keysym a key produces in the current state, or NoSymbol
+// if it produces none or several. If Lock is active and the key doesn't use
+// it to choose a level, the keysym is converted to uppercase.
+func (s *State) Keysym(keycode uint32) Keysym {
+	syms := s.Keysyms(keycode)
+	if len(syms) != 1 {
+		return NoSymbol
+	}
+	sym := syms[0]
+	if s.lockActive(keycode) {
+		sym = sym.ToUpper()
+	}
+	return sym
+}
+
+// Text returns the text a key produces in the current state, or "" if it
+// produces none. Lock converts letters to uppercase as in Keysym, and
+// Control converts characters to control characters, so Control+C produces
+// "\x03".
+func (s *State) Text(keycode uint32) string {
+	syms := s.Keysyms(keycode)
+	if len(syms) == 0 {
+		return ""
+	}
+	upper := s.lockActive(keycode)
+	var buf []byte
+	for _, sym := range syms {
+		if upper {
+			sym = sym.ToUpper()
+		}
+		r := sym.Rune()
+		if r == 0 {
+			continue
+		}
+		buf = append(buf, string(r)...)
+	}
+	if len(syms) == 1 && len(buf) > 0 && s.controlActive(keycode) {
+		r, _ := utf8.DecodeRune(buf)
+		if r >= 0x80 {
+			// Control shortcuts use the Latin letters of another group, so
+			// that Control+C works in non-Latin layouts.
+			r = s.latinRune(keycode)
+		}
+		if r != 0 && r < 0x80 {
+			buf = []byte(string(toControl(r)))
+		}
+	}
+	return string(buf)
+}
+
+// latinRune returns the first ASCII character a key produces in any group,
+// with the current modifiers, or zero if it produces none.
+func (s *State) latinRune(keycode uint32) rune {
+	k := s.km.keys[keycode]
+	for _, group := range k.groups {
+		level := 0
+		if entry := s.entry(group.typ); entry != nil {
+			level = entry.level
+		}
+		if level >= len(group.levels) || len(group.levels[level]) != 1 {
+			continue
+		}
+		if r := group.levels[level][0].Rune(); r != 0 && r < 0x80 {
+			return r
+		}
+	}
+	return 0
+}
+
+// ConsumedMods returns the real modifiers that were used to choose the level
+// of a key in the current state, so that shortcuts can ignore them: Shift is
+// consumed by Shift+a, which produces "A".
+func (s *State) ConsumedMods(keycode uint32) uint32 {
+	k := s.km.keys[keycode]
+	if k == nil {
+		return 0
+	}
+	group, ok := s.keyGroup(k)
+	if !ok {
+		return 0
+	}
+	t := k.groups[group].typ
+	consumed := t.mods
+	if entry := s.entry(t); entry != nil {
+		consumed &^= entry.preserve
+	}
+	return consumed
+}
+
+// Mods returns the effective mask of real modifiers.
+func (s *State) Mods() uint32 {
+	return s.mods
+}
+
+// lockActive returns whether Lock is active and not used by a key.
+func (s *State) lockActive(keycode uint32) bool {
+	return s.mods&(1<<modLock) != 0 && s.ConsumedMods(keycode)&(1<<modLock) == 0
+}
+
+// controlActive returns whether Control is active and not used by a key.
+func (s *State) controlActive(keycode uint32) bool {
+	return s.mods&(1<<modControl) != 0 && s.ConsumedMods(keycode)&(1<<modControl) == 0
+}
+
+// keyGroup returns the group to use for a key, which may have fewer groups
+// than the keymap.
+func (s *State) keyGroup(k *key) (int, bool) {
+	n := len(k.groups)
+	if n == 0 {
+		return 0, false
+	}
+	group := int(s.group)
+	if s.km.Groups() > 0 {
+		group = wrapGroup(group, s.km.Groups())
+	}
+	if group < n {
+		return group, true
+	}
+	switch k.outOfRange {
+	case rangeSaturate:
+		return n - 1, true
+	case rangeRedirect:
+		if k.redirect >= n {
+			return 0, true
+		}
+		return k.redirect, true
+	}
+	return wrapGroup(group, n), true
+}
+
+// entry returns the entry of a type that matches the current modifiers, or
+// nil if none does, which means the first level.
+func (s *State) entry(t *keyType) *typeEntry {
+	active := s.mods & t.mods
+	for i := range t.entries {
+		if t.entries[i].mods == active {
+			return &t.entries[i]
+		}
+	}
+	return nil
+}
+
+func wrapGroup(group, n int) int {
+	group %= n
+	if group < 0 {
+		group += n
+	}
+	return group
+}
+
+// toControl returns the control character for an ASCII character, as
+// produced with Control held.
+func toControl(r rune) rune {
+	switch {
+	case r >= '@' && r < '\x7f' || r == ' ':
+		return r & 0x1f
+	case r == '2':
+		return 0
+	case r >= '3' && r <= '7':
+		return r - ('3' - 0x1b)
+	case r == '8':
+		return 0x7f
+	case r == '/':
+		return 0x1f
+	}
+	return r
+}
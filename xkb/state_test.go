@@ -0,0 +1,161 @@
+package xkb
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// The keymaps in testdata were compiled by libxkbcommon from the evdev rules with
+// the pc105 model and no options, and the expected results below are those of
+// libxkbcommon for the same keymaps. Keycodes are evdev keycodes plus 8.
+const (
+	keyOne       = 10
+	keyTwo       = 11
+	keyMinus     = 20
+	keyQ         = 24
+	keyE         = 26
+	keyY         = 29
+	keyReturn    = 36
+	keyA         = 38
+	keySemicolon = 47
+	keyGrave     = 49
+	keyC         = 54
+	keyKP1       = 87
+)
+
+func loadKeymap(t *testing.T, layout string) *Keymap {
+	t.Helper()
+	src, err := os.ReadFile(filepath.Join("testdata", layout+".xkb"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	km, err := ParseKeymap(src)
+	if err != nil {
+		t.Fatalf("parsing %s keymap: %v", layout, err)
+	}
+	return km
+}
+
+// modMask returns the mask of a "+" separated list of modifier names.
+func modMask(t *testing.T, km *Keymap, names string) uint32 {
+	t.Helper()
+	var mask uint32
+	if names == "" {
+		return 0
+	}
+	for _, name := range strings.Split(names, "+") {
+		m := km.ModMask(name)
+		if m == 0 {
+			t.Fatalf("modifier %s is not bound", name)
+		}
+		mask |= m
+	}
+	return mask
+}
+
+func TestState(t *testing.T) {
+	tests := []struct {
+		layout   string
+		keycode  uint32
+		mods     string // depressed, except Lock, which is locked
+		keysym   string
+		text     string
+		consumed string
+	}{
+		{"us", keyA, "", "a", "a", "Shift+Lock"},
+		{"us", keyA, "Shift", "A", "A", "Shift+Lock"},
+		{"us", keyA, "Lock", "A", "A", "Shift+Lock"},
+		{"us", keyA, "Shift+Lock", "a", "a", "Shift+Lock"},
+		{"us", keyA, "LevelThree", "a", "a", "Shift+Lock"},
+		{"us", keyA, "Control", "a", "\x01", "Shift+Lock"},
+		{"us", keyA, "Shift+Control", "A", "\x01", "Shift+Lock"},
+		{"us", keyC, "Control", "c", "\x03", "Shift+Lock"},
+		{"us", keyTwo, "", "2", "2", "Shift"},
+		{"us", keyTwo, "Shift", "at", "@", "Shift"},
+		{"us", keyTwo, "Lock", "2", "2", "Shift"},
+		{"us", keyTwo, "Control", "2", "\x00", "Shift"},
+		{"us", keyTwo, "Shift+Control", "at", "\x00", "Shift"},
+		{"us", keySemicolon, "Shift", "colon", ":", "Shift"},
+		{"us", keySemicolon, "Control", "semicolon", ";", "Shift"},
+		{"us", keyReturn, "", "Return", "\r", ""},
+		{"us", keyReturn, "Control", "Return", "\r", ""},
+		{"us", keyKP1, "", "KP_End", "", "Shift+NumLock"},
+		{"us", keyKP1, "Shift", "KP_End", "", "Shift+NumLock"},
+
+		{"de", keyY, "", "z", "z", "Shift+Lock+LevelThree"},
+		{"de", keyY, "Shift", "Z", "Z", "Shift+Lock+LevelThree"},
+		{"de", keyY, "Lock", "Z", "Z", "Shift+Lock+LevelThree"},
+		{"de", keyY, "LevelThree", "leftarrow", "←", "Shift+Lock+LevelThree"},
+		{"de", keyY, "Shift+LevelThree", "yen", "¥", "Shift+Lock+LevelThree"},
+		{"de", keyY, "Control", "z", "\x1a", "Shift+Lock+LevelThree"},
+		{"de", keyQ, "LevelThree", "at", "@", "Shift+Lock+LevelThree"},
+		{"de", keyQ, "Shift+LevelThree", "Greek_OMEGA", "Ω", "Shift+Lock+LevelThree"},
+		{"de", keyE, "LevelThree", "EuroSign", "€", "Shift+Lock+LevelThree"},
+		{"de", keyTwo, "Shift", "quotedbl", "\"", "Shift+LevelThree"},
+		{"de", keyTwo, "Lock", "2", "2", "Shift+LevelThree"},
+		{"de", keyTwo, "LevelThree", "twosuperior", "²", "Shift+LevelThree"},
+		{"de", keyMinus, "", "ssharp", "ß", "Shift+Lock+LevelThree"},
+		{"de", keyMinus, "Lock", "U1E9E", "ẞ", "Shift+Lock+LevelThree"},
+		{"de", keyMinus, "Shift", "question", "?", "Shift+Lock+LevelThree"},
+		{"de", keyMinus, "Shift+Lock", "question", "?", "Shift+Lock+LevelThree"},
+		{"de", keyMinus, "LevelThree", "backslash", "\\", "Shift+Lock+LevelThree"},
+		{"de", keyMinus, "Control", "ssharp", "ß", "Shift+Lock+LevelThree"},
+		{"de", keySemicolon, "", "odiaeresis", "ö", "Shift+Lock+LevelThree"},
+		{"de", keySemicolon, "Lock", "Odiaeresis", "Ö", "Shift+Lock+LevelThree"},
+		{"de", keySemicolon, "LevelThree", "dead_doubleacute", "", "Shift+Lock+LevelThree"},
+
+		{"fr", keyOne, "", "ampersand", "&", "Shift+LevelThree"},
+		{"fr", keyOne, "Shift", "1", "1", "Shift+LevelThree"},
+		{"fr", keyOne, "Lock", "ampersand", "&", "Shift+LevelThree"},
+		{"fr", keyTwo, "", "eacute", "é", "Shift+LevelThree"},
+		{"fr", keyTwo, "Lock", "Eacute", "É", "Shift+LevelThree"},
+		{"fr", keyTwo, "Shift+Lock", "2", "2", "Shift+LevelThree"},
+		{"fr", keyTwo, "LevelThree", "asciitilde", "~", "Shift+LevelThree"},
+		{"fr", keyTwo, "Control", "eacute", "é", "Shift+LevelThree"},
+		{"fr", keyQ, "", "a", "a", "Shift+Lock+LevelThree"},
+		{"fr", keyQ, "LevelThree", "ae", "æ", "Shift+Lock+LevelThree"},
+		{"fr", keyQ, "Shift+LevelThree", "AE", "Æ", "Shift+Lock+LevelThree"},
+		{"fr", keyQ, "Control", "a", "\x01", "Shift+Lock+LevelThree"},
+		{"fr", keyA, "", "q", "q", "Shift+Lock+LevelThree"},
+		{"fr", keyA, "Control", "q", "\x11", "Shift+Lock+LevelThree"},
+		{"fr", keyE, "Shift+LevelThree", "cent", "¢", "Shift+Lock+LevelThree"},
+
+		{"ru", keyQ, "", "Cyrillic_shorti", "й", "Shift+Lock"},
+		{"ru", keyQ, "Shift", "Cyrillic_SHORTI", "Й", "Shift+Lock"},
+		{"ru", keyQ, "Lock", "Cyrillic_SHORTI", "Й", "Shift+Lock"},
+		{"ru", keyQ, "Shift+Lock", "Cyrillic_shorti", "й", "Shift+Lock"},
+		{"ru", keyQ, "LevelThree", "Cyrillic_shorti", "й", "Shift+Lock"},
+		{"ru", keyQ, "Control", "Cyrillic_shorti", "й", "Shift+Lock"},
+		{"ru", keyC, "", "Cyrillic_es", "с", "Shift+Lock"},
+		{"ru", keyC, "Shift+Control", "Cyrillic_ES", "С", "Shift+Lock"},
+		{"ru", keyGrave, "", "Cyrillic_io", "ё", "Shift+Lock"},
+		{"ru", keyGrave, "Lock", "Cyrillic_IO", "Ё", "Shift+Lock"},
+		{"ru", keyTwo, "Shift", "quotedbl", "\"", "Shift"},
+		{"ru", keyTwo, "Control", "2", "\x00", "Shift"},
+	}
+
+	keymaps := make(map[string]*Keymap)
+	for _, test := range tests {
+		km := keymaps[test.layout]
+		if km == nil {
+			km = loadKeymap(t, test.layout)
+			keymaps[test.layout] = km
+		}
+		mods := modMask(t, km, test.mods)
+		lock := km.ModMask("Lock")
+
+		s := NewState(km)
+		s.UpdateMask(mods&^lock, 0, mods&lock, 0)
+		if got := s.Keysym(test.keycode).Name(); got != test.keysym {
+			t.Errorf("%s: keysym of %d with %q is %s, want %s", test.layout, test.keycode, test.mods, got, test.keysym)
+		}
+		if got := s.Text(test.keycode); got != test.text {
+			t.Errorf("%s: text of %d with %q is %q, want %q", test.layout, test.keycode, test.mods, got, test.text)
+		}
+		if got, want := s.ConsumedMods(test.keycode), modMask(t, km, test.consumed); got != want {
+			t.Errorf("%s: consumed modifiers of %d with %q are %#x, want %#x", test.layout, test.keycode, test.mods, got, want)
+		}
+	}
+}